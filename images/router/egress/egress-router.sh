@@ -28,6 +28,16 @@ if ! ip route get ${EGRESS_DESTINATION} | grep -q macvlan0; then
     iptables -t nat -A POSTROUTING -j SNAT --to-source ${EGRESS_SOURCE}
 fi
 
+# In an HA pair the standby configures itself fully, but does not claim the shared
+# MAC/IP until the master promotes it by updating the role annotation, which the
+# pod observes through a downward API volume.
+if [ "${EGRESS_ROUTER_ROLE:-active}" = "standby" ]; then
+    role_file=${EGRESS_ROUTER_ROLE_FILE:-/etc/egress-router/annotations}
+    while ! grep -q 'egress-router-role="active"' "${role_file}" 2>/dev/null; do
+        sleep 1
+    done
+fi
+
 # Update neighbor ARP caches in case another node previously had the IP. (This is
 # the same code ifup uses.)
 arping -q -A -c 1 -I macvlan0 ${EGRESS_SOURCE}
@@ -14,6 +14,10 @@ const (
 	// If present, this extra value is used as the preferred username
 	IdentityPreferredUsernameKey = "preferred_username"
 
+	// IdentityProviderKey is the key for the names of the identity providers that
+	// produced the identities bound to a user, in a user.Info's Extra map
+	IdentityProviderKey = "authentication.openshift.io/identity-provider"
+
 	ImpersonateUserHeader      = "Impersonate-User"
 	ImpersonateGroupHeader     = "Impersonate-Group"
 	ImpersonateUserScopeHeader = "Impersonate-User-Scope"
@@ -0,0 +1,163 @@
+package lockout
+
+import (
+	"fmt"
+	"net"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/golang/glog"
+
+	"k8s.io/kubernetes/pkg/auth/user"
+
+	"github.com/openshift/origin/pkg/auth/authenticator"
+)
+
+// tracker counts consecutive authentication failures per key and enforces a temporary
+// lockout once the configured threshold is reached.
+type tracker struct {
+	maxFailures  int
+	lockDuration time.Duration
+
+	lock    sync.Mutex
+	entries map[string]*entry
+
+	// now is overridable for testing
+	now func() time.Time
+}
+
+type entry struct {
+	failures    int
+	lockedUntil time.Time
+}
+
+func newTracker(maxFailures int, lockDuration time.Duration) *tracker {
+	return &tracker{
+		maxFailures:  maxFailures,
+		lockDuration: lockDuration,
+		entries:      map[string]*entry{},
+		now:          time.Now,
+	}
+}
+
+// locked returns true while the key is locked out. Expired lockouts are forgotten.
+func (t *tracker) locked(key string) bool {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	e, ok := t.entries[key]
+	if !ok {
+		return false
+	}
+	if e.lockedUntil.IsZero() {
+		return false
+	}
+	if t.now().Before(e.lockedUntil) {
+		return true
+	}
+	delete(t.entries, key)
+	return false
+}
+
+// failure records a failed attempt for the key and returns true if this failure
+// triggered a lockout.
+func (t *tracker) failure(key string) bool {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	e, ok := t.entries[key]
+	if !ok {
+		e = &entry{}
+		t.entries[key] = e
+	}
+	e.failures++
+	if e.failures >= t.maxFailures {
+		e.failures = 0
+		e.lockedUntil = t.now().Add(t.lockDuration)
+		return true
+	}
+	return false
+}
+
+// success forgets any recorded failures for the key.
+func (t *tracker) success(key string) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	delete(t.entries, key)
+}
+
+// lockoutPasswordAuthenticator wraps another password authenticator and temporarily
+// locks out user names with too many consecutive failed logins.
+type lockoutPasswordAuthenticator struct {
+	delegate authenticator.Password
+	failures *tracker
+}
+
+// New creates a password authenticator that delegates to another authenticator and
+// locks a user name out for lockDuration after maxFailures consecutive failed logins.
+func New(delegate authenticator.Password, maxFailures int, lockDuration time.Duration) authenticator.Password {
+	return &lockoutPasswordAuthenticator{
+		delegate: delegate,
+		failures: newTracker(maxFailures, lockDuration),
+	}
+}
+
+func (a *lockoutPasswordAuthenticator) AuthenticatePassword(username, password string) (user.Info, bool, error) {
+	if a.failures.locked(username) {
+		glog.Warningf("AUDIT: rejected login for locked out user %q", username)
+		return nil, false, fmt.Errorf("too many failed login attempts for %q, try again later", username)
+	}
+	identity, ok, err := a.delegate.AuthenticatePassword(username, password)
+	switch {
+	case err == nil && ok:
+		a.failures.success(username)
+	case err == nil && !ok:
+		if a.failures.failure(username) {
+			glog.Warningf("AUDIT: locked out user %q after too many failed login attempts", username)
+		}
+	}
+	return identity, ok, err
+}
+
+// lockoutRequestAuthenticator wraps a request authenticator and temporarily locks out
+// source IPs with too many consecutive failed logins.
+type lockoutRequestAuthenticator struct {
+	delegate authenticator.Request
+	failures *tracker
+}
+
+// NewRequest creates a request authenticator that delegates to another authenticator
+// and locks a source IP out for lockDuration after maxFailures consecutive failed
+// logins. Requests that do not carry credentials are not counted.
+func NewRequest(delegate authenticator.Request, maxFailures int, lockDuration time.Duration) authenticator.Request {
+	return &lockoutRequestAuthenticator{
+		delegate: delegate,
+		failures: newTracker(maxFailures, lockDuration),
+	}
+}
+
+func (a *lockoutRequestAuthenticator) AuthenticateRequest(req *http.Request) (user.Info, bool, error) {
+	ip := sourceIP(req)
+	if a.failures.locked(ip) {
+		glog.Warningf("AUDIT: rejected login from locked out source IP %s", ip)
+		return nil, false, fmt.Errorf("too many failed login attempts, try again later")
+	}
+	_, _, hasCredentials := req.BasicAuth()
+	identity, ok, err := a.delegate.AuthenticateRequest(req)
+	switch {
+	case err == nil && ok:
+		a.failures.success(ip)
+	case err == nil && !ok && hasCredentials:
+		if a.failures.failure(ip) {
+			glog.Warningf("AUDIT: locked out source IP %s after too many failed login attempts", ip)
+		}
+	}
+	return identity, ok, err
+}
+
+// sourceIP returns the remote IP the request was made from.
+func sourceIP(req *http.Request) string {
+	if host, _, err := net.SplitHostPort(req.RemoteAddr); err == nil {
+		return host
+	}
+	return req.RemoteAddr
+}
@@ -0,0 +1,80 @@
+package lockout
+
+import (
+	"testing"
+	"time"
+
+	"k8s.io/kubernetes/pkg/auth/user"
+)
+
+type fakePasswordAuthenticator struct {
+	calls int
+}
+
+func (a *fakePasswordAuthenticator) AuthenticatePassword(username, password string) (user.Info, bool, error) {
+	a.calls++
+	if password == "good" {
+		return &user.DefaultInfo{Name: username}, true, nil
+	}
+	return nil, false, nil
+}
+
+func TestFailuresBelowThresholdDoNotLock(t *testing.T) {
+	delegate := &fakePasswordAuthenticator{}
+	auth := New(delegate, 3, time.Minute)
+
+	for i := 0; i < 2; i++ {
+		if _, ok, err := auth.AuthenticatePassword("user", "bad"); ok || err != nil {
+			t.Fatalf("unexpected result for failed login: ok=%v err=%v", ok, err)
+		}
+	}
+	if _, ok, err := auth.AuthenticatePassword("user", "good"); !ok || err != nil {
+		t.Fatalf("expected successful login, got ok=%v err=%v", ok, err)
+	}
+}
+
+func TestSuccessResetsFailureCount(t *testing.T) {
+	delegate := &fakePasswordAuthenticator{}
+	auth := New(delegate, 3, time.Minute)
+
+	for i := 0; i < 2; i++ {
+		auth.AuthenticatePassword("user", "bad")
+	}
+	auth.AuthenticatePassword("user", "good")
+	for i := 0; i < 2; i++ {
+		auth.AuthenticatePassword("user", "bad")
+	}
+	// without the reset this third failure after a success would trigger the lockout
+	if _, ok, err := auth.AuthenticatePassword("user", "good"); !ok || err != nil {
+		t.Fatalf("expected successful login, got ok=%v err=%v", ok, err)
+	}
+}
+
+func TestLockoutAndExpiry(t *testing.T) {
+	now := time.Now()
+	delegate := &fakePasswordAuthenticator{}
+	auth := New(delegate, 3, time.Minute).(*lockoutPasswordAuthenticator)
+	auth.failures.now = func() time.Time { return now }
+
+	for i := 0; i < 3; i++ {
+		auth.AuthenticatePassword("user", "bad")
+	}
+
+	calls := delegate.calls
+	if _, ok, err := auth.AuthenticatePassword("user", "good"); ok || err == nil {
+		t.Fatalf("expected lockout error, got ok=%v err=%v", ok, err)
+	}
+	if delegate.calls != calls {
+		t.Fatalf("delegate was called for a locked out user")
+	}
+
+	// other users are unaffected
+	if _, ok, err := auth.AuthenticatePassword("otheruser", "good"); !ok || err != nil {
+		t.Fatalf("expected successful login for other user, got ok=%v err=%v", ok, err)
+	}
+
+	now = now.Add(2 * time.Minute)
+	if _, ok, err := auth.AuthenticatePassword("user", "good"); !ok || err != nil {
+		t.Fatalf("expected successful login after lockout expired, got ok=%v err=%v", ok, err)
+	}
+}
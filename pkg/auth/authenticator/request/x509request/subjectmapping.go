@@ -0,0 +1,145 @@
+package x509request
+
+import (
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"fmt"
+
+	"github.com/golang/glog"
+
+	"k8s.io/kubernetes/pkg/auth/user"
+
+	authapi "github.com/openshift/origin/pkg/auth/api"
+)
+
+// Subject attributes usable for mapping verified client certificates to identities
+const (
+	SubjectAttributeCommonName         = "CN"
+	SubjectAttributeEmailAddress       = "emailAddress"
+	SubjectAttributeOrganization       = "O"
+	SubjectAttributeOrganizationalUnit = "OU"
+)
+
+// oidEmailAddress identifies the emailAddress attribute (RFC 2985) in a certificate subject
+var oidEmailAddress = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 9, 1}
+
+// SubjectMappingConfig controls how the subject of a verified client certificate is mapped to an identity
+type SubjectMappingConfig struct {
+	// UsernameAttribute is the subject attribute whose value becomes the user name ("CN" or "emailAddress"). Defaults to "CN".
+	UsernameAttribute string
+	// GroupAttributes are the subject attributes whose values become group names ("O" or "OU"). Defaults to ["O"].
+	GroupAttributes []string
+	// GroupPrefix is an optional prefix prepended to every extracted group name
+	GroupPrefix string
+}
+
+type subjectMappingUserConversion struct {
+	providerName string
+	config       SubjectMappingConfig
+	mapper       authapi.UserIdentityMapper
+}
+
+// NewSubjectMappingUserConversion returns a UserConversion that maps the leaf certificate's subject
+// to an identity from the named provider, and adds groups extracted from the subject to the mapped user
+func NewSubjectMappingUserConversion(providerName string, config SubjectMappingConfig, mapper authapi.UserIdentityMapper) UserConversion {
+	if len(config.UsernameAttribute) == 0 {
+		config.UsernameAttribute = SubjectAttributeCommonName
+	}
+	if len(config.GroupAttributes) == 0 {
+		config.GroupAttributes = []string{SubjectAttributeOrganization}
+	}
+	return &subjectMappingUserConversion{
+		providerName: providerName,
+		config:       config,
+		mapper:       mapper,
+	}
+}
+
+func (c *subjectMappingUserConversion) User(chain []*x509.Certificate) (user.Info, bool, error) {
+	leaf := chain[0]
+
+	username, err := subjectUsername(leaf, c.config.UsernameAttribute)
+	if err != nil {
+		return nil, false, err
+	}
+
+	identity := authapi.NewDefaultUserIdentityInfo(c.providerName, username)
+	if len(leaf.Subject.CommonName) > 0 {
+		identity.Extra[authapi.IdentityPreferredUsernameKey] = leaf.Subject.CommonName
+	}
+	if email := subjectEmailAddress(leaf); len(email) > 0 {
+		identity.Extra[authapi.IdentityEmailKey] = email
+	}
+
+	mappedUser, err := c.mapper.UserFor(identity)
+	if err != nil {
+		glog.V(4).Infof("Error creating or updating mapping for: %#v due to %v", identity, err)
+		return nil, false, err
+	}
+
+	groups := subjectGroups(leaf.Subject, c.config.GroupAttributes, c.config.GroupPrefix)
+	if len(groups) == 0 {
+		return mappedUser, true, nil
+	}
+	return &user.DefaultInfo{
+		Name:   mappedUser.GetName(),
+		UID:    mappedUser.GetUID(),
+		Groups: append(append([]string{}, mappedUser.GetGroups()...), groups...),
+		Extra:  mappedUser.GetExtra(),
+	}, true, nil
+}
+
+// subjectUsername extracts the value of the configured username attribute from the certificate
+func subjectUsername(cert *x509.Certificate, attribute string) (string, error) {
+	var username string
+	switch attribute {
+	case SubjectAttributeCommonName:
+		username = cert.Subject.CommonName
+	case SubjectAttributeEmailAddress:
+		username = subjectEmailAddress(cert)
+	default:
+		return "", fmt.Errorf("unsupported username attribute %q", attribute)
+	}
+	if len(username) == 0 {
+		return "", fmt.Errorf("client certificate has no %s subject attribute", attribute)
+	}
+	return username, nil
+}
+
+// subjectEmailAddress returns the certificate's email address, preferring the
+// subject alternative name over the legacy emailAddress subject attribute
+func subjectEmailAddress(cert *x509.Certificate) string {
+	if len(cert.EmailAddresses) > 0 {
+		return cert.EmailAddresses[0]
+	}
+	for _, name := range cert.Subject.Names {
+		if name.Type.Equal(oidEmailAddress) {
+			if email, ok := name.Value.(string); ok {
+				return email
+			}
+		}
+	}
+	return ""
+}
+
+// subjectGroups extracts group names from the configured subject attributes
+func subjectGroups(subject pkix.Name, attributes []string, prefix string) []string {
+	groups := []string{}
+	for _, attribute := range attributes {
+		var values []string
+		switch attribute {
+		case SubjectAttributeOrganization:
+			values = subject.Organization
+		case SubjectAttributeOrganizationalUnit:
+			values = subject.OrganizationalUnit
+		}
+		for _, value := range values {
+			if len(value) == 0 {
+				continue
+			}
+			groups = append(groups, prefix+value)
+		}
+	}
+	return groups
+}
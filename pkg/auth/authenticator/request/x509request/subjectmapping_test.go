@@ -0,0 +1,81 @@
+package x509request
+
+import (
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"reflect"
+	"testing"
+
+	"k8s.io/kubernetes/pkg/auth/user"
+
+	authapi "github.com/openshift/origin/pkg/auth/api"
+)
+
+type fakeIdentityMapper struct {
+	identity authapi.UserIdentityInfo
+}
+
+func (m *fakeIdentityMapper) UserFor(identityInfo authapi.UserIdentityInfo) (user.Info, error) {
+	m.identity = identityInfo
+	return &user.DefaultInfo{Name: identityInfo.GetProviderUserName(), Groups: []string{"system:authenticated"}}, nil
+}
+
+func TestSubjectMappingUserConversion(t *testing.T) {
+	testCases := map[string]struct {
+		Config         SubjectMappingConfig
+		Subject        pkix.Name
+		ExpectedUser   string
+		ExpectedGroups []string
+		ExpectedErr    bool
+	}{
+		"defaults map CN and O": {
+			Config: SubjectMappingConfig{},
+			Subject: pkix.Name{
+				CommonName:         "jsmith",
+				Organization:       []string{"engineering", "ops"},
+				OrganizationalUnit: []string{"widgets"},
+			},
+			ExpectedUser:   "jsmith",
+			ExpectedGroups: []string{"system:authenticated", "engineering", "ops"},
+		},
+		"group prefix and OU groups": {
+			Config: SubjectMappingConfig{
+				GroupAttributes: []string{SubjectAttributeOrganizationalUnit},
+				GroupPrefix:     "cert:",
+			},
+			Subject: pkix.Name{
+				CommonName:         "jsmith",
+				OrganizationalUnit: []string{"widgets"},
+			},
+			ExpectedUser:   "jsmith",
+			ExpectedGroups: []string{"system:authenticated", "cert:widgets"},
+		},
+		"missing username attribute": {
+			Config:      SubjectMappingConfig{},
+			Subject:     pkix.Name{Organization: []string{"engineering"}},
+			ExpectedErr: true,
+		},
+	}
+
+	for k, tc := range testCases {
+		mapper := &fakeIdentityMapper{}
+		conversion := NewSubjectMappingUserConversion("testprovider", tc.Config, mapper)
+		userInfo, ok, err := conversion.User([]*x509.Certificate{{Subject: tc.Subject}})
+		if tc.ExpectedErr {
+			if err == nil {
+				t.Errorf("%s: expected error, got user %v", k, userInfo)
+			}
+			continue
+		}
+		if err != nil || !ok {
+			t.Errorf("%s: unexpected result: %v, %v", k, ok, err)
+			continue
+		}
+		if userInfo.GetName() != tc.ExpectedUser {
+			t.Errorf("%s: expected user %q, got %q", k, tc.ExpectedUser, userInfo.GetName())
+		}
+		if !reflect.DeepEqual(userInfo.GetGroups(), tc.ExpectedGroups) {
+			t.Errorf("%s: expected groups %v, got %v", k, tc.ExpectedGroups, userInfo.GetGroups())
+		}
+	}
+}
@@ -0,0 +1,143 @@
+package sascopes
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/auth/user"
+	"k8s.io/kubernetes/pkg/serviceaccount"
+
+	authorizationapi "github.com/openshift/origin/pkg/authorization/api"
+)
+
+type fakeTokenAuthenticator struct {
+	user user.Info
+	ok   bool
+	err  error
+}
+
+func (a *fakeTokenAuthenticator) AuthenticateToken(token string) (user.Info, bool, error) {
+	return a.user, a.ok, a.err
+}
+
+type fakeServiceAccountGetter struct {
+	serviceAccount *kapi.ServiceAccount
+	err            error
+}
+
+func (g *fakeServiceAccountGetter) GetServiceAccount(namespace, name string) (*kapi.ServiceAccount, error) {
+	return g.serviceAccount, g.err
+}
+
+func (g *fakeServiceAccountGetter) GetSecret(namespace, name string) (*kapi.Secret, error) {
+	return nil, errors.New("not implemented")
+}
+
+func TestAuthenticateToken(t *testing.T) {
+	testCases := map[string]struct {
+		delegateUser   user.Info
+		serviceAccount *kapi.ServiceAccount
+
+		expectedOK     bool
+		expectedErr    bool
+		expectedScopes []string
+	}{
+		"service account with scope annotation": {
+			delegateUser: &user.DefaultInfo{Name: serviceaccount.MakeUsername("ns", "builder")},
+			serviceAccount: &kapi.ServiceAccount{
+				ObjectMeta: kapi.ObjectMeta{
+					Namespace:   "ns",
+					Name:        "builder",
+					Annotations: map[string]string{ScopesAnnotation: "role:view:ns, user:info"},
+				},
+			},
+			expectedOK:     true,
+			expectedScopes: []string{"role:view:ns", "user:info"},
+		},
+		"service account without annotation": {
+			delegateUser: &user.DefaultInfo{Name: serviceaccount.MakeUsername("ns", "builder")},
+			serviceAccount: &kapi.ServiceAccount{
+				ObjectMeta: kapi.ObjectMeta{Namespace: "ns", Name: "builder"},
+			},
+			expectedOK:     true,
+			expectedScopes: nil,
+		},
+		"non service account user": {
+			delegateUser:   &user.DefaultInfo{Name: "alice"},
+			expectedOK:     true,
+			expectedScopes: nil,
+		},
+		"service account with invalid scopes": {
+			delegateUser: &user.DefaultInfo{Name: serviceaccount.MakeUsername("ns", "builder")},
+			serviceAccount: &kapi.ServiceAccount{
+				ObjectMeta: kapi.ObjectMeta{
+					Namespace:   "ns",
+					Name:        "builder",
+					Annotations: map[string]string{ScopesAnnotation: "bad\"scope"},
+				},
+			},
+			expectedErr: true,
+		},
+		// an annotation that names no scopes still constrains the token: the scope
+		// authorizer sees an empty scope list and grants nothing
+		"service account with empty annotation": {
+			delegateUser: &user.DefaultInfo{Name: serviceaccount.MakeUsername("ns", "builder")},
+			serviceAccount: &kapi.ServiceAccount{
+				ObjectMeta: kapi.ObjectMeta{
+					Namespace:   "ns",
+					Name:        "builder",
+					Annotations: map[string]string{ScopesAnnotation: ""},
+				},
+			},
+			expectedOK:     true,
+			expectedScopes: []string{},
+		},
+	}
+
+	for name, tc := range testCases {
+		delegate := &fakeTokenAuthenticator{user: tc.delegateUser, ok: true}
+		getter := &fakeServiceAccountGetter{serviceAccount: tc.serviceAccount}
+
+		u, ok, err := NewAuthenticator(delegate, getter).AuthenticateToken("token")
+		switch {
+		case tc.expectedErr:
+			if err == nil {
+				t.Errorf("%s: expected an error, got none", name)
+			}
+			continue
+		case err != nil:
+			t.Errorf("%s: unexpected error: %v", name, err)
+			continue
+		}
+		if ok != tc.expectedOK {
+			t.Errorf("%s: expected ok=%v, got %v", name, tc.expectedOK, ok)
+			continue
+		}
+
+		scopes, hasScopes := u.GetExtra()[authorizationapi.ScopesKey]
+		if tc.expectedScopes == nil {
+			if hasScopes {
+				t.Errorf("%s: expected no scopes on the user, got %v", name, scopes)
+			}
+			continue
+		}
+		if !hasScopes || !reflect.DeepEqual(scopes, tc.expectedScopes) {
+			t.Errorf("%s: expected scopes %v, got %v", name, tc.expectedScopes, scopes)
+		}
+	}
+}
+
+func TestAuthenticateTokenDelegateRejects(t *testing.T) {
+	delegate := &fakeTokenAuthenticator{ok: false}
+	getter := &fakeServiceAccountGetter{err: errors.New("should not be called")}
+
+	_, ok, err := NewAuthenticator(delegate, getter).AuthenticateToken("token")
+	if err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if ok {
+		t.Errorf("expected the delegate rejection to be passed through")
+	}
+}
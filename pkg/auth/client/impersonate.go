@@ -56,7 +56,10 @@ func cloneRequest(r *http.Request) *http.Request {
 func NewImpersonatingConfig(user user.Info, config restclient.Config) restclient.Config {
 	oldWrapTransport := config.WrapTransport
 	config.WrapTransport = func(rt http.RoundTripper) http.RoundTripper {
-		return NewImpersonatingRoundTripper(user, oldWrapTransport(rt))
+		if oldWrapTransport != nil {
+			rt = oldWrapTransport(rt)
+		}
+		return NewImpersonatingRoundTripper(user, rt)
 	}
 	return config
 }
@@ -8,6 +8,7 @@ import (
 
 	"github.com/openshift/origin/pkg/auth/api"
 	"github.com/openshift/origin/pkg/auth/authenticator"
+	oauthapi "github.com/openshift/origin/pkg/oauth/api"
 	"k8s.io/kubernetes/pkg/auth/user"
 )
 
@@ -81,8 +82,8 @@ func (h *AccessAuthenticator) HandleAccess(ar *osin.AccessRequest, w http.Respon
 	}
 
 	if ok {
-		// Disable refresh_token generation
-		ar.GenerateRefresh = false
+		// Only generate refresh_tokens for clients that opted in
+		ar.GenerateRefresh = grantsRefreshTokens(ar.Client)
 		ar.Authorized = true
 		if info != nil {
 			ar.AccessData.UserData = info
@@ -91,6 +92,18 @@ func (h *AccessAuthenticator) HandleAccess(ar *osin.AccessRequest, w http.Respon
 	return nil
 }
 
+// grantsRefreshTokens returns true if the OAuthClient backing the osin client opted in to
+// refresh token issuance
+func grantsRefreshTokens(client osin.Client) bool {
+	if client == nil {
+		return false
+	}
+	if oauthClient, ok := client.GetUserData().(*oauthapi.OAuthClient); ok {
+		return oauthClient.GrantRefreshTokens
+	}
+	return false
+}
+
 // NewDenyAccessAuthenticator returns an AccessAuthenticator which rejects all non-token access requests
 func NewDenyAccessAuthenticator() *AccessAuthenticator {
 	return &AccessAuthenticator{Deny, Deny, Deny}
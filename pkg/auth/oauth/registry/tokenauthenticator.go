@@ -3,8 +3,10 @@ package registry
 import (
 	"errors"
 	"fmt"
+	"strings"
 	"time"
 
+	authenticationapi "github.com/openshift/origin/pkg/auth/api"
 	"github.com/openshift/origin/pkg/auth/userregistry/identitymapper"
 	authorizationapi "github.com/openshift/origin/pkg/authorization/api"
 	"github.com/openshift/origin/pkg/oauth/registry/oauthaccesstoken"
@@ -58,12 +60,22 @@ func (a *TokenAuthenticator) AuthenticateToken(value string) (kuser.Info, bool,
 	}
 	groupNames = append(groupNames, u.Groups...)
 
+	// record the providers of the identities bound to the user so downstream
+	// consumers (the audit log in particular) can tell how the user authenticated
+	providers := []string{}
+	for _, identity := range u.Identities {
+		if parts := strings.SplitN(identity, ":", 2); len(parts) == 2 {
+			providers = append(providers, parts[0])
+		}
+	}
+
 	return &kuser.DefaultInfo{
 		Name:   u.Name,
 		UID:    string(u.UID),
 		Groups: groupNames,
 		Extra: map[string][]string{
-			authorizationapi.ScopesKey: token.Scopes,
+			authorizationapi.ScopesKey:            token.Scopes,
+			authenticationapi.IdentityProviderKey: providers,
 		},
 	}, true, nil
 }
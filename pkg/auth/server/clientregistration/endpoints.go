@@ -0,0 +1,125 @@
+package clientregistration
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"path"
+
+	"github.com/pborman/uuid"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/api/errors"
+	utilvalidation "k8s.io/kubernetes/pkg/util/validation"
+
+	"github.com/openshift/origin/pkg/auth/server/login"
+	oauthapi "github.com/openshift/origin/pkg/oauth/api"
+	clientregistry "github.com/openshift/origin/pkg/oauth/registry/oauthclient"
+)
+
+// RegisterEndpoint implements OAuth 2.0 Dynamic Client Registration (RFC 7591). A POST
+// with a client metadata document creates an OAuthClient and returns the issued
+// client_id and client_secret.
+const RegisterEndpoint = "/register"
+
+// clientMetadata is the subset of RFC 7591 client metadata that maps onto an OAuthClient.
+type clientMetadata struct {
+	RedirectURIs []string `json:"redirect_uris"`
+	ClientName   string   `json:"client_name,omitempty"`
+	GrantTypes   []string `json:"grant_types,omitempty"`
+}
+
+// clientInformationResponse is the RFC 7591 client information response.
+type clientInformationResponse struct {
+	ClientID     string   `json:"client_id"`
+	ClientSecret string   `json:"client_secret"`
+	RedirectURIs []string `json:"redirect_uris"`
+	ClientName   string   `json:"client_name,omitempty"`
+	GrantTypes   []string `json:"grant_types,omitempty"`
+}
+
+// registrationError is the RFC 7591 error response.
+type registrationError struct {
+	Error            string `json:"error"`
+	ErrorDescription string `json:"error_description,omitempty"`
+}
+
+type endpoints struct {
+	clients clientregistry.Registry
+}
+
+type Endpoints interface {
+	Install(mux login.Mux, paths ...string)
+}
+
+// NewEndpoints returns endpoints that serve dynamic client registration backed by the
+// provided client registry.
+func NewEndpoints(clients clientregistry.Registry) Endpoints {
+	return &endpoints{clients: clients}
+}
+
+// Install registers the client registration endpoint into a mux. It is expected that the
+// provided prefix will serve all operations.
+func (e *endpoints) Install(mux login.Mux, paths ...string) {
+	for _, prefix := range paths {
+		mux.HandleFunc(path.Join(prefix, RegisterEndpoint), e.register)
+	}
+}
+
+func (e *endpoints) register(w http.ResponseWriter, req *http.Request) {
+	if req.Method != "POST" {
+		writeRegistrationError(w, http.StatusMethodNotAllowed, "invalid_request", "only POST is supported")
+		return
+	}
+
+	metadata := clientMetadata{}
+	if err := json.NewDecoder(req.Body).Decode(&metadata); err != nil {
+		writeRegistrationError(w, http.StatusBadRequest, "invalid_client_metadata", fmt.Sprintf("unable to parse client metadata: %v", err))
+		return
+	}
+	if len(metadata.RedirectURIs) == 0 {
+		writeRegistrationError(w, http.StatusBadRequest, "invalid_redirect_uri", "at least one redirect_uri is required")
+		return
+	}
+
+	name := metadata.ClientName
+	if len(name) == 0 || len(utilvalidation.IsDNS1123Subdomain(name)) != 0 {
+		name = "dynamic"
+	}
+	name = fmt.Sprintf("%s-%s", name, uuid.New())
+
+	client := &oauthapi.OAuthClient{
+		ObjectMeta:   kapi.ObjectMeta{Name: name},
+		Secret:       uuid.New(),
+		RedirectURIs: metadata.RedirectURIs,
+		// dynamically registered clients must always prompt the resource owner for grants
+		GrantMethod: oauthapi.GrantHandlerPrompt,
+	}
+
+	created, err := e.clients.CreateClient(kapi.NewContext(), client)
+	if err != nil {
+		if errors.IsInvalid(err) {
+			writeRegistrationError(w, http.StatusBadRequest, "invalid_client_metadata", err.Error())
+			return
+		}
+		writeRegistrationError(w, http.StatusInternalServerError, "server_error", err.Error())
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+	w.WriteHeader(http.StatusCreated)
+	json.NewEncoder(w).Encode(&clientInformationResponse{
+		ClientID:     created.Name,
+		ClientSecret: created.Secret,
+		RedirectURIs: created.RedirectURIs,
+		ClientName:   metadata.ClientName,
+		GrantTypes:   metadata.GrantTypes,
+	})
+}
+
+func writeRegistrationError(w http.ResponseWriter, code int, name, description string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	json.NewEncoder(w).Encode(&registrationError{Error: name, ErrorDescription: description})
+}
@@ -0,0 +1,432 @@
+package deviceauth
+
+import (
+	"crypto/rand"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"path"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/RangelReale/osin"
+	"github.com/golang/glog"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/api/unversioned"
+	"k8s.io/kubernetes/pkg/auth/authenticator"
+
+	authapi "github.com/openshift/origin/pkg/auth/api"
+	"github.com/openshift/origin/pkg/auth/oauth/handlers"
+	"github.com/openshift/origin/pkg/auth/server/csrf"
+	"github.com/openshift/origin/pkg/auth/server/login"
+	oauthapi "github.com/openshift/origin/pkg/oauth/api"
+	accesstokenregistry "github.com/openshift/origin/pkg/oauth/registry/oauthaccesstoken"
+	clientregistry "github.com/openshift/origin/pkg/oauth/registry/oauthclient"
+	"github.com/openshift/origin/pkg/oauth/server/osinserver"
+)
+
+// The device authorization grant (RFC 8628) lets clients on input-constrained or
+// browserless hosts obtain tokens by directing the user to authorize the request
+// from a second device.
+const (
+	// DeviceCodeEndpoint accepts device authorization requests and issues a
+	// device code and user code pair.
+	DeviceCodeEndpoint = "/device/code"
+	// DeviceVerifyEndpoint is the browser-facing endpoint where the user enters
+	// the user code to approve or deny a pending device authorization.
+	DeviceVerifyEndpoint = "/device/verify"
+	// DeviceTokenEndpoint is polled by the device with its device code until the
+	// user completes verification and a token can be issued.
+	DeviceTokenEndpoint = "/device/token"
+
+	// DeviceCodeGrantType is the RFC 8628 grant type expected on token requests.
+	DeviceCodeGrantType = "urn:ietf:params:oauth:grant-type:device_code"
+
+	// deviceRequestExpiry bounds how long the user has to complete verification.
+	deviceRequestExpiry = 5 * time.Minute
+	// pollInterval is the minimum number of seconds the device must wait between polls.
+	pollInterval = 5
+
+	// userCodeAlphabet deliberately omits vowels and easily confused characters so
+	// codes are unambiguous to read from one screen and type on another.
+	userCodeAlphabet = "BCDFGHJKLMNPQRSTVWXZ"
+	userCodeLength   = 8
+)
+
+// deviceRequest is a pending device authorization awaiting user verification.
+type deviceRequest struct {
+	clientName string
+	scopes     []string
+
+	deviceCode string
+	userCode   string
+	expires    time.Time
+	lastPoll   time.Time
+
+	// approved is set once the user completes verification, along with the
+	// identity the token will be issued for.
+	approved bool
+	denied   bool
+	userName string
+	userUID  string
+}
+
+// codeResponse is the RFC 8628 device authorization response.
+type codeResponse struct {
+	DeviceCode              string `json:"device_code"`
+	UserCode                string `json:"user_code"`
+	VerificationURI         string `json:"verification_uri"`
+	VerificationURIComplete string `json:"verification_uri_complete"`
+	ExpiresIn               int    `json:"expires_in"`
+	Interval                int    `json:"interval"`
+}
+
+// tokenResponse is the access token response returned once the grant is approved.
+type tokenResponse struct {
+	AccessToken string `json:"access_token"`
+	TokenType   string `json:"token_type"`
+	ExpiresIn   int64  `json:"expires_in,omitempty"`
+	Scope       string `json:"scope,omitempty"`
+}
+
+// tokenError is the RFC 8628 error response for the token endpoint.
+type tokenError struct {
+	Error            string `json:"error"`
+	ErrorDescription string `json:"error_description,omitempty"`
+}
+
+type endpoints struct {
+	publicMasterURL string
+	auth            authenticator.Request
+	authHandler     handlers.AuthenticationHandler
+	csrf            csrf.CSRF
+	clients         clientregistry.Getter
+	accesstokens    accesstokenregistry.Registry
+	expiresIn       int64
+
+	// verifyPath is the server-relative path of the verification endpoint, set on Install.
+	verifyPath string
+
+	lock         sync.Mutex
+	byDeviceCode map[string]*deviceRequest
+	byUserCode   map[string]*deviceRequest
+}
+
+type Endpoints interface {
+	Install(mux login.Mux, paths ...string)
+}
+
+// NewEndpoints returns endpoints implementing the device authorization grant. The
+// auth handlers are used to authenticate the user in the browser during
+// verification, and accessExpiresIn bounds the lifetime of issued tokens.
+func NewEndpoints(publicMasterURL string, auth authenticator.Request, authHandler handlers.AuthenticationHandler, csrf csrf.CSRF, clients clientregistry.Getter, accesstokens accesstokenregistry.Registry, accessExpiresIn int64) Endpoints {
+	return &endpoints{
+		publicMasterURL: publicMasterURL,
+		auth:            auth,
+		authHandler:     authHandler,
+		csrf:            csrf,
+		clients:         clients,
+		accesstokens:    accesstokens,
+		expiresIn:       accessExpiresIn,
+		byDeviceCode:    map[string]*deviceRequest{},
+		byUserCode:      map[string]*deviceRequest{},
+	}
+}
+
+// Install registers the device authorization endpoints into a mux. It is expected
+// that the provided prefix will serve all operations.
+func (e *endpoints) Install(mux login.Mux, paths ...string) {
+	for _, prefix := range paths {
+		e.verifyPath = path.Join(prefix, DeviceVerifyEndpoint)
+		mux.HandleFunc(path.Join(prefix, DeviceCodeEndpoint), e.handleCode)
+		mux.HandleFunc(e.verifyPath, e.handleVerify)
+		mux.HandleFunc(path.Join(prefix, DeviceTokenEndpoint), e.handleToken)
+	}
+}
+
+// handleCode issues a device code and user code pair for the requesting client.
+func (e *endpoints) handleCode(w http.ResponseWriter, req *http.Request) {
+	if req.Method != "POST" {
+		writeTokenError(w, http.StatusMethodNotAllowed, "invalid_request", "only POST is supported")
+		return
+	}
+
+	clientID := req.FormValue("client_id")
+	if len(clientID) == 0 {
+		writeTokenError(w, http.StatusBadRequest, "invalid_request", "client_id is required")
+		return
+	}
+	if _, err := e.clients.GetClient(kapi.NewContext(), clientID); err != nil {
+		writeTokenError(w, http.StatusUnauthorized, "invalid_client", fmt.Sprintf("unknown client %q", clientID))
+		return
+	}
+
+	pending := &deviceRequest{
+		clientName: clientID,
+		deviceCode: randomDeviceCode(),
+		userCode:   randomUserCode(),
+		expires:    time.Now().Add(deviceRequestExpiry),
+	}
+	if scope := req.FormValue("scope"); len(scope) > 0 {
+		pending.scopes = strings.Split(scope, " ")
+	}
+
+	e.lock.Lock()
+	e.prune()
+	e.byDeviceCode[pending.deviceCode] = pending
+	e.byUserCode[pending.userCode] = pending
+	e.lock.Unlock()
+
+	verifyURI := e.publicMasterURL + e.verifyPath
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+	json.NewEncoder(w).Encode(&codeResponse{
+		DeviceCode:              pending.deviceCode,
+		UserCode:                pending.userCode,
+		VerificationURI:         verifyURI,
+		VerificationURIComplete: verifyURI + "?user_code=" + url.QueryEscape(pending.userCode),
+		ExpiresIn:               int(deviceRequestExpiry / time.Second),
+		Interval:                pollInterval,
+	})
+}
+
+// handleVerify authenticates the user in the browser and lets them approve or
+// deny the pending request matching the entered user code.
+func (e *endpoints) handleVerify(w http.ResponseWriter, req *http.Request) {
+	user, ok, err := e.auth.AuthenticateRequest(req)
+	if err != nil || !ok {
+		client, clientErr := e.verifyClient()
+		if clientErr != nil {
+			http.Error(w, "Unable to authenticate this request", http.StatusInternalServerError)
+			return
+		}
+		handled, handlerErr := e.authHandler.AuthenticationNeeded(client, w, req)
+		if handlerErr != nil || !handled {
+			glog.V(4).Infof("Unable to initiate authentication for device verification: %v", handlerErr)
+			http.Error(w, "Unable to authenticate this request", http.StatusInternalServerError)
+		}
+		return
+	}
+
+	switch req.Method {
+	case "GET":
+		e.renderForm(w, req, req.URL.Query().Get("user_code"), "")
+	case "POST":
+		e.handleVerifyPost(user.GetName(), string(user.GetUID()), w, req)
+	default:
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+	}
+}
+
+func (e *endpoints) handleVerifyPost(userName, userUID string, w http.ResponseWriter, req *http.Request) {
+	if ok, err := e.csrf.Check(req, req.FormValue("csrf")); err != nil || !ok {
+		glog.V(4).Infof("Invalid CSRF token on device verification: %v", err)
+		e.renderForm(w, req, req.FormValue("user_code"), "Could not check CSRF token. Please try again.")
+		return
+	}
+
+	userCode := normalizeUserCode(req.FormValue("user_code"))
+
+	e.lock.Lock()
+	defer e.lock.Unlock()
+	e.prune()
+
+	pending, ok := e.byUserCode[userCode]
+	if !ok {
+		e.renderForm(w, req, "", "The code you entered is incorrect or has expired. Please try again.")
+		return
+	}
+
+	if len(req.FormValue("deny")) > 0 {
+		pending.denied = true
+		delete(e.byUserCode, userCode)
+		e.renderResult(w, fmt.Sprintf("Denied the request for client %q. You may close this page.", pending.clientName))
+		return
+	}
+
+	pending.approved = true
+	pending.userName = userName
+	pending.userUID = userUID
+	delete(e.byUserCode, userCode)
+	e.renderResult(w, fmt.Sprintf("Approved the request for client %q as %q. Return to your device to continue.", pending.clientName, userName))
+}
+
+// handleToken is polled by the device and issues an access token once the user
+// has approved the request.
+func (e *endpoints) handleToken(w http.ResponseWriter, req *http.Request) {
+	if req.Method != "POST" {
+		writeTokenError(w, http.StatusMethodNotAllowed, "invalid_request", "only POST is supported")
+		return
+	}
+	if grantType := req.FormValue("grant_type"); grantType != DeviceCodeGrantType {
+		writeTokenError(w, http.StatusBadRequest, "unsupported_grant_type", fmt.Sprintf("grant_type must be %q", DeviceCodeGrantType))
+		return
+	}
+
+	deviceCode := req.FormValue("device_code")
+
+	e.lock.Lock()
+	e.prune()
+	pending, ok := e.byDeviceCode[deviceCode]
+	if !ok {
+		e.lock.Unlock()
+		writeTokenError(w, http.StatusBadRequest, "expired_token", "the device code is invalid or has expired")
+		return
+	}
+	if clientID := req.FormValue("client_id"); clientID != pending.clientName {
+		e.lock.Unlock()
+		writeTokenError(w, http.StatusUnauthorized, "invalid_client", "client_id does not match the device authorization request")
+		return
+	}
+	if interval := time.Since(pending.lastPoll); interval < pollInterval*time.Second {
+		e.lock.Unlock()
+		writeTokenError(w, http.StatusBadRequest, "slow_down", "polling too frequently")
+		return
+	}
+	pending.lastPoll = time.Now()
+	switch {
+	case pending.denied:
+		delete(e.byDeviceCode, deviceCode)
+		e.lock.Unlock()
+		writeTokenError(w, http.StatusBadRequest, "access_denied", "the user denied the request")
+		return
+	case !pending.approved:
+		e.lock.Unlock()
+		writeTokenError(w, http.StatusBadRequest, "authorization_pending", "the user has not yet completed verification")
+		return
+	}
+	delete(e.byDeviceCode, deviceCode)
+	e.lock.Unlock()
+
+	accessToken, _, err := osinserver.TokenGen{}.GenerateAccessToken(&osin.AccessData{}, false)
+	if err != nil {
+		writeTokenError(w, http.StatusInternalServerError, "server_error", err.Error())
+		return
+	}
+	token := &oauthapi.OAuthAccessToken{
+		ObjectMeta: kapi.ObjectMeta{
+			Name:              accessToken,
+			CreationTimestamp: unversioned.Now(),
+		},
+		ClientName: pending.clientName,
+		ExpiresIn:  e.expiresIn,
+		Scopes:     pending.scopes,
+		UserName:   pending.userName,
+		UserUID:    pending.userUID,
+	}
+	if _, err := e.accesstokens.CreateAccessToken(kapi.NewContext(), token); err != nil {
+		writeTokenError(w, http.StatusInternalServerError, "server_error", err.Error())
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+	json.NewEncoder(w).Encode(&tokenResponse{
+		AccessToken: accessToken,
+		TokenType:   "Bearer",
+		ExpiresIn:   e.expiresIn,
+		Scope:       strings.Join(pending.scopes, " "),
+	})
+}
+
+// verifyClient returns the client used to drive browser authentication for the
+// verification endpoint. Verification always uses redirecting login flows.
+func (e *endpoints) verifyClient() (authapi.Client, error) {
+	client, err := e.clients.GetClient(kapi.NewContext(), "openshift-browser-client")
+	if err != nil {
+		return nil, err
+	}
+	return &verificationClient{client}, nil
+}
+
+// verificationClient adapts an OAuthClient to the authapi.Client interface, forcing
+// redirect-based login so the user ends up back at the verification page.
+type verificationClient struct {
+	client *oauthapi.OAuthClient
+}
+
+func (w *verificationClient) GetId() string          { return w.client.Name }
+func (w *verificationClient) GetSecret() string      { return w.client.Secret }
+func (w *verificationClient) GetRedirectUri() string { return strings.Join(w.client.RedirectURIs, ",") }
+func (w *verificationClient) GetUserData() interface{} {
+	client := *w.client
+	client.RespondWithChallenges = false
+	return &client
+}
+
+// prune drops expired requests. Callers must hold the lock.
+func (e *endpoints) prune() {
+	now := time.Now()
+	for code, pending := range e.byDeviceCode {
+		if now.After(pending.expires) {
+			delete(e.byDeviceCode, code)
+			delete(e.byUserCode, pending.userCode)
+		}
+	}
+}
+
+func (e *endpoints) renderForm(w http.ResponseWriter, req *http.Request, userCode, failure string) {
+	csrfToken, err := e.csrf.Generate(w, req)
+	if err != nil {
+		glog.Errorf("Unable to generate CSRF token: %v", err)
+		http.Error(w, "Unable to generate CSRF token", http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "text/html; charset=UTF-8")
+	verifyTemplate.Execute(w, verifyForm{
+		Action:   req.URL.Path,
+		CSRF:     csrfToken,
+		UserCode: normalizeUserCode(userCode),
+		Error:    failure,
+	})
+}
+
+func (e *endpoints) renderResult(w http.ResponseWriter, message string) {
+	w.Header().Set("Content-Type", "text/html; charset=UTF-8")
+	resultTemplate.Execute(w, message)
+}
+
+func writeTokenError(w http.ResponseWriter, code int, name, description string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+	w.WriteHeader(code)
+	json.NewEncoder(w).Encode(&tokenError{Error: name, ErrorDescription: description})
+}
+
+// randomDeviceCode returns a high-entropy opaque code for the polling device.
+func randomDeviceCode() string {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		// rand.Read should never fail
+		panic(err)
+	}
+	return fmt.Sprintf("%x", b)
+}
+
+// randomUserCode returns a short code the user can read from one screen and type
+// on another, formatted as XXXX-XXXX.
+func randomUserCode() string {
+	b := make([]byte, userCodeLength)
+	if _, err := rand.Read(b); err != nil {
+		// rand.Read should never fail
+		panic(err)
+	}
+	code := make([]byte, userCodeLength)
+	for i := range b {
+		code[i] = userCodeAlphabet[int(b[i])%len(userCodeAlphabet)]
+	}
+	return fmt.Sprintf("%s-%s", code[:userCodeLength/2], code[userCodeLength/2:])
+}
+
+// normalizeUserCode tolerates lowercase input and a missing separator.
+func normalizeUserCode(code string) string {
+	code = strings.ToUpper(strings.TrimSpace(code))
+	code = strings.Replace(code, "-", "", -1)
+	if len(code) != userCodeLength {
+		return code
+	}
+	return fmt.Sprintf("%s-%s", code[:userCodeLength/2], code[userCodeLength/2:])
+}
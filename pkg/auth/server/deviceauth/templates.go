@@ -0,0 +1,41 @@
+package deviceauth
+
+import "html/template"
+
+type verifyForm struct {
+	Action   string
+	CSRF     string
+	UserCode string
+	Error    string
+}
+
+var verifyTemplate = template.Must(template.New("deviceVerify").Parse(`<!DOCTYPE html>
+<html>
+  <head>
+    <title>Device Authorization</title>
+  </head>
+  <body>
+    <h2>Device Authorization</h2>
+    <p>Enter the code displayed on your device to continue.</p>
+    {{ if .Error }}<p style="color:red">{{ .Error }}</p>{{ end }}
+    <form method="post" action="{{ .Action }}">
+      <input type="hidden" name="csrf" value="{{ .CSRF }}">
+      <input type="text" name="user_code" value="{{ .UserCode }}" autofocus>
+      <input type="submit" name="approve" value="Approve">
+      <input type="submit" name="deny" value="Deny">
+    </form>
+  </body>
+</html>
+`))
+
+var resultTemplate = template.Must(template.New("deviceVerifyResult").Parse(`<!DOCTYPE html>
+<html>
+  <head>
+    <title>Device Authorization</title>
+  </head>
+  <body>
+    <h2>Device Authorization</h2>
+    <p>{{ . }}</p>
+  </body>
+</html>
+`))
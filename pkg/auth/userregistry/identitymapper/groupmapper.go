@@ -0,0 +1,165 @@
+package identitymapper
+
+import (
+	"regexp"
+
+	"github.com/golang/glog"
+	kapi "k8s.io/kubernetes/pkg/api"
+	kerrs "k8s.io/kubernetes/pkg/api/errors"
+	kuser "k8s.io/kubernetes/pkg/auth/user"
+	"k8s.io/kubernetes/pkg/util/sets"
+
+	authapi "github.com/openshift/origin/pkg/auth/api"
+	userapi "github.com/openshift/origin/pkg/user/api"
+	groupregistry "github.com/openshift/origin/pkg/user/registry/group"
+	groupmappingregistry "github.com/openshift/origin/pkg/user/registry/groupmapping"
+)
+
+// GroupMappingAnnotation is set on Groups whose membership is managed by GroupMappings.
+// Users are removed from annotated groups when their identity attributes no longer map
+// to them; groups without the annotation are never pruned.
+const GroupMappingAnnotation = "openshift.io/group-mapping"
+
+var _ = authapi.UserIdentityMapper(&groupMappingMapper{})
+
+// groupMappingMapper wraps a UserIdentityMapper and applies GroupMappings to the
+// authenticated identity, so virtual group membership is refreshed on every login
+// rather than by a periodic sync job.
+type groupMappingMapper struct {
+	delegate      authapi.UserIdentityMapper
+	groupMappings groupmappingregistry.Registry
+	groups        groupregistry.Registry
+}
+
+// NewGroupMappingMapper returns a UserIdentityMapper that delegates to the given
+// mapper and then reconciles the user's membership in mapped groups.
+func NewGroupMappingMapper(delegate authapi.UserIdentityMapper, groupMappings groupmappingregistry.Registry, groups groupregistry.Registry) authapi.UserIdentityMapper {
+	return &groupMappingMapper{
+		delegate:      delegate,
+		groupMappings: groupMappings,
+		groups:        groups,
+	}
+}
+
+// UserFor returns info about the user for whom identity info have been provided
+func (m *groupMappingMapper) UserFor(info authapi.UserIdentityInfo) (kuser.Info, error) {
+	user, err := m.delegate.UserFor(info)
+	if err != nil {
+		return nil, err
+	}
+	if err := m.applyGroupMappings(info, user.GetName()); err != nil {
+		return nil, err
+	}
+	return user, nil
+}
+
+// applyGroupMappings adds the user to every group its identity attributes currently
+// map to and removes it from mapping-managed groups it no longer maps to.
+func (m *groupMappingMapper) applyGroupMappings(info authapi.UserIdentityInfo, username string) error {
+	ctx := kapi.NewContext()
+
+	mappings, err := m.groupMappings.ListGroupMappings(ctx, &kapi.ListOptions{})
+	if err != nil {
+		return err
+	}
+
+	desired := sets.NewString()
+	mappedBy := map[string]string{}
+	for i := range mappings.Items {
+		mapping := &mappings.Items[i]
+		for _, name := range mappedGroupNames(mapping, info) {
+			desired.Insert(name)
+			mappedBy[name] = mapping.Name
+		}
+	}
+
+	for _, name := range desired.List() {
+		group, err := m.groups.GetGroup(ctx, name)
+		if kerrs.IsNotFound(err) {
+			group = &userapi.Group{
+				ObjectMeta: kapi.ObjectMeta{
+					Name:        name,
+					Annotations: map[string]string{GroupMappingAnnotation: mappedBy[name]},
+				},
+				Users: []string{username},
+			}
+			if _, err := m.groups.CreateGroup(ctx, group); err != nil && !kerrs.IsAlreadyExists(err) {
+				return err
+			}
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		if sets.NewString(group.Users...).Has(username) {
+			continue
+		}
+		group.Users = append(group.Users, username)
+		if _, err := m.groups.UpdateGroup(ctx, group); err != nil {
+			return err
+		}
+	}
+
+	groups, err := m.groups.ListGroups(ctx, &kapi.ListOptions{})
+	if err != nil {
+		return err
+	}
+	for i := range groups.Items {
+		group := &groups.Items[i]
+		if _, ok := group.Annotations[GroupMappingAnnotation]; !ok {
+			continue
+		}
+		if desired.Has(group.Name) {
+			continue
+		}
+		users := sets.NewString(group.Users...)
+		if !users.Has(username) {
+			continue
+		}
+		users.Delete(username)
+		group.Users = users.List()
+		if _, err := m.groups.UpdateGroup(ctx, group); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// mappedGroupNames returns the group names the given identity maps to under a single
+// mapping. Invalid patterns are skipped; they are rejected by validation, so this only
+// guards against objects written before the pattern rules changed.
+func mappedGroupNames(mapping *userapi.GroupMapping, info authapi.UserIdentityInfo) []string {
+	if len(mapping.ProviderName) > 0 && mapping.ProviderName != info.GetProviderName() {
+		return nil
+	}
+	value, ok := info.GetExtra()[mapping.Attribute]
+	if !ok || len(value) == 0 {
+		return nil
+	}
+
+	if len(mapping.Pattern) == 0 {
+		if len(mapping.GroupNameFormat) > 0 {
+			return []string{mapping.GroupNameFormat}
+		}
+		return []string{value}
+	}
+
+	re, err := regexp.Compile(mapping.Pattern)
+	if err != nil {
+		glog.Errorf("groupmapping %q has an invalid pattern: %v", mapping.Name, err)
+		return nil
+	}
+	match := re.FindStringSubmatchIndex(value)
+	if match == nil {
+		return nil
+	}
+	if len(mapping.GroupNameFormat) == 0 {
+		return []string{value}
+	}
+	name := string(re.ExpandString(nil, mapping.GroupNameFormat, value, match))
+	if len(name) == 0 {
+		return nil
+	}
+	return []string{name}
+}
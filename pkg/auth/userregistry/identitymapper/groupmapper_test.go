@@ -0,0 +1,148 @@
+package identitymapper
+
+import (
+	"reflect"
+	"testing"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+	kuser "k8s.io/kubernetes/pkg/auth/user"
+
+	authapi "github.com/openshift/origin/pkg/auth/api"
+	userapi "github.com/openshift/origin/pkg/user/api"
+	"github.com/openshift/origin/pkg/user/registry/test"
+)
+
+type testDelegateMapper struct {
+	info kuser.Info
+	err  error
+}
+
+func (m *testDelegateMapper) UserFor(info authapi.UserIdentityInfo) (kuser.Info, error) {
+	return m.info, m.err
+}
+
+func identityInfo(providerName, providerUserName string, extra map[string]string) authapi.UserIdentityInfo {
+	info := authapi.NewDefaultUserIdentityInfo(providerName, providerUserName)
+	for k, v := range extra {
+		info.Extra[k] = v
+	}
+	return info
+}
+
+func TestMappedGroupNames(t *testing.T) {
+	testcases := map[string]struct {
+		Mapping  userapi.GroupMapping
+		Info     authapi.UserIdentityInfo
+		Expected []string
+	}{
+		"attribute missing": {
+			Mapping:  userapi.GroupMapping{Attribute: "ou"},
+			Info:     identityInfo("ldap", "bob", nil),
+			Expected: nil,
+		},
+		"attribute value used as group name": {
+			Mapping:  userapi.GroupMapping{Attribute: "ou"},
+			Info:     identityInfo("ldap", "bob", map[string]string{"ou": "engineering"}),
+			Expected: []string{"engineering"},
+		},
+		"provider mismatch": {
+			Mapping:  userapi.GroupMapping{ProviderName: "ldap", Attribute: "ou"},
+			Info:     identityInfo("github", "bob", map[string]string{"ou": "engineering"}),
+			Expected: nil,
+		},
+		"static group name": {
+			Mapping:  userapi.GroupMapping{Attribute: "ou", GroupNameFormat: "developers"},
+			Info:     identityInfo("ldap", "bob", map[string]string{"ou": "engineering"}),
+			Expected: []string{"developers"},
+		},
+		"pattern mismatch": {
+			Mapping:  userapi.GroupMapping{Attribute: "ou", Pattern: "^eng-.*$"},
+			Info:     identityInfo("ldap", "bob", map[string]string{"ou": "sales"}),
+			Expected: nil,
+		},
+		"pattern submatch expansion": {
+			Mapping:  userapi.GroupMapping{Attribute: "roles", Pattern: "^cluster-(.*)$", GroupNameFormat: "oidc-$1"},
+			Info:     identityInfo("oidc", "bob", map[string]string{"roles": "cluster-operators"}),
+			Expected: []string{"oidc-operators"},
+		},
+		"invalid pattern skipped": {
+			Mapping:  userapi.GroupMapping{Attribute: "ou", Pattern: "["},
+			Info:     identityInfo("ldap", "bob", map[string]string{"ou": "engineering"}),
+			Expected: nil,
+		},
+	}
+
+	for k, tc := range testcases {
+		names := mappedGroupNames(&tc.Mapping, tc.Info)
+		if !reflect.DeepEqual(names, tc.Expected) {
+			t.Errorf("%s: expected %v, got %v", k, tc.Expected, names)
+		}
+	}
+}
+
+func TestGroupMappingMapperUserFor(t *testing.T) {
+	mappingRegistry := test.NewGroupMappingRegistry()
+	mappingRegistry.List = &userapi.GroupMappingList{
+		Items: []userapi.GroupMapping{
+			{
+				ObjectMeta:      kapi.ObjectMeta{Name: "ldap-ou"},
+				Attribute:       "ou",
+				Pattern:         "^(.*)$",
+				GroupNameFormat: "ldap-$1",
+			},
+		},
+	}
+
+	groupRegistry := test.NewGroupRegistry()
+	groupRegistry.Get["ldap-sales"] = &userapi.Group{
+		ObjectMeta: kapi.ObjectMeta{
+			Name:        "ldap-sales",
+			Annotations: map[string]string{GroupMappingAnnotation: "ldap-ou"},
+		},
+		Users: []string{"bob", "alice"},
+	}
+	groupRegistry.List = &userapi.GroupList{Items: []userapi.Group{*groupRegistry.Get["ldap-sales"]}}
+
+	mapper := NewGroupMappingMapper(
+		&testDelegateMapper{info: &kuser.DefaultInfo{Name: "bob"}},
+		mappingRegistry,
+		groupRegistry,
+	)
+
+	info := identityInfo("ldap", "bob", map[string]string{"ou": "engineering"})
+	user, err := mapper.UserFor(info)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if user.GetName() != "bob" {
+		t.Fatalf("unexpected user: %#v", user)
+	}
+
+	var created *userapi.Group
+	var updated *userapi.Group
+	for _, action := range *groupRegistry.Actions {
+		switch action.Name {
+		case "CreateGroup":
+			created = action.Object.(*userapi.Group)
+		case "UpdateGroup":
+			updated = action.Object.(*userapi.Group)
+		}
+	}
+
+	if created == nil || created.Name != "ldap-engineering" {
+		t.Fatalf("expected group ldap-engineering to be created, got %#v", created)
+	}
+	if !reflect.DeepEqual(created.Users, []string{"bob"}) {
+		t.Errorf("expected bob to be the only member of the created group, got %v", created.Users)
+	}
+	if created.Annotations[GroupMappingAnnotation] != "ldap-ou" {
+		t.Errorf("expected the created group to be annotated with the mapping name, got %v", created.Annotations)
+	}
+
+	if updated == nil || updated.Name != "ldap-sales" {
+		t.Fatalf("expected bob to be removed from ldap-sales, got %#v", updated)
+	}
+	if !reflect.DeepEqual(updated.Users, []string{"alice"}) {
+		t.Errorf("expected alice to remain the only member of ldap-sales, got %v", updated.Users)
+	}
+}
@@ -33,7 +33,9 @@ const (
 // 1. Returns an existing user if the identity exists and is associated with an existing user
 // 2. Returns an error if the identity exists and is not associated with a user (or is associated with a missing user)
 // 3. Handles new identities according to the requested method
-func NewIdentityUserMapper(identities identityregistry.Registry, users userregistry.Registry, method MappingMethodType) (authapi.UserIdentityMapper, error) {
+// The optional mapping derives the preferred user name for new identities from the
+// identity's claims; a nil mapping uses the identity's preferred user name unchanged.
+func NewIdentityUserMapper(identities identityregistry.Registry, users userregistry.Registry, method MappingMethodType, mapping *UserNameMapping) (authapi.UserIdentityMapper, error) {
 	// initUser initializes fields in a User API object from its associated Identity
 	// called when adding the first Identity to a User (during create or update of a User)
 	initUser := user.NewDefaultUserInitStrategy()
@@ -45,13 +47,13 @@ func NewIdentityUserMapper(identities identityregistry.Registry, users userregis
 		return &lookupIdentityMapper{mappingRegistry, users}, nil
 
 	case MappingMethodClaim:
-		return &provisioningIdentityMapper{identities, users, NewStrategyClaim(users, initUser)}, nil
+		return &provisioningIdentityMapper{identities, users, NewStrategyClaim(users, initUser), mapping}, nil
 
 	case MappingMethodAdd:
-		return &provisioningIdentityMapper{identities, users, NewStrategyAdd(users, initUser)}, nil
+		return &provisioningIdentityMapper{identities, users, NewStrategyAdd(users, initUser), mapping}, nil
 
 	case MappingMethodGenerate:
-		return &provisioningIdentityMapper{identities, users, NewStrategyGenerate(users, initUser)}, nil
+		return &provisioningIdentityMapper{identities, users, NewStrategyGenerate(users, initUser), mapping}, nil
 
 	default:
 		return nil, fmt.Errorf("unsupported mapping method %q", method)
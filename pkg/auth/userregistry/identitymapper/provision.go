@@ -30,6 +30,9 @@ type provisioningIdentityMapper struct {
 	identity             identityregistry.Registry
 	user                 userregistry.Registry
 	provisioningStrategy UserForNewIdentityGetter
+	// userNameMapping optionally derives the preferred user name for new identities
+	// from the identity's claims. If nil, the default preferred user name is used.
+	userNameMapping *UserNameMapping
 }
 
 // UserFor returns info about the user for whom identity info have been provided
@@ -83,8 +86,13 @@ func (p *provisioningIdentityMapper) createIdentityAndMapping(ctx kapi.Context,
 		Extra:            info.GetExtra(),
 	}
 
+	preferredUserName := getPreferredUserName(identity)
+	if p.userNameMapping != nil {
+		preferredUserName = p.userNameMapping.UserNameFor(identity)
+	}
+
 	// Get or create a persisted user pointing to the identity
-	persistedUser, err := p.provisioningStrategy.UserForNewIdentity(ctx, getPreferredUserName(identity), identity)
+	persistedUser, err := p.provisioningStrategy.UserForNewIdentity(ctx, preferredUserName, identity)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,57 @@
+package identitymapper
+
+import (
+	"regexp"
+	"strings"
+
+	userapi "github.com/openshift/origin/pkg/user/api"
+)
+
+// claimTokenRegexp matches {claim} tokens in a user name template
+var claimTokenRegexp = regexp.MustCompile(`\{([A-Za-z0-9_.-]+)\}`)
+
+// providerUserNameToken is the template token replaced with the identity's
+// provider user name rather than a claim from its extra map
+const providerUserNameToken = "providerUserName"
+
+// UserNameMapping derives the user name to provision for new identities from
+// the identity's claims. The zero value preserves the default behavior of using
+// the identity's preferred user name unchanged.
+type UserNameMapping struct {
+	// Template derives the base user name. Tokens of the form {claim} are replaced
+	// with the matching entry in the identity's extra claims, and {providerUserName}
+	// is replaced with the provider's user name. An empty template, or one that
+	// expands to an empty string, falls back to the identity's preferred user name.
+	Template string
+	// Lowercase forces the derived user name to lower case
+	Lowercase bool
+	// Prefix is prepended to the derived user name
+	Prefix string
+}
+
+// UserNameFor returns the user name to use for the given identity
+func (m *UserNameMapping) UserNameFor(identity *userapi.Identity) string {
+	name := ""
+	if len(m.Template) > 0 {
+		name = claimTokenRegexp.ReplaceAllStringFunc(m.Template, func(token string) string {
+			claim := token[1 : len(token)-1]
+			if claim == providerUserNameToken {
+				return identity.ProviderUserName
+			}
+			return identity.Extra[claim]
+		})
+	}
+	if len(name) == 0 {
+		name = getPreferredUserName(identity)
+	}
+	if m.Lowercase {
+		name = strings.ToLower(name)
+	}
+	return m.Prefix + name
+}
+
+// IsValidUserNameTemplate returns false if the template contains malformed
+// claim tokens, like unbalanced braces or empty claim names
+func IsValidUserNameTemplate(template string) bool {
+	return !strings.ContainsAny(claimTokenRegexp.ReplaceAllString(template, ""), "{}")
+}
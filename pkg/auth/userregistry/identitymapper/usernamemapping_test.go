@@ -0,0 +1,79 @@
+package identitymapper
+
+import (
+	"testing"
+
+	authapi "github.com/openshift/origin/pkg/auth/api"
+	userapi "github.com/openshift/origin/pkg/user/api"
+)
+
+func TestUserNameFor(t *testing.T) {
+	identity := &userapi.Identity{
+		ProviderUserName: "JDoe",
+		Extra: map[string]string{
+			authapi.IdentityEmailKey:             "jdoe@example.com",
+			authapi.IdentityPreferredUsernameKey: "jdoe-preferred",
+		},
+	}
+
+	testCases := map[string]struct {
+		Mapping  UserNameMapping
+		Expected string
+	}{
+		"zero value uses preferred user name": {
+			Mapping:  UserNameMapping{},
+			Expected: "jdoe-preferred",
+		},
+		"provider user name token": {
+			Mapping:  UserNameMapping{Template: "{providerUserName}"},
+			Expected: "JDoe",
+		},
+		"claim template": {
+			Mapping:  UserNameMapping{Template: "{email}"},
+			Expected: "jdoe@example.com",
+		},
+		"missing claim falls back to preferred user name": {
+			Mapping:  UserNameMapping{Template: "{missing}"},
+			Expected: "jdoe-preferred",
+		},
+		"lowercase": {
+			Mapping:  UserNameMapping{Template: "{providerUserName}", Lowercase: true},
+			Expected: "jdoe",
+		},
+		"prefix": {
+			Mapping:  UserNameMapping{Prefix: "corp:"},
+			Expected: "corp:jdoe-preferred",
+		},
+		"combined": {
+			Mapping:  UserNameMapping{Template: "{providerUserName}", Lowercase: true, Prefix: "ldap-"},
+			Expected: "ldap-jdoe",
+		},
+	}
+
+	for k, tc := range testCases {
+		if actual := tc.Mapping.UserNameFor(identity); actual != tc.Expected {
+			t.Errorf("%s: expected %q, got %q", k, tc.Expected, actual)
+		}
+	}
+}
+
+func TestIsValidUserNameTemplate(t *testing.T) {
+	testCases := map[string]bool{
+		"":                       true,
+		"{email}":                true,
+		"prefix-{email}":         true,
+		"{providerUserName}":     true,
+		"{email":                 false,
+		"email}":                 false,
+		"{}":                     false,
+		"{bad claim}":            false,
+		"{email}-{unclosed":      false,
+		"{email}.{custom_claim}": true,
+	}
+
+	for template, expected := range testCases {
+		if actual := IsValidUserNameTemplate(template); actual != expected {
+			t.Errorf("%q: expected valid=%v, got %v", template, expected, actual)
+		}
+	}
+}
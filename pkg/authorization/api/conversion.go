@@ -71,6 +71,11 @@ func convert_api_PolicyRules_To_rbac_PolicyRules(in []PolicyRule) []rbac.PolicyR
 		if rule.AttributeRestrictions != nil {
 			continue
 		}
+		// RBAC rules are purely additive and cannot express a deny, so deny rules cannot be
+		// preserved during conversion.  The Origin authorizer remains authoritative for deny semantics.
+		if rule.Deny {
+			continue
+		}
 		r := rbac.PolicyRule{
 			APIGroups:       rule.APIGroups,
 			Verbs:           rule.Verbs.List(),
@@ -240,6 +240,10 @@ func TestAttributeRestrictionsRuleLoss(t *testing.T) {
 var fuzzer = fuzz.New().NilChance(0).Funcs(
 	func(*unversioned.TypeMeta, fuzz.Continue) {}, // Ignore TypeMeta
 	func(*runtime.Object, fuzz.Continue) {},       // Ignore AttributeRestrictions since they are deprecated
+	func(opr *authorizationapi.PolicyRule, c fuzz.Continue) {
+		c.FuzzNoCustom(opr)
+		opr.Deny = false // RBAC rules are purely additive, so deny rules are intentionally dropped by conversion
+	},
 	func(ocrb *authorizationapi.ClusterRoleBinding, c fuzz.Continue) {
 		c.FuzzNoCustom(ocrb)
 		setRandomOriginRoleBindingData(ocrb.Subjects, &ocrb.RoleRef, "", c)
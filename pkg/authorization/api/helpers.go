@@ -77,6 +77,10 @@ func (r PolicyRule) String() string {
 func (r PolicyRule) CompactString() string {
 	formatStringParts := []string{}
 	formatArgs := []interface{}{}
+	if r.Deny {
+		formatStringParts = append(formatStringParts, "Deny:%v")
+		formatArgs = append(formatArgs, r.Deny)
+	}
 	if len(r.Verbs) > 0 {
 		formatStringParts = append(formatStringParts, "Verbs:%q")
 		formatArgs = append(formatArgs, r.Verbs.List())
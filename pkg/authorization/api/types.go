@@ -58,6 +58,10 @@ var DiscoveryRule = PolicyRule{
 // PolicyRule holds information that describes a policy rule, but does not contain information
 // about who the rule applies to or which namespace the rule applies to.
 type PolicyRule struct {
+	// Deny inverts the effect of the rule.  A request matching a deny rule is rejected even if
+	// another rule allows it: the authorizer evaluates every deny rule bound to the user before
+	// considering any allow rule.  Deny rules grant nothing and are ignored for escalation checks.
+	Deny bool
 	// Verbs is a list of Verbs that apply to ALL the ResourceKinds and AttributeRestrictions contained in this rule.  VerbAll represents all kinds.
 	Verbs sets.String
 	// AttributeRestrictions will vary depending on what the Authorizer/AuthorizationAttributeBuilder pair supports.
@@ -252,6 +256,22 @@ type SubjectAccessReview struct {
 	Scopes []string
 }
 
+// BulkSubjectAccessReview is an object for requesting authorization decisions for many actions in a single call
+type BulkSubjectAccessReview struct {
+	unversioned.TypeMeta
+
+	// Items is the list of access reviews to evaluate
+	Items []SubjectAccessReview
+}
+
+// BulkSubjectAccessReviewResponse holds the responses for a BulkSubjectAccessReview, in the order the reviews were requested
+type BulkSubjectAccessReviewResponse struct {
+	unversioned.TypeMeta
+
+	// Items is the list of responses, one per requested review
+	Items []SubjectAccessReviewResponse
+}
+
 // LocalResourceAccessReview is a means to request a list of which users and groups are authorized to perform the action specified by spec in a particular namespace
 type LocalResourceAccessReview struct {
 	unversioned.TypeMeta
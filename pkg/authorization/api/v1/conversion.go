@@ -87,6 +87,8 @@ func Convert_v1_PolicyRule_To_api_PolicyRule(in *PolicyRule, out *newer.PolicyRu
 
 	out.NonResourceURLs = sets.NewString(in.NonResourceURLsSlice...)
 
+	out.Deny = in.Deny
+
 	return nil
 }
 
@@ -107,6 +109,8 @@ func Convert_api_PolicyRule_To_v1_PolicyRule(in *newer.PolicyRule, out *PolicyRu
 
 	out.NonResourceURLsSlice = in.NonResourceURLs.List()
 
+	out.Deny = in.Deny
+
 	return nil
 }
 
@@ -1341,6 +1341,14 @@ func (m *PolicyRule) MarshalTo(data []byte) (int, error) {
 			i += copy(data[i:], s)
 		}
 	}
+	data[i] = 0x38
+	i++
+	if m.Deny {
+		data[i] = 1
+	} else {
+		data[i] = 0
+	}
+	i++
 	return i, nil
 }
 
@@ -2518,6 +2526,7 @@ func (m *PolicyRule) Size() (n int) {
 			n += 1 + l + sovGenerated(uint64(l))
 		}
 	}
+	n += 2
 	return n
 }
 
@@ -3076,6 +3085,7 @@ func (this *PolicyRule) String() string {
 		`Resources:` + fmt.Sprintf("%v", this.Resources) + `,`,
 		`ResourceNames:` + fmt.Sprintf("%v", this.ResourceNames) + `,`,
 		`NonResourceURLsSlice:` + fmt.Sprintf("%v", this.NonResourceURLsSlice) + `,`,
+		`Deny:` + fmt.Sprintf("%v", this.Deny) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -6427,6 +6437,26 @@ func (m *PolicyRule) Unmarshal(data []byte) error {
 			}
 			m.NonResourceURLsSlice = append(m.NonResourceURLsSlice, string(data[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deny", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Deny = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(data[iNdEx:])
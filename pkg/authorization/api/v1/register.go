@@ -43,6 +43,8 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 		&LocalSubjectAccessReview{},
 		&ResourceAccessReviewResponse{},
 		&SubjectAccessReviewResponse{},
+		&BulkSubjectAccessReview{},
+		&BulkSubjectAccessReviewResponse{},
 		&IsPersonalSubjectAccessReview{},
 
 		&ClusterRole{},
@@ -34,6 +34,9 @@ type PolicyRule struct {
 	// NonResourceURLsSlice is a set of partial urls that a user should have access to.  *s are allowed, but only as the full, final step in the path
 	// This name is intentionally different than the internal type so that the DefaultConvert works nicely and because the ordering may be different.
 	NonResourceURLsSlice []string `json:"nonResourceURLs,omitempty" protobuf:"bytes,6,rep,name=nonResourceURLs"`
+	// Deny inverts the effect of the rule.  A request matching a deny rule is rejected even if
+	// another rule allows it.  Deny rules are evaluated before any allow rule.
+	Deny bool `json:"deny,omitempty" protobuf:"varint,7,opt,name=deny"`
 }
 
 // IsPersonalSubjectAccessReview is a marker for PolicyRule.AttributeRestrictions that denotes that subjectaccessreviews on self should be allowed
@@ -261,6 +264,22 @@ type SubjectAccessReview struct {
 	Scopes OptionalScopes `json:"scopes" protobuf:"bytes,4,rep,name=scopes"`
 }
 
+// BulkSubjectAccessReview is an object for requesting authorization decisions for many actions in a single call
+type BulkSubjectAccessReview struct {
+	unversioned.TypeMeta `json:",inline"`
+
+	// Items is the list of access reviews to evaluate
+	Items []SubjectAccessReview `json:"items" protobuf:"bytes,1,rep,name=items"`
+}
+
+// BulkSubjectAccessReviewResponse holds the responses for a BulkSubjectAccessReview, in the order the reviews were requested
+type BulkSubjectAccessReviewResponse struct {
+	unversioned.TypeMeta `json:",inline"`
+
+	// Items is the list of responses, one per requested review
+	Items []SubjectAccessReviewResponse `json:"items" protobuf:"bytes,1,rep,name=items"`
+}
+
 // LocalResourceAccessReview is a means to request a list of which users and groups are authorized to perform the action specified by spec in a particular namespace
 type LocalResourceAccessReview struct {
 	unversioned.TypeMeta `json:",inline"`
@@ -530,6 +530,7 @@ func DeepCopy_v1_PolicyRule(in interface{}, out interface{}, c *conversion.Clone
 		} else {
 			out.NonResourceURLsSlice = nil
 		}
+		out.Deny = in.Deny
 		return nil
 	}
 }
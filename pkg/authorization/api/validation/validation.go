@@ -72,6 +72,23 @@ func ValidateSubjectAccessReview(review *authorizationapi.SubjectAccessReview) f
 	return allErrs
 }
 
+func ValidateBulkSubjectAccessReview(review *authorizationapi.BulkSubjectAccessReview) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if len(review.Items) == 0 {
+		allErrs = append(allErrs, field.Required(field.NewPath("items"), ""))
+	}
+	for i := range review.Items {
+		itemPath := field.NewPath("items").Index(i)
+		if len(review.Items[i].Action.Verb) == 0 {
+			allErrs = append(allErrs, field.Required(itemPath.Child("verb"), ""))
+		}
+		allErrs = append(allErrs, validateCommonAccessReviewAction(itemPath, &review.Items[i].Action)...)
+	}
+
+	return allErrs
+}
+
 func ValidateResourceAccessReview(review *authorizationapi.ResourceAccessReview) field.ErrorList {
 	allErrs := field.ErrorList{}
 
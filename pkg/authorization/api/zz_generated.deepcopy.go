@@ -492,6 +492,7 @@ func DeepCopy_api_PolicyRule(in interface{}, out interface{}, c *conversion.Clon
 		} else {
 			out.NonResourceURLs = nil
 		}
+		out.Deny = in.Deny
 		return nil
 	}
 }
@@ -55,7 +55,7 @@ func RuleMatches(a authorizer.Attributes, rule authorizationapi.PolicyRule) (boo
 	}
 
 	if verbMatches(a, rule.Verbs) {
-		if apiGroupMatches(a, rule.APIGroups) {
+		if apiGroupMatches(a, rule.APIGroups) || denyMatchesAllAPIGroups(rule) {
 
 			allowedResourceTypes := authorizationapi.NormalizeResources(rule.Resources)
 			if resourceMatches(a, allowedResourceTypes) {
@@ -84,6 +84,13 @@ func apiGroupMatches(a authorizer.Attributes, allowedGroups []string) bool {
 	return false
 }
 
+// denyMatchesAllAPIGroups treats a deny rule that names no API groups as applying to every
+// group.  A deny that silently stops matching because its author omitted the group list would
+// turn the carve-out into a no-op, which is far worse than denying too broadly.
+func denyMatchesAllAPIGroups(rule authorizationapi.PolicyRule) bool {
+	return rule.Deny && len(rule.APIGroups) == 0
+}
+
 func verbMatches(a authorizer.Attributes, verbs sets.String) bool {
 	return verbs.Has(authorizationapi.VerbAll) || verbs.Has(strings.ToLower(a.GetVerb()))
 }
@@ -33,6 +33,11 @@ func (a *openshiftAuthorizer) Authorize(attributes authorizer.Attributes) (bool,
 		return false, "", err
 	}
 
+	// a non-empty reason means the request matched an explicit deny rule
+	if len(reason) > 0 {
+		return false, reason, nil
+	}
+
 	denyReason, err := a.forbiddenMessageMaker.MakeMessage(attributes)
 	if err != nil {
 		denyReason = err.Error()
@@ -70,6 +75,11 @@ func (a *openshiftAuthorizer) getAllowedSubjectsFromNamespaceBindings(attributes
 		}
 
 		for _, rule := range role.Rules() {
+			// deny rules grant nothing, so they never contribute subjects.  Subjects carved out
+			// by a deny rule elsewhere may still be listed here; this keeps the subject list additive.
+			if rule.Deny {
+				continue
+			}
 			matches, err := RuleMatches(attributes, rule)
 			if err != nil {
 				errs = append(errs, err)
@@ -89,11 +99,35 @@ func (a *openshiftAuthorizer) getAllowedSubjectsFromNamespaceBindings(attributes
 // authorizeWithNamespaceRules returns isAllowed, reason, and error.  If an error is returned, isAllowed and reason are still valid.  This seems strange
 // but errors are not always fatal to the authorization process.  It is entirely possible to get an error and be able to continue determine authorization
 // status in spite of it.  This is most common when a bound role is missing, but enough roles are still present and bound to authorize the request.
+//
+// Deny rules take precedence over allow rules: every deny rule bound to the user is evaluated
+// before any allow rule, so a matching deny short circuits the request as forbidden regardless
+// of what other roles would allow.  This lets admins broadly grant a role while carving out
+// specific resources with a deny rule.
 func (a *openshiftAuthorizer) authorizeWithNamespaceRules(attributes authorizer.Attributes) (bool, string, error) {
 	allRules, ruleRetrievalError := a.ruleResolver.RulesFor(attributes.GetUser(), attributes.GetNamespace())
 
 	var errs []error
 	for _, rule := range allRules {
+		if !rule.Deny {
+			continue
+		}
+		matches, err := RuleMatches(attributes, rule)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		if matches {
+			if len(attributes.GetNamespace()) == 0 {
+				return false, "denied by cluster rule", nil
+			}
+			return false, "denied by rule in " + attributes.GetNamespace(), nil
+		}
+	}
+	for _, rule := range allRules {
+		if rule.Deny {
+			continue
+		}
 		matches, err := RuleMatches(attributes, rule)
 		if err != nil {
 			errs = append(errs, err)
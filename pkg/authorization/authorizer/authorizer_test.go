@@ -116,6 +116,48 @@ func TestAPIGroupAllAllow(t *testing.T) {
 	test.test(t)
 }
 
+func TestDenyRuleOverridesAllow(t *testing.T) {
+	test := &authorizeTest{
+		attributes: kauthorizer.AttributesRecord{
+			ResourceRequest: true,
+			User:            &user.DefaultInfo{Name: "Anna"},
+			Namespace:       "adze",
+			Verb:            "list",
+			Resource:        "pods",
+		},
+		expectedAllowed: false,
+		expectedReason:  "denied by rule in adze",
+	}
+	test.clusterPolicies = newDefaultClusterPolicies()
+	test.policies = append(test.policies, newAdzePolicies()...)
+	test.clusterBindings = newDefaultClusterPolicyBindings()
+	test.bindings = append(test.bindings, newAdzeBindings()...)
+	// Anna is allowed to list pods in adze (see TestAPIGroupDefaultAllow), but an explicit
+	// deny rule takes precedence over the allow.
+	test.policies[0].Roles["deny-pods"] = &authorizationapi.Role{
+		ObjectMeta: kapi.ObjectMeta{
+			Name:      "deny-pods",
+			Namespace: "adze",
+		},
+		Rules: []authorizationapi.PolicyRule{
+			{
+				Deny: true, Verbs: sets.NewString("list"), Resources: sets.NewString("pods"),
+			},
+		},
+	}
+	test.bindings[0].RoleBindings["deny-pods"] = &authorizationapi.RoleBinding{
+		ObjectMeta: kapi.ObjectMeta{
+			Name: "deny-pods",
+		},
+		RoleRef: kapi.ObjectReference{
+			Namespace: "adze",
+			Name:      "deny-pods",
+		},
+		Subjects: []kapi.ObjectReference{{Kind: authorizationapi.UserKind, Name: "Anna"}},
+	}
+	test.test(t)
+}
+
 func TestAPIAllAllow(t *testing.T) {
 	test := &authorizeTest{
 		attributes: kauthorizer.AttributesRecord{
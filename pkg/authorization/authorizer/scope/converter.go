@@ -90,6 +90,7 @@ func ScopesToVisibleNamespaces(scopes []string, clusterPolicyGetter client.Clust
 const (
 	UserIndicator        = "user:"
 	ClusterRoleIndicator = "role:"
+	RegistryIndicator    = "registry:"
 )
 
 // ScopeEvaluator takes a scope and returns the rules that express it
@@ -109,6 +110,7 @@ type ScopeEvaluator interface {
 var ScopeEvaluators = []ScopeEvaluator{
 	userEvaluator{},
 	clusterRoleEvaluator{},
+	registryEvaluator{},
 }
 
 // scopes are in the format
@@ -116,6 +118,7 @@ var ScopeEvaluators = []ScopeEvaluator{
 // we have the following formats:
 // user:<scope name>
 // role:<clusterrole name>:<namespace to allow the cluster role, * means all>
+// registry:pull:<namespace>/<imagestream name>
 // TODO
 // cluster:<comma-delimited verbs>:<comma-delimited resources>
 // namespace:<namespace name>:<comma-delimited verbs>:<comma-delimited resources>
@@ -207,6 +210,78 @@ func (userEvaluator) ResolveGettableNamespaces(scope string, clusterPolicyGetter
 	}
 }
 
+const registryPullOperation = "pull"
+
+// RegistryPullScope returns the scope that limits a token to pulling the named
+// image stream from the given namespace
+func RegistryPullScope(namespace, name string) string {
+	return RegistryIndicator + registryPullOperation + ":" + namespace + "/" + name
+}
+
+// registry:pull:<namespace>/<imagestream name>
+type registryEvaluator struct{}
+
+func (registryEvaluator) Handles(scope string) bool {
+	return strings.HasPrefix(scope, RegistryIndicator)
+}
+
+func (e registryEvaluator) Validate(scope string) error {
+	_, _, _, err := e.parseScope(scope)
+	return err
+}
+
+// parseScope parses the requested scope, determining the operation, the namespace, and the
+// image stream name.  It will return an error if it doesn't parse cleanly
+func (e registryEvaluator) parseScope(scope string) (string /*operation*/, string /*namespace*/, string /*imagestream name*/, error) {
+	if !e.Handles(scope) {
+		return "", "", "", fmt.Errorf("bad format for scope %v", scope)
+	}
+	tokens := strings.SplitN(scope[len(RegistryIndicator):], ":", 2)
+	if len(tokens) != 2 {
+		return "", "", "", fmt.Errorf("bad format for scope %v", scope)
+	}
+	if tokens[0] != registryPullOperation {
+		return "", "", "", fmt.Errorf("unrecognized operation for scope %v", scope)
+	}
+
+	// image stream names can't have slashes, so there must be exactly one
+	qualified := strings.Split(tokens[1], "/")
+	if len(qualified) != 2 || len(qualified[0]) == 0 || len(qualified[1]) == 0 {
+		return "", "", "", fmt.Errorf("bad format for scope %v", scope)
+	}
+
+	return tokens[0], qualified[0], qualified[1], nil
+}
+
+func (e registryEvaluator) Describe(scope string) (string, string, error) {
+	_, scopeNamespace, name, err := e.parseScope(scope)
+	if err != nil {
+		return "", "", err
+	}
+
+	return fmt.Sprintf("Pull-only access to the image stream %q in project %q", name, scopeNamespace), "", nil
+}
+
+func (e registryEvaluator) ResolveRules(scope, namespace string, clusterPolicyGetter client.ClusterPolicyLister) ([]authorizationapi.PolicyRule, error) {
+	_, scopeNamespace, name, err := e.parseScope(scope)
+	if err != nil {
+		return nil, err
+	}
+
+	// if the scope namespace doesn't match, then don't add any rules, but its not an error
+	if scopeNamespace != namespace {
+		return []authorizationapi.PolicyRule{}, nil
+	}
+
+	return []authorizationapi.PolicyRule{
+		{Verbs: sets.NewString("get"), APIGroups: []string{imageapi.GroupName, imageapi.LegacyGroupName}, Resources: sets.NewString("imagestreams", "imagestreams/layers"), ResourceNames: sets.NewString(name)},
+	}, nil
+}
+
+func (e registryEvaluator) ResolveGettableNamespaces(scope string, clusterPolicyGetter client.ClusterPolicyLister) ([]string, error) {
+	return []string{}, nil
+}
+
 // escalatingScopeResources are resources that are considered escalating for scope evaluation
 var escalatingScopeResources = []unversioned.GroupResource{
 	{Group: kapi.GroupName, Resource: "secrets"},
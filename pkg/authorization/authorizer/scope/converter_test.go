@@ -79,6 +79,63 @@ func TestUserEvaluator(t *testing.T) {
 	}
 }
 
+func TestRegistryEvaluator(t *testing.T) {
+	testCases := []struct {
+		name     string
+		scopes   []string
+		err      string
+		numRules int
+	}{
+		{
+			name:     "missing-part",
+			scopes:   []string{RegistryIndicator},
+			err:      "bad format for scope",
+			numRules: 1, // we always add the discovery rules
+		},
+		{
+			name:     "bad-operation",
+			scopes:   []string{RegistryIndicator + "push:namespace/stream"},
+			err:      "unrecognized operation for scope",
+			numRules: 1, // we always add the discovery rules
+		},
+		{
+			name:     "missing-name",
+			scopes:   []string{RegistryIndicator + "pull:namespace/"},
+			err:      "bad format for scope",
+			numRules: 1, // we always add the discovery rules
+		},
+		{
+			name:     "matching-namespace",
+			scopes:   []string{RegistryPullScope("namespace", "stream")},
+			numRules: 2,
+		},
+		{
+			name:     "other-namespace",
+			scopes:   []string{RegistryPullScope("other-namespace", "stream")},
+			numRules: 1, // rules are only added in the scope's namespace
+		},
+	}
+
+	for _, tc := range testCases {
+		actualRules, actualErr := ScopesToRules(tc.scopes, "namespace", nil)
+		switch {
+		case len(tc.err) == 0 && actualErr == nil:
+		case len(tc.err) == 0 && actualErr != nil:
+			t.Errorf("%s: unexpected error: %v", tc.name, actualErr)
+		case len(tc.err) != 0 && actualErr == nil:
+			t.Errorf("%s: missing error: %v", tc.name, tc.err)
+		case len(tc.err) != 0 && actualErr != nil:
+			if !strings.Contains(actualErr.Error(), tc.err) {
+				t.Errorf("%s: expected %v, got %v", tc.name, tc.err, actualErr)
+			}
+		}
+
+		if len(actualRules) != tc.numRules {
+			t.Errorf("%s: expected %v, got %v", tc.name, tc.numRules, len(actualRules))
+		}
+	}
+}
+
 func TestClusterRoleEvaluator(t *testing.T) {
 	testCases := []struct {
 		name            string
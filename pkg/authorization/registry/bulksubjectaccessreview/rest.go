@@ -0,0 +1,56 @@
+package bulksubjectaccessreview
+
+import (
+	"fmt"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+	kapierrors "k8s.io/kubernetes/pkg/api/errors"
+	"k8s.io/kubernetes/pkg/runtime"
+
+	authorizationapi "github.com/openshift/origin/pkg/authorization/api"
+	authorizationvalidation "github.com/openshift/origin/pkg/authorization/api/validation"
+	"github.com/openshift/origin/pkg/authorization/registry/subjectaccessreview"
+)
+
+// REST implements the RESTStorage interface in terms of an Registry.
+type REST struct {
+	sarRegistry subjectaccessreview.Registry
+}
+
+// NewREST creates a new REST that evaluates each review through the given subject access review registry.
+func NewREST(sarRegistry subjectaccessreview.Registry) *REST {
+	return &REST{sarRegistry}
+}
+
+// New creates a new BulkSubjectAccessReview object
+func (r *REST) New() runtime.Object {
+	return &authorizationapi.BulkSubjectAccessReview{}
+}
+
+// Create evaluates every review in the bulk request against the authorizer, so callers building a
+// permission matrix can learn the answer for many (user, verb, resource) tuples in one round trip.
+// Each item is subject to the same access checks it would be as an individual SubjectAccessReview.
+func (r *REST) Create(ctx kapi.Context, obj runtime.Object) (runtime.Object, error) {
+	bulkReview, ok := obj.(*authorizationapi.BulkSubjectAccessReview)
+	if !ok {
+		return nil, kapierrors.NewBadRequest(fmt.Sprintf("not a bulkSubjectAccessReview: %#v", obj))
+	}
+	if errs := authorizationvalidation.ValidateBulkSubjectAccessReview(bulkReview); len(errs) > 0 {
+		return nil, kapierrors.NewInvalid(authorizationapi.Kind(bulkReview.Kind), "", errs)
+	}
+
+	response := &authorizationapi.BulkSubjectAccessReviewResponse{
+		Items: make([]authorizationapi.SubjectAccessReviewResponse, 0, len(bulkReview.Items)),
+	}
+	for i := range bulkReview.Items {
+		// make a copy, the registry is free to mutate the review it is handed
+		itemReview := bulkReview.Items[i]
+		itemResponse, err := r.sarRegistry.CreateSubjectAccessReview(ctx, &itemReview)
+		if err != nil {
+			return nil, err
+		}
+		response.Items = append(response.Items, *itemResponse)
+	}
+
+	return response, nil
+}
@@ -0,0 +1,101 @@
+package bulksubjectaccessreview
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/auth/user"
+	"k8s.io/kubernetes/pkg/util/sets"
+
+	authorizationapi "github.com/openshift/origin/pkg/authorization/api"
+)
+
+type testSARRegistry struct {
+	responses map[string]*authorizationapi.SubjectAccessReviewResponse
+	err       string
+
+	actualReviews []*authorizationapi.SubjectAccessReview
+}
+
+func (r *testSARRegistry) CreateSubjectAccessReview(ctx kapi.Context, subjectAccessReview *authorizationapi.SubjectAccessReview) (*authorizationapi.SubjectAccessReviewResponse, error) {
+	r.actualReviews = append(r.actualReviews, subjectAccessReview)
+
+	if len(r.err) != 0 {
+		return nil, errors.New(r.err)
+	}
+	if response, exists := r.responses[subjectAccessReview.Action.Verb+"/"+subjectAccessReview.Action.Resource]; exists {
+		return response, nil
+	}
+	return &authorizationapi.SubjectAccessReviewResponse{Allowed: false}, nil
+}
+
+func TestEmptyBulkReview(t *testing.T) {
+	storage := NewREST(&testSARRegistry{})
+	ctx := kapi.WithUser(kapi.NewContext(), &user.DefaultInfo{Name: "foo"})
+
+	_, err := storage.Create(ctx, &authorizationapi.BulkSubjectAccessReview{})
+	if err == nil || !strings.Contains(err.Error(), "items") {
+		t.Errorf("expected an error about missing items, got %v", err)
+	}
+}
+
+func TestInvalidItemRejectsWholeReview(t *testing.T) {
+	registry := &testSARRegistry{}
+	storage := NewREST(registry)
+	ctx := kapi.WithUser(kapi.NewContext(), &user.DefaultInfo{Name: "foo"})
+
+	review := &authorizationapi.BulkSubjectAccessReview{
+		Items: []authorizationapi.SubjectAccessReview{
+			{Action: authorizationapi.Action{Verb: "get", Resource: "pods"}},
+			{Action: authorizationapi.Action{Resource: "pods"}},
+		},
+	}
+	_, err := storage.Create(ctx, review)
+	if err == nil || !strings.Contains(err.Error(), "items[1].verb") {
+		t.Errorf("expected a validation error on items[1].verb, got %v", err)
+	}
+	if len(registry.actualReviews) != 0 {
+		t.Errorf("expected no reviews to be evaluated, got %d", len(registry.actualReviews))
+	}
+}
+
+func TestBulkReviewOrdering(t *testing.T) {
+	registry := &testSARRegistry{
+		responses: map[string]*authorizationapi.SubjectAccessReviewResponse{
+			"get/pods":       {Allowed: true, Reason: "allowed by policy"},
+			"delete/secrets": {Allowed: false, Reason: "denied by policy"},
+		},
+	}
+	storage := NewREST(registry)
+	ctx := kapi.WithUser(kapi.NewContext(), &user.DefaultInfo{Name: "foo"})
+
+	review := &authorizationapi.BulkSubjectAccessReview{
+		Items: []authorizationapi.SubjectAccessReview{
+			{Action: authorizationapi.Action{Verb: "get", Resource: "pods"}, User: "harold", Groups: sets.NewString()},
+			{Action: authorizationapi.Action{Verb: "delete", Resource: "secrets"}, User: "harold", Groups: sets.NewString()},
+		},
+	}
+	obj, err := storage.Create(ctx, review)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	response, ok := obj.(*authorizationapi.BulkSubjectAccessReviewResponse)
+	if !ok {
+		t.Fatalf("unexpected response type: %#v", obj)
+	}
+
+	if len(response.Items) != 2 {
+		t.Fatalf("expected 2 responses, got %d", len(response.Items))
+	}
+	if !response.Items[0].Allowed || response.Items[0].Reason != "allowed by policy" {
+		t.Errorf("unexpected first response: %#v", response.Items[0])
+	}
+	if response.Items[1].Allowed || response.Items[1].Reason != "denied by policy" {
+		t.Errorf("unexpected second response: %#v", response.Items[1])
+	}
+	if len(registry.actualReviews) != 2 || registry.actualReviews[0].Action.Verb != "get" || registry.actualReviews[1].Action.Verb != "delete" {
+		t.Errorf("reviews were not evaluated in order: %#v", registry.actualReviews)
+	}
+}
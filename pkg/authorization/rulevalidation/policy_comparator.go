@@ -18,6 +18,10 @@ func Covers(ownerRules, servantRules []authorizationapi.PolicyRule) (bool, []aut
 
 	subrules := []authorizationapi.PolicyRule{}
 	for _, servantRule := range servantRules {
+		// deny rules grant nothing, so they require no coverage and provide none
+		if servantRule.Deny {
+			continue
+		}
 		subrules = append(subrules, BreakdownRule(servantRule)...)
 	}
 
@@ -28,6 +32,9 @@ func Covers(ownerRules, servantRules []authorizationapi.PolicyRule) (bool, []aut
 	for _, subrule := range subrules {
 		covered := false
 		for _, ownerRule := range ownerRules {
+			if ownerRule.Deny {
+				continue
+			}
 			if ruleCovers(ownerRule, subrule) {
 				covered = true
 				break
@@ -33,7 +33,7 @@ const (
 )
 
 // InstallRegistry checks whether a registry is installed and installs one if not already installed
-func (h *Helper) InstallRegistry(kubeClient kclientset.Interface, f *clientcmd.Factory, configDir, images, pvDir string, out, errout io.Writer) error {
+func (h *Helper) InstallRegistry(kubeClient kclientset.Interface, f *clientcmd.Factory, configDir, images, pvDir, patchDir string, out, errout io.Writer) error {
 	_, err := kubeClient.Core().Services(DefaultNamespace).Get(SvcDockerRegistry)
 	if err == nil {
 		// If there's no error, the registry already exists
@@ -75,11 +75,15 @@ func (h *Helper) InstallRegistry(kubeClient kclientset.Interface, f *clientcmd.F
 	if err != nil {
 		return errors.NewError("cannot install registry").WithCause(err).WithDetails(h.OriginLog())
 	}
-	return nil
+	osClient, _, err := f.Clients()
+	if err != nil {
+		return errors.NewError("cannot obtain API clients").WithCause(err)
+	}
+	return patchDeploymentConfig(osClient, patchDir, RegistryPatchName, DefaultNamespace, SvcDockerRegistry)
 }
 
 // InstallRouter installs a default router on the OpenShift server
-func (h *Helper) InstallRouter(kubeClient kclientset.Interface, f *clientcmd.Factory, configDir, images, hostIP string, portForwarding bool, out, errout io.Writer) error {
+func (h *Helper) InstallRouter(kubeClient kclientset.Interface, f *clientcmd.Factory, configDir, images, hostIP string, portForwarding bool, patchDir string, out, errout io.Writer) error {
 	_, err := kubeClient.Core().Services(DefaultNamespace).Get(SvcRouter)
 	if err == nil {
 		// Router service already exists, nothing to do
@@ -171,7 +175,11 @@ func (h *Helper) InstallRouter(kubeClient kclientset.Interface, f *clientcmd.Fac
 	if err != nil {
 		return errors.NewError("cannot install router").WithCause(err).WithDetails(h.OriginLog())
 	}
-	return nil
+	osClient, _, err := f.Clients()
+	if err != nil {
+		return errors.NewError("cannot obtain API clients").WithCause(err)
+	}
+	return patchDeploymentConfig(osClient, patchDir, RouterPatchName, DefaultNamespace, SvcRouter)
 }
 
 func AddClusterRole(osClient client.Interface, role, user string) error {
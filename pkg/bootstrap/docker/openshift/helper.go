@@ -112,6 +112,7 @@ type StartOptions struct {
 	NoProxy                  []string
 	KubeconfigContents       string
 	DockerRoot               string
+	PatchDir                 string
 }
 
 // NewHelper creates a new OpenShift helper
@@ -706,6 +707,14 @@ func (h *Helper) updateConfig(configDir string, opt *StartOptions) error {
 	if err != nil {
 		return err
 	}
+	if patch, err := LoadPatch(opt.PatchDir, MasterConfigPatchName); err != nil {
+		return err
+	} else if len(patch) > 0 {
+		cfgBytes, err = applyMergePatchToYAML(cfgBytes, patch)
+		if err != nil {
+			return errors.NewError("cannot apply master configuration patch").WithCause(err)
+		}
+	}
 	err = ioutil.WriteFile(configPath, cfgBytes, 0644)
 	if err != nil {
 		return err
@@ -3,9 +3,12 @@ package openshift
 import (
 	"fmt"
 
+	jsonpatch "github.com/evanphx/json-patch"
 	kapi "k8s.io/kubernetes/pkg/api"
 	apierrors "k8s.io/kubernetes/pkg/api/errors"
 	kbatch "k8s.io/kubernetes/pkg/apis/batch"
+	batchv1 "k8s.io/kubernetes/pkg/apis/batch/v1"
+	"k8s.io/kubernetes/pkg/runtime"
 
 	"github.com/openshift/origin/pkg/bootstrap/docker/errors"
 	"github.com/openshift/origin/pkg/cmd/util/clientcmd"
@@ -20,7 +23,7 @@ const (
 )
 
 // InstallMetrics checks whether metrics is installed and installs it if not already installed
-func (h *Helper) InstallMetrics(f *clientcmd.Factory, hostName, imagePrefix, imageVersion string) error {
+func (h *Helper) InstallMetrics(f *clientcmd.Factory, hostName, imagePrefix, imageVersion, patchDir string) error {
 	osClient, kubeClient, err := f.Clients()
 	if err != nil {
 		return errors.NewError("cannot obtain API clients").WithCause(err).WithDetails(h.OriginLog())
@@ -69,12 +72,49 @@ func (h *Helper) InstallMetrics(f *clientcmd.Factory, hostName, imagePrefix, ima
 	// Create the job client
 	jobClient := kubeClient.Batch().Jobs(infraNamespace)
 
+	job := metricsDeployerJob(hostName, imagePrefix, imageVersion)
+	job, err = patchMetricsDeployerJob(job, patchDir)
+	if err != nil {
+		return errors.NewError("cannot apply metrics patch").WithCause(err)
+	}
+
 	// Submit the job
-	jobClient.Create(metricsDeployerJob(hostName, imagePrefix, imageVersion))
+	jobClient.Create(job)
 
 	return nil
 }
 
+// patchMetricsDeployerJob applies a merge patch from the patch directory to
+// the metrics deployer job before it is submitted. It returns the job
+// unchanged when no metrics patch file exists.
+func patchMetricsDeployerJob(job *kbatch.Job, patchDir string) (*kbatch.Job, error) {
+	patch, err := LoadPatch(patchDir, MetricsPatchName)
+	if err != nil {
+		return nil, err
+	}
+	if len(patch) == 0 {
+		return job, nil
+	}
+	codec := kapi.Codecs.LegacyCodec(batchv1.SchemeGroupVersion)
+	original, err := runtime.Encode(codec, job)
+	if err != nil {
+		return nil, err
+	}
+	patched, err := jsonpatch.MergePatch(original, patch)
+	if err != nil {
+		return nil, err
+	}
+	obj, err := runtime.Decode(codec, patched)
+	if err != nil {
+		return nil, err
+	}
+	patchedJob, ok := obj.(*kbatch.Job)
+	if !ok {
+		return nil, fmt.Errorf("metrics patch produced an unexpected object: %T", obj)
+	}
+	return patchedJob, nil
+}
+
 // Returns a job to create the metrics deployer pod
 func metricsDeployerJob(hostName, imagePrefix, imageVersion string) *kbatch.Job {
 	env := []kapi.EnvVar{
@@ -0,0 +1,86 @@
+package openshift
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	jsonpatch "github.com/evanphx/json-patch"
+	"github.com/ghodss/yaml"
+	"github.com/golang/glog"
+	kapi "k8s.io/kubernetes/pkg/api"
+
+	"github.com/openshift/origin/pkg/client"
+)
+
+// Patch file names recognized inside a --patch-dir directory. Each file is a
+// YAML or JSON merge patch applied to the corresponding generated artifact:
+// master-config is patched before the server configuration is uploaded,
+// router and registry are patched onto the installed deployment configs, and
+// metrics is patched onto the metrics deployer job before it is submitted.
+const (
+	MasterConfigPatchName = "master-config"
+	RouterPatchName       = "router"
+	RegistryPatchName     = "registry"
+	MetricsPatchName      = "metrics"
+)
+
+var patchFileExtensions = []string{".yaml", ".yml", ".json"}
+
+// LoadPatch looks for a patch file for the named component in dir and returns
+// its content as JSON. It returns nil bytes when dir is empty or no patch
+// file exists for the component.
+func LoadPatch(dir, component string) ([]byte, error) {
+	if len(dir) == 0 {
+		return nil, nil
+	}
+	for _, ext := range patchFileExtensions {
+		path := filepath.Join(dir, component+ext)
+		data, err := ioutil.ReadFile(path)
+		if os.IsNotExist(err) {
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("cannot read patch file %s: %v", path, err)
+		}
+		jsonData, err := yaml.YAMLToJSON(data)
+		if err != nil {
+			return nil, fmt.Errorf("cannot parse patch file %s: %v", path, err)
+		}
+		return jsonData, nil
+	}
+	return nil, nil
+}
+
+// applyMergePatchToYAML applies a JSON merge patch to a YAML document and
+// returns the patched document as YAML.
+func applyMergePatchToYAML(originalYAML, patchJSON []byte) ([]byte, error) {
+	originalJSON, err := yaml.YAMLToJSON(originalYAML)
+	if err != nil {
+		return nil, err
+	}
+	patchedJSON, err := jsonpatch.MergePatch(originalJSON, patchJSON)
+	if err != nil {
+		return nil, err
+	}
+	return yaml.JSONToYAML(patchedJSON)
+}
+
+// patchDeploymentConfig applies a strategic merge patch from the patch
+// directory to the named deployment config. It is a no-op when no patch file
+// exists for the component.
+func patchDeploymentConfig(osClient client.Interface, patchDir, component, namespace, name string) error {
+	patch, err := LoadPatch(patchDir, component)
+	if err != nil {
+		return err
+	}
+	if len(patch) == 0 {
+		return nil
+	}
+	glog.V(2).Infof("Applying %s patch to deployment config %s/%s", component, namespace, name)
+	if _, err := osClient.DeploymentConfigs(namespace).Patch(name, kapi.StrategicMergePatchType, patch); err != nil {
+		return fmt.Errorf("cannot patch deployment config %s/%s: %v", namespace, name, err)
+	}
+	return nil
+}
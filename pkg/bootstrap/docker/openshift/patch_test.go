@@ -0,0 +1,57 @@
+package openshift
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestLoadPatch(t *testing.T) {
+	dir, err := ioutil.TempDir("", "patch-test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	if err := ioutil.WriteFile(filepath.Join(dir, "master-config.yaml"), []byte("corsAllowedOrigins:\n- example.com\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	patch, err := LoadPatch(dir, MasterConfigPatchName)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !strings.Contains(string(patch), `"corsAllowedOrigins"`) {
+		t.Errorf("expected patch converted to JSON, got %q", string(patch))
+	}
+
+	patch, err = LoadPatch(dir, RouterPatchName)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if patch != nil {
+		t.Errorf("expected no patch for missing file, got %q", string(patch))
+	}
+
+	patch, err = LoadPatch("", MasterConfigPatchName)
+	if err != nil || patch != nil {
+		t.Errorf("expected no patch for empty directory, got %q, %v", string(patch), err)
+	}
+}
+
+func TestApplyMergePatchToYAML(t *testing.T) {
+	original := []byte("imageConfig:\n  format: openshift/origin-${component}:${version}\n  latest: false\n")
+	patch := []byte(`{"imageConfig":{"latest":true}}`)
+
+	patched, err := applyMergePatchToYAML(original, patch)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !strings.Contains(string(patched), "latest: true") {
+		t.Errorf("expected patched value, got:\n%s", string(patched))
+	}
+	if !strings.Contains(string(patched), "openshift/origin-${component}:${version}") {
+		t.Errorf("expected untouched fields to be preserved, got:\n%s", string(patched))
+	}
+}
@@ -199,6 +199,7 @@ type CommonStartConfig struct {
 	HTTPSProxy               string
 	NoProxy                  []string
 	CACert                   string
+	PatchDir                 string
 
 	dockerClient    *docker.Client
 	engineAPIClient *dockerclient.Client
@@ -249,6 +250,7 @@ func (config *CommonStartConfig) Bind(flags *pflag.FlagSet) {
 	flags.StringVar(&config.HTTPProxy, "http-proxy", "", "HTTP proxy to use for master and builds")
 	flags.StringVar(&config.HTTPSProxy, "https-proxy", "", "HTTPS proxy to use for master and builds")
 	flags.StringArrayVar(&config.NoProxy, "no-proxy", config.NoProxy, "List of hosts or subnets for which a proxy should not be used")
+	flags.StringVar(&config.PatchDir, "patch-dir", "", "Directory with merge patch files to apply to the generated master configuration and installed components")
 }
 
 // Validate validates that required fields in StartConfig have been populated
@@ -256,6 +258,15 @@ func (c *CommonStartConfig) Validate(out io.Writer) error {
 	if len(c.Tasks) == 0 {
 		return fmt.Errorf("no startup tasks to execute")
 	}
+	if len(c.PatchDir) > 0 {
+		info, err := os.Stat(c.PatchDir)
+		if err != nil {
+			return fmt.Errorf("cannot access patch directory %s: %v", c.PatchDir, err)
+		}
+		if !info.IsDir() {
+			return fmt.Errorf("patch directory %s is not a directory", c.PatchDir)
+		}
+	}
 	return nil
 }
 
@@ -778,6 +789,7 @@ func (c *ClientStartConfig) StartOpenShift(out io.Writer) error {
 		HTTPSProxy:               c.HTTPSProxy,
 		NoProxy:                  c.NoProxy,
 		DockerRoot:               dockerRoot,
+		PatchDir:                 c.PatchDir,
 	}
 	if c.ShouldInstallMetrics {
 		opt.MetricsHost = openshift.MetricsHost(c.RoutingSuffix, c.ServerIP)
@@ -842,7 +854,7 @@ func (c *ClientStartConfig) InstallRegistry(out io.Writer) error {
 	if err != nil {
 		return err
 	}
-	return c.OpenShiftHelper().InstallRegistry(kubeClient, f, c.LocalConfigDir, c.imageFormat(), c.HostPersistentVolumesDir, out, os.Stderr)
+	return c.OpenShiftHelper().InstallRegistry(kubeClient, f, c.LocalConfigDir, c.imageFormat(), c.HostPersistentVolumesDir, c.PatchDir, out, os.Stderr)
 }
 
 // InstallRouter installs a default router on the server
@@ -855,7 +867,7 @@ func (c *ClientStartConfig) InstallRouter(out io.Writer) error {
 	if err != nil {
 		return err
 	}
-	return c.OpenShiftHelper().InstallRouter(kubeClient, f, c.LocalConfigDir, c.imageFormat(), c.ServerIP, c.PortForwarding, out, os.Stderr)
+	return c.OpenShiftHelper().InstallRouter(kubeClient, f, c.LocalConfigDir, c.imageFormat(), c.ServerIP, c.PortForwarding, c.PatchDir, out, os.Stderr)
 }
 
 // ImportImageStreams imports default image streams into the server
@@ -895,7 +907,7 @@ func (c *ClientStartConfig) InstallMetrics(out io.Writer) error {
 	if err != nil {
 		return err
 	}
-	return c.OpenShiftHelper().InstallMetrics(f, openshift.MetricsHost(c.RoutingSuffix, c.ServerIP), c.Image, c.ImageVersion)
+	return c.OpenShiftHelper().InstallMetrics(f, openshift.MetricsHost(c.RoutingSuffix, c.ServerIP), c.Image, c.ImageVersion, c.PatchDir)
 }
 
 // Login logs into the new server and sets up a default user and project
@@ -1,8 +1,12 @@
 package defaults
 
 import (
+	"encoding/json"
+
 	"github.com/golang/glog"
 	kapi "k8s.io/kubernetes/pkg/api"
+	kclientset "k8s.io/kubernetes/pkg/client/clientset_generated/internalclientset"
+	"k8s.io/kubernetes/pkg/labels"
 
 	buildadmission "github.com/openshift/origin/pkg/build/admission"
 	defaultsapi "github.com/openshift/origin/pkg/build/admission/defaults/api"
@@ -13,6 +17,10 @@ import (
 
 type BuildDefaults struct {
 	config *defaultsapi.BuildDefaultsConfig
+
+	// Client is used to look up namespace labels when selective defaulting rules with
+	// a namespace selector are configured.
+	Client kclientset.Interface
 }
 
 // NewBuildDefaults creates a new BuildDefaults that will apply the defaults specified in the plugin config
@@ -47,6 +55,10 @@ func (b BuildDefaults) ApplyDefaults(pod *kapi.Pod) error {
 
 	b.applyPodDefaults(pod)
 
+	if err := b.applyRules(build, pod); err != nil {
+		return err
+	}
+
 	err = buildadmission.SetPodLogLevelFromBuild(pod, build)
 	if err != nil {
 		return err
@@ -125,25 +137,55 @@ func (b BuildDefaults) applyBuildDefaults(build *buildapi.Build) {
 	}
 
 	//Apply default resources
-	defaultResources := b.config.Resources
-	if len(build.Spec.Resources.Limits) == 0 {
-		build.Spec.Resources.Limits = kapi.ResourceList{}
-	}
-	for name, value := range defaultResources.Limits {
-		if _, ok := build.Spec.Resources.Limits[name]; !ok {
-			glog.V(5).Infof("Setting default resource limit %s for build %s/%s to %s", name, build.Namespace, build.Name, value)
-			build.Spec.Resources.Limits[name] = value
+	addDefaultResources(build, b.config.Resources)
+}
+
+// applyRules applies the selective defaulting rules that match the build's strategy and
+// namespace to the build and its pod.
+func (b BuildDefaults) applyRules(build *buildapi.Build, pod *kapi.Pod) error {
+	strategyType := buildapi.StrategyType(build.Spec.Strategy)
+	for i := range b.config.Rules {
+		rule := &b.config.Rules[i]
+		if len(rule.Strategy) != 0 && string(rule.Strategy) != strategyType {
+			continue
+		}
+		if len(rule.NamespaceSelector) != 0 {
+			matches, err := b.namespaceMatches(build.Namespace, rule.NamespaceSelector)
+			if err != nil {
+				return err
+			}
+			if !matches {
+				continue
+			}
+		}
+		glog.V(5).Infof("Applying defaulting rule %d to build %s/%s", i, build.Namespace, build.Name)
+		addDefaultResources(build, rule.Resources)
+		if len(rule.NodeSelector) != 0 {
+			if pod.Spec.NodeSelector == nil {
+				pod.Spec.NodeSelector = map[string]string{}
+			}
+			for k, v := range rule.NodeSelector {
+				addDefaultNodeSelector(k, v, pod.Spec.NodeSelector)
+			}
+		}
+		if err := addDefaultTolerations(pod, rule.Tolerations); err != nil {
+			return err
 		}
 	}
-	if len(build.Spec.Resources.Requests) == 0 {
-		build.Spec.Resources.Requests = kapi.ResourceList{}
+	return nil
+}
+
+// namespaceMatches returns true if the labels on the named namespace match the selector.
+func (b BuildDefaults) namespaceMatches(namespace string, selector map[string]string) (bool, error) {
+	if b.Client == nil {
+		glog.V(4).Infof("No client configured for build defaults, skipping namespace selector match for %s", namespace)
+		return false, nil
 	}
-	for name, value := range defaultResources.Requests {
-		if _, ok := build.Spec.Resources.Requests[name]; !ok {
-			glog.V(5).Infof("Setting default resource request %s for build %s/%s to %s", name, build.Namespace, build.Name, value)
-			build.Spec.Resources.Requests[name] = value
-		}
+	ns, err := b.Client.Core().Namespaces().Get(namespace)
+	if err != nil {
+		return false, err
 	}
+	return labels.Set(selector).AsSelector().Matches(labels.Set(ns.Labels)), nil
 }
 
 func getBuildEnv(build *buildapi.Build) *[]kapi.EnvVar {
@@ -183,6 +225,62 @@ func addDefaultLabel(defaultLabel buildapi.ImageLabel, buildLabels *[]buildapi.I
 	}
 }
 
+// addDefaultResources sets the provided resource defaults on the build for any
+// resource name the build does not already specify.
+func addDefaultResources(build *buildapi.Build, defaultResources kapi.ResourceRequirements) {
+	if len(build.Spec.Resources.Limits) == 0 {
+		build.Spec.Resources.Limits = kapi.ResourceList{}
+	}
+	for name, value := range defaultResources.Limits {
+		if _, ok := build.Spec.Resources.Limits[name]; !ok {
+			glog.V(5).Infof("Setting default resource limit %s for build %s/%s to %s", name, build.Namespace, build.Name, value)
+			build.Spec.Resources.Limits[name] = value
+		}
+	}
+	if len(build.Spec.Resources.Requests) == 0 {
+		build.Spec.Resources.Requests = kapi.ResourceList{}
+	}
+	for name, value := range defaultResources.Requests {
+		if _, ok := build.Spec.Resources.Requests[name]; !ok {
+			glog.V(5).Infof("Setting default resource request %s for build %s/%s to %s", name, build.Namespace, build.Name, value)
+			build.Spec.Resources.Requests[name] = value
+		}
+	}
+}
+
+// addDefaultTolerations merges the provided tolerations into the pod's toleration
+// annotation, skipping any toleration the pod already carries.
+func addDefaultTolerations(pod *kapi.Pod, tolerations []kapi.Toleration) error {
+	if len(tolerations) == 0 {
+		return nil
+	}
+	existing, err := kapi.GetTolerationsFromPodAnnotations(pod.Annotations)
+	if err != nil {
+		return err
+	}
+	for _, toleration := range tolerations {
+		found := false
+		for _, e := range existing {
+			if e == toleration {
+				found = true
+				break
+			}
+		}
+		if !found {
+			existing = append(existing, toleration)
+		}
+	}
+	data, err := json.Marshal(existing)
+	if err != nil {
+		return err
+	}
+	if pod.Annotations == nil {
+		pod.Annotations = map[string]string{}
+	}
+	pod.Annotations[kapi.TolerationsAnnotationKey] = string(data)
+	return nil
+}
+
 func addDefaultNodeSelector(k, v string, selectors map[string]string) bool {
 	if _, ok := selectors[k]; !ok {
 		selectors[k] = v
@@ -22,7 +22,7 @@ func TestProxyDefaults(t *testing.T) {
 		GitNoProxy:    "no",
 	}
 
-	admitter := BuildDefaults{defaultsConfig}
+	admitter := BuildDefaults{config: defaultsConfig}
 	pod := u.Pod().WithBuild(t, u.Build().WithDockerStrategy().AsBuild(), "v1")
 	err := admitter.ApplyDefaults((*kapi.Pod)(pod))
 	if err != nil {
@@ -58,7 +58,7 @@ func TestEnvDefaults(t *testing.T) {
 		},
 	}
 
-	admitter := BuildDefaults{defaultsConfig}
+	admitter := BuildDefaults{config: defaultsConfig}
 	pod := u.Pod().WithBuild(t, u.Build().WithSourceStrategy().AsBuild(), "v1")
 	err := admitter.ApplyDefaults((*kapi.Pod)(pod))
 	if err != nil {
@@ -101,7 +101,7 @@ func TestIncrementalDefaults(t *testing.T) {
 		},
 	}
 
-	admitter := BuildDefaults{defaultsConfig}
+	admitter := BuildDefaults{config: defaultsConfig}
 
 	pod := u.Pod().WithBuild(t, u.Build().WithSourceStrategy().AsBuild(), "v1")
 	err := admitter.ApplyDefaults((*kapi.Pod)(pod))
@@ -250,7 +250,7 @@ func TestLabelDefaults(t *testing.T) {
 			ImageLabels: test.defaultLabels,
 		}
 
-		admitter := BuildDefaults{defaultsConfig}
+		admitter := BuildDefaults{config: defaultsConfig}
 		pod := u.Pod().WithBuild(t, u.Build().WithImageLabels(test.buildLabels).AsBuild(), "v1")
 		err := admitter.ApplyDefaults((*kapi.Pod)(pod))
 		if err != nil {
@@ -559,3 +559,56 @@ func TestResourceDefaults(t *testing.T) {
 		}
 	}
 }
+
+func TestBuildDefaultsRules(t *testing.T) {
+	defaultsConfig := &defaultsapi.BuildDefaultsConfig{
+		Rules: []defaultsapi.BuildDefaultsRule{
+			{
+				Strategy: "Docker",
+				Resources: kapi.ResourceRequirements{
+					Limits: kapi.ResourceList{
+						kapi.ResourceName(kapi.ResourceCPU): resource.MustParse("20"),
+					},
+				},
+				NodeSelector: map[string]string{"builds": "docker"},
+				Tolerations: []kapi.Toleration{
+					{Key: "dedicated", Operator: kapi.TolerationOpEqual, Value: "builds", Effect: kapi.TaintEffectNoSchedule},
+				},
+			},
+			{
+				Strategy: "Source",
+				Resources: kapi.ResourceRequirements{
+					Limits: kapi.ResourceList{
+						kapi.ResourceName(kapi.ResourceCPU): resource.MustParse("40"),
+					},
+				},
+			},
+		},
+	}
+
+	admitter := BuildDefaults{config: defaultsConfig}
+	pod := u.Pod().WithBuild(t, u.Build().WithDockerStrategy().AsBuild(), "v1")
+	err := admitter.ApplyDefaults((*kapi.Pod)(pod))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	build, _, err := buildadmission.GetBuildFromPod((*kapi.Pod)(pod))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	cpu := build.Spec.Resources.Limits[kapi.ResourceName(kapi.ResourceCPU)]
+	if cpu.String() != "20" {
+		t.Errorf("expected cpu limit from the docker rule, got %s", cpu.String())
+	}
+	if pod.Spec.NodeSelector["builds"] != "docker" {
+		t.Errorf("expected node selector from the docker rule, got %v", pod.Spec.NodeSelector)
+	}
+	tolerations, err := kapi.GetTolerationsFromPodAnnotations(pod.Annotations)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(tolerations) != 1 || tolerations[0].Key != "dedicated" {
+		t.Errorf("expected toleration from the docker rule, got %v", tolerations)
+	}
+}
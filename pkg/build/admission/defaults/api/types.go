@@ -43,6 +43,34 @@ type BuildDefaultsConfig struct {
 
 	// resources defines resource requirements to execute the build.
 	Resources kapi.ResourceRequirements
+
+	// rules are additional defaults that are applied only to builds matching a
+	// strategy and/or namespaces matching a label selector. Rules are evaluated in
+	// order after the global defaults.
+	Rules []BuildDefaultsRule
+}
+
+// BuildDefaultsRule applies defaults to builds that use a particular strategy and/or
+// run in namespaces whose labels match a selector.
+type BuildDefaultsRule struct {
+	// strategy limits the rule to builds using the given strategy: Docker, Source,
+	// Custom or JenkinsPipeline. An empty value matches every strategy.
+	Strategy buildapi.BuildStrategyType
+
+	// namespaceSelector limits the rule to builds in namespaces whose labels match
+	// the selector. An empty selector matches all namespaces.
+	NamespaceSelector map[string]string
+
+	// resources defines resource requirements applied to matching builds when the
+	// build does not specify them.
+	Resources kapi.ResourceRequirements
+
+	// nodeSelector entries are added to the build pod's node selector when the pod
+	// does not already set the key.
+	NodeSelector map[string]string
+
+	// tolerations are added to the build pod's tolerations.
+	Tolerations []kapi.Toleration
 }
 
 // SourceStrategyDefaultsConfig contains values that apply to builds using the
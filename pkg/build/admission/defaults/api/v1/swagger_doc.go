@@ -16,12 +16,26 @@ var map_BuildDefaultsConfig = map[string]string{
 	"nodeSelector":           "nodeSelector is a selector which must be true for the build pod to fit on a node",
 	"annotations":            "annotations are annotations that will be added to the build pod",
 	"resources":              "resources defines resource requirements to execute the build.",
+	"rules":                  "rules are additional defaults that are applied only to builds matching a strategy and/or namespaces matching a label selector. Rules are evaluated in order after the global defaults.",
 }
 
 func (BuildDefaultsConfig) SwaggerDoc() map[string]string {
 	return map_BuildDefaultsConfig
 }
 
+var map_BuildDefaultsRule = map[string]string{
+	"":                  "BuildDefaultsRule applies defaults to builds that use a particular strategy and/or run in namespaces whose labels match a selector.",
+	"strategy":          "strategy limits the rule to builds using the given strategy: Docker, Source, Custom or JenkinsPipeline. An empty value matches every strategy.",
+	"namespaceSelector": "namespaceSelector limits the rule to builds in namespaces whose labels match the selector. An empty selector matches all namespaces.",
+	"resources":         "resources defines resource requirements applied to matching builds when the build does not specify them.",
+	"nodeSelector":      "nodeSelector entries are added to the build pod's node selector when the pod does not already set the key.",
+	"tolerations":       "tolerations are added to the build pod's tolerations.",
+}
+
+func (BuildDefaultsRule) SwaggerDoc() map[string]string {
+	return map_BuildDefaultsRule
+}
+
 var map_SourceStrategyDefaultsConfig = map[string]string{
 	"":            "SourceStrategyDefaultsConfig contains values that apply to builds using the source strategy.",
 	"incremental": "incremental indicates if s2i build strategies should perform an incremental build or not",
@@ -1,6 +1,7 @@
 package validation
 
 import (
+	unversionedvalidation "k8s.io/kubernetes/pkg/api/unversioned/validation"
 	"k8s.io/kubernetes/pkg/api/validation"
 	"k8s.io/kubernetes/pkg/util/validation/field"
 
@@ -17,6 +18,21 @@ func ValidateBuildDefaultsConfig(config *api.BuildDefaultsConfig) field.ErrorLis
 	allErrs = append(allErrs, buildvalidation.ValidateImageLabels(config.ImageLabels, field.NewPath("imageLabels"))...)
 	allErrs = append(allErrs, buildvalidation.ValidateNodeSelector(config.NodeSelector, field.NewPath("nodeSelector"))...)
 	allErrs = append(allErrs, validation.ValidateAnnotations(config.Annotations, field.NewPath("annotations"))...)
+	for i, rule := range config.Rules {
+		allErrs = append(allErrs, validateBuildDefaultsRule(rule, field.NewPath("rules").Index(i))...)
+	}
+	return allErrs
+}
+
+func validateBuildDefaultsRule(rule api.BuildDefaultsRule, path *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	switch rule.Strategy {
+	case "", "Docker", "Source", "Custom", "JenkinsPipeline":
+	default:
+		allErrs = append(allErrs, field.NotSupported(path.Child("strategy"), rule.Strategy, []string{"Docker", "Source", "Custom", "JenkinsPipeline"}))
+	}
+	allErrs = append(allErrs, unversionedvalidation.ValidateLabels(rule.NamespaceSelector, path.Child("namespaceSelector"))...)
+	allErrs = append(allErrs, buildvalidation.ValidateNodeSelector(rule.NodeSelector, path.Child("nodeSelector"))...)
 	return allErrs
 }
 
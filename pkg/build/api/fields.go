@@ -8,7 +8,10 @@ func BuildToSelectableFields(build *Build) fields.Set {
 	return fields.Set{
 		"metadata.name":      build.Name,
 		"metadata.namespace": build.Namespace,
+		// TODO: "status" is deprecated in favor of "status.phase", remove in a future release
 		"status":             string(build.Status.Phase),
+		"status.phase":       string(build.Status.Phase),
+		"spec.strategy.type": StrategyType(build.Spec.Strategy),
 		"podName":            GetBuildPodName(build),
 	}
 }
@@ -19,6 +19,13 @@ const (
 	BuildCloneAnnotation = "openshift.io/build.clone-of"
 	// BuildPodNameAnnotation is an annotation whose value is the name of the pod running this build
 	BuildPodNameAnnotation = "openshift.io/build.pod-name"
+	// BuildPlatformAnnotation is an annotation whose value is the os/arch platform
+	// a build produced by a multi-platform BuildConfig targets
+	BuildPlatformAnnotation = "openshift.io/build.platform"
+	// BuildManifestListTagAnnotation is an annotation whose value is the image stream
+	// tag a manifest list should be pushed to once all platform builds of a
+	// multi-platform BuildConfig succeed
+	BuildManifestListTagAnnotation = "openshift.io/build.manifest-list-tag"
 	// BuildJenkinsStatusJSONAnnotation is an annotation holding the Jenkins status information
 	BuildJenkinsStatusJSONAnnotation = "openshift.io/jenkins-status-json"
 	// BuildJenkinsLogURLAnnotation is an annotation holding a link to the raw Jenkins build console log
@@ -63,6 +70,12 @@ const (
 	// forces the build to be processed by the build controller queue without waiting
 	// for a resync.
 	BuildAcceptedAnnotation = "build.openshift.io/accepted"
+	// BuildRetriesAnnotation is an annotation whose value is the number of times this
+	// build has been retried after an infrastructure failure.
+	BuildRetriesAnnotation = "build.openshift.io/retries"
+	// BuildRetryNotBeforeAnnotation is an annotation holding an RFC3339 timestamp before
+	// which a retried build must not be started, implementing the failure policy backoff.
+	BuildRetryNotBeforeAnnotation = "build.openshift.io/retry-not-before"
 )
 
 // +genclient=true
@@ -124,6 +137,11 @@ type CommonSpec struct {
 	// build; value must be positive integer.
 	CompletionDeadlineSeconds *int64
 
+	// FailurePolicy controls automatic retry of builds that fail for
+	// infrastructure reasons. Builds that fail because of their own content
+	// (for example a compile error) are never retried.
+	FailurePolicy *BuildFailurePolicy
+
 	// NodeSelector is a selector which must be true for the build pod to fit on a node
 	// If nil, it can be overridden by default build nodeselector values for the cluster.
 	// If set to an empty map or a map with any values, default build nodeselector values
@@ -131,6 +149,18 @@ type CommonSpec struct {
 	NodeSelector map[string]string
 }
 
+// BuildFailurePolicy describes how builds that fail for infrastructure reasons
+// (image pull timeouts, node eviction, a deleted build pod) are retried.
+type BuildFailurePolicy struct {
+	// MaxRetries is the number of times a build is retried after an
+	// infrastructure failure before it is marked failed.
+	MaxRetries int32
+
+	// BackoffSeconds is the delay before the first retry; each successive
+	// retry doubles the delay. If unset, a delay of 30 seconds is used.
+	BackoffSeconds *int64
+}
+
 const (
 	BuildTriggerCauseManualMsg    = "Manually triggered"
 	BuildTriggerCauseConfigMsg    = "Build configuration change"
@@ -265,6 +295,15 @@ type BuildStatus struct {
 
 	// Output describes the Docker image the build has produced.
 	Output BuildStatusOutput
+
+	// Artifacts holds the content of files extracted from the post commit
+	// hook container, as declared by the hook's ArtifactPaths.
+	Artifacts []BuildArtifact
+
+	// QueuePosition is the one-based position of this build among the builds of
+	// its build config waiting on the run policy to start. It is zero once the
+	// build is no longer queued.
+	QueuePosition int32
 }
 
 // BuildPhase represents the status of a build at a point in time.
@@ -368,6 +407,14 @@ const (
 	// StatusReasonGenericBuildFailed is the reason associated with a broad
 	// range of build failures.
 	StatusReasonGenericBuildFailed StatusReason = "GenericBuildFailed"
+
+	// StatusReasonWaitingForRunPolicy indicates the build is queued behind
+	// other builds of its build config by the configured run policy.
+	StatusReasonWaitingForRunPolicy StatusReason = "WaitingForRunPolicy"
+
+	// StatusReasonQuotaExceeded indicates the build pod could not be created
+	// because the namespace quota is exceeded.
+	StatusReasonQuotaExceeded StatusReason = "QuotaExceeded"
 )
 
 // NOTE: These messages might change.
@@ -388,6 +435,8 @@ const (
 	StatusMessageDockerBuildFailed         = "Docker build strategy has failed."
 	StatusMessageBuildPodExists            = "The pod for this build already exists and is older than the build."
 	StatusMessageGenericBuildFailed        = "Generic Build failure - check logs for details."
+	StatusMessageWaitingForRunPolicy       = "Waiting on earlier builds of this build config to complete."
+	StatusMessageQuotaExceeded             = "Build pod creation was rejected because the namespace quota is exceeded."
 )
 
 // BuildStatusOutput contains the status of the built image.
@@ -433,6 +482,10 @@ type BuildSource struct {
 	// Images describes a set of images to be used to provide source for the build
 	Images []ImageSource
 
+	// Volumes describes a set of persistent volume claims whose contents are used to
+	// provide source for the build
+	Volumes []VolumeBuildSource
+
 	// ContextDir specifies the sub-directory where the source code for the application exists.
 	// This allows to have buildable sources in directory other than root of
 	// repository.
@@ -466,6 +519,18 @@ type ImageSource struct {
 	PullSecret *kapi.LocalObjectReference
 }
 
+// VolumeBuildSource describes a persistent volume claim whose contents are used as source
+// for the build.  The claim is mounted read-only into the build pod and the listed paths
+// are copied into the build directory, which allows builds to chain artifacts through a
+// shared volume without an external artifact store.
+type VolumeBuildSource struct {
+	// PersistentVolumeClaim is a reference to an existing claim in the build's namespace
+	PersistentVolumeClaim kapi.LocalObjectReference
+
+	// Paths is a list of source and destination paths to copy from the volume
+	Paths []ImageSourcePath
+}
+
 // ImageSourcePath describes a path to be copied from a source image and its destination within the build directory.
 type ImageSourcePath struct {
 	// SourcePath is the absolute path of the file or directory inside the image to
@@ -550,6 +615,15 @@ type GitBuildSource struct {
 
 	// ProxyConfig defines the proxies to use for the git clone operation
 	ProxyConfig
+
+	// SubmoduleSecret is the name of a Secret providing additional credentials
+	// for fetching submodules hosted outside the main repository. The secret is
+	// set up like SourceSecret and applied when submodules are updated.
+	SubmoduleSecret *kapi.LocalObjectReference
+
+	// LFS, if true, fetches Git LFS content referenced by the cloned revision
+	// after checkout.
+	LFS bool
 }
 
 // SourceControlUser defines the identity of a user of source control
@@ -820,6 +894,24 @@ type BuildPostCommitSpec struct {
 	// necessary to make `ruby`, `bundle` and other binaries available in
 	// the PATH.
 	Script string
+
+	// ArtifactPaths is a list of absolute paths of files inside the hook
+	// container, such as test reports, that are extracted after the hook
+	// finishes and recorded in the build status. The files are extracted
+	// regardless of the hook's exit code so reports from failing test runs
+	// are preserved. Files that are missing or exceed the artifact size
+	// limit are skipped.
+	ArtifactPaths []string
+}
+
+// BuildArtifact holds the content of a single file extracted from the post
+// commit hook container.
+type BuildArtifact struct {
+	// Path is the path of the file inside the hook container.
+	Path string
+
+	// Data is the raw content of the file.
+	Data []byte
 }
 
 // BuildOutput is input to a build strategy and describes the Docker image that the strategy
@@ -875,6 +967,18 @@ type BuildConfigSpec struct {
 	// This is optional, if not specified we default to "Serial".
 	RunPolicy BuildRunPolicy
 
+	// Platforms is an optional list of target platforms in os/arch form, e.g.
+	// "linux/amd64". When more than one platform is listed, each instantiation
+	// fans out into one build per platform, scheduled onto nodes of the matching
+	// architecture, and a manifest list referencing the per-platform images is
+	// tagged into the output image stream tag once all builds succeed.
+	Platforms []string
+
+	// AllowedSourceOverridePatterns is a list of shell-style glob patterns that
+	// the source ref supplied on an instantiate request must match. When the
+	// list is empty, source overrides on instantiate requests are rejected.
+	AllowedSourceOverridePatterns []string
+
 	// CommonSpec is the desired build specification
 	CommonSpec
 }
@@ -1086,6 +1190,25 @@ type BuildRequest struct {
 
 	// DockerStrategyOptions contains additional docker-strategy specific options for the build
 	DockerStrategyOptions *DockerStrategyOptions
+
+	// SourceOverrides allows the request to override select source fields of the
+	// build config. Overrides are only honored when the build config declares
+	// allowed source override patterns.
+	SourceOverrides *SourceOverrides
+}
+
+// SourceOverrides are per-request overrides for select source fields of a build
+// config, allowing one build config to serve builds from many branches.
+type SourceOverrides struct {
+	// Ref overrides the git branch, tag, or ref to build. It must match one of
+	// the allowed source override patterns on the build config.
+	Ref string
+
+	// Commit pins the build to a specific commit within the ref.
+	Commit string
+
+	// ContextDir overrides the context directory inside the source.
+	ContextDir string
 }
 
 type BinaryBuildRequestOptions struct {
@@ -13,10 +13,12 @@
 		BinaryBuildSource
 		BitbucketWebHookCause
 		Build
+		BuildArtifact
 		BuildConfig
 		BuildConfigList
 		BuildConfigSpec
 		BuildConfigStatus
+		BuildFailurePolicy
 		BuildList
 		BuildLog
 		BuildLogOptions
@@ -55,8 +57,10 @@
 		SecretSpec
 		SourceBuildStrategy
 		SourceControlUser
+		SourceOverrides
 		SourceRevision
 		WebHookTrigger
+		VolumeBuildSource
 */
 package v1
 
@@ -101,193 +105,211 @@ func (m *Build) Reset()                    { *m = Build{} }
 func (*Build) ProtoMessage()               {}
 func (*Build) Descriptor() ([]byte, []int) { return fileDescriptorGenerated, []int{3} }
 
+func (m *BuildArtifact) Reset()                    { *m = BuildArtifact{} }
+func (*BuildArtifact) ProtoMessage()               {}
+func (*BuildArtifact) Descriptor() ([]byte, []int) { return fileDescriptorGenerated, []int{4} }
+
 func (m *BuildConfig) Reset()                    { *m = BuildConfig{} }
 func (*BuildConfig) ProtoMessage()               {}
-func (*BuildConfig) Descriptor() ([]byte, []int) { return fileDescriptorGenerated, []int{4} }
+func (*BuildConfig) Descriptor() ([]byte, []int) { return fileDescriptorGenerated, []int{5} }
 
 func (m *BuildConfigList) Reset()                    { *m = BuildConfigList{} }
 func (*BuildConfigList) ProtoMessage()               {}
-func (*BuildConfigList) Descriptor() ([]byte, []int) { return fileDescriptorGenerated, []int{5} }
+func (*BuildConfigList) Descriptor() ([]byte, []int) { return fileDescriptorGenerated, []int{6} }
 
 func (m *BuildConfigSpec) Reset()                    { *m = BuildConfigSpec{} }
 func (*BuildConfigSpec) ProtoMessage()               {}
-func (*BuildConfigSpec) Descriptor() ([]byte, []int) { return fileDescriptorGenerated, []int{6} }
+func (*BuildConfigSpec) Descriptor() ([]byte, []int) { return fileDescriptorGenerated, []int{7} }
 
 func (m *BuildConfigStatus) Reset()                    { *m = BuildConfigStatus{} }
 func (*BuildConfigStatus) ProtoMessage()               {}
-func (*BuildConfigStatus) Descriptor() ([]byte, []int) { return fileDescriptorGenerated, []int{7} }
+func (*BuildConfigStatus) Descriptor() ([]byte, []int) { return fileDescriptorGenerated, []int{9} }
+
+func (m *BuildFailurePolicy) Reset()                    { *m = BuildFailurePolicy{} }
+func (*BuildFailurePolicy) ProtoMessage()               {}
+func (*BuildFailurePolicy) Descriptor() ([]byte, []int) { return fileDescriptorGenerated, []int{8} }
 
 func (m *BuildList) Reset()                    { *m = BuildList{} }
 func (*BuildList) ProtoMessage()               {}
-func (*BuildList) Descriptor() ([]byte, []int) { return fileDescriptorGenerated, []int{8} }
+func (*BuildList) Descriptor() ([]byte, []int) { return fileDescriptorGenerated, []int{10} }
 
 func (m *BuildLog) Reset()                    { *m = BuildLog{} }
 func (*BuildLog) ProtoMessage()               {}
-func (*BuildLog) Descriptor() ([]byte, []int) { return fileDescriptorGenerated, []int{9} }
+func (*BuildLog) Descriptor() ([]byte, []int) { return fileDescriptorGenerated, []int{11} }
 
 func (m *BuildLogOptions) Reset()                    { *m = BuildLogOptions{} }
 func (*BuildLogOptions) ProtoMessage()               {}
-func (*BuildLogOptions) Descriptor() ([]byte, []int) { return fileDescriptorGenerated, []int{10} }
+func (*BuildLogOptions) Descriptor() ([]byte, []int) { return fileDescriptorGenerated, []int{12} }
 
 func (m *BuildOutput) Reset()                    { *m = BuildOutput{} }
 func (*BuildOutput) ProtoMessage()               {}
-func (*BuildOutput) Descriptor() ([]byte, []int) { return fileDescriptorGenerated, []int{11} }
+func (*BuildOutput) Descriptor() ([]byte, []int) { return fileDescriptorGenerated, []int{13} }
 
 func (m *BuildPostCommitSpec) Reset()                    { *m = BuildPostCommitSpec{} }
 func (*BuildPostCommitSpec) ProtoMessage()               {}
-func (*BuildPostCommitSpec) Descriptor() ([]byte, []int) { return fileDescriptorGenerated, []int{12} }
+func (*BuildPostCommitSpec) Descriptor() ([]byte, []int) { return fileDescriptorGenerated, []int{14} }
 
 func (m *BuildRequest) Reset()                    { *m = BuildRequest{} }
 func (*BuildRequest) ProtoMessage()               {}
-func (*BuildRequest) Descriptor() ([]byte, []int) { return fileDescriptorGenerated, []int{13} }
+func (*BuildRequest) Descriptor() ([]byte, []int) { return fileDescriptorGenerated, []int{15} }
 
 func (m *BuildSource) Reset()                    { *m = BuildSource{} }
 func (*BuildSource) ProtoMessage()               {}
-func (*BuildSource) Descriptor() ([]byte, []int) { return fileDescriptorGenerated, []int{14} }
+func (*BuildSource) Descriptor() ([]byte, []int) { return fileDescriptorGenerated, []int{16} }
 
 func (m *BuildSpec) Reset()                    { *m = BuildSpec{} }
 func (*BuildSpec) ProtoMessage()               {}
-func (*BuildSpec) Descriptor() ([]byte, []int) { return fileDescriptorGenerated, []int{15} }
+func (*BuildSpec) Descriptor() ([]byte, []int) { return fileDescriptorGenerated, []int{17} }
 
 func (m *BuildStatus) Reset()                    { *m = BuildStatus{} }
 func (*BuildStatus) ProtoMessage()               {}
-func (*BuildStatus) Descriptor() ([]byte, []int) { return fileDescriptorGenerated, []int{16} }
+func (*BuildStatus) Descriptor() ([]byte, []int) { return fileDescriptorGenerated, []int{18} }
 
 func (m *BuildStatusOutput) Reset()                    { *m = BuildStatusOutput{} }
 func (*BuildStatusOutput) ProtoMessage()               {}
-func (*BuildStatusOutput) Descriptor() ([]byte, []int) { return fileDescriptorGenerated, []int{17} }
+func (*BuildStatusOutput) Descriptor() ([]byte, []int) { return fileDescriptorGenerated, []int{19} }
 
 func (m *BuildStatusOutputTo) Reset()                    { *m = BuildStatusOutputTo{} }
 func (*BuildStatusOutputTo) ProtoMessage()               {}
-func (*BuildStatusOutputTo) Descriptor() ([]byte, []int) { return fileDescriptorGenerated, []int{18} }
+func (*BuildStatusOutputTo) Descriptor() ([]byte, []int) { return fileDescriptorGenerated, []int{20} }
 
 func (m *BuildStrategy) Reset()                    { *m = BuildStrategy{} }
 func (*BuildStrategy) ProtoMessage()               {}
-func (*BuildStrategy) Descriptor() ([]byte, []int) { return fileDescriptorGenerated, []int{19} }
+func (*BuildStrategy) Descriptor() ([]byte, []int) { return fileDescriptorGenerated, []int{21} }
 
 func (m *BuildTriggerCause) Reset()                    { *m = BuildTriggerCause{} }
 func (*BuildTriggerCause) ProtoMessage()               {}
-func (*BuildTriggerCause) Descriptor() ([]byte, []int) { return fileDescriptorGenerated, []int{20} }
+func (*BuildTriggerCause) Descriptor() ([]byte, []int) { return fileDescriptorGenerated, []int{22} }
 
 func (m *BuildTriggerPolicy) Reset()                    { *m = BuildTriggerPolicy{} }
 func (*BuildTriggerPolicy) ProtoMessage()               {}
-func (*BuildTriggerPolicy) Descriptor() ([]byte, []int) { return fileDescriptorGenerated, []int{21} }
+func (*BuildTriggerPolicy) Descriptor() ([]byte, []int) { return fileDescriptorGenerated, []int{23} }
 
 func (m *CommonSpec) Reset()                    { *m = CommonSpec{} }
 func (*CommonSpec) ProtoMessage()               {}
-func (*CommonSpec) Descriptor() ([]byte, []int) { return fileDescriptorGenerated, []int{22} }
+func (*CommonSpec) Descriptor() ([]byte, []int) { return fileDescriptorGenerated, []int{24} }
 
 func (m *CommonWebHookCause) Reset()                    { *m = CommonWebHookCause{} }
 func (*CommonWebHookCause) ProtoMessage()               {}
-func (*CommonWebHookCause) Descriptor() ([]byte, []int) { return fileDescriptorGenerated, []int{23} }
+func (*CommonWebHookCause) Descriptor() ([]byte, []int) { return fileDescriptorGenerated, []int{25} }
 
 func (m *CustomBuildStrategy) Reset()                    { *m = CustomBuildStrategy{} }
 func (*CustomBuildStrategy) ProtoMessage()               {}
-func (*CustomBuildStrategy) Descriptor() ([]byte, []int) { return fileDescriptorGenerated, []int{24} }
+func (*CustomBuildStrategy) Descriptor() ([]byte, []int) { return fileDescriptorGenerated, []int{26} }
 
 func (m *DockerBuildStrategy) Reset()                    { *m = DockerBuildStrategy{} }
 func (*DockerBuildStrategy) ProtoMessage()               {}
-func (*DockerBuildStrategy) Descriptor() ([]byte, []int) { return fileDescriptorGenerated, []int{25} }
+func (*DockerBuildStrategy) Descriptor() ([]byte, []int) { return fileDescriptorGenerated, []int{27} }
 
 func (m *DockerStrategyOptions) Reset()                    { *m = DockerStrategyOptions{} }
 func (*DockerStrategyOptions) ProtoMessage()               {}
-func (*DockerStrategyOptions) Descriptor() ([]byte, []int) { return fileDescriptorGenerated, []int{26} }
+func (*DockerStrategyOptions) Descriptor() ([]byte, []int) { return fileDescriptorGenerated, []int{28} }
 
 func (m *GenericWebHookCause) Reset()                    { *m = GenericWebHookCause{} }
 func (*GenericWebHookCause) ProtoMessage()               {}
-func (*GenericWebHookCause) Descriptor() ([]byte, []int) { return fileDescriptorGenerated, []int{27} }
+func (*GenericWebHookCause) Descriptor() ([]byte, []int) { return fileDescriptorGenerated, []int{29} }
 
 func (m *GenericWebHookEvent) Reset()                    { *m = GenericWebHookEvent{} }
 func (*GenericWebHookEvent) ProtoMessage()               {}
-func (*GenericWebHookEvent) Descriptor() ([]byte, []int) { return fileDescriptorGenerated, []int{28} }
+func (*GenericWebHookEvent) Descriptor() ([]byte, []int) { return fileDescriptorGenerated, []int{30} }
 
 func (m *GitBuildSource) Reset()                    { *m = GitBuildSource{} }
 func (*GitBuildSource) ProtoMessage()               {}
-func (*GitBuildSource) Descriptor() ([]byte, []int) { return fileDescriptorGenerated, []int{29} }
+func (*GitBuildSource) Descriptor() ([]byte, []int) { return fileDescriptorGenerated, []int{31} }
 
 func (m *GitHubWebHookCause) Reset()                    { *m = GitHubWebHookCause{} }
 func (*GitHubWebHookCause) ProtoMessage()               {}
-func (*GitHubWebHookCause) Descriptor() ([]byte, []int) { return fileDescriptorGenerated, []int{30} }
+func (*GitHubWebHookCause) Descriptor() ([]byte, []int) { return fileDescriptorGenerated, []int{32} }
 
 func (m *GitInfo) Reset()                    { *m = GitInfo{} }
 func (*GitInfo) ProtoMessage()               {}
-func (*GitInfo) Descriptor() ([]byte, []int) { return fileDescriptorGenerated, []int{31} }
+func (*GitInfo) Descriptor() ([]byte, []int) { return fileDescriptorGenerated, []int{33} }
 
 func (m *GitLabWebHookCause) Reset()                    { *m = GitLabWebHookCause{} }
 func (*GitLabWebHookCause) ProtoMessage()               {}
-func (*GitLabWebHookCause) Descriptor() ([]byte, []int) { return fileDescriptorGenerated, []int{32} }
+func (*GitLabWebHookCause) Descriptor() ([]byte, []int) { return fileDescriptorGenerated, []int{34} }
 
 func (m *GitSourceRevision) Reset()                    { *m = GitSourceRevision{} }
 func (*GitSourceRevision) ProtoMessage()               {}
-func (*GitSourceRevision) Descriptor() ([]byte, []int) { return fileDescriptorGenerated, []int{33} }
+func (*GitSourceRevision) Descriptor() ([]byte, []int) { return fileDescriptorGenerated, []int{35} }
 
 func (m *ImageChangeCause) Reset()                    { *m = ImageChangeCause{} }
 func (*ImageChangeCause) ProtoMessage()               {}
-func (*ImageChangeCause) Descriptor() ([]byte, []int) { return fileDescriptorGenerated, []int{34} }
+func (*ImageChangeCause) Descriptor() ([]byte, []int) { return fileDescriptorGenerated, []int{36} }
 
 func (m *ImageChangeTrigger) Reset()                    { *m = ImageChangeTrigger{} }
 func (*ImageChangeTrigger) ProtoMessage()               {}
-func (*ImageChangeTrigger) Descriptor() ([]byte, []int) { return fileDescriptorGenerated, []int{35} }
+func (*ImageChangeTrigger) Descriptor() ([]byte, []int) { return fileDescriptorGenerated, []int{37} }
 
 func (m *ImageLabel) Reset()                    { *m = ImageLabel{} }
 func (*ImageLabel) ProtoMessage()               {}
-func (*ImageLabel) Descriptor() ([]byte, []int) { return fileDescriptorGenerated, []int{36} }
+func (*ImageLabel) Descriptor() ([]byte, []int) { return fileDescriptorGenerated, []int{38} }
 
 func (m *ImageSource) Reset()                    { *m = ImageSource{} }
 func (*ImageSource) ProtoMessage()               {}
-func (*ImageSource) Descriptor() ([]byte, []int) { return fileDescriptorGenerated, []int{37} }
+func (*ImageSource) Descriptor() ([]byte, []int) { return fileDescriptorGenerated, []int{39} }
 
 func (m *ImageSourcePath) Reset()                    { *m = ImageSourcePath{} }
 func (*ImageSourcePath) ProtoMessage()               {}
-func (*ImageSourcePath) Descriptor() ([]byte, []int) { return fileDescriptorGenerated, []int{38} }
+func (*ImageSourcePath) Descriptor() ([]byte, []int) { return fileDescriptorGenerated, []int{40} }
 
 func (m *JenkinsPipelineBuildStrategy) Reset()      { *m = JenkinsPipelineBuildStrategy{} }
 func (*JenkinsPipelineBuildStrategy) ProtoMessage() {}
 func (*JenkinsPipelineBuildStrategy) Descriptor() ([]byte, []int) {
-	return fileDescriptorGenerated, []int{39}
+	return fileDescriptorGenerated, []int{41}
 }
 
 func (m *OptionalNodeSelector) Reset()                    { *m = OptionalNodeSelector{} }
 func (*OptionalNodeSelector) ProtoMessage()               {}
-func (*OptionalNodeSelector) Descriptor() ([]byte, []int) { return fileDescriptorGenerated, []int{40} }
+func (*OptionalNodeSelector) Descriptor() ([]byte, []int) { return fileDescriptorGenerated, []int{42} }
 
 func (m *ProxyConfig) Reset()                    { *m = ProxyConfig{} }
 func (*ProxyConfig) ProtoMessage()               {}
-func (*ProxyConfig) Descriptor() ([]byte, []int) { return fileDescriptorGenerated, []int{41} }
+func (*ProxyConfig) Descriptor() ([]byte, []int) { return fileDescriptorGenerated, []int{43} }
 
 func (m *SecretBuildSource) Reset()                    { *m = SecretBuildSource{} }
 func (*SecretBuildSource) ProtoMessage()               {}
-func (*SecretBuildSource) Descriptor() ([]byte, []int) { return fileDescriptorGenerated, []int{42} }
+func (*SecretBuildSource) Descriptor() ([]byte, []int) { return fileDescriptorGenerated, []int{44} }
 
 func (m *SecretSpec) Reset()                    { *m = SecretSpec{} }
 func (*SecretSpec) ProtoMessage()               {}
-func (*SecretSpec) Descriptor() ([]byte, []int) { return fileDescriptorGenerated, []int{43} }
+func (*SecretSpec) Descriptor() ([]byte, []int) { return fileDescriptorGenerated, []int{45} }
 
 func (m *SourceBuildStrategy) Reset()                    { *m = SourceBuildStrategy{} }
 func (*SourceBuildStrategy) ProtoMessage()               {}
-func (*SourceBuildStrategy) Descriptor() ([]byte, []int) { return fileDescriptorGenerated, []int{44} }
+func (*SourceBuildStrategy) Descriptor() ([]byte, []int) { return fileDescriptorGenerated, []int{46} }
 
 func (m *SourceControlUser) Reset()                    { *m = SourceControlUser{} }
 func (*SourceControlUser) ProtoMessage()               {}
-func (*SourceControlUser) Descriptor() ([]byte, []int) { return fileDescriptorGenerated, []int{45} }
+func (*SourceControlUser) Descriptor() ([]byte, []int) { return fileDescriptorGenerated, []int{47} }
+
+func (m *SourceOverrides) Reset()                    { *m = SourceOverrides{} }
+func (*SourceOverrides) ProtoMessage()               {}
+func (*SourceOverrides) Descriptor() ([]byte, []int) { return fileDescriptorGenerated, []int{48} }
 
 func (m *SourceRevision) Reset()                    { *m = SourceRevision{} }
 func (*SourceRevision) ProtoMessage()               {}
-func (*SourceRevision) Descriptor() ([]byte, []int) { return fileDescriptorGenerated, []int{46} }
+func (*SourceRevision) Descriptor() ([]byte, []int) { return fileDescriptorGenerated, []int{49} }
 
 func (m *WebHookTrigger) Reset()                    { *m = WebHookTrigger{} }
 func (*WebHookTrigger) ProtoMessage()               {}
-func (*WebHookTrigger) Descriptor() ([]byte, []int) { return fileDescriptorGenerated, []int{47} }
+func (*WebHookTrigger) Descriptor() ([]byte, []int) { return fileDescriptorGenerated, []int{50} }
+
+func (m *VolumeBuildSource) Reset()                    { *m = VolumeBuildSource{} }
+func (*VolumeBuildSource) ProtoMessage()               {}
+func (*VolumeBuildSource) Descriptor() ([]byte, []int) { return fileDescriptorGenerated, []int{51} }
 
 func init() {
 	proto.RegisterType((*BinaryBuildRequestOptions)(nil), "github.com.openshift.origin.pkg.build.api.v1.BinaryBuildRequestOptions")
 	proto.RegisterType((*BinaryBuildSource)(nil), "github.com.openshift.origin.pkg.build.api.v1.BinaryBuildSource")
 	proto.RegisterType((*BitbucketWebHookCause)(nil), "github.com.openshift.origin.pkg.build.api.v1.BitbucketWebHookCause")
 	proto.RegisterType((*Build)(nil), "github.com.openshift.origin.pkg.build.api.v1.Build")
+	proto.RegisterType((*BuildArtifact)(nil), "github.com.openshift.origin.pkg.build.api.v1.BuildArtifact")
 	proto.RegisterType((*BuildConfig)(nil), "github.com.openshift.origin.pkg.build.api.v1.BuildConfig")
 	proto.RegisterType((*BuildConfigList)(nil), "github.com.openshift.origin.pkg.build.api.v1.BuildConfigList")
 	proto.RegisterType((*BuildConfigSpec)(nil), "github.com.openshift.origin.pkg.build.api.v1.BuildConfigSpec")
 	proto.RegisterType((*BuildConfigStatus)(nil), "github.com.openshift.origin.pkg.build.api.v1.BuildConfigStatus")
+	proto.RegisterType((*BuildFailurePolicy)(nil), "github.com.openshift.origin.pkg.build.api.v1.BuildFailurePolicy")
 	proto.RegisterType((*BuildList)(nil), "github.com.openshift.origin.pkg.build.api.v1.BuildList")
 	proto.RegisterType((*BuildLog)(nil), "github.com.openshift.origin.pkg.build.api.v1.BuildLog")
 	proto.RegisterType((*BuildLogOptions)(nil), "github.com.openshift.origin.pkg.build.api.v1.BuildLogOptions")
@@ -326,8 +348,10 @@ func init() {
 	proto.RegisterType((*SecretSpec)(nil), "github.com.openshift.origin.pkg.build.api.v1.SecretSpec")
 	proto.RegisterType((*SourceBuildStrategy)(nil), "github.com.openshift.origin.pkg.build.api.v1.SourceBuildStrategy")
 	proto.RegisterType((*SourceControlUser)(nil), "github.com.openshift.origin.pkg.build.api.v1.SourceControlUser")
+	proto.RegisterType((*SourceOverrides)(nil), "github.com.openshift.origin.pkg.build.api.v1.SourceOverrides")
 	proto.RegisterType((*SourceRevision)(nil), "github.com.openshift.origin.pkg.build.api.v1.SourceRevision")
 	proto.RegisterType((*WebHookTrigger)(nil), "github.com.openshift.origin.pkg.build.api.v1.WebHookTrigger")
+	proto.RegisterType((*VolumeBuildSource)(nil), "github.com.openshift.origin.pkg.build.api.v1.VolumeBuildSource")
 }
 func (m *BinaryBuildRequestOptions) Marshal() (data []byte, err error) {
 	size := m.Size()
@@ -473,6 +497,34 @@ func (m *Build) MarshalTo(data []byte) (int, error) {
 	return i, nil
 }
 
+func (m *BuildArtifact) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *BuildArtifact) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	data[i] = 0xa
+	i++
+	i = encodeVarintGenerated(data, i, uint64(len(m.Path)))
+	i += copy(data[i:], m.Path)
+	if m.Data != nil {
+		data[i] = 0x12
+		i++
+		i = encodeVarintGenerated(data, i, uint64(len(m.Data)))
+		i += copy(data[i:], m.Data)
+	}
+	return i, nil
+}
+
 func (m *BuildConfig) Marshal() (data []byte, err error) {
 	size := m.Size()
 	data = make([]byte, size)
@@ -592,6 +644,36 @@ func (m *BuildConfigSpec) MarshalTo(data []byte) (int, error) {
 		return 0, err
 	}
 	i += n10
+	if len(m.Platforms) > 0 {
+		for _, s := range m.Platforms {
+			data[i] = 0x22
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				data[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			data[i] = uint8(l)
+			i++
+			i += copy(data[i:], s)
+		}
+	}
+	if len(m.AllowedSourceOverridePatterns) > 0 {
+		for _, s := range m.AllowedSourceOverridePatterns {
+			data[i] = 0x2a
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				data[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			data[i] = uint8(l)
+			i++
+			i += copy(data[i:], s)
+		}
+	}
 	return i, nil
 }
 
@@ -616,6 +698,32 @@ func (m *BuildConfigStatus) MarshalTo(data []byte) (int, error) {
 	return i, nil
 }
 
+func (m *BuildFailurePolicy) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *BuildFailurePolicy) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	data[i] = 0x8
+	i++
+	i = encodeVarintGenerated(data, i, uint64(m.MaxRetries))
+	if m.BackoffSeconds != nil {
+		data[i] = 0x10
+		i++
+		i = encodeVarintGenerated(data, i, uint64(*m.BackoffSeconds))
+	}
+	return i, nil
+}
+
 func (m *BuildList) Marshal() (data []byte, err error) {
 	size := m.Size()
 	data = make([]byte, size)
@@ -855,6 +963,21 @@ func (m *BuildPostCommitSpec) MarshalTo(data []byte) (int, error) {
 	i++
 	i = encodeVarintGenerated(data, i, uint64(len(m.Script)))
 	i += copy(data[i:], m.Script)
+	if len(m.ArtifactPaths) > 0 {
+		for _, s := range m.ArtifactPaths {
+			data[i] = 0x22
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				data[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			data[i] = uint8(l)
+			i++
+			i += copy(data[i:], s)
+		}
+	}
 	return i, nil
 }
 
@@ -960,6 +1083,16 @@ func (m *BuildRequest) MarshalTo(data []byte) (int, error) {
 		}
 		i += n20
 	}
+	if m.SourceOverrides != nil {
+		data[i] = 0x52
+		i++
+		i = encodeVarintGenerated(data, i, uint64(m.SourceOverrides.Size()))
+		n21, err := m.SourceOverrides.MarshalTo(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n21
+	}
 	return i, nil
 }
 
@@ -1046,6 +1179,18 @@ func (m *BuildSource) MarshalTo(data []byte) (int, error) {
 			i += n
 		}
 	}
+	if len(m.Volumes) > 0 {
+		for _, msg := range m.Volumes {
+			data[i] = 0x4a
+			i++
+			i = encodeVarintGenerated(data, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(data[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
 	return i, nil
 }
 
@@ -1167,6 +1312,21 @@ func (m *BuildStatus) MarshalTo(data []byte) (int, error) {
 		return 0, err
 	}
 	i += n28
+	if len(m.Artifacts) > 0 {
+		for _, msg := range m.Artifacts {
+			data[i] = 0x5a
+			i++
+			i = encodeVarintGenerated(data, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(data[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	data[i] = 0x60
+	i++
+	i = encodeVarintGenerated(data, i, uint64(m.QueuePosition))
 	return i, nil
 }
 
@@ -1510,6 +1670,16 @@ func (m *CommonSpec) MarshalTo(data []byte) (int, error) {
 		}
 		i += n50
 	}
+	if m.FailurePolicy != nil {
+		data[i] = 0x52
+		i++
+		i = encodeVarintGenerated(data, i, uint64(m.FailurePolicy.Size()))
+		n51, err := m.FailurePolicy.MarshalTo(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n51
+	}
 	return i, nil
 }
 
@@ -1860,6 +2030,24 @@ func (m *GitBuildSource) MarshalTo(data []byte) (int, error) {
 		return 0, err
 	}
 	i += n59
+	if m.SubmoduleSecret != nil {
+		data[i] = 0x22
+		i++
+		i = encodeVarintGenerated(data, i, uint64(m.SubmoduleSecret.Size()))
+		n60, err := m.SubmoduleSecret.MarshalTo(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n60
+	}
+	data[i] = 0x28
+	i++
+	if m.LFS {
+		data[i] = 1
+	} else {
+		data[i] = 0
+	}
+	i++
 	return i, nil
 }
 
@@ -2448,6 +2636,36 @@ func (m *SourceControlUser) MarshalTo(data []byte) (int, error) {
 	return i, nil
 }
 
+func (m *SourceOverrides) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *SourceOverrides) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	data[i] = 0xa
+	i++
+	i = encodeVarintGenerated(data, i, uint64(len(m.Ref)))
+	i += copy(data[i:], m.Ref)
+	data[i] = 0x12
+	i++
+	i = encodeVarintGenerated(data, i, uint64(len(m.Commit)))
+	i += copy(data[i:], m.Commit)
+	data[i] = 0x1a
+	i++
+	i = encodeVarintGenerated(data, i, uint64(len(m.ContextDir)))
+	i += copy(data[i:], m.ContextDir)
+	return i, nil
+}
+
 func (m *SourceRevision) Marshal() (data []byte, err error) {
 	size := m.Size()
 	data = make([]byte, size)
@@ -2510,6 +2728,44 @@ func (m *WebHookTrigger) MarshalTo(data []byte) (int, error) {
 	return i, nil
 }
 
+func (m *VolumeBuildSource) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *VolumeBuildSource) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	data[i] = 0xa
+	i++
+	i = encodeVarintGenerated(data, i, uint64(m.PersistentVolumeClaim.Size()))
+	n60, err := m.PersistentVolumeClaim.MarshalTo(data[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n60
+	if len(m.Paths) > 0 {
+		for _, msg := range m.Paths {
+			data[i] = 0x12
+			i++
+			i = encodeVarintGenerated(data, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(data[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
 func encodeFixed64Generated(data []byte, offset int, v uint64) int {
 	data[offset] = uint8(v)
 	data[offset+1] = uint8(v >> 8)
@@ -2587,6 +2843,18 @@ func (m *Build) Size() (n int) {
 	return n
 }
 
+func (m *BuildArtifact) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Path)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.Data != nil {
+		l = len(m.Data)
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
 func (m *BuildConfig) Size() (n int) {
 	var l int
 	_ = l
@@ -2626,6 +2894,18 @@ func (m *BuildConfigSpec) Size() (n int) {
 	n += 1 + l + sovGenerated(uint64(l))
 	l = m.CommonSpec.Size()
 	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Platforms) > 0 {
+		for _, s := range m.Platforms {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if len(m.AllowedSourceOverridePatterns) > 0 {
+		for _, s := range m.AllowedSourceOverridePatterns {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -2636,6 +2916,16 @@ func (m *BuildConfigStatus) Size() (n int) {
 	return n
 }
 
+func (m *BuildFailurePolicy) Size() (n int) {
+	var l int
+	_ = l
+	n += 1 + sovGenerated(uint64(m.MaxRetries))
+	if m.BackoffSeconds != nil {
+		n += 1 + sovGenerated(uint64(*m.BackoffSeconds))
+	}
+	return n
+}
+
 func (m *BuildList) Size() (n int) {
 	var l int
 	_ = l
@@ -2721,6 +3011,12 @@ func (m *BuildPostCommitSpec) Size() (n int) {
 	}
 	l = len(m.Script)
 	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.ArtifactPaths) > 0 {
+		for _, s := range m.ArtifactPaths {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -2764,6 +3060,10 @@ func (m *BuildRequest) Size() (n int) {
 		l = m.DockerStrategyOptions.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	if m.SourceOverrides != nil {
+		l = m.SourceOverrides.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -2802,6 +3102,12 @@ func (m *BuildSource) Size() (n int) {
 			n += 1 + l + sovGenerated(uint64(l))
 		}
 	}
+	if len(m.Volumes) > 0 {
+		for _, e := range m.Volumes {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -2846,6 +3152,13 @@ func (m *BuildStatus) Size() (n int) {
 	}
 	l = m.Output.Size()
 	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Artifacts) > 0 {
+		for _, e := range m.Artifacts {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	n += 1 + sovGenerated(uint64(m.QueuePosition))
 	return n
 }
 
@@ -2973,6 +3286,10 @@ func (m *CommonSpec) Size() (n int) {
 		l = m.NodeSelector.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	if m.FailurePolicy != nil {
+		l = m.FailurePolicy.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -3105,6 +3422,11 @@ func (m *GitBuildSource) Size() (n int) {
 	n += 1 + l + sovGenerated(uint64(l))
 	l = m.ProxyConfig.Size()
 	n += 1 + l + sovGenerated(uint64(l))
+	if m.SubmoduleSecret != nil {
+		l = m.SubmoduleSecret.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	n += 2
 	return n
 }
 
@@ -3326,6 +3648,18 @@ func (m *SourceControlUser) Size() (n int) {
 	return n
 }
 
+func (m *SourceOverrides) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Ref)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Commit)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.ContextDir)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
 func (m *SourceRevision) Size() (n int) {
 	var l int
 	_ = l
@@ -3347,6 +3681,20 @@ func (m *WebHookTrigger) Size() (n int) {
 	return n
 }
 
+func (m *VolumeBuildSource) Size() (n int) {
+	var l int
+	_ = l
+	l = m.PersistentVolumeClaim.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Paths) > 0 {
+		for _, e := range m.Paths {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovGenerated(x uint64) (n int) {
 	for {
 		n++
@@ -3409,11 +3757,22 @@ func (this *Build) String() string {
 	}, "")
 	return s
 }
-func (this *BuildConfig) String() string {
+func (this *BuildArtifact) String() string {
 	if this == nil {
 		return "nil"
 	}
-	s := strings.Join([]string{`&BuildConfig{`,
+	s := strings.Join([]string{`&BuildArtifact{`,
+		`Path:` + fmt.Sprintf("%v", this.Path) + `,`,
+		`Data:` + valueToStringGenerated(this.Data) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *BuildConfig) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&BuildConfig{`,
 		`ObjectMeta:` + strings.Replace(strings.Replace(this.ObjectMeta.String(), "ObjectMeta", "k8s_io_kubernetes_pkg_api_v1.ObjectMeta", 1), `&`, ``, 1) + `,`,
 		`Spec:` + strings.Replace(strings.Replace(this.Spec.String(), "BuildConfigSpec", "BuildConfigSpec", 1), `&`, ``, 1) + `,`,
 		`Status:` + strings.Replace(strings.Replace(this.Status.String(), "BuildConfigStatus", "BuildConfigStatus", 1), `&`, ``, 1) + `,`,
@@ -3439,6 +3798,8 @@ func (this *BuildConfigSpec) String() string {
 	s := strings.Join([]string{`&BuildConfigSpec{`,
 		`Triggers:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Triggers), "BuildTriggerPolicy", "BuildTriggerPolicy", 1), `&`, ``, 1) + `,`,
 		`RunPolicy:` + fmt.Sprintf("%v", this.RunPolicy) + `,`,
+		`Platforms:` + fmt.Sprintf("%v", this.Platforms) + `,`,
+		`AllowedSourceOverridePatterns:` + fmt.Sprintf("%v", this.AllowedSourceOverridePatterns) + `,`,
 		`CommonSpec:` + strings.Replace(strings.Replace(this.CommonSpec.String(), "CommonSpec", "CommonSpec", 1), `&`, ``, 1) + `,`,
 		`}`,
 	}, "")
@@ -3454,6 +3815,17 @@ func (this *BuildConfigStatus) String() string {
 	}, "")
 	return s
 }
+func (this *BuildFailurePolicy) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&BuildFailurePolicy{`,
+		`MaxRetries:` + fmt.Sprintf("%v", this.MaxRetries) + `,`,
+		`BackoffSeconds:` + valueToStringGenerated(this.BackoffSeconds) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *BuildList) String() string {
 	if this == nil {
 		return "nil"
@@ -3513,6 +3885,7 @@ func (this *BuildPostCommitSpec) String() string {
 		`Command:` + fmt.Sprintf("%v", this.Command) + `,`,
 		`Args:` + fmt.Sprintf("%v", this.Args) + `,`,
 		`Script:` + fmt.Sprintf("%v", this.Script) + `,`,
+		`ArtifactPaths:` + fmt.Sprintf("%v", this.ArtifactPaths) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -3531,6 +3904,7 @@ func (this *BuildRequest) String() string {
 		`Env:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Env), "EnvVar", "k8s_io_kubernetes_pkg_api_v1.EnvVar", 1), `&`, ``, 1) + `,`,
 		`TriggeredBy:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.TriggeredBy), "BuildTriggerCause", "BuildTriggerCause", 1), `&`, ``, 1) + `,`,
 		`DockerStrategyOptions:` + strings.Replace(fmt.Sprintf("%v", this.DockerStrategyOptions), "DockerStrategyOptions", "DockerStrategyOptions", 1) + `,`,
+		`SourceOverrides:` + strings.Replace(fmt.Sprintf("%v", this.SourceOverrides), "SourceOverrides", "SourceOverrides", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -3548,6 +3922,7 @@ func (this *BuildSource) String() string {
 		`ContextDir:` + fmt.Sprintf("%v", this.ContextDir) + `,`,
 		`SourceSecret:` + strings.Replace(fmt.Sprintf("%v", this.SourceSecret), "LocalObjectReference", "k8s_io_kubernetes_pkg_api_v1.LocalObjectReference", 1) + `,`,
 		`Secrets:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Secrets), "SecretBuildSource", "SecretBuildSource", 1), `&`, ``, 1) + `,`,
+		`Volumes:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Volumes), "VolumeBuildSource", "VolumeBuildSource", 1), `&`, ``, 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -3578,6 +3953,8 @@ func (this *BuildStatus) String() string {
 		`OutputDockerImageReference:` + fmt.Sprintf("%v", this.OutputDockerImageReference) + `,`,
 		`Config:` + strings.Replace(fmt.Sprintf("%v", this.Config), "ObjectReference", "k8s_io_kubernetes_pkg_api_v1.ObjectReference", 1) + `,`,
 		`Output:` + strings.Replace(strings.Replace(this.Output.String(), "BuildStatusOutput", "BuildStatusOutput", 1), `&`, ``, 1) + `,`,
+		`Artifacts:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Artifacts), "BuildArtifact", "BuildArtifact", 1), `&`, ``, 1) + `,`,
+		`QueuePosition:` + fmt.Sprintf("%v", this.QueuePosition) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -3660,6 +4037,7 @@ func (this *CommonSpec) String() string {
 		`PostCommit:` + strings.Replace(strings.Replace(this.PostCommit.String(), "BuildPostCommitSpec", "BuildPostCommitSpec", 1), `&`, ``, 1) + `,`,
 		`CompletionDeadlineSeconds:` + valueToStringGenerated(this.CompletionDeadlineSeconds) + `,`,
 		`NodeSelector:` + strings.Replace(fmt.Sprintf("%v", this.NodeSelector), "OptionalNodeSelector", "OptionalNodeSelector", 1) + `,`,
+		`FailurePolicy:` + strings.Replace(fmt.Sprintf("%v", this.FailurePolicy), "BuildFailurePolicy", "BuildFailurePolicy", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -3750,6 +4128,8 @@ func (this *GitBuildSource) String() string {
 		`URI:` + fmt.Sprintf("%v", this.URI) + `,`,
 		`Ref:` + fmt.Sprintf("%v", this.Ref) + `,`,
 		`ProxyConfig:` + strings.Replace(strings.Replace(this.ProxyConfig.String(), "ProxyConfig", "ProxyConfig", 1), `&`, ``, 1) + `,`,
+		`SubmoduleSecret:` + strings.Replace(fmt.Sprintf("%v", this.SubmoduleSecret), "LocalObjectReference", "k8s_io_kubernetes_pkg_api_v1.LocalObjectReference", 1) + `,`,
+		`LFS:` + fmt.Sprintf("%v", this.LFS) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -3929,6 +4309,18 @@ func (this *SourceControlUser) String() string {
 	}, "")
 	return s
 }
+func (this *SourceOverrides) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SourceOverrides{`,
+		`Ref:` + fmt.Sprintf("%v", this.Ref) + `,`,
+		`Commit:` + fmt.Sprintf("%v", this.Commit) + `,`,
+		`ContextDir:` + fmt.Sprintf("%v", this.ContextDir) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *SourceRevision) String() string {
 	if this == nil {
 		return "nil"
@@ -3951,6 +4343,17 @@ func (this *WebHookTrigger) String() string {
 	}, "")
 	return s
 }
+func (this *VolumeBuildSource) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&VolumeBuildSource{`,
+		`PersistentVolumeClaim:` + strings.Replace(strings.Replace(this.PersistentVolumeClaim.String(), "LocalObjectReference", "k8s_io_kubernetes_pkg_api_v1.LocalObjectReference", 1), `&`, ``, 1) + `,`,
+		`Paths:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Paths), "ImageSourcePath", "ImageSourcePath", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func valueToStringGenerated(v interface{}) string {
 	rv := reflect.ValueOf(v)
 	if rv.IsNil() {
@@ -4541,6 +4944,116 @@ func (m *Build) Unmarshal(data []byte) error {
 	}
 	return nil
 }
+func (m *BuildArtifact) Unmarshal(data []byte) error {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BuildArtifact: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BuildArtifact: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Path = string(data[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], data[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(data[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *BuildConfig) Unmarshal(data []byte) error {
 	l := len(data)
 	iNdEx := 0
@@ -4911,6 +5424,64 @@ func (m *BuildConfigSpec) Unmarshal(data []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Platforms", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Platforms = append(m.Platforms, string(data[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedSourceOverridePatterns", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedSourceOverridePatterns = append(m.AllowedSourceOverridePatterns, string(data[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(data[iNdEx:])
@@ -5001,6 +5572,95 @@ func (m *BuildConfigStatus) Unmarshal(data []byte) error {
 	}
 	return nil
 }
+func (m *BuildFailurePolicy) Unmarshal(data []byte) error {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BuildFailurePolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BuildFailurePolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRetries", wireType)
+			}
+			m.MaxRetries = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.MaxRetries |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BackoffSeconds", wireType)
+			}
+			var v int64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				v |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.BackoffSeconds = &v
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(data[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *BuildList) Unmarshal(data []byte) error {
 	l := len(data)
 	iNdEx := 0
@@ -5697,6 +6357,35 @@ func (m *BuildPostCommitSpec) Unmarshal(data []byte) error {
 			}
 			m.Script = string(data[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ArtifactPaths", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ArtifactPaths = append(m.ArtifactPaths, string(data[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(data[iNdEx:])
@@ -6024,6 +6713,39 @@ func (m *BuildRequest) Unmarshal(data []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceOverrides", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SourceOverrides == nil {
+				m.SourceOverrides = &SourceOverrides{}
+			}
+			if err := m.SourceOverrides.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(data[iNdEx:])
@@ -6323,6 +7045,37 @@ func (m *BuildSource) Unmarshal(data []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Volumes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Volumes = append(m.Volumes, VolumeBuildSource{})
+			if err := m.Volumes[len(m.Volumes)-1].Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(data[iNdEx:])
@@ -6768,6 +7521,56 @@ func (m *BuildStatus) Unmarshal(data []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Artifacts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Artifacts = append(m.Artifacts, BuildArtifact{})
+			if err := m.Artifacts[len(m.Artifacts)-1].Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueuePosition", wireType)
+			}
+			m.QueuePosition = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.QueuePosition |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(data[iNdEx:])
@@ -7944,6 +8747,39 @@ func (m *CommonSpec) Unmarshal(data []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailurePolicy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.FailurePolicy == nil {
+				m.FailurePolicy = &BuildFailurePolicy{}
+			}
+			if err := m.FailurePolicy.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(data[iNdEx:])
@@ -9058,7 +9894,70 @@ func (m *GitBuildSource) Unmarshal(data []byte) error {
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field ProxyConfig", wireType)
 			}
-			var msglen int
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ProxyConfig.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubmoduleSecret", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SubmoduleSecret == nil {
+				m.SubmoduleSecret = &k8s_io_kubernetes_pkg_api_v1.LocalObjectReference{}
+			}
+			if err := m.SubmoduleSecret.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LFS", wireType)
+			}
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -9068,22 +9967,12 @@ func (m *GitBuildSource) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				v |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.ProxyConfig.Unmarshal(data[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			m.LFS = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(data[iNdEx:])
@@ -11203,6 +12092,143 @@ func (m *SourceControlUser) Unmarshal(data []byte) error {
 	}
 	return nil
 }
+func (m *SourceOverrides) Unmarshal(data []byte) error {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SourceOverrides: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SourceOverrides: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ref", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Ref = string(data[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Commit = string(data[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContextDir", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContextDir = string(data[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(data[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *SourceRevision) Unmarshal(data []byte) error {
 	l := len(data)
 	iNdEx := 0
@@ -11414,6 +12440,117 @@ func (m *WebHookTrigger) Unmarshal(data []byte) error {
 	}
 	return nil
 }
+func (m *VolumeBuildSource) Unmarshal(data []byte) error {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VolumeBuildSource: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VolumeBuildSource: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PersistentVolumeClaim", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PersistentVolumeClaim.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Paths", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Paths = append(m.Paths, ImageSourcePath{})
+			if err := m.Paths[len(m.Paths)-1].Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(data[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipGenerated(data []byte) (n int, err error) {
 	l := len(data)
 	iNdEx := 0
@@ -82,6 +82,23 @@ type CommonSpec struct {
 	// If set to an empty map or a map with any values, default build nodeselector values
 	// are ignored.
 	NodeSelector OptionalNodeSelector `json:"nodeSelector" protobuf:"bytes,9,name=nodeSelector"`
+
+	// failurePolicy controls automatic retry of builds that fail for
+	// infrastructure reasons. Builds that fail because of their own content
+	// (for example a compile error) are never retried.
+	FailurePolicy *BuildFailurePolicy `json:"failurePolicy,omitempty" protobuf:"bytes,10,opt,name=failurePolicy"`
+}
+
+// BuildFailurePolicy describes how builds that fail for infrastructure reasons
+// (image pull timeouts, node eviction, a deleted build pod) are retried.
+type BuildFailurePolicy struct {
+	// maxRetries is the number of times a build is retried after an
+	// infrastructure failure before it is marked failed.
+	MaxRetries int32 `json:"maxRetries" protobuf:"varint,1,opt,name=maxRetries"`
+
+	// backoffSeconds is the delay before the first retry; each successive
+	// retry doubles the delay. If unset, a delay of 30 seconds is used.
+	BackoffSeconds *int64 `json:"backoffSeconds,omitempty" protobuf:"varint,2,opt,name=backoffSeconds"`
 }
 
 // BuildTriggerCause holds information about a triggered build. It is used for
@@ -207,6 +224,24 @@ type BuildStatus struct {
 
 	// output describes the Docker image the build has produced.
 	Output BuildStatusOutput `json:"output,omitempty" protobuf:"bytes,10,opt,name=output"`
+
+	// artifacts holds the content of files extracted from the post commit
+	// hook container, as declared by the hook's artifactPaths.
+	Artifacts []BuildArtifact `json:"artifacts,omitempty" protobuf:"bytes,11,rep,name=artifacts"`
+
+	// queuePosition is the one-based position of this build among the builds of
+	// its build config waiting on the run policy to start. It is zero once the
+	// build is no longer queued.
+	QueuePosition int32 `json:"queuePosition,omitempty" protobuf:"varint,12,opt,name=queuePosition"`
+}
+
+// BuildArtifact holds the content of a single file extracted from the post
+// commit hook container.
+type BuildArtifact struct {
+	// path is the path of the file inside the hook container.
+	Path string `json:"path" protobuf:"bytes,1,opt,name=path"`
+	// data is the raw content of the file.
+	Data []byte `json:"data,omitempty" protobuf:"bytes,2,opt,name=data"`
 }
 
 // BuildPhase represents the status of a build at a point in time.
@@ -321,6 +356,10 @@ type BuildSource struct {
 	// secrets represents a list of secrets and their destinations that will
 	// be used only for the build.
 	Secrets []SecretBuildSource `json:"secrets,omitempty" protobuf:"bytes,8,rep,name=secrets"`
+
+	// volumes describes a set of persistent volume claims whose contents are used to
+	// provide source for the build
+	Volumes []VolumeBuildSource `json:"volumes,omitempty" protobuf:"bytes,9,rep,name=volumes"`
 }
 
 // ImageSource is used to describe build source that will be extracted from an image. A reference of
@@ -341,6 +380,18 @@ type ImageSource struct {
 	PullSecret *kapi.LocalObjectReference `json:"pullSecret,omitempty" protobuf:"bytes,3,opt,name=pullSecret"`
 }
 
+// VolumeBuildSource describes a persistent volume claim whose contents are used as source for
+// the build. The claim is mounted read-only into the build pod and the listed paths are copied
+// into the build directory, which allows builds to chain artifacts through a shared volume
+// without an external artifact store.
+type VolumeBuildSource struct {
+	// persistentVolumeClaim is a reference to an existing claim in the build's namespace
+	PersistentVolumeClaim kapi.LocalObjectReference `json:"persistentVolumeClaim" protobuf:"bytes,1,opt,name=persistentVolumeClaim"`
+
+	// paths is a list of source and destination paths to copy from the volume.
+	Paths []ImageSourcePath `json:"paths" protobuf:"bytes,2,rep,name=paths"`
+}
+
 // ImageSourcePath describes a path to be copied from a source image and its destination within the build directory.
 type ImageSourcePath struct {
 	// sourcePath is the absolute path of the file or directory inside the image to
@@ -431,6 +482,15 @@ type GitBuildSource struct {
 
 	// proxyConfig defines the proxies to use for the git clone operation
 	ProxyConfig `json:",inline" protobuf:"bytes,3,opt,name=proxyConfig"`
+
+	// submoduleSecret is the name of a Secret providing additional credentials
+	// for fetching submodules hosted outside the main repository. The secret is
+	// set up like sourceSecret and applied when submodules are updated.
+	SubmoduleSecret *kapi.LocalObjectReference `json:"submoduleSecret,omitempty" protobuf:"bytes,4,opt,name=submoduleSecret"`
+
+	// lfs, if true, fetches Git LFS content referenced by the cloned revision
+	// after checkout.
+	LFS bool `json:"lfs,omitempty" protobuf:"varint,5,opt,name=lfs"`
 }
 
 // SourceControlUser defines the identity of a user of source control
@@ -717,6 +777,13 @@ type BuildPostCommitSpec struct {
 	// necessary to make `ruby`, `bundle` and other binaries available in
 	// the PATH.
 	Script string `json:"script,omitempty" protobuf:"bytes,3,opt,name=script"`
+	// artifactPaths is a list of absolute paths of files inside the hook
+	// container, such as test reports, that are extracted after the hook
+	// finishes and recorded in the build status. The files are extracted
+	// regardless of the hook's exit code so reports from failing test runs
+	// are preserved. Files that are missing or exceed the artifact size
+	// limit are skipped.
+	ArtifactPaths []string `json:"artifactPaths,omitempty" protobuf:"bytes,4,rep,name=artifactPaths"`
 }
 
 // BuildOutput is input to a build strategy and describes the Docker image that the strategy
@@ -776,6 +843,18 @@ type BuildConfigSpec struct {
 	// This is optional, if not specified we default to "Serial".
 	RunPolicy BuildRunPolicy `json:"runPolicy,omitempty" protobuf:"bytes,2,opt,name=runPolicy,casttype=BuildRunPolicy"`
 
+	// platforms is an optional list of target platforms in os/arch form, e.g.
+	// "linux/amd64". When more than one platform is listed, each instantiation
+	// fans out into one build per platform, scheduled onto nodes of the matching
+	// architecture, and a manifest list referencing the per-platform images is
+	// tagged into the output image stream tag once all builds succeed.
+	Platforms []string `json:"platforms,omitempty" protobuf:"bytes,4,rep,name=platforms"`
+
+	// allowedSourceOverridePatterns is a list of shell-style glob patterns that
+	// the source ref supplied on an instantiate request must match. When the
+	// list is empty, source overrides on instantiate requests are rejected.
+	AllowedSourceOverridePatterns []string `json:"allowedSourceOverridePatterns,omitempty" protobuf:"bytes,5,rep,name=allowedSourceOverridePatterns"`
+
 	// CommonSpec is the desired build specification
 	CommonSpec `json:",inline" protobuf:"bytes,3,opt,name=commonSpec"`
 }
@@ -970,6 +1049,25 @@ type BuildRequest struct {
 
 	// DockerStrategyOptions contains additional docker-strategy specific options for the build
 	DockerStrategyOptions *DockerStrategyOptions `json:"dockerStrategyOptions,omitempty" protobuf:"bytes,9,opt,name=dockerStrategyOptions"`
+
+	// sourceOverrides allows the request to override select source fields of the
+	// build config. Overrides are only honored when the build config declares
+	// allowed source override patterns.
+	SourceOverrides *SourceOverrides `json:"sourceOverrides,omitempty" protobuf:"bytes,10,opt,name=sourceOverrides"`
+}
+
+// SourceOverrides are per-request overrides for select source fields of a build
+// config, allowing one build config to serve builds from many branches.
+type SourceOverrides struct {
+	// ref overrides the git branch, tag, or ref to build. It must match one of
+	// the allowed source override patterns on the build config.
+	Ref string `json:"ref,omitempty" protobuf:"bytes,1,opt,name=ref"`
+
+	// commit pins the build to a specific commit within the ref.
+	Commit string `json:"commit,omitempty" protobuf:"bytes,2,opt,name=commit"`
+
+	// contextDir overrides the context directory inside the source.
+	ContextDir string `json:"contextDir,omitempty" protobuf:"bytes,3,opt,name=contextDir"`
 }
 
 // BinaryBuildRequestOptions are the options required to fully speficy a binary build request
@@ -31,6 +31,8 @@ func RegisterConversions(scheme *runtime.Scheme) error {
 		Convert_api_BitbucketWebHookCause_To_v1_BitbucketWebHookCause,
 		Convert_v1_Build_To_api_Build,
 		Convert_api_Build_To_v1_Build,
+		Convert_v1_BuildArtifact_To_api_BuildArtifact,
+		Convert_api_BuildArtifact_To_v1_BuildArtifact,
 		Convert_v1_BuildConfig_To_api_BuildConfig,
 		Convert_api_BuildConfig_To_v1_BuildConfig,
 		Convert_v1_BuildConfigList_To_api_BuildConfigList,
@@ -113,8 +115,12 @@ func RegisterConversions(scheme *runtime.Scheme) error {
 		Convert_api_SourceBuildStrategy_To_v1_SourceBuildStrategy,
 		Convert_v1_SourceControlUser_To_api_SourceControlUser,
 		Convert_api_SourceControlUser_To_v1_SourceControlUser,
+		Convert_v1_SourceOverrides_To_api_SourceOverrides,
+		Convert_api_SourceOverrides_To_v1_SourceOverrides,
 		Convert_v1_SourceRevision_To_api_SourceRevision,
 		Convert_api_SourceRevision_To_v1_SourceRevision,
+		Convert_v1_VolumeBuildSource_To_api_VolumeBuildSource,
+		Convert_api_VolumeBuildSource_To_v1_VolumeBuildSource,
 		Convert_v1_WebHookTrigger_To_api_WebHookTrigger,
 		Convert_api_WebHookTrigger_To_v1_WebHookTrigger,
 	)
@@ -230,6 +236,26 @@ func Convert_api_Build_To_v1_Build(in *api.Build, out *Build, s conversion.Scope
 	return autoConvert_api_Build_To_v1_Build(in, out, s)
 }
 
+func autoConvert_v1_BuildArtifact_To_api_BuildArtifact(in *BuildArtifact, out *api.BuildArtifact, s conversion.Scope) error {
+	out.Path = in.Path
+	out.Data = *(*[]byte)(unsafe.Pointer(&in.Data))
+	return nil
+}
+
+func Convert_v1_BuildArtifact_To_api_BuildArtifact(in *BuildArtifact, out *api.BuildArtifact, s conversion.Scope) error {
+	return autoConvert_v1_BuildArtifact_To_api_BuildArtifact(in, out, s)
+}
+
+func autoConvert_api_BuildArtifact_To_v1_BuildArtifact(in *api.BuildArtifact, out *BuildArtifact, s conversion.Scope) error {
+	out.Path = in.Path
+	out.Data = *(*[]byte)(unsafe.Pointer(&in.Data))
+	return nil
+}
+
+func Convert_api_BuildArtifact_To_v1_BuildArtifact(in *api.BuildArtifact, out *BuildArtifact, s conversion.Scope) error {
+	return autoConvert_api_BuildArtifact_To_v1_BuildArtifact(in, out, s)
+}
+
 func autoConvert_v1_BuildConfig_To_api_BuildConfig(in *BuildConfig, out *api.BuildConfig, s conversion.Scope) error {
 	if err := api_v1.Convert_v1_ObjectMeta_To_api_ObjectMeta(&in.ObjectMeta, &out.ObjectMeta, s); err != nil {
 		return err
@@ -313,6 +339,8 @@ func autoConvert_v1_BuildConfigSpec_To_api_BuildConfigSpec(in *BuildConfigSpec,
 		out.Triggers = nil
 	}
 	out.RunPolicy = api.BuildRunPolicy(in.RunPolicy)
+	out.Platforms = *(*[]string)(unsafe.Pointer(&in.Platforms))
+	out.AllowedSourceOverridePatterns = *(*[]string)(unsafe.Pointer(&in.AllowedSourceOverridePatterns))
 	if err := Convert_v1_CommonSpec_To_api_CommonSpec(&in.CommonSpec, &out.CommonSpec, s); err != nil {
 		return err
 	}
@@ -336,6 +364,8 @@ func autoConvert_api_BuildConfigSpec_To_v1_BuildConfigSpec(in *api.BuildConfigSp
 		out.Triggers = nil
 	}
 	out.RunPolicy = BuildRunPolicy(in.RunPolicy)
+	out.Platforms = *(*[]string)(unsafe.Pointer(&in.Platforms))
+	out.AllowedSourceOverridePatterns = *(*[]string)(unsafe.Pointer(&in.AllowedSourceOverridePatterns))
 	if err := Convert_api_CommonSpec_To_v1_CommonSpec(&in.CommonSpec, &out.CommonSpec, s); err != nil {
 		return err
 	}
@@ -510,6 +540,7 @@ func autoConvert_v1_BuildPostCommitSpec_To_api_BuildPostCommitSpec(in *BuildPost
 	out.Command = *(*[]string)(unsafe.Pointer(&in.Command))
 	out.Args = *(*[]string)(unsafe.Pointer(&in.Args))
 	out.Script = in.Script
+	out.ArtifactPaths = *(*[]string)(unsafe.Pointer(&in.ArtifactPaths))
 	return nil
 }
 
@@ -521,6 +552,7 @@ func autoConvert_api_BuildPostCommitSpec_To_v1_BuildPostCommitSpec(in *api.Build
 	out.Command = *(*[]string)(unsafe.Pointer(&in.Command))
 	out.Args = *(*[]string)(unsafe.Pointer(&in.Args))
 	out.Script = in.Script
+	out.ArtifactPaths = *(*[]string)(unsafe.Pointer(&in.ArtifactPaths))
 	return nil
 }
 
@@ -592,6 +624,7 @@ func autoConvert_v1_BuildRequest_To_api_BuildRequest(in *BuildRequest, out *api.
 	} else {
 		out.DockerStrategyOptions = nil
 	}
+	out.SourceOverrides = (*api.SourceOverrides)(unsafe.Pointer(in.SourceOverrides))
 	return nil
 }
 
@@ -663,6 +696,7 @@ func autoConvert_api_BuildRequest_To_v1_BuildRequest(in *api.BuildRequest, out *
 	} else {
 		out.DockerStrategyOptions = nil
 	}
+	out.SourceOverrides = (*SourceOverrides)(unsafe.Pointer(in.SourceOverrides))
 	return nil
 }
 
@@ -707,6 +741,17 @@ func autoConvert_v1_BuildSource_To_api_BuildSource(in *BuildSource, out *api.Bui
 	} else {
 		out.Secrets = nil
 	}
+	if in.Volumes != nil {
+		in, out := &in.Volumes, &out.Volumes
+		*out = make([]api.VolumeBuildSource, len(*in))
+		for i := range *in {
+			if err := Convert_v1_VolumeBuildSource_To_api_VolumeBuildSource(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Volumes = nil
+	}
 	return nil
 }
 
@@ -750,6 +795,17 @@ func autoConvert_api_BuildSource_To_v1_BuildSource(in *api.BuildSource, out *Bui
 	} else {
 		out.Secrets = nil
 	}
+	if in.Volumes != nil {
+		in, out := &in.Volumes, &out.Volumes
+		*out = make([]VolumeBuildSource, len(*in))
+		for i := range *in {
+			if err := Convert_api_VolumeBuildSource_To_v1_VolumeBuildSource(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Volumes = nil
+	}
 	return nil
 }
 
@@ -818,6 +874,8 @@ func autoConvert_v1_BuildStatus_To_api_BuildStatus(in *BuildStatus, out *api.Bui
 	if err := Convert_v1_BuildStatusOutput_To_api_BuildStatusOutput(&in.Output, &out.Output, s); err != nil {
 		return err
 	}
+	out.Artifacts = *(*[]api.BuildArtifact)(unsafe.Pointer(&in.Artifacts))
+	out.QueuePosition = in.QueuePosition
 	return nil
 }
 
@@ -846,6 +904,8 @@ func autoConvert_api_BuildStatus_To_v1_BuildStatus(in *api.BuildStatus, out *Bui
 	if err := Convert_api_BuildStatusOutput_To_v1_BuildStatusOutput(&in.Output, &out.Output, s); err != nil {
 		return err
 	}
+	out.Artifacts = *(*[]BuildArtifact)(unsafe.Pointer(&in.Artifacts))
+	out.QueuePosition = in.QueuePosition
 	return nil
 }
 
@@ -1150,6 +1210,7 @@ func autoConvert_v1_CommonSpec_To_api_CommonSpec(in *CommonSpec, out *api.Common
 	}
 	out.CompletionDeadlineSeconds = (*int64)(unsafe.Pointer(in.CompletionDeadlineSeconds))
 	out.NodeSelector = *(*map[string]string)(unsafe.Pointer(&in.NodeSelector))
+	out.FailurePolicy = (*api.BuildFailurePolicy)(unsafe.Pointer(in.FailurePolicy))
 	return nil
 }
 
@@ -1184,6 +1245,7 @@ func autoConvert_api_CommonSpec_To_v1_CommonSpec(in *api.CommonSpec, out *Common
 		return err
 	}
 	out.CompletionDeadlineSeconds = (*int64)(unsafe.Pointer(in.CompletionDeadlineSeconds))
+	out.FailurePolicy = (*BuildFailurePolicy)(unsafe.Pointer(in.FailurePolicy))
 	out.NodeSelector = *(*OptionalNodeSelector)(unsafe.Pointer(&in.NodeSelector))
 	return nil
 }
@@ -1569,6 +1631,16 @@ func autoConvert_v1_GitBuildSource_To_api_GitBuildSource(in *GitBuildSource, out
 	if err := Convert_v1_ProxyConfig_To_api_ProxyConfig(&in.ProxyConfig, &out.ProxyConfig, s); err != nil {
 		return err
 	}
+	if in.SubmoduleSecret != nil {
+		in, out := &in.SubmoduleSecret, &out.SubmoduleSecret
+		*out = new(pkg_api.LocalObjectReference)
+		if err := api_v1.Convert_v1_LocalObjectReference_To_api_LocalObjectReference(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.SubmoduleSecret = nil
+	}
+	out.LFS = in.LFS
 	return nil
 }
 
@@ -1582,6 +1654,16 @@ func autoConvert_api_GitBuildSource_To_v1_GitBuildSource(in *api.GitBuildSource,
 	if err := Convert_api_ProxyConfig_To_v1_ProxyConfig(&in.ProxyConfig, &out.ProxyConfig, s); err != nil {
 		return err
 	}
+	if in.SubmoduleSecret != nil {
+		in, out := &in.SubmoduleSecret, &out.SubmoduleSecret
+		*out = new(api_v1.LocalObjectReference)
+		if err := api_v1.Convert_api_LocalObjectReference_To_v1_LocalObjectReference(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.SubmoduleSecret = nil
+	}
+	out.LFS = in.LFS
 	return nil
 }
 
@@ -2078,6 +2160,28 @@ func Convert_api_SourceControlUser_To_v1_SourceControlUser(in *api.SourceControl
 	return autoConvert_api_SourceControlUser_To_v1_SourceControlUser(in, out, s)
 }
 
+func autoConvert_v1_SourceOverrides_To_api_SourceOverrides(in *SourceOverrides, out *api.SourceOverrides, s conversion.Scope) error {
+	out.Ref = in.Ref
+	out.Commit = in.Commit
+	out.ContextDir = in.ContextDir
+	return nil
+}
+
+func Convert_v1_SourceOverrides_To_api_SourceOverrides(in *SourceOverrides, out *api.SourceOverrides, s conversion.Scope) error {
+	return autoConvert_v1_SourceOverrides_To_api_SourceOverrides(in, out, s)
+}
+
+func autoConvert_api_SourceOverrides_To_v1_SourceOverrides(in *api.SourceOverrides, out *SourceOverrides, s conversion.Scope) error {
+	out.Ref = in.Ref
+	out.Commit = in.Commit
+	out.ContextDir = in.ContextDir
+	return nil
+}
+
+func Convert_api_SourceOverrides_To_v1_SourceOverrides(in *api.SourceOverrides, out *SourceOverrides, s conversion.Scope) error {
+	return autoConvert_api_SourceOverrides_To_v1_SourceOverrides(in, out, s)
+}
+
 func autoConvert_v1_SourceRevision_To_api_SourceRevision(in *SourceRevision, out *api.SourceRevision, s conversion.Scope) error {
 	// INFO: in.Type opted out of conversion generation
 	out.Git = (*api.GitSourceRevision)(unsafe.Pointer(in.Git))
@@ -2093,6 +2197,30 @@ func autoConvert_api_SourceRevision_To_v1_SourceRevision(in *api.SourceRevision,
 	return nil
 }
 
+func autoConvert_v1_VolumeBuildSource_To_api_VolumeBuildSource(in *VolumeBuildSource, out *api.VolumeBuildSource, s conversion.Scope) error {
+	if err := api_v1.Convert_v1_LocalObjectReference_To_api_LocalObjectReference(&in.PersistentVolumeClaim, &out.PersistentVolumeClaim, s); err != nil {
+		return err
+	}
+	out.Paths = *(*[]api.ImageSourcePath)(unsafe.Pointer(&in.Paths))
+	return nil
+}
+
+func Convert_v1_VolumeBuildSource_To_api_VolumeBuildSource(in *VolumeBuildSource, out *api.VolumeBuildSource, s conversion.Scope) error {
+	return autoConvert_v1_VolumeBuildSource_To_api_VolumeBuildSource(in, out, s)
+}
+
+func autoConvert_api_VolumeBuildSource_To_v1_VolumeBuildSource(in *api.VolumeBuildSource, out *VolumeBuildSource, s conversion.Scope) error {
+	if err := api_v1.Convert_api_LocalObjectReference_To_v1_LocalObjectReference(&in.PersistentVolumeClaim, &out.PersistentVolumeClaim, s); err != nil {
+		return err
+	}
+	out.Paths = *(*[]ImageSourcePath)(unsafe.Pointer(&in.Paths))
+	return nil
+}
+
+func Convert_api_VolumeBuildSource_To_v1_VolumeBuildSource(in *api.VolumeBuildSource, out *VolumeBuildSource, s conversion.Scope) error {
+	return autoConvert_api_VolumeBuildSource_To_v1_VolumeBuildSource(in, out, s)
+}
+
 func autoConvert_v1_WebHookTrigger_To_api_WebHookTrigger(in *WebHookTrigger, out *api.WebHookTrigger, s conversion.Scope) error {
 	out.Secret = in.Secret
 	out.AllowEnv = in.AllowEnv
@@ -24,10 +24,12 @@ func RegisterDeepCopies(scheme *runtime.Scheme) error {
 		conversion.GeneratedDeepCopyFunc{Fn: DeepCopy_v1_BinaryBuildSource, InType: reflect.TypeOf(&BinaryBuildSource{})},
 		conversion.GeneratedDeepCopyFunc{Fn: DeepCopy_v1_BitbucketWebHookCause, InType: reflect.TypeOf(&BitbucketWebHookCause{})},
 		conversion.GeneratedDeepCopyFunc{Fn: DeepCopy_v1_Build, InType: reflect.TypeOf(&Build{})},
+		conversion.GeneratedDeepCopyFunc{Fn: DeepCopy_v1_BuildArtifact, InType: reflect.TypeOf(&BuildArtifact{})},
 		conversion.GeneratedDeepCopyFunc{Fn: DeepCopy_v1_BuildConfig, InType: reflect.TypeOf(&BuildConfig{})},
 		conversion.GeneratedDeepCopyFunc{Fn: DeepCopy_v1_BuildConfigList, InType: reflect.TypeOf(&BuildConfigList{})},
 		conversion.GeneratedDeepCopyFunc{Fn: DeepCopy_v1_BuildConfigSpec, InType: reflect.TypeOf(&BuildConfigSpec{})},
 		conversion.GeneratedDeepCopyFunc{Fn: DeepCopy_v1_BuildConfigStatus, InType: reflect.TypeOf(&BuildConfigStatus{})},
+		conversion.GeneratedDeepCopyFunc{Fn: DeepCopy_v1_BuildFailurePolicy, InType: reflect.TypeOf(&BuildFailurePolicy{})},
 		conversion.GeneratedDeepCopyFunc{Fn: DeepCopy_v1_BuildList, InType: reflect.TypeOf(&BuildList{})},
 		conversion.GeneratedDeepCopyFunc{Fn: DeepCopy_v1_BuildLog, InType: reflect.TypeOf(&BuildLog{})},
 		conversion.GeneratedDeepCopyFunc{Fn: DeepCopy_v1_BuildLogOptions, InType: reflect.TypeOf(&BuildLogOptions{})},
@@ -65,7 +67,9 @@ func RegisterDeepCopies(scheme *runtime.Scheme) error {
 		conversion.GeneratedDeepCopyFunc{Fn: DeepCopy_v1_SecretSpec, InType: reflect.TypeOf(&SecretSpec{})},
 		conversion.GeneratedDeepCopyFunc{Fn: DeepCopy_v1_SourceBuildStrategy, InType: reflect.TypeOf(&SourceBuildStrategy{})},
 		conversion.GeneratedDeepCopyFunc{Fn: DeepCopy_v1_SourceControlUser, InType: reflect.TypeOf(&SourceControlUser{})},
+		conversion.GeneratedDeepCopyFunc{Fn: DeepCopy_v1_SourceOverrides, InType: reflect.TypeOf(&SourceOverrides{})},
 		conversion.GeneratedDeepCopyFunc{Fn: DeepCopy_v1_SourceRevision, InType: reflect.TypeOf(&SourceRevision{})},
+		conversion.GeneratedDeepCopyFunc{Fn: DeepCopy_v1_VolumeBuildSource, InType: reflect.TypeOf(&VolumeBuildSource{})},
 		conversion.GeneratedDeepCopyFunc{Fn: DeepCopy_v1_WebHookTrigger, InType: reflect.TypeOf(&WebHookTrigger{})},
 	)
 }
@@ -127,6 +131,22 @@ func DeepCopy_v1_Build(in interface{}, out interface{}, c *conversion.Cloner) er
 	}
 }
 
+func DeepCopy_v1_BuildArtifact(in interface{}, out interface{}, c *conversion.Cloner) error {
+	{
+		in := in.(*BuildArtifact)
+		out := out.(*BuildArtifact)
+		out.Path = in.Path
+		if in.Data != nil {
+			in, out := &in.Data, &out.Data
+			*out = make([]byte, len(*in))
+			copy(*out, *in)
+		} else {
+			out.Data = nil
+		}
+		return nil
+	}
+}
+
 func DeepCopy_v1_BuildConfig(in interface{}, out interface{}, c *conversion.Cloner) error {
 	{
 		in := in.(*BuildConfig)
@@ -180,6 +200,20 @@ func DeepCopy_v1_BuildConfigSpec(in interface{}, out interface{}, c *conversion.
 			out.Triggers = nil
 		}
 		out.RunPolicy = in.RunPolicy
+		if in.Platforms != nil {
+			in, out := &in.Platforms, &out.Platforms
+			*out = make([]string, len(*in))
+			copy(*out, *in)
+		} else {
+			out.Platforms = nil
+		}
+		if in.AllowedSourceOverridePatterns != nil {
+			in, out := &in.AllowedSourceOverridePatterns, &out.AllowedSourceOverridePatterns
+			*out = make([]string, len(*in))
+			copy(*out, *in)
+		} else {
+			out.AllowedSourceOverridePatterns = nil
+		}
 		if err := DeepCopy_v1_CommonSpec(&in.CommonSpec, &out.CommonSpec, c); err != nil {
 			return err
 		}
@@ -196,6 +230,22 @@ func DeepCopy_v1_BuildConfigStatus(in interface{}, out interface{}, c *conversio
 	}
 }
 
+func DeepCopy_v1_BuildFailurePolicy(in interface{}, out interface{}, c *conversion.Cloner) error {
+	{
+		in := in.(*BuildFailurePolicy)
+		out := out.(*BuildFailurePolicy)
+		out.MaxRetries = in.MaxRetries
+		if in.BackoffSeconds != nil {
+			in, out := &in.BackoffSeconds, &out.BackoffSeconds
+			*out = new(int64)
+			**out = **in
+		} else {
+			out.BackoffSeconds = nil
+		}
+		return nil
+	}
+}
+
 func DeepCopy_v1_BuildList(in interface{}, out interface{}, c *conversion.Cloner) error {
 	{
 		in := in.(*BuildList)
@@ -325,6 +375,13 @@ func DeepCopy_v1_BuildPostCommitSpec(in interface{}, out interface{}, c *convers
 			out.Args = nil
 		}
 		out.Script = in.Script
+		if in.ArtifactPaths != nil {
+			in, out := &in.ArtifactPaths, &out.ArtifactPaths
+			*out = make([]string, len(*in))
+			copy(*out, *in)
+		} else {
+			out.ArtifactPaths = nil
+		}
 		return nil
 	}
 }
@@ -405,6 +462,13 @@ func DeepCopy_v1_BuildRequest(in interface{}, out interface{}, c *conversion.Clo
 		} else {
 			out.DockerStrategyOptions = nil
 		}
+		if in.SourceOverrides != nil {
+			in, out := &in.SourceOverrides, &out.SourceOverrides
+			*out = new(SourceOverrides)
+			**out = **in
+		} else {
+			out.SourceOverrides = nil
+		}
 		return nil
 	}
 }
@@ -465,6 +529,17 @@ func DeepCopy_v1_BuildSource(in interface{}, out interface{}, c *conversion.Clon
 		} else {
 			out.Secrets = nil
 		}
+		if in.Volumes != nil {
+			in, out := &in.Volumes, &out.Volumes
+			*out = make([]VolumeBuildSource, len(*in))
+			for i := range *in {
+				if err := DeepCopy_v1_VolumeBuildSource(&(*in)[i], &(*out)[i], c); err != nil {
+					return err
+				}
+			}
+		} else {
+			out.Volumes = nil
+		}
 		return nil
 	}
 }
@@ -525,6 +600,18 @@ func DeepCopy_v1_BuildStatus(in interface{}, out interface{}, c *conversion.Clon
 		if err := DeepCopy_v1_BuildStatusOutput(&in.Output, &out.Output, c); err != nil {
 			return err
 		}
+		if in.Artifacts != nil {
+			in, out := &in.Artifacts, &out.Artifacts
+			*out = make([]BuildArtifact, len(*in))
+			for i := range *in {
+				if err := DeepCopy_v1_BuildArtifact(&(*in)[i], &(*out)[i], c); err != nil {
+					return err
+				}
+			}
+		} else {
+			out.Artifacts = nil
+		}
+		out.QueuePosition = in.QueuePosition
 		return nil
 	}
 }
@@ -743,6 +830,15 @@ func DeepCopy_v1_CommonSpec(in interface{}, out interface{}, c *conversion.Clone
 		} else {
 			out.NodeSelector = nil
 		}
+		if in.FailurePolicy != nil {
+			in, out := &in.FailurePolicy, &out.FailurePolicy
+			*out = new(BuildFailurePolicy)
+			if err := DeepCopy_v1_BuildFailurePolicy(*in, *out, c); err != nil {
+				return err
+			}
+		} else {
+			out.FailurePolicy = nil
+		}
 		return nil
 	}
 }
@@ -942,6 +1038,14 @@ func DeepCopy_v1_GitBuildSource(in interface{}, out interface{}, c *conversion.C
 		if err := DeepCopy_v1_ProxyConfig(&in.ProxyConfig, &out.ProxyConfig, c); err != nil {
 			return err
 		}
+		if in.SubmoduleSecret != nil {
+			in, out := &in.SubmoduleSecret, &out.SubmoduleSecret
+			*out = new(api_v1.LocalObjectReference)
+			**out = **in
+		} else {
+			out.SubmoduleSecret = nil
+		}
+		out.LFS = in.LFS
 		return nil
 	}
 }
@@ -1208,6 +1312,17 @@ func DeepCopy_v1_SourceControlUser(in interface{}, out interface{}, c *conversio
 	}
 }
 
+func DeepCopy_v1_SourceOverrides(in interface{}, out interface{}, c *conversion.Cloner) error {
+	{
+		in := in.(*SourceOverrides)
+		out := out.(*SourceOverrides)
+		out.Ref = in.Ref
+		out.Commit = in.Commit
+		out.ContextDir = in.ContextDir
+		return nil
+	}
+}
+
 func DeepCopy_v1_SourceRevision(in interface{}, out interface{}, c *conversion.Cloner) error {
 	{
 		in := in.(*SourceRevision)
@@ -1224,6 +1339,24 @@ func DeepCopy_v1_SourceRevision(in interface{}, out interface{}, c *conversion.C
 	}
 }
 
+func DeepCopy_v1_VolumeBuildSource(in interface{}, out interface{}, c *conversion.Cloner) error {
+	{
+		in := in.(*VolumeBuildSource)
+		out := out.(*VolumeBuildSource)
+		out.PersistentVolumeClaim = in.PersistentVolumeClaim
+		if in.Paths != nil {
+			in, out := &in.Paths, &out.Paths
+			*out = make([]ImageSourcePath, len(*in))
+			for i := range *in {
+				(*out)[i] = (*in)[i]
+			}
+		} else {
+			out.Paths = nil
+		}
+		return nil
+	}
+}
+
 func DeepCopy_v1_WebHookTrigger(in interface{}, out interface{}, c *conversion.Cloner) error {
 	{
 		in := in.(*WebHookTrigger)
@@ -148,6 +148,31 @@ func ValidateBuildConfig(config *buildapi.BuildConfig) field.ErrorList {
 			"run policy must Parallel, Serial, or SerialLatestOnly"))
 	}
 
+	platformsPath := specPath.Child("platforms")
+	seenPlatforms := map[string]struct{}{}
+	for i, platform := range config.Spec.Platforms {
+		parts := strings.Split(platform, "/")
+		if len(parts) != 2 || len(parts[0]) == 0 || len(parts[1]) == 0 {
+			allErrs = append(allErrs, field.Invalid(platformsPath.Index(i), platform, "must be in os/arch form, e.g. linux/amd64"))
+			continue
+		}
+		if _, exists := seenPlatforms[platform]; exists {
+			allErrs = append(allErrs, field.Duplicate(platformsPath.Index(i), platform))
+		}
+		seenPlatforms[platform] = struct{}{}
+	}
+
+	patternsPath := specPath.Child("allowedSourceOverridePatterns")
+	for i, pattern := range config.Spec.AllowedSourceOverridePatterns {
+		if len(pattern) == 0 {
+			allErrs = append(allErrs, field.Required(patternsPath.Index(i), ""))
+			continue
+		}
+		if _, err := path.Match(pattern, ""); err != nil {
+			allErrs = append(allErrs, field.Invalid(patternsPath.Index(i), pattern, "must be a valid shell-style glob pattern"))
+		}
+	}
+
 	allErrs = append(allErrs, validateCommonSpec(&config.Spec.CommonSpec, specPath)...)
 
 	return allErrs
@@ -162,7 +187,20 @@ func ValidateBuildConfigUpdate(config *buildapi.BuildConfig, older *buildapi.Bui
 
 // ValidateBuildRequest validates a BuildRequest object
 func ValidateBuildRequest(request *buildapi.BuildRequest) field.ErrorList {
-	return validation.ValidateObjectMeta(&request.ObjectMeta, true, kpath.ValidatePathSegmentName, field.NewPath("metadata"))
+	allErrs := validation.ValidateObjectMeta(&request.ObjectMeta, true, kpath.ValidatePathSegmentName, field.NewPath("metadata"))
+
+	if request.SourceOverrides != nil {
+		overridesPath := field.NewPath("sourceOverrides")
+		overrides := request.SourceOverrides
+		if len(overrides.Ref) == 0 && len(overrides.Commit) == 0 && len(overrides.ContextDir) == 0 {
+			allErrs = append(allErrs, field.Required(overridesPath, "must specify at least one of ref, commit or contextDir"))
+		}
+		if len(overrides.ContextDir) != 0 && strings.HasPrefix(path.Clean(overrides.ContextDir), "..") {
+			allErrs = append(allErrs, field.Invalid(overridesPath.Child("contextDir"), overrides.ContextDir, "context dir must not be a relative path"))
+		}
+	}
+
+	return allErrs
 }
 
 func validateCommonSpec(spec *buildapi.CommonSpec, fldPath *field.Path) field.ErrorList {
@@ -188,6 +226,15 @@ func validateCommonSpec(spec *buildapi.CommonSpec, fldPath *field.Path) field.Er
 		}
 	}
 
+	if spec.FailurePolicy != nil {
+		if spec.FailurePolicy.MaxRetries < 0 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("failurePolicy", "maxRetries"), spec.FailurePolicy.MaxRetries, "maxRetries must not be negative"))
+		}
+		if spec.FailurePolicy.BackoffSeconds != nil && *spec.FailurePolicy.BackoffSeconds <= 0 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("failurePolicy", "backoffSeconds"), spec.FailurePolicy.BackoffSeconds, "backoffSeconds must be a positive integer greater than 0"))
+		}
+	}
+
 	allErrs = append(allErrs, validateOutput(&spec.Output, fldPath.Child("output"))...)
 	allErrs = append(allErrs, validateStrategy(&spec.Strategy, fldPath.Child("strategy"))...)
 	allErrs = append(allErrs, validatePostCommit(spec.PostCommit, fldPath.Child("postCommit"))...)
@@ -227,6 +274,9 @@ func validateSource(input *buildapi.BuildSource, isCustomStrategy, isDockerStrat
 			allErrs = append(allErrs, validateImageSource(image, fldPath.Child("images").Index(i))...)
 		}
 	}
+	for i, volume := range input.Volumes {
+		allErrs = append(allErrs, validateVolumeSource(volume, fldPath.Child("volumes").Index(i))...)
+	}
 	if isJenkinsPipelineStrategyFromRepo && input.Git == nil {
 		allErrs = append(allErrs, field.Invalid(fldPath.Child("git"), "", "must be set when using Jenkins Pipeline strategy with Jenkinsfile from a git repo"))
 	}
@@ -282,6 +332,7 @@ func validateGitSource(git *buildapi.GitBuildSource, fldPath *field.Path) field.
 	if git.HTTPSProxy != nil && len(*git.HTTPSProxy) != 0 && !IsValidURL(*git.HTTPSProxy) {
 		allErrs = append(allErrs, field.Invalid(fldPath.Child("httpsproxy"), *git.HTTPSProxy, "proxy is not a valid url"))
 	}
+	allErrs = append(allErrs, validateSecretRef(git.SubmoduleSecret, fldPath.Child("submoduleSecret"))...)
 	return allErrs
 }
 
@@ -319,6 +370,20 @@ func validateImageSource(imageSource buildapi.ImageSource, fldPath *field.Path)
 	return allErrs
 }
 
+func validateVolumeSource(volumeSource buildapi.VolumeBuildSource, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if len(volumeSource.PersistentVolumeClaim.Name) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("persistentVolumeClaim", "name"), ""))
+	}
+	if len(volumeSource.Paths) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("paths"), ""))
+	}
+	for i, path := range volumeSource.Paths {
+		allErrs = append(allErrs, validateImageSourcePath(path, fldPath.Child("paths").Index(i))...)
+	}
+	return allErrs
+}
+
 func validateImageSourcePath(imagePath buildapi.ImageSourcePath, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
 	if len(imagePath.SourcePath) == 0 {
@@ -611,11 +676,11 @@ func validateTrigger(trigger *buildapi.BuildTriggerPolicy, buildFrom *kapi.Objec
 
 			break
 		}
-		if kind := trigger.ImageChange.From.Kind; kind != "ImageStreamTag" {
+		if kind := trigger.ImageChange.From.Kind; kind != "ImageStreamTag" && kind != "DockerImage" {
 			invalidKindErr := field.Invalid(
 				fldPath.Child("imageChange").Child("from").Child("kind"),
 				kind,
-				"only an ImageStreamTag type of reference is allowed in an ImageChange trigger.")
+				"only an ImageStreamTag or DockerImage type of reference is allowed in an ImageChange trigger.")
 			allErrs = append(allErrs, invalidKindErr)
 			break
 		}
@@ -686,6 +751,14 @@ func validatePostCommit(spec buildapi.BuildPostCommitSpec, fldPath *field.Path)
 	if spec.Script != "" && len(spec.Command) > 0 {
 		allErrs = append(allErrs, field.Invalid(fldPath, spec, "cannot use command and script together"))
 	}
+	if len(spec.ArtifactPaths) > 0 && spec.Script == "" && len(spec.Command) == 0 && len(spec.Args) == 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("artifactPaths"), spec.ArtifactPaths, "cannot specify artifact paths without a hook to run"))
+	}
+	for i, artifactPath := range spec.ArtifactPaths {
+		if !path.IsAbs(artifactPath) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("artifactPaths").Index(i), artifactPath, "must be an absolute path"))
+		}
+	}
 	return allErrs
 }
 
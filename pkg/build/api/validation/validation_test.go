@@ -571,7 +571,7 @@ func TestBuildConfigImageChangeTriggers(t *testing.T) {
 			expectError: false,
 		},
 		{
-			name: "invalid reference kind for trigger",
+			name: "docker image reference kind for trigger",
 			triggers: []buildapi.BuildTriggerPolicy{
 				{
 					Type:        buildapi.ImageChangeBuildTriggerType,
@@ -588,8 +588,7 @@ func TestBuildConfigImageChangeTriggers(t *testing.T) {
 				},
 			},
 			fromKind:    "ImageStreamTag",
-			expectError: true,
-			errorType:   field.ErrorTypeInvalid,
+			expectError: false,
 		},
 		{
 			name: "empty reference kind for trigger",
@@ -760,6 +759,50 @@ func TestBuildConfigImageChangeTriggers(t *testing.T) {
 	}
 }
 
+func TestBuildConfigValidationPlatforms(t *testing.T) {
+	tests := []struct {
+		platforms     []string
+		expectedError int
+	}{
+		{[]string{}, 0},
+		{[]string{"linux/amd64"}, 0},
+		{[]string{"linux/amd64", "linux/arm64", "linux/ppc64le"}, 0},
+		{[]string{"amd64"}, 1},
+		{[]string{"linux/"}, 1},
+		{[]string{"/amd64"}, 1},
+		{[]string{"linux/amd64", "linux/amd64"}, 1},
+	}
+	for _, tc := range tests {
+		buildConfig := &buildapi.BuildConfig{
+			ObjectMeta: kapi.ObjectMeta{Name: "config-id", Namespace: "namespace"},
+			Spec: buildapi.BuildConfigSpec{
+				RunPolicy: buildapi.BuildRunPolicySerial,
+				Platforms: tc.platforms,
+				CommonSpec: buildapi.CommonSpec{
+					Source: buildapi.BuildSource{
+						Git: &buildapi.GitBuildSource{
+							URI: "http://github.com/my/repository",
+						},
+					},
+					Strategy: buildapi.BuildStrategy{
+						DockerStrategy: &buildapi.DockerBuildStrategy{},
+					},
+					Output: buildapi.BuildOutput{
+						To: &kapi.ObjectReference{
+							Kind: "ImageStreamTag",
+							Name: "repository:latest",
+						},
+					},
+				},
+			},
+		}
+		errors := ValidateBuildConfig(buildConfig)
+		if len(errors) != tc.expectedError {
+			t.Errorf("platforms %v: expected %d validation errors, got %v", tc.platforms, tc.expectedError, errors)
+		}
+	}
+}
+
 func TestBuildConfigValidationOutputFailure(t *testing.T) {
 	buildConfig := &buildapi.BuildConfig{
 		ObjectMeta: kapi.ObjectMeta{Name: ""},
@@ -1126,6 +1169,76 @@ func TestValidateSource(t *testing.T) {
 				},
 			},
 		},
+		// 22
+		{
+			ok: true,
+			source: &buildapi.BuildSource{
+				Volumes: []buildapi.VolumeBuildSource{
+					{
+						PersistentVolumeClaim: kapi.LocalObjectReference{
+							Name: "artifacts",
+						},
+						Paths: []buildapi.ImageSourcePath{
+							{
+								SourcePath:     "/some/path",
+								DestinationDir: "test/dir",
+							},
+						},
+					},
+				},
+			},
+		},
+		// 23
+		{
+			t:    field.ErrorTypeRequired,
+			path: "volumes[0].persistentVolumeClaim.name",
+			source: &buildapi.BuildSource{
+				Volumes: []buildapi.VolumeBuildSource{
+					{
+						Paths: []buildapi.ImageSourcePath{
+							{
+								SourcePath:     "/some/path",
+								DestinationDir: "test/dir",
+							},
+						},
+					},
+				},
+			},
+		},
+		// 24
+		{
+			t:    field.ErrorTypeRequired,
+			path: "volumes[0].paths",
+			source: &buildapi.BuildSource{
+				Volumes: []buildapi.VolumeBuildSource{
+					{
+						PersistentVolumeClaim: kapi.LocalObjectReference{
+							Name: "artifacts",
+						},
+					},
+				},
+			},
+		},
+		// 25 - sourcepath is not absolute.
+		{
+			t:    field.ErrorTypeInvalid,
+			path: "volumes[0].paths[0].sourcePath",
+			source: &buildapi.BuildSource{
+				Volumes: []buildapi.VolumeBuildSource{
+					{
+						PersistentVolumeClaim: kapi.LocalObjectReference{
+							Name: "artifacts",
+						},
+						Paths: []buildapi.ImageSourcePath{
+							{
+								SourcePath:     "some/path",
+								DestinationDir: "test/dir",
+							},
+						},
+					},
+				},
+			},
+		},
 	}
 	for i, tc := range errorCases {
 		errors := validateSource(tc.source, false, false, false, nil)
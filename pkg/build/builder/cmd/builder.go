@@ -33,12 +33,13 @@ type builder interface {
 }
 
 type builderConfig struct {
-	out             io.Writer
-	build           *api.Build
-	sourceSecretDir string
-	dockerClient    *docker.Client
-	dockerEndpoint  string
-	buildsClient    client.BuildInterface
+	out                io.Writer
+	build              *api.Build
+	sourceSecretDir    string
+	submoduleSecretDir string
+	dockerClient       *docker.Client
+	dockerEndpoint     string
+	buildsClient       client.BuildInterface
 }
 
 func newBuilderConfigFromEnvironment(out io.Writer) (*builderConfig, error) {
@@ -70,6 +71,9 @@ func newBuilderConfigFromEnvironment(out io.Writer) (*builderConfig, error) {
 	// sourceSecretsDir (SOURCE_SECRET_PATH)
 	cfg.sourceSecretDir = os.Getenv("SOURCE_SECRET_PATH")
 
+	// submoduleSecretDir (SUBMODULE_SECRET_PATH)
+	cfg.submoduleSecretDir = os.Getenv("SUBMODULE_SECRET_PATH")
+
 	// dockerClient and dockerEndpoint (DOCKER_HOST)
 	// usually not set, defaults to docker socket
 	cfg.dockerClient, cfg.dockerEndpoint, err = bld.GetDockerClient()
@@ -91,14 +95,15 @@ func newBuilderConfigFromEnvironment(out io.Writer) (*builderConfig, error) {
 	return cfg, nil
 }
 
-func (c *builderConfig) setupGitEnvironment() (string, []string, error) {
-	var sourceSecretDir string
-	var errSecret error
+func (c *builderConfig) setupGitEnvironment() ([]string, []string, error) {
+	// secretTmpDirs tracks the temporary directories secrets were copied into so
+	// that they can be removed when the build completes
+	secretTmpDirs := []string{}
 
 	// For now, we only handle git. If not specified, we're done
 	gitSource := c.build.Spec.Source.Git
 	if gitSource == nil {
-		return "", []string{}, nil
+		return secretTmpDirs, []string{}, nil
 	}
 
 	sourceSecret := c.build.Spec.Source.SourceSecret
@@ -109,24 +114,46 @@ func (c *builderConfig) setupGitEnvironment() (string, []string, error) {
 		//   it accepts
 		sourceURL, err := git.ParseRepository(gitSource.URI)
 		if err != nil {
-			return "", nil, fmt.Errorf("cannot parse build URL: %s", gitSource.URI)
+			return secretTmpDirs, nil, fmt.Errorf("cannot parse build URL: %s", gitSource.URI)
 		}
 		scmAuths := scmauth.GitAuths(sourceURL)
 
 		// TODO: remove when not necessary to fix up the secret dir permission
-		sourceSecretDir, errSecret = fixSecretPermissions(c.sourceSecretDir)
+		sourceSecretDir, errSecret := fixSecretPermissions(c.sourceSecretDir)
 		if errSecret != nil {
-			return sourceSecretDir, nil, fmt.Errorf("cannot fix source secret permissions: %v", errSecret)
+			return secretTmpDirs, nil, fmt.Errorf("cannot fix source secret permissions: %v", errSecret)
 		}
+		secretTmpDirs = append(secretTmpDirs, sourceSecretDir)
 		secretsEnv, overrideURL, err := scmAuths.Setup(sourceSecretDir)
 		if err != nil {
-			return sourceSecretDir, nil, fmt.Errorf("cannot setup source secret: %v", err)
+			return secretTmpDirs, nil, fmt.Errorf("cannot setup source secret: %v", err)
 		}
 		if overrideURL != nil {
 			gitSource.URI = overrideURL.String()
 		}
 		gitEnv = append(gitEnv, secretsEnv...)
 	}
+	// If a submodule secret is present, set it up as well; its environment is
+	// appended after the source secret so that submodules hosted elsewhere can
+	// use independent credentials
+	if gitSource.SubmoduleSecret != nil && len(c.submoduleSecretDir) > 0 {
+		sourceURL, err := git.ParseRepository(gitSource.URI)
+		if err != nil {
+			return secretTmpDirs, nil, fmt.Errorf("cannot parse build URL: %s", gitSource.URI)
+		}
+		scmAuths := scmauth.GitAuths(sourceURL)
+
+		submoduleSecretDir, errSecret := fixSecretPermissions(c.submoduleSecretDir)
+		if errSecret != nil {
+			return secretTmpDirs, nil, fmt.Errorf("cannot fix submodule secret permissions: %v", errSecret)
+		}
+		secretTmpDirs = append(secretTmpDirs, submoduleSecretDir)
+		secretsEnv, _, err := scmAuths.Setup(submoduleSecretDir)
+		if err != nil {
+			return secretTmpDirs, nil, fmt.Errorf("cannot setup submodule secret: %v", err)
+		}
+		gitEnv = append(gitEnv, secretsEnv...)
+	}
 	if gitSource.HTTPProxy != nil && len(*gitSource.HTTPProxy) > 0 {
 		gitEnv = append(gitEnv, fmt.Sprintf("HTTP_PROXY=%s", *gitSource.HTTPProxy))
 		gitEnv = append(gitEnv, fmt.Sprintf("http_proxy=%s", *gitSource.HTTPProxy))
@@ -139,12 +166,12 @@ func (c *builderConfig) setupGitEnvironment() (string, []string, error) {
 		gitEnv = append(gitEnv, fmt.Sprintf("NO_PROXY=%s", *gitSource.NoProxy))
 		gitEnv = append(gitEnv, fmt.Sprintf("no_proxy=%s", *gitSource.NoProxy))
 	}
-	return sourceSecretDir, bld.MergeEnv(os.Environ(), gitEnv), nil
+	return secretTmpDirs, bld.MergeEnv(os.Environ(), gitEnv), nil
 }
 
 // execute is responsible for running a build
 func (c *builderConfig) execute(b builder) error {
-	secretTmpDir, gitEnv, err := c.setupGitEnvironment()
+	secretTmpDirs, gitEnv, err := c.setupGitEnvironment()
 	if err != nil {
 		return err
 	}
@@ -165,7 +192,9 @@ func (c *builderConfig) execute(b builder) error {
 		fmt.Fprintf(c.out, "Build complete, no image push requested\n")
 	}
 
-	os.RemoveAll(secretTmpDir)
+	for _, dir := range secretTmpDirs {
+		os.RemoveAll(dir)
+	}
 	return nil
 }
 
@@ -1,9 +1,15 @@
 package builder
 
 import (
+	"archive/tar"
+	"bytes"
 	"crypto/sha1"
+	"crypto/sha256"
 	"fmt"
+	"io"
+	"io/ioutil"
 	"math/rand"
+	"net/url"
 	"os"
 	"time"
 
@@ -27,6 +33,10 @@ const (
 	// We cannot reuse the prefix "k8s" because we don't want the containers to
 	// be managed by a kubelet.
 	containerNamePrefix = "openshift"
+
+	// maxArtifactSize bounds the content extracted for a single post commit
+	// hook artifact, since artifact contents are recorded in the build status.
+	maxArtifactSize = 128 * 1024
 )
 
 // KeyValue can be used to build ordered lists of key-value pairs.
@@ -40,6 +50,7 @@ type GitClient interface {
 	CloneWithOptions(dir string, url string, args ...string) error
 	Checkout(dir string, ref string) error
 	SubmoduleUpdate(dir string, init, recursive bool) error
+	LFSPull(dir string) error
 	TimedListRemote(timeout time.Duration, url string, args ...string) (string, string, error)
 	GetInfo(location string) (*git.SourceInfo, []error)
 }
@@ -72,6 +83,56 @@ func buildInfo(build *api.Build, sourceInfo *git.SourceInfo) []KeyValue {
 	return kv
 }
 
+// provenanceLabels returns Docker image labels recording where and how the image was
+// built: the build, its namespace, the source repository and commit. Identifying
+// details are anonymized before they are persisted - credentials are stripped from the
+// source URL and the committer identity is reduced to a short digest - so the labels
+// are safe to ship in images that leave the cluster.
+func provenanceLabels(build *api.Build, sourceInfo *git.SourceInfo) map[string]string {
+	labels := map[string]string{
+		api.DefaultDockerLabelNamespace + "build.name":      build.Name,
+		api.DefaultDockerLabelNamespace + "build.namespace": build.Namespace,
+	}
+	if build.Spec.Source.Git != nil {
+		labels[api.DefaultDockerLabelNamespace+"build.source-location"] = anonymizeURL(build.Spec.Source.Git.URI)
+		if len(build.Spec.Source.Git.Ref) > 0 {
+			labels[api.DefaultDockerLabelNamespace+"build.source-ref"] = build.Spec.Source.Git.Ref
+		}
+	}
+	if sourceInfo != nil {
+		if len(sourceInfo.CommitID) > 0 {
+			labels[api.DefaultDockerLabelNamespace+"build.commit.id"] = sourceInfo.CommitID
+		}
+		// the digests are emitted alongside the full commit.author/commit.committer
+		// labels rather than replacing them, so existing consumers of those labels
+		// keep working
+		if len(sourceInfo.AuthorEmail) > 0 {
+			labels[api.DefaultDockerLabelNamespace+"build.commit.author.digest"] = anonymizeIdentity(sourceInfo.AuthorEmail)
+		}
+		if len(sourceInfo.CommitterEmail) > 0 {
+			labels[api.DefaultDockerLabelNamespace+"build.commit.committer.digest"] = anonymizeIdentity(sourceInfo.CommitterEmail)
+		}
+	}
+	return labels
+}
+
+// anonymizeURL removes any userinfo (tokens, passwords) embedded in a source URL.
+func anonymizeURL(rawurl string) string {
+	u, err := url.Parse(rawurl)
+	if err != nil {
+		// not a parsable URL (e.g. scp-style git address), do not risk leaking it
+		return ""
+	}
+	u.User = nil
+	return u.String()
+}
+
+// anonymizeIdentity reduces a commit identity to a short, stable digest so builds from
+// the same author can be correlated without persisting the address itself.
+func anonymizeIdentity(identity string) string {
+	return fmt.Sprintf("%x", sha256.Sum256([]byte(identity)))[:12]
+}
+
 // randomBuildTag generates a random tag used for building images in such a way
 // that the built image can be referred to unambiguously even in the face of
 // concurrent builds with the same name in the same namespace.
@@ -100,15 +161,17 @@ func containerName(strategyName, buildName, namespace, containerPurpose string)
 
 // execPostCommitHook uses the client to execute a command based on the
 // postCommitSpec in a new ephemeral Docker container running the given image.
-// It returns an error if the hook cannot be run or returns a non-zero exit
-// code.
-func execPostCommitHook(client DockerClient, postCommitSpec api.BuildPostCommitSpec, image, containerName string) error {
+// It returns the artifacts declared by the hook's ArtifactPaths, and an error
+// if the hook cannot be run or returns a non-zero exit code. Artifacts are
+// extracted even when the hook fails so reports from failing test runs are
+// preserved.
+func execPostCommitHook(client DockerClient, postCommitSpec api.BuildPostCommitSpec, image, containerName string) ([]api.BuildArtifact, error) {
 	command := postCommitSpec.Command
 	args := postCommitSpec.Args
 	script := postCommitSpec.Script
 	if script == "" && len(command) == 0 && len(args) == 0 {
 		// Post commit hook is not set, return early.
-		return nil
+		return nil, nil
 	}
 	glog.V(0).Infof("Running post commit hook ...")
 	glog.V(4).Infof("Post commit hook spec: %+v", postCommitSpec)
@@ -125,10 +188,19 @@ func execPostCommitHook(client DockerClient, postCommitSpec api.BuildPostCommitS
 
 	limits, err := GetCGroupLimits()
 	if err != nil {
-		return fmt.Errorf("read cgroup limits: %v", err)
+		return nil, fmt.Errorf("read cgroup limits: %v", err)
 	}
 
-	return dockerRun(client, docker.CreateContainerOptions{
+	var extract func(containerID string) error
+	var artifacts []api.BuildArtifact
+	if len(postCommitSpec.ArtifactPaths) > 0 {
+		extract = func(containerID string) error {
+			artifacts = downloadArtifacts(client, containerID, postCommitSpec.ArtifactPaths)
+			return nil
+		}
+	}
+
+	err = dockerRunAndExtract(client, docker.CreateContainerOptions{
 		Name: containerName,
 		Config: &docker.Config{
 			Image:      image,
@@ -150,7 +222,54 @@ func execPostCommitHook(client DockerClient, postCommitSpec api.BuildPostCommitS
 		Stream:       true,
 		Stdout:       true,
 		Stderr:       true,
-	})
+	}, extract)
+	return artifacts, err
+}
+
+// downloadArtifacts downloads each declared artifact path from the stopped
+// hook container. Files that are missing or exceed the artifact size limit
+// are skipped with a warning rather than failing the build.
+func downloadArtifacts(client DockerClient, containerID string, paths []string) []api.BuildArtifact {
+	artifacts := []api.BuildArtifact{}
+	for _, artifactPath := range paths {
+		data, err := downloadFileFromContainer(client, containerID, artifactPath)
+		if err != nil {
+			glog.V(0).Infof("warning: Failed to extract post commit hook artifact %q: %v", artifactPath, err)
+			continue
+		}
+		artifacts = append(artifacts, api.BuildArtifact{Path: artifactPath, Data: data})
+	}
+	return artifacts
+}
+
+// downloadFileFromContainer returns the content of a single file from the
+// container. The Docker API delivers the file as a tar stream.
+func downloadFileFromContainer(client DockerClient, containerID, path string) ([]byte, error) {
+	buf := &bytes.Buffer{}
+	if err := client.DownloadFromContainer(containerID, docker.DownloadFromContainerOptions{
+		Path:         path,
+		OutputStream: buf,
+	}); err != nil {
+		return nil, err
+	}
+	tr := tar.NewReader(buf)
+	for {
+		header, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		if header.Typeflag != tar.TypeReg {
+			continue
+		}
+		if header.Size > maxArtifactSize {
+			return nil, fmt.Errorf("file is %d bytes, exceeding the %d byte artifact limit", header.Size, maxArtifactSize)
+		}
+		return ioutil.ReadAll(tr)
+	}
+	return nil, fmt.Errorf("no regular file found at %q", path)
 }
 
 func updateBuildRevision(build *api.Build, sourceInfo *git.SourceInfo) *api.SourceRevision {
@@ -189,6 +308,9 @@ func retryBuildStatusUpdate(build *api.Build, client client.BuildInterface, sour
 		latestBuild.Status.Phase = build.Status.Phase
 		latestBuild.Status.Reason = build.Status.Reason
 		latestBuild.Status.Message = build.Status.Message
+		if len(build.Status.Artifacts) > 0 {
+			latestBuild.Status.Artifacts = build.Status.Artifacts
+		}
 		latestBuild.Status.Output.To = build.Status.Output.To
 
 		if _, err := client.UpdateDetails(latestBuild); err != nil {
@@ -146,13 +146,20 @@ func (d *DockerBuilder) Build() error {
 	}
 
 	cname := containerName("docker", d.build.Name, d.build.Namespace, "post-commit")
-	if err := execPostCommitHook(d.dockerClient, d.build.Spec.PostCommit, buildTag, cname); err != nil {
+	artifacts, err := execPostCommitHook(d.dockerClient, d.build.Spec.PostCommit, buildTag, cname)
+	if len(artifacts) > 0 {
+		d.build.Status.Artifacts = artifacts
+	}
+	if err != nil {
 		d.build.Status.Phase = api.BuildPhaseFailed
 		d.build.Status.Reason = api.StatusReasonPostCommitHookFailed
 		d.build.Status.Message = api.StatusMessagePostCommitHookFailed
 		handleBuildStatusUpdate(d.build, d.client, nil)
 		return err
 	}
+	if len(artifacts) > 0 {
+		handleBuildStatusUpdate(d.build, d.client, nil)
+	}
 
 	if push {
 		if err := tagImage(d.dockerClient, buildTag, pushTag); err != nil {
@@ -323,6 +330,10 @@ func (d *DockerBuilder) buildLabels(sourceInfo *git.SourceInfo) []dockerfile.Key
 		sourceInfo.ContextDir = d.build.Spec.Source.ContextDir
 	}
 	labels = util.GenerateLabelsFromSourceInfo(labels, &sourceInfo.SourceInfo, api.DefaultDockerLabelNamespace)
+	// record anonymized provenance metadata about the build itself
+	for k, v := range provenanceLabels(d.build, sourceInfo) {
+		labels[k] = v
+	}
 	kv := make([]dockerfile.KeyValue, 0, len(labels)+len(d.build.Spec.Output.ImageLabels))
 	for k, v := range labels {
 		kv = append(kv, dockerfile.KeyValue{Key: k, Value: v})
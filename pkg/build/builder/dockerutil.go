@@ -280,6 +280,13 @@ func tagImage(dockerClient DockerClient, image, name string) error {
 // API to create and start a container and stream its logs. The container is
 // removed after it terminates.
 func dockerRun(client DockerClient, createOpts docker.CreateContainerOptions, attachOpts docker.AttachToContainerOptions) error {
+	return dockerRunAndExtract(client, createOpts, attachOpts, nil)
+}
+
+// dockerRunAndExtract behaves like dockerRun, additionally invoking extract with the container id
+// after the container exits and before it is removed. extract runs regardless of the container's
+// exit code so that artifacts produced by failing commands can still be retrieved.
+func dockerRunAndExtract(client DockerClient, createOpts docker.CreateContainerOptions, attachOpts docker.AttachToContainerOptions, extract func(containerID string) error) error {
 	// Create a new container.
 	glog.V(4).Infof("Creating container with options {Name:%q Config:%+v HostConfig:%+v} ...", createOpts.Name, createOpts.Config, createOpts.HostConfig)
 	c, err := client.CreateContainer(createOpts)
@@ -341,6 +348,11 @@ func dockerRun(client DockerClient, createOpts docker.CreateContainerOptions, at
 		if err != nil {
 			return fmt.Errorf("waiting for container %q to stop: %v", containerName, err)
 		}
+		if extract != nil {
+			if err := extract(c.ID); err != nil {
+				return err
+			}
+		}
 		if exitCode != 0 {
 			return fmt.Errorf("container %q returned non-zero exit code: %d", containerName, exitCode)
 		}
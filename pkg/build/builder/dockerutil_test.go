@@ -16,10 +16,11 @@ import (
 )
 
 type FakeDocker struct {
-	pushImageFunc   func(opts docker.PushImageOptions, auth docker.AuthConfiguration) error
-	pullImageFunc   func(opts docker.PullImageOptions, auth docker.AuthConfiguration) error
-	buildImageFunc  func(opts docker.BuildImageOptions) error
-	removeImageFunc func(name string) error
+	pushImageFunc             func(opts docker.PushImageOptions, auth docker.AuthConfiguration) error
+	pullImageFunc             func(opts docker.PullImageOptions, auth docker.AuthConfiguration) error
+	buildImageFunc            func(opts docker.BuildImageOptions) error
+	removeImageFunc           func(name string) error
+	downloadFromContainerFunc func(id string, opts docker.DownloadFromContainerOptions) error
 
 	buildImageCalled  bool
 	pushImageCalled   bool
@@ -89,6 +90,9 @@ func (d *FakeDocker) CreateContainer(opts docker.CreateContainerOptions) (*docke
 	return &docker.Container{}, nil
 }
 func (d *FakeDocker) DownloadFromContainer(id string, opts docker.DownloadFromContainerOptions) error {
+	if d.downloadFromContainerFunc != nil {
+		return d.downloadFromContainerFunc(id, opts)
+	}
 	return nil
 }
 func (d *FakeDocker) PullImage(opts docker.PullImageOptions, auth docker.AuthConfiguration) error {
@@ -17,6 +17,7 @@ import (
 
 	"github.com/openshift/origin/pkg/build/api"
 	"github.com/openshift/origin/pkg/build/builder/cmd/dockercfg"
+	"github.com/openshift/origin/pkg/build/controller/strategy"
 	"github.com/openshift/origin/pkg/generate/git"
 	"github.com/openshift/source-to-image/pkg/tar"
 )
@@ -97,6 +98,13 @@ func fetchSource(dockerClient DockerClient, dir string, build *api.Build, urlTim
 		}
 	}
 
+	// extract source from mounted volumes if specified
+	for _, volume := range build.Spec.Source.Volumes {
+		if err := extractSourceFromVolume(volume, dir); err != nil {
+			return nil, err
+		}
+	}
+
 	if len(build.Spec.Source.ContextDir) > 0 {
 		if _, err := os.Stat(filepath.Join(dir, build.Spec.Source.ContextDir)); os.IsNotExist(err) {
 			return sourceInfo, contextDirNotFoundError(build.Spec.Source.ContextDir)
@@ -261,6 +269,14 @@ func extractGitSource(gitClient GitClient, gitSource *api.GitBuildSource, revisi
 		}
 	}
 
+	// fetch Git LFS content referenced by the checked out revision
+	if gitSource.LFS {
+		glog.V(3).Infof("Fetching Git LFS content for %s", gitSource.URI)
+		if err := gitClient.LFSPull(dir); err != nil {
+			return true, err
+		}
+	}
+
 	if glog.Is(0) {
 		if information, gitErr := gitClient.GetInfo(dir); len(gitErr) == 0 {
 			glog.Infof("\tCommit:\t%s (%s)\n", information.CommitID, information.Message)
@@ -322,6 +338,38 @@ func copyImageSource(dockerClient DockerClient, containerID, sourceDir, destDir
 	return tarHelper.ExtractTarStreamWithLogging(destDir, file, tarOutput)
 }
 
+// extractSourceFromVolume copies the configured paths from a source volume that the build
+// pod mounted under strategy.VolumeBuildSourceBaseMountPath into the build directory.
+func extractSourceFromVolume(volume api.VolumeBuildSource, buildDir string) error {
+	claimName := volume.PersistentVolumeClaim.Name
+	volumeRoot := filepath.Join(strategy.VolumeBuildSourceBaseMountPath, claimName)
+	fileSystem := s2iutil.NewFileSystem()
+
+	for _, path := range volume.Paths {
+		sourcePath := filepath.Join(volumeRoot, path.SourcePath)
+		destDir := filepath.Join(buildDir, path.DestinationDir)
+		glog.V(4).Infof("Extracting path %s from volume %s to %s", path.SourcePath, claimName, path.DestinationDir)
+		if err := os.MkdirAll(destDir, 0777); err != nil {
+			return err
+		}
+		info, err := os.Stat(sourcePath)
+		if err != nil {
+			return fmt.Errorf("error copying source path %s from volume %s: %v", path.SourcePath, claimName, err)
+		}
+		dest := filepath.Join(destDir, filepath.Base(sourcePath))
+		if info.IsDir() {
+			err = fileSystem.CopyContents(sourcePath, dest)
+		} else {
+			err = fileSystem.Copy(sourcePath, dest)
+		}
+		if err != nil {
+			return fmt.Errorf("error copying source path %s from volume %s: %v", path.SourcePath, claimName, err)
+		}
+	}
+
+	return nil
+}
+
 func extractSourceFromImage(dockerClient DockerClient, image, buildDir string, imageSecretIndex int, paths []api.ImageSourcePath, forcePull bool) error {
 	glog.V(4).Infof("Extracting image source from %s", image)
 
@@ -285,13 +285,20 @@ func (s *S2IBuilder) Build() error {
 	}
 
 	cName := containerName("s2i", s.build.Name, s.build.Namespace, "post-commit")
-	if err = execPostCommitHook(s.dockerClient, s.build.Spec.PostCommit, buildTag, cName); err != nil {
+	artifacts, err := execPostCommitHook(s.dockerClient, s.build.Spec.PostCommit, buildTag, cName)
+	if len(artifacts) > 0 {
+		s.build.Status.Artifacts = artifacts
+	}
+	if err != nil {
 		s.build.Status.Phase = api.BuildPhaseFailed
 		s.build.Status.Reason = api.StatusReasonPostCommitHookFailed
 		s.build.Status.Message = api.StatusMessagePostCommitHookFailed
 		handleBuildStatusUpdate(s.build, s.client, nil)
 		return err
 	}
+	if len(artifacts) > 0 {
+		handleBuildStatusUpdate(s.build, s.client, nil)
+	}
 
 	if push {
 		if err = tagImage(s.dockerClient, buildTag, pushTag); err != nil {
@@ -2,6 +2,7 @@ package buildpod
 
 import (
 	"fmt"
+	"strconv"
 	"time"
 
 	"github.com/golang/glog"
@@ -36,9 +37,11 @@ const (
 
 // BuildPodController watches pods running builds and manages the build state
 type BuildPodController struct {
-	buildUpdater buildclient.BuildUpdater
-	secretClient kcoreclient.SecretsGetter
-	podClient    kcoreclient.PodsGetter
+	buildUpdater        buildclient.BuildUpdater
+	secretClient        kcoreclient.SecretsGetter
+	podClient           kcoreclient.PodsGetter
+	imageStreamTags     osclient.ImageStreamTagsNamespacer
+	imageStreamMappings osclient.ImageStreamMappingsNamespacer
 
 	queue workqueue.RateLimitingInterface
 
@@ -55,10 +58,12 @@ type BuildPodController struct {
 func NewBuildPodController(buildInformer, podInformer cache.SharedIndexInformer, kc kclientset.Interface, oc osclient.Interface) *BuildPodController {
 	buildListerUpdater := buildclient.NewOSClientBuildClient(oc)
 	c := &BuildPodController{
-		buildUpdater: buildListerUpdater,
-		secretClient: kc.Core(), // TODO: Replace with cache client
-		podClient:    kc.Core(),
-		queue:        workqueue.NewRateLimitingQueue(workqueue.DefaultControllerRateLimiter()),
+		buildUpdater:        buildListerUpdater,
+		secretClient:        kc.Core(), // TODO: Replace with cache client
+		podClient:           kc.Core(),
+		imageStreamTags:     oc,
+		imageStreamMappings: oc,
+		queue:               workqueue.NewRateLimitingQueue(workqueue.DefaultControllerRateLimiter()),
 	}
 
 	c.runPolicies = policy.GetAllRunPolicies(buildListerUpdater, buildListerUpdater)
@@ -176,6 +181,18 @@ func (bc *BuildPodController) HandlePod(pod *kapi.Pod) error {
 			build.Status.Message = ""
 		}
 	}
+	// A build that failed for an infrastructure reason may be retried by its
+	// failure policy instead of being marked failed.
+	if nextStatus == buildapi.BuildPhaseFailed && !buildutil.IsBuildComplete(build) {
+		retried, err := bc.retryBuildOnInfrastructureFailure(build, pod)
+		if err != nil {
+			return err
+		}
+		if retried {
+			return nil
+		}
+	}
+
 	// Update the build object when it progress to a next state or the reason for
 	// the current state changed.
 	if (!common.HasBuildPodNameAnnotation(build) || build.Status.Phase != nextStatus || build.Status.Phase == buildapi.BuildPhaseFailed) && !buildutil.IsBuildComplete(build) {
@@ -201,11 +218,71 @@ func (bc *BuildPodController) HandlePod(pod *kapi.Pod) error {
 
 		if buildutil.IsBuildComplete(build) {
 			common.HandleBuildCompletion(build, bc.runPolicies)
+			if err := bc.handleMultiPlatformCompletion(build); err != nil {
+				utilruntime.HandleError(err)
+			}
 		}
 	}
 	return nil
 }
 
+// handleMultiPlatformCompletion assembles a manifest list for builds fanned out
+// from a multi-platform BuildConfig once all of its platform builds succeeded.
+func (bc *BuildPodController) handleMultiPlatformCompletion(build *buildapi.Build) error {
+	if len(build.Annotations[buildapi.BuildManifestListTagAnnotation]) == 0 {
+		return nil
+	}
+	siblings, err := bc.buildStore.Builds(build.Namespace).List(buildutil.BuildConfigSelector(buildutil.ConfigNameForBuild(build)))
+	if err != nil {
+		return fmt.Errorf("cannot list platform builds for %s/%s: %v", build.Namespace, build.Name, err)
+	}
+	return common.HandleMultiPlatformBuildCompletion(build, siblings, bc.imageStreamTags, bc.imageStreamMappings)
+}
+
+// retryBuildOnInfrastructureFailure resets a build that failed for an infrastructure
+// reason back to the new phase if its failure policy has retries left. The failed pod
+// is deleted so the build controller can create a fresh one once the backoff recorded
+// on the build has elapsed. It returns true if the build was reset.
+func (bc *BuildPodController) retryBuildOnInfrastructureFailure(build *buildapi.Build, pod *kapi.Pod) (bool, error) {
+	failurePolicy := build.Spec.FailurePolicy
+	if failurePolicy == nil || failurePolicy.MaxRetries <= 0 {
+		return false, nil
+	}
+	if !buildutil.IsInfrastructureFailure(build.Status.Reason) {
+		glog.V(4).Infof("Not retrying build %s/%s: reason %q is not an infrastructure failure", build.Namespace, build.Name, build.Status.Reason)
+		return false, nil
+	}
+	retries := buildutil.BuildRetries(build)
+	if retries >= failurePolicy.MaxRetries {
+		glog.V(4).Infof("Not retrying build %s/%s: already retried %d times", build.Namespace, build.Name, retries)
+		return false, nil
+	}
+
+	backoff := int64(30)
+	if failurePolicy.BackoffSeconds != nil {
+		backoff = *failurePolicy.BackoffSeconds
+	}
+	delay := time.Duration(backoff<<uint(retries)) * time.Second
+
+	if err := bc.podClient.Pods(pod.Namespace).Delete(pod.Name, &kapi.DeleteOptions{}); err != nil && !errors.IsNotFound(err) {
+		return false, fmt.Errorf("failed to delete pod for retried build %s/%s: %v", build.Namespace, build.Name, err)
+	}
+
+	if build.Annotations == nil {
+		build.Annotations = make(map[string]string)
+	}
+	build.Annotations[buildapi.BuildRetriesAnnotation] = strconv.Itoa(int(retries + 1))
+	build.Annotations[buildapi.BuildRetryNotBeforeAnnotation] = time.Now().Add(delay).UTC().Format(time.RFC3339)
+	delete(build.Annotations, buildapi.BuildPodNameAnnotation)
+	build.Status.Phase = buildapi.BuildPhaseNew
+	build.Status.Message = fmt.Sprintf("Build failed for an infrastructure reason (%s) and will be retried (%d/%d)", build.Status.Reason, retries+1, failurePolicy.MaxRetries)
+	glog.V(2).Infof("Retrying build %s/%s after %s: %s", build.Namespace, build.Name, delay, build.Status.Message)
+	if err := bc.buildUpdater.Update(build.Namespace, build); err != nil {
+		return false, fmt.Errorf("failed to update retried build %s/%s: %v", build.Namespace, build.Name, err)
+	}
+	return true, nil
+}
+
 // HandleBuildPodDeletion sets the status of a build to error if the build pod has been deleted
 func (bc *BuildPodController) HandleBuildPodDeletion(pod *kapi.Pod) error {
 	glog.V(4).Infof("Handling deletion of build pod %s/%s", pod.Namespace, pod.Name)
@@ -234,6 +311,12 @@ func (bc *BuildPodController) HandleBuildPodDeletion(pod *kapi.Pod) error {
 		return nil
 	}
 
+	// A build reset to the new phase by its failure policy deletes its own pod
+	if build.Status.Phase == buildapi.BuildPhaseNew && !common.HasBuildPodNameAnnotation(build) {
+		glog.V(4).Infof("Pod was deleted as part of retrying build %s/%s, ignoring", build.Namespace, build.Name)
+		return nil
+	}
+
 	nextStatus := buildapi.BuildPhaseError
 	if build.Status.Phase != nextStatus {
 		glog.V(4).Infof("Updating build %s/%s status %s -> %s", build.Namespace, build.Name, build.Status.Phase, nextStatus)
@@ -246,6 +246,51 @@ func TestHandlePod(t *testing.T) {
 	}
 }
 
+func TestHandlePodRetryOnInfrastructureFailure(t *testing.T) {
+	build := mockBuild(buildapi.BuildPhaseRunning, buildapi.BuildOutput{})
+	build.Spec.FailurePolicy = &buildapi.BuildFailurePolicy{MaxRetries: 2}
+	build.Status.Reason = buildapi.StatusReasonPullBuilderImageFailed
+	ctrl := mockBuildPodController(build, &customBuildUpdater{
+		UpdateFunc: func(namespace string, updatedBuild *buildapi.Build) error {
+			build = updatedBuild
+			return nil
+		},
+	})
+	pod := mockPod(kapi.PodFailed, -1)
+
+	if err := ctrl.HandlePod(pod); err != nil {
+		t.Fatalf("Unexpected error %v", err)
+	}
+	if build.Status.Phase != buildapi.BuildPhaseNew {
+		t.Errorf("Expected build to be reset to %s, got %s", buildapi.BuildPhaseNew, build.Status.Phase)
+	}
+	if build.Annotations[buildapi.BuildRetriesAnnotation] != "1" {
+		t.Errorf("Expected retries annotation to be 1, got %q", build.Annotations[buildapi.BuildRetriesAnnotation])
+	}
+	if len(build.Annotations[buildapi.BuildRetryNotBeforeAnnotation]) == 0 {
+		t.Errorf("Expected a retry backoff annotation to be set. %#v", build.Annotations)
+	}
+	if common.HasBuildPodNameAnnotation(build) {
+		t.Errorf("Expected pod name annotation to be cleared. %#v", build.Annotations)
+	}
+
+	// a second failure for a content reason must not be retried
+	build.Status.Phase = buildapi.BuildPhaseRunning
+	build.Status.Reason = buildapi.StatusReasonDockerBuildFailed
+	ctrl = mockBuildPodController(build, &customBuildUpdater{
+		UpdateFunc: func(namespace string, updatedBuild *buildapi.Build) error {
+			build = updatedBuild
+			return nil
+		},
+	})
+	if err := ctrl.HandlePod(pod); err != nil {
+		t.Fatalf("Unexpected error %v", err)
+	}
+	if build.Status.Phase != buildapi.BuildPhaseFailed {
+		t.Errorf("Expected build to fail, got %s", build.Status.Phase)
+	}
+}
+
 func TestHandleBuildPodDeletionOK(t *testing.T) {
 	updateWasCalled := false
 	// only not finished build (buildutil.IsBuildComplete) should be handled
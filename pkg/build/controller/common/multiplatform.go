@@ -0,0 +1,147 @@
+package common
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/golang/glog"
+
+	"github.com/docker/distribution"
+	"github.com/docker/distribution/digest"
+	"github.com/docker/distribution/manifest/manifestlist"
+	"github.com/docker/distribution/manifest/schema2"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+
+	buildapi "github.com/openshift/origin/pkg/build/api"
+	osclient "github.com/openshift/origin/pkg/client"
+	imageapi "github.com/openshift/origin/pkg/image/api"
+)
+
+// HandleMultiPlatformBuildCompletion assembles a manifest list once every
+// platform build fanned out from a multi-platform BuildConfig has completed
+// successfully. The list references the per-platform image manifests by digest
+// and is tagged into the output image stream tag recorded on the builds, so
+// pulling the tag resolves to the right image for the node's architecture.
+// siblings must contain all builds of the same build config as build.
+func HandleMultiPlatformBuildCompletion(build *buildapi.Build, siblings []*buildapi.Build, isTags osclient.ImageStreamTagsNamespacer, mappings osclient.ImageStreamMappingsNamespacer) error {
+	targetTag := build.Annotations[buildapi.BuildManifestListTagAnnotation]
+	if len(targetTag) == 0 || build.Status.Phase != buildapi.BuildPhaseComplete {
+		return nil
+	}
+	buildNumber := build.Annotations[buildapi.BuildNumberAnnotation]
+
+	descriptors := []manifestlist.ManifestDescriptor{}
+	for _, sibling := range siblings {
+		// the cached copy of the triggering build may not reflect its final state yet
+		if sibling.Name == build.Name {
+			sibling = build
+		}
+		if sibling.Annotations[buildapi.BuildNumberAnnotation] != buildNumber {
+			continue
+		}
+		platform := sibling.Annotations[buildapi.BuildPlatformAnnotation]
+		if len(platform) == 0 {
+			continue
+		}
+		switch sibling.Status.Phase {
+		case buildapi.BuildPhaseComplete:
+		case buildapi.BuildPhaseFailed, buildapi.BuildPhaseError, buildapi.BuildPhaseCancelled:
+			glog.V(4).Infof("Not assembling manifest list for %s: platform build %s/%s is %s", targetTag, sibling.Namespace, sibling.Name, sibling.Status.Phase)
+			return nil
+		default:
+			// assemble once the remaining platform builds complete
+			glog.V(5).Infof("Waiting for platform build %s/%s before assembling manifest list for %s", sibling.Namespace, sibling.Name, targetTag)
+			return nil
+		}
+
+		if sibling.Status.Output.To == nil || len(sibling.Status.Output.To.ImageDigest) == 0 {
+			return fmt.Errorf("cannot assemble manifest list for %s: build %s/%s has no output image digest", targetTag, sibling.Namespace, sibling.Name)
+		}
+		manifestSize, err := platformManifestSize(sibling, isTags)
+		if err != nil {
+			return err
+		}
+		parts := strings.SplitN(platform, "/", 2)
+		descriptors = append(descriptors, manifestlist.ManifestDescriptor{
+			Descriptor: distribution.Descriptor{
+				MediaType: schema2.MediaTypeManifest,
+				Size:      manifestSize,
+				Digest:    digest.Digest(sibling.Status.Output.To.ImageDigest),
+			},
+			Platform: manifestlist.PlatformSpec{
+				OS:           parts[0],
+				Architecture: parts[1],
+			},
+		})
+	}
+	if len(descriptors) == 0 {
+		return nil
+	}
+
+	list, err := manifestlist.FromDescriptors(descriptors)
+	if err != nil {
+		return fmt.Errorf("cannot assemble manifest list for %s: %v", targetTag, err)
+	}
+	_, payload, err := list.Payload()
+	if err != nil {
+		return fmt.Errorf("cannot serialize manifest list for %s: %v", targetTag, err)
+	}
+	listDigest := digest.FromBytes(payload)
+
+	streamName, tag, ok := imageapi.SplitImageStreamTag(targetTag)
+	if !ok {
+		return fmt.Errorf("invalid manifest list target %q on build %s/%s", targetTag, build.Namespace, build.Name)
+	}
+
+	mapping := &imageapi.ImageStreamMapping{
+		ObjectMeta: kapi.ObjectMeta{
+			Namespace: build.Namespace,
+			Name:      streamName,
+		},
+		Tag: tag,
+		Image: imageapi.Image{
+			ObjectMeta: kapi.ObjectMeta{
+				Name: listDigest.String(),
+			},
+			DockerImageReference:         manifestListReference(build, listDigest),
+			DockerImageManifest:          string(payload),
+			DockerImageManifestMediaType: manifestlist.MediaTypeManifestList,
+		},
+	}
+	if err := mappings.ImageStreamMappings(build.Namespace).Create(mapping); err != nil {
+		return fmt.Errorf("cannot tag manifest list for %s: %v", targetTag, err)
+	}
+	glog.V(2).Infof("Tagged manifest list %s into %s/%s referencing %d platform(s)", listDigest, build.Namespace, targetTag, len(descriptors))
+	return nil
+}
+
+// platformManifestSize returns the size of the manifest a platform build pushed,
+// looked up from the image tagged into the arch-suffixed output tag.
+func platformManifestSize(build *buildapi.Build, isTags osclient.ImageStreamTagsNamespacer) (int64, error) {
+	to := build.Spec.Output.To
+	if to == nil || to.Kind != "ImageStreamTag" {
+		return 0, fmt.Errorf("build %s/%s does not push to an image stream tag", build.Namespace, build.Name)
+	}
+	name, tag, ok := imageapi.SplitImageStreamTag(to.Name)
+	if !ok {
+		return 0, fmt.Errorf("build %s/%s has invalid output tag %q", build.Namespace, build.Name, to.Name)
+	}
+	ist, err := isTags.ImageStreamTags(build.Namespace).Get(name, tag)
+	if err != nil {
+		return 0, fmt.Errorf("cannot look up output image of build %s/%s: %v", build.Namespace, build.Name, err)
+	}
+	return int64(len(ist.Image.DockerImageManifest)), nil
+}
+
+// manifestListReference derives a by-digest pull spec for the manifest list from
+// the push target of the completed build.
+func manifestListReference(build *buildapi.Build, listDigest digest.Digest) string {
+	ref, err := imageapi.ParseDockerImageReference(build.Status.OutputDockerImageReference)
+	if err != nil {
+		return ""
+	}
+	ref.Tag = ""
+	ref.ID = listDigest.String()
+	return ref.Exact()
+}
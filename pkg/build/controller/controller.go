@@ -2,6 +2,7 @@ package controller
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/golang/glog"
 
@@ -115,6 +116,13 @@ func (bc *BuildController) HandleBuild(build *buildapi.Build) error {
 		return nil
 	}
 
+	// A build retried by its failure policy must wait out the recorded backoff
+	// before a new pod is created for it.
+	if notBefore := buildutil.BuildRetryNotBefore(build); notBefore != nil && notBefore.After(time.Now()) {
+		glog.V(4).Infof("Build %s/%s is backing off until %s before being retried", build.Namespace, build.Name, notBefore)
+		return fmt.Errorf("build %s/%s is waiting until %s to be retried", build.Namespace, build.Name, notBefore)
+	}
+
 	runPolicy := policy.ForBuild(build, bc.RunPolicies)
 	if runPolicy == nil {
 		return fmt.Errorf("unable to determine build scheduler for %s/%s", build.Namespace, build.Name)
@@ -122,6 +130,9 @@ func (bc *BuildController) HandleBuild(build *buildapi.Build) error {
 
 	// The runPolicy decides whether to execute this build or not.
 	if run, err := runPolicy.IsRunnable(build); err != nil || !run {
+		if err == nil {
+			bc.recordBuildQueueStatus(build)
+		}
 		return err
 	}
 
@@ -215,8 +226,13 @@ func (bc *BuildController) nextBuildPhase(build *buildapi.Build) error {
 		}
 		// Log an event if the pod is not created (most likely due to quota denial).
 		bc.Recorder.Eventf(build, kapi.EventTypeWarning, "FailedCreate", "Error creating: %v", err)
-		build.Status.Reason = buildapi.StatusReasonCannotCreateBuildPod
-		build.Status.Message = buildapi.StatusMessageCannotCreateBuildPod
+		if errors.IsForbidden(err) {
+			build.Status.Reason = buildapi.StatusReasonQuotaExceeded
+			build.Status.Message = buildapi.StatusMessageQuotaExceeded
+		} else {
+			build.Status.Reason = buildapi.StatusReasonCannotCreateBuildPod
+			build.Status.Message = buildapi.StatusMessageCannotCreateBuildPod
+		}
 		return fmt.Errorf("failed to create build pod: %v", err)
 	}
 	common.SetBuildPodNameAnnotation(build, podSpec.Name)
@@ -226,9 +242,29 @@ func (bc *BuildController) nextBuildPhase(build *buildapi.Build) error {
 	build.Status.Phase = buildapi.BuildPhasePending
 	build.Status.Reason = ""
 	build.Status.Message = ""
+	build.Status.QueuePosition = 0
 	return nil
 }
 
+// recordBuildQueueStatus records why a new build is waiting on its run policy,
+// so clients describing the build can see its position in the queue.
+func (bc *BuildController) recordBuildQueueStatus(build *buildapi.Build) {
+	position, err := policy.QueuePosition(bc.BuildLister, build)
+	if err != nil {
+		glog.V(4).Infof("Unable to determine queue position for build %s/%s: %v", build.Namespace, build.Name, err)
+		return
+	}
+	if build.Status.Reason == buildapi.StatusReasonWaitingForRunPolicy && build.Status.QueuePosition == position {
+		return
+	}
+	build.Status.Reason = buildapi.StatusReasonWaitingForRunPolicy
+	build.Status.Message = buildapi.StatusMessageWaitingForRunPolicy
+	build.Status.QueuePosition = position
+	if err := bc.BuildUpdater.Update(build.Namespace, build); err != nil {
+		glog.V(4).Infof("Failed to record queue position for build %s/%s: %v", build.Namespace, build.Name, err)
+	}
+}
+
 // resolveOutputDockerImageReference returns a reference to a Docker image
 // computed from the buid.Spec.Output.To reference.
 func (bc *BuildController) resolveOutputDockerImageReference(build *buildapi.Build) (string, error) {
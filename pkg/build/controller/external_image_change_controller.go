@@ -0,0 +1,130 @@
+package controller
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/golang/glog"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+	utilruntime "k8s.io/kubernetes/pkg/util/runtime"
+	"k8s.io/kubernetes/pkg/util/sets"
+	utilwait "k8s.io/kubernetes/pkg/util/wait"
+
+	buildapi "github.com/openshift/origin/pkg/build/api"
+	buildclient "github.com/openshift/origin/pkg/build/client"
+	oscache "github.com/openshift/origin/pkg/client/cache"
+	imageapi "github.com/openshift/origin/pkg/image/api"
+)
+
+// DigestResolver knows the content digest currently associated with an external image
+// reference.
+type DigestResolver interface {
+	ResolveImageDigest(ref imageapi.DockerImageReference) (string, error)
+}
+
+// ExternalImageChangeController polls external Docker registries for digest changes of
+// images referenced directly by ImageChange triggers on build configurations, for images
+// that are not mirrored into an image stream. A build is instantiated whenever the tag of
+// a referenced image moves to a new digest. Only references hosted on one of the
+// configured registries are polled.
+type ExternalImageChangeController struct {
+	BuildConfigIndex        oscache.StoreToBuildConfigLister
+	BuildConfigInstantiator buildclient.BuildConfigInstantiator
+	// DigestResolver resolves the current digest of an external image reference.
+	DigestResolver DigestResolver
+	// Registries is the set of registry hostnames that may be polled.
+	Registries sets.String
+	// Interval is how often the configured registries are polled.
+	Interval time.Duration
+}
+
+// Run polls the configured registries for digest changes until stopCh is closed.
+func (c *ExternalImageChangeController) Run(stopCh <-chan struct{}) {
+	utilwait.Until(c.poll, c.Interval, stopCh)
+}
+
+// poll processes every build configuration in the index, recording errors instead of
+// aborting so that a single unreachable registry does not starve the other triggers.
+func (c *ExternalImageChangeController) poll() {
+	configs, err := c.BuildConfigIndex.List()
+	if err != nil {
+		utilruntime.HandleError(fmt.Errorf("unable to list build configs for external image change triggers: %v", err))
+		return
+	}
+	for _, config := range configs {
+		if err := c.HandleBuildConfig(config); err != nil {
+			utilruntime.HandleError(err)
+		}
+	}
+}
+
+// HandleBuildConfig instantiates a build when an external image referenced directly by an
+// ImageChange trigger of the build config points at a new digest.
+func (c *ExternalImageChangeController) HandleBuildConfig(config *buildapi.BuildConfig) error {
+	for _, trigger := range config.Spec.Triggers {
+		if trigger.Type != buildapi.ImageChangeBuildTriggerType || trigger.ImageChange == nil {
+			continue
+		}
+		from := trigger.ImageChange.From
+		if from == nil || from.Kind != "DockerImage" {
+			continue
+		}
+		ref, err := imageapi.ParseDockerImageReference(from.Name)
+		if err != nil {
+			glog.Errorf("Invalid Docker image reference %q in build config %s/%s: %v", from.Name, config.Namespace, config.Name, err)
+			continue
+		}
+		// references pinned to a digest can never change
+		if len(ref.ID) != 0 {
+			continue
+		}
+		if !c.Registries.Has(ref.DockerClientDefaults().Registry) {
+			continue
+		}
+
+		digest, err := c.DigestResolver.ResolveImageDigest(ref)
+		if err != nil {
+			return fmt.Errorf("unable to resolve the digest of image %q referenced by build config %s/%s: %v", from.Name, config.Namespace, config.Name, err)
+		}
+		resolved := ref
+		resolved.Tag = ""
+		resolved.ID = digest
+		next := resolved.Exact()
+
+		// (must be different) to trigger a build
+		last := trigger.ImageChange.LastTriggeredImageID
+		if len(last) != 0 && next == last {
+			continue
+		}
+
+		glog.V(4).Infof("Running build for BuildConfig %s/%s: external image %q changed to %s", config.Namespace, config.Name, from.Name, digest)
+		request := &buildapi.BuildRequest{
+			ObjectMeta: kapi.ObjectMeta{
+				Name:      config.Name,
+				Namespace: config.Namespace,
+			},
+			TriggeredBy: []buildapi.BuildTriggerCause{
+				{
+					Message: buildapi.BuildTriggerCauseImageMsg,
+					ImageChangeBuild: &buildapi.ImageChangeCause{
+						ImageID: next,
+						FromRef: from,
+					},
+				},
+			},
+			TriggeredByImage: &kapi.ObjectReference{
+				Kind: "DockerImage",
+				Name: next,
+			},
+			From: from,
+		}
+		if _, err := c.BuildConfigInstantiator.Instantiate(config.Namespace, request); err != nil {
+			return fmt.Errorf("error instantiating Build from BuildConfig %s/%s: %v", config.Namespace, config.Name, err)
+		}
+		// the instantiate endpoint records the triggered image id for every image
+		// change trigger on the config, so one build per poll is enough
+		return nil
+	}
+	return nil
+}
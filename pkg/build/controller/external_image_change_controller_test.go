@@ -0,0 +1,132 @@
+package controller
+
+import (
+	"testing"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/util/sets"
+
+	buildapi "github.com/openshift/origin/pkg/build/api"
+	imageapi "github.com/openshift/origin/pkg/image/api"
+)
+
+type fakeDigestResolver struct {
+	digest string
+	calls  int
+}
+
+func (r *fakeDigestResolver) ResolveImageDigest(ref imageapi.DockerImageReference) (string, error) {
+	r.calls++
+	return r.digest, nil
+}
+
+type recordingInstantiator struct {
+	requests []*buildapi.BuildRequest
+}
+
+func (i *recordingInstantiator) Instantiate(namespace string, request *buildapi.BuildRequest) (*buildapi.Build, error) {
+	i.requests = append(i.requests, request)
+	return &buildapi.Build{}, nil
+}
+
+func mockExternalBuildConfig(image, lastTriggeredImageID string) *buildapi.BuildConfig {
+	return &buildapi.BuildConfig{
+		ObjectMeta: kapi.ObjectMeta{
+			Name:      "testBuildCfg",
+			Namespace: "testns",
+		},
+		Spec: buildapi.BuildConfigSpec{
+			Triggers: []buildapi.BuildTriggerPolicy{
+				{
+					Type: buildapi.ImageChangeBuildTriggerType,
+					ImageChange: &buildapi.ImageChangeTrigger{
+						From: &kapi.ObjectReference{
+							Kind: "DockerImage",
+							Name: image,
+						},
+						LastTriggeredImageID: lastTriggeredImageID,
+					},
+				},
+			},
+		},
+	}
+}
+
+func mockExternalImageChangeController(resolver *fakeDigestResolver, registries ...string) *ExternalImageChangeController {
+	return &ExternalImageChangeController{
+		BuildConfigInstantiator: &recordingInstantiator{},
+		DigestResolver:          resolver,
+		Registries:              sets.NewString(registries...),
+	}
+}
+
+func TestExternalImageNewDigest(t *testing.T) {
+	// the registry digest differs from the last triggered image, a build should run
+	buildcfg := mockExternalBuildConfig("registry.com/namespace/imagename:testTag", "")
+	resolver := &fakeDigestResolver{digest: "sha256:0000000000000000000000000000000000000000000000000000000000000001"}
+	controller := mockExternalImageChangeController(resolver, "registry.com")
+
+	if err := controller.HandleBuildConfig(buildcfg); err != nil {
+		t.Fatalf("Unexpected error %v from HandleBuildConfig", err)
+	}
+	instantiator := controller.BuildConfigInstantiator.(*recordingInstantiator)
+	if len(instantiator.requests) != 1 {
+		t.Fatalf("Expected one build to be instantiated, got %d", len(instantiator.requests))
+	}
+	expected := "registry.com/namespace/imagename@" + resolver.digest
+	if actual := instantiator.requests[0].TriggeredByImage.Name; actual != expected {
+		t.Errorf("Expected triggered image %q, got %q", expected, actual)
+	}
+}
+
+func TestExternalImageUnchangedDigest(t *testing.T) {
+	// the registry still reports the digest the last build ran with, nothing should happen
+	digest := "sha256:0000000000000000000000000000000000000000000000000000000000000001"
+	buildcfg := mockExternalBuildConfig("registry.com/namespace/imagename:testTag", "registry.com/namespace/imagename@"+digest)
+	resolver := &fakeDigestResolver{digest: digest}
+	controller := mockExternalImageChangeController(resolver, "registry.com")
+
+	if err := controller.HandleBuildConfig(buildcfg); err != nil {
+		t.Fatalf("Unexpected error %v from HandleBuildConfig", err)
+	}
+	instantiator := controller.BuildConfigInstantiator.(*recordingInstantiator)
+	if len(instantiator.requests) != 0 {
+		t.Errorf("New build generated when the digest did not change")
+	}
+}
+
+func TestExternalImageUnlistedRegistry(t *testing.T) {
+	// references hosted on registries that are not configured for polling are ignored
+	buildcfg := mockExternalBuildConfig("other.com/namespace/imagename:testTag", "")
+	resolver := &fakeDigestResolver{digest: "sha256:0000000000000000000000000000000000000000000000000000000000000001"}
+	controller := mockExternalImageChangeController(resolver, "registry.com")
+
+	if err := controller.HandleBuildConfig(buildcfg); err != nil {
+		t.Fatalf("Unexpected error %v from HandleBuildConfig", err)
+	}
+	if resolver.calls != 0 {
+		t.Errorf("Resolved a digest for a registry that is not configured for polling")
+	}
+	instantiator := controller.BuildConfigInstantiator.(*recordingInstantiator)
+	if len(instantiator.requests) != 0 {
+		t.Errorf("New build generated for a registry that is not configured for polling")
+	}
+}
+
+func TestExternalImagePinnedByDigest(t *testing.T) {
+	// references pinned to a digest can never change and are never polled
+	buildcfg := mockExternalBuildConfig("registry.com/namespace/imagename@sha256:0000000000000000000000000000000000000000000000000000000000000001", "")
+	resolver := &fakeDigestResolver{digest: "sha256:0000000000000000000000000000000000000000000000000000000000000002"}
+	controller := mockExternalImageChangeController(resolver, "registry.com")
+
+	if err := controller.HandleBuildConfig(buildcfg); err != nil {
+		t.Fatalf("Unexpected error %v from HandleBuildConfig", err)
+	}
+	if resolver.calls != 0 {
+		t.Errorf("Resolved a digest for a reference that is pinned by digest")
+	}
+	instantiator := controller.BuildConfigInstantiator.(*recordingInstantiator)
+	if len(instantiator.requests) != 0 {
+		t.Errorf("New build generated for a reference that is pinned by digest")
+	}
+}
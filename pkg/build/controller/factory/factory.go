@@ -25,6 +25,7 @@ import (
 	buildclient "github.com/openshift/origin/pkg/build/client"
 	buildcontroller "github.com/openshift/origin/pkg/build/controller"
 	"github.com/openshift/origin/pkg/build/controller/policy"
+	"github.com/openshift/origin/pkg/build/controller/shard"
 	strategy "github.com/openshift/origin/pkg/build/controller/strategy"
 	osclient "github.com/openshift/origin/pkg/client"
 	oscache "github.com/openshift/origin/pkg/client/cache"
@@ -78,6 +79,9 @@ type BuildControllerFactory struct {
 	CustomBuildStrategy *strategy.CustomBuildStrategy
 	BuildDefaults       builddefaults.BuildDefaults
 	BuildOverrides      buildoverrides.BuildOverrides
+	// Sharder, if set, limits the controller to builds in namespaces belonging to
+	// the shards owned by this instance.
+	Sharder shard.Sharder
 
 	// Stop may be set to allow controllers created by this factory to be terminated.
 	Stop <-chan struct{}
@@ -117,6 +121,10 @@ func (factory *BuildControllerFactory) Create() controller.RunnableController {
 			flowcontrol.NewTokenBucketRateLimiter(1, 10)),
 		Handle: func(obj interface{}) error {
 			build := obj.(*buildapi.Build)
+			if factory.Sharder != nil && !factory.Sharder.Accepts(build.Namespace) {
+				glog.V(5).Infof("Skipping Build %s/%s: namespace belongs to a shard owned by another instance", build.Namespace, build.Name)
+				return nil
+			}
 			err := buildController.HandleBuild(build)
 			if err != nil {
 				// Update the build status message only if it changed.
@@ -267,6 +275,9 @@ type BuildConfigControllerFactory struct {
 	Client                  osclient.Interface
 	KubeClient              kclientset.Interface
 	BuildConfigInstantiator buildclient.BuildConfigInstantiator
+	// Sharder, if set, limits the controller to build configs in namespaces belonging
+	// to the shards owned by this instance.
+	Sharder shard.Sharder
 	// Stop may be set to allow controllers created by this factory to be terminated.
 	Stop <-chan struct{}
 }
@@ -293,6 +304,10 @@ func (factory *BuildConfigControllerFactory) Create() controller.RunnableControl
 			flowcontrol.NewTokenBucketRateLimiter(1, 10)),
 		Handle: func(obj interface{}) error {
 			bc := obj.(*buildapi.BuildConfig)
+			if factory.Sharder != nil && !factory.Sharder.Accepts(bc.Namespace) {
+				glog.V(5).Infof("Skipping BuildConfig %s/%s: namespace belongs to a shard owned by another instance", bc.Namespace, bc.Name)
+				return nil
+			}
 			return bcController.HandleBuildConfig(bc)
 		},
 	}
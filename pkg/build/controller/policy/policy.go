@@ -124,6 +124,37 @@ func GetNextConfigBuild(lister buildclient.BuildLister, namespace, buildConfigNa
 	return nextBuilds, hasRunningBuilds, nil
 }
 
+// QueuePosition returns the one-based position of the given build among the
+// new builds of its build config, ordered by build number, or zero if the
+// build is not queued.
+func QueuePosition(lister buildclient.BuildLister, build *buildapi.Build) (int32, error) {
+	bcName := buildutil.ConfigNameForBuild(build)
+	if len(bcName) == 0 {
+		return 0, nil
+	}
+	buildNumber, err := buildutil.BuildNumber(build)
+	if err != nil {
+		return 0, err
+	}
+	builds, err := buildutil.BuildConfigBuilds(lister, build.Namespace, bcName, func(b buildapi.Build) bool {
+		return b.Status.Phase == buildapi.BuildPhaseNew
+	})
+	if err != nil {
+		return 0, err
+	}
+	position := int32(1)
+	for i := range builds.Items {
+		queuedNumber, err := buildutil.BuildNumber(&builds.Items[i])
+		if err != nil {
+			continue
+		}
+		if queuedNumber < buildNumber {
+			position++
+		}
+	}
+	return position, nil
+}
+
 // handleComplete represents the default OnComplete handler. This Handler will
 // check which build should be run next and set the accepted annotation for
 // that build. That will trigger HandleBuild() to process that build immediately
@@ -147,3 +147,33 @@ func TestHandleCompleteParallel(t *testing.T) {
 		t.Errorf("build-3 should have Annotation %s set to trigger it", buildapi.BuildAcceptedAnnotation)
 	}
 }
+
+func TestQueuePosition(t *testing.T) {
+	builds := []buildapi.Build{
+		addBuild("build-1", "sample-bc", buildapi.BuildPhaseRunning, buildapi.BuildRunPolicySerial),
+		addBuild("build-2", "sample-bc", buildapi.BuildPhaseNew, buildapi.BuildRunPolicySerial),
+		addBuild("build-3", "sample-bc", buildapi.BuildPhaseNew, buildapi.BuildRunPolicySerial),
+		addBuild("build-4", "sample-bc", buildapi.BuildPhaseNew, buildapi.BuildRunPolicySerial),
+	}
+	client := newTestClient(builds)
+
+	expectations := map[string]int32{
+		"build-2": 1,
+		"build-3": 2,
+		"build-4": 3,
+	}
+	for i := range builds {
+		expected, exists := expectations[builds[i].Name]
+		if !exists {
+			continue
+		}
+		position, err := QueuePosition(client, &builds[i])
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", builds[i].Name, err)
+			continue
+		}
+		if position != expected {
+			t.Errorf("%s: expected queue position %d, got %d", builds[i].Name, expected, position)
+		}
+	}
+}
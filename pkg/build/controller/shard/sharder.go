@@ -0,0 +1,103 @@
+package shard
+
+import (
+	"fmt"
+	"hash/fnv"
+	"path"
+	"sync"
+	"time"
+
+	etcdclient "github.com/coreos/etcd/client"
+	"github.com/golang/glog"
+
+	"github.com/openshift/origin/pkg/util/leaderlease"
+)
+
+// Sharder decides whether this build controller instance is responsible for
+// objects in a given namespace. The namespace space is divided into a fixed
+// number of shards by hash, and an instance owns the shards for which it
+// currently holds a leader lease, so multiple masters can split the build
+// workload between them.
+type Sharder interface {
+	// Accepts returns true if the namespace belongs to a shard owned by this instance.
+	Accepts(namespace string) bool
+}
+
+// All returns a Sharder that owns every namespace, used when sharding is disabled.
+func All() Sharder {
+	return all{}
+}
+
+type all struct{}
+
+func (all) Accepts(string) bool { return true }
+
+// ForNamespace returns the shard index the provided namespace hashes to.
+func ForNamespace(namespace string, total int) int {
+	h := fnv.New32a()
+	h.Write([]byte(namespace))
+	return int(h.Sum32() % uint32(total))
+}
+
+// Leased owns the shards for which it holds a leader lease in etcd.
+type Leased struct {
+	leasers []leaderlease.Leaser
+
+	lock  sync.Mutex
+	owned map[int]bool
+}
+
+// NewLeased creates a Sharder that divides namespaces into total shards and
+// competes for a lease per shard stored below the provided etcd key. The id
+// identifies this instance and ttl is the lease expiration in seconds.
+func NewLeased(client etcdclient.Client, key, id string, total int, ttl uint64) *Leased {
+	s := &Leased{owned: make(map[int]bool)}
+	for i := 0; i < total; i++ {
+		s.leasers = append(s.leasers, leaderlease.NewEtcd(client, path.Join(key, fmt.Sprintf("shard-%d", i)), id, ttl))
+	}
+	return s
+}
+
+var _ Sharder = &Leased{}
+
+func (s *Leased) Accepts(namespace string) bool {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return s.owned[ForNamespace(namespace, len(s.leasers))]
+}
+
+// Run starts competing for every shard lease and returns immediately. Ownership
+// is adjusted as leases are acquired and lost.
+func (s *Leased) Run() {
+	for i := range s.leasers {
+		go s.runShard(i)
+	}
+}
+
+func (s *Leased) runShard(i int) {
+	for {
+		ch := make(chan error, 1)
+		go s.leasers[i].AcquireAndHold(ch)
+		for err := range ch {
+			if err != nil {
+				glog.Errorf("Error holding lease for build controller shard %d: %v", i, err)
+				continue
+			}
+			s.setOwned(i, true)
+			glog.V(2).Infof("Acquired build controller shard %d", i)
+		}
+		if s.setOwned(i, false) {
+			glog.V(2).Infof("Lost build controller shard %d", i)
+		}
+		time.Sleep(time.Second)
+	}
+}
+
+// setOwned records ownership of a shard and returns true if it changed.
+func (s *Leased) setOwned(i int, owned bool) bool {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	changed := s.owned[i] != owned
+	s.owned[i] = owned
+	return changed
+}
@@ -113,7 +113,9 @@ func (bs *CustomBuildStrategy) CreateBuildPod(build *buildapi.Build) (*kapi.Pod,
 		setupDockerSecrets(pod, build.Spec.Output.PushSecret, strategy.PullSecret, build.Spec.Source.Images)
 	}
 	setupSourceSecrets(pod, build.Spec.Source.SourceSecret)
+	setupSubmoduleSecrets(pod, build.Spec.Source.Git)
 	setupSecrets(pod, build.Spec.Source.Secrets)
+	setupInputVolumes(pod, build.Spec.Source.Volumes)
 	setupAdditionalSecrets(pod, build.Spec.Strategy.CustomStrategy.Secrets)
 	return pod, nil
 }
@@ -78,7 +78,9 @@ func (bs *DockerBuildStrategy) CreateBuildPod(build *buildapi.Build) (*kapi.Pod,
 	setupDockerSocket(pod)
 	setupDockerSecrets(pod, build.Spec.Output.PushSecret, strategy.PullSecret, build.Spec.Source.Images)
 	setupSourceSecrets(pod, build.Spec.Source.SourceSecret)
+	setupSubmoduleSecrets(pod, build.Spec.Source.Git)
 	setupSecrets(pod, build.Spec.Source.Secrets)
+	setupInputVolumes(pod, build.Spec.Source.Volumes)
 
 	return pod, nil
 }
@@ -22,7 +22,11 @@ const (
 	DockerPullSecretMountPath      = "/var/run/secrets/openshift.io/pull"
 	SecretBuildSourceBaseMountPath = "/var/run/secrets/openshift.io/build"
 	SourceImagePullSecretMountPath = "/var/run/secrets/openshift.io/source-image"
+	// VolumeBuildSourceBaseMountPath is the directory the build pod mounts source volumes
+	// under, one subdirectory per claim name.
+	VolumeBuildSourceBaseMountPath = "/var/run/openshift.io/source-volumes"
 	sourceSecretMountPath          = "/var/run/secrets/openshift.io/source"
+	submoduleSecretMountPath       = "/var/run/secrets/openshift.io/submodule"
 )
 
 var whitelistEnvVarNames = []string{"BUILD_LOGLEVEL", "GIT_SSL_NO_VERIFY"}
@@ -135,6 +139,20 @@ func setupSourceSecrets(pod *kapi.Pod, sourceSecret *kapi.LocalObjectReference)
 	}...)
 }
 
+// setupSubmoduleSecrets mounts the secret holding additional credentials for
+// fetching submodules hosted outside the main repository.
+func setupSubmoduleSecrets(pod *kapi.Pod, gitSource *buildapi.GitBuildSource) {
+	if gitSource == nil || gitSource.SubmoduleSecret == nil {
+		return
+	}
+
+	mountSecretVolume(pod, gitSource.SubmoduleSecret.Name, submoduleSecretMountPath, "submodule")
+	glog.V(3).Infof("Installed submodule secrets in %s, in Pod %s/%s", submoduleSecretMountPath, pod.Namespace, pod.Name)
+	pod.Spec.Containers[0].Env = append(pod.Spec.Containers[0].Env, []kapi.EnvVar{
+		{Name: "SUBMODULE_SECRET_PATH", Value: submoduleSecretMountPath},
+	}...)
+}
+
 // setupSecrets mounts the secrets referenced by the SecretBuildSource
 // into a builder container. It also sets an environment variable that contains
 // a name of the secret and the destination directory.
@@ -145,6 +163,33 @@ func setupSecrets(pod *kapi.Pod, secrets []buildapi.SecretBuildSource) {
 	}
 }
 
+// setupInputVolumes mounts the persistent volume claims referenced by the
+// VolumeBuildSource entries read-only into the builder container so their
+// contents can be copied into the build directory.
+func setupInputVolumes(pod *kapi.Pod, volumes []buildapi.VolumeBuildSource) {
+	for _, v := range volumes {
+		claimName := v.PersistentVolumeClaim.Name
+		volumeName := namer.GetName(claimName, "source-volume", kvalidation.DNS1123SubdomainMaxLength)
+		volume := kapi.Volume{
+			Name: volumeName,
+			VolumeSource: kapi.VolumeSource{
+				PersistentVolumeClaim: &kapi.PersistentVolumeClaimVolumeSource{
+					ClaimName: claimName,
+					ReadOnly:  true,
+				},
+			},
+		}
+		volumeMount := kapi.VolumeMount{
+			Name:      volumeName,
+			MountPath: filepath.Join(VolumeBuildSourceBaseMountPath, claimName),
+			ReadOnly:  true,
+		}
+		pod.Spec.Volumes = append(pod.Spec.Volumes, volume)
+		pod.Spec.Containers[0].VolumeMounts = append(pod.Spec.Containers[0].VolumeMounts, volumeMount)
+		glog.V(3).Infof("%s will be used as a source volume in %s", claimName, pod.Name)
+	}
+}
+
 // addSourceEnvVars adds environment variables related to the source code
 // repository to builder container
 func addSourceEnvVars(source buildapi.BuildSource, output *[]kapi.EnvVar) {
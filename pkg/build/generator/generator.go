@@ -2,6 +2,7 @@ package generator
 
 import (
 	"fmt"
+	"path"
 	"reflect"
 	"regexp"
 	"strconv"
@@ -24,6 +25,10 @@ import (
 	"github.com/openshift/origin/pkg/util/namer"
 )
 
+// archNodeSelectorLabel is the node label platform builds use to schedule onto
+// nodes of the target architecture.
+const archNodeSelectorLabel = "beta.kubernetes.io/arch"
+
 // GeneratorFatalError represents a fatal error while generating a build.
 // An operation that fails because of a fatal error should not be retried.
 type GeneratorFatalError struct {
@@ -297,6 +302,14 @@ func (g *BuildGenerator) Instantiate(ctx kapi.Context, request *buildapi.BuildRe
 		}
 	}
 
+	// Apply per-request source overrides after checking them against the
+	// patterns the build config allows.
+	if request.SourceOverrides != nil {
+		if err := applySourceOverrides(bc, newBuild, request.SourceOverrides); err != nil {
+			return nil, err
+		}
+	}
+
 	glog.V(4).Infof("Build %s/%s has been generated from %s/%s BuildConfig", newBuild.Namespace, newBuild.ObjectMeta.Name, bc.Namespace, bc.ObjectMeta.Name)
 
 	// need to update the BuildConfig because LastVersion and possibly
@@ -311,9 +324,57 @@ func (g *BuildGenerator) Instantiate(ctx kapi.Context, request *buildapi.BuildRe
 	// create the corresponding build, however doing things in that order
 	// allows for a race condition in which two builds get kicked off.  Doing
 	// it in this order ensures that we catch the race while updating the BC.
+
+	// Multi-platform configs fan out into one build per platform; the first
+	// platform's build is returned to the caller.
+	if len(bc.Spec.Platforms) > 1 {
+		return g.instantiatePlatformBuilds(ctx, bc, newBuild)
+	}
+
 	return g.createBuild(ctx, newBuild)
 }
 
+// instantiatePlatformBuilds creates one build per platform declared on the build
+// config. Each build is pinned to nodes of the target architecture and pushes to
+// an arch-suffixed output tag, and carries annotations that let the build pod
+// controller assemble a manifest list once all platform builds succeed.
+func (g *BuildGenerator) instantiatePlatformBuilds(ctx kapi.Context, bc *buildapi.BuildConfig, newBuild *buildapi.Build) (*buildapi.Build, error) {
+	var first *buildapi.Build
+	for _, platform := range bc.Spec.Platforms {
+		obj, err := kapi.Scheme.Copy(newBuild)
+		if err != nil {
+			return nil, errors.NewInternalError(err)
+		}
+		platformBuild := obj.(*buildapi.Build)
+
+		parts := strings.SplitN(platform, "/", 2)
+		arch := parts[len(parts)-1]
+		platformBuild.Name = fmt.Sprintf("%s-%s", newBuild.Name, arch)
+		if platformBuild.Annotations == nil {
+			platformBuild.Annotations = map[string]string{}
+		}
+		platformBuild.Annotations[buildapi.BuildPlatformAnnotation] = platform
+		if platformBuild.Spec.NodeSelector == nil {
+			platformBuild.Spec.NodeSelector = map[string]string{}
+		}
+		platformBuild.Spec.NodeSelector[archNodeSelectorLabel] = arch
+		if to := platformBuild.Spec.Output.To; to != nil && to.Kind == "ImageStreamTag" {
+			platformBuild.Annotations[buildapi.BuildManifestListTagAnnotation] = to.Name
+			to.Name = fmt.Sprintf("%s-%s", to.Name, arch)
+		}
+
+		created, err := g.createBuild(ctx, platformBuild)
+		if err != nil {
+			return nil, err
+		}
+		glog.V(4).Infof("Build %s/%s has been generated for platform %s", created.Namespace, created.Name, platform)
+		if first == nil {
+			first = created
+		}
+	}
+	return first, nil
+}
+
 // checkBuildConfigLastVersion will return an error if the BuildConfig's LastVersion doesn't match the passed in lastVersion
 // when lastVersion is not nil
 func (g *BuildGenerator) checkLastVersion(bc *buildapi.BuildConfig, lastVersion *int64) error {
@@ -325,6 +386,40 @@ func (g *BuildGenerator) checkLastVersion(bc *buildapi.BuildConfig, lastVersion
 	return nil
 }
 
+// applySourceOverrides applies per-request source overrides to the generated build.
+// The requested ref must match one of the allowed source override patterns on the
+// build config; a config without patterns does not accept overrides at all.
+func applySourceOverrides(bc *buildapi.BuildConfig, build *buildapi.Build, overrides *buildapi.SourceOverrides) error {
+	if build.Spec.Source.Git == nil {
+		return errors.NewBadRequest(fmt.Sprintf("cannot override source on %s/%s, not a git build", bc.Namespace, bc.Name))
+	}
+	if len(bc.Spec.AllowedSourceOverridePatterns) == 0 {
+		return errors.NewBadRequest(fmt.Sprintf("BuildConfig %s/%s does not allow source overrides", bc.Namespace, bc.Name))
+	}
+	if len(overrides.Ref) > 0 {
+		matched := false
+		for _, pattern := range bc.Spec.AllowedSourceOverridePatterns {
+			if ok, err := path.Match(pattern, overrides.Ref); err == nil && ok {
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			return errors.NewBadRequest(fmt.Sprintf("ref %q does not match any allowed source override pattern on BuildConfig %s/%s", overrides.Ref, bc.Namespace, bc.Name))
+		}
+		build.Spec.Source.Git.Ref = overrides.Ref
+	}
+	if len(overrides.Commit) > 0 {
+		build.Spec.Revision = &buildapi.SourceRevision{
+			Git: &buildapi.GitSourceRevision{Commit: overrides.Commit},
+		}
+	}
+	if len(overrides.ContextDir) > 0 {
+		build.Spec.Source.ContextDir = overrides.ContextDir
+	}
+	return nil
+}
+
 // updateImageTriggers sets the LastTriggeredImageID on all the ImageChangeTriggers on the BuildConfig and
 // updates the From reference of the strategy if the strategy uses an ImageStream or ImageStreamTag reference
 func (g *BuildGenerator) updateImageTriggers(ctx kapi.Context, bc *buildapi.BuildConfig, from, triggeredBy *kapi.ObjectReference) error {
@@ -67,6 +67,47 @@ func TestInstantiateBinary(t *testing.T) {
 	}
 }
 
+func TestInstantiateWithSourceOverrides(t *testing.T) {
+	generator := mockBuildGenerator()
+	c := generator.Client.(Client)
+	c.GetBuildConfigFunc = func(ctx kapi.Context, name string) (*buildapi.BuildConfig, error) {
+		bc := mocks.MockBuildConfig(mocks.MockSource(), mocks.MockSourceStrategyForImageRepository(), mocks.MockOutput())
+		bc.Spec.AllowedSourceOverridePatterns = []string{"pr/*"}
+		return bc, nil
+	}
+	generator.Client = c
+
+	build, err := generator.Instantiate(kapi.NewDefaultContext(), &buildapi.BuildRequest{
+		SourceOverrides: &buildapi.SourceOverrides{Ref: "pr/42", Commit: "abcdef1", ContextDir: "subdir"},
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error %v", err)
+	}
+	if build.Spec.Source.Git.Ref != "pr/42" {
+		t.Errorf("expected overridden ref %q, got %q", "pr/42", build.Spec.Source.Git.Ref)
+	}
+	if build.Spec.Revision == nil || build.Spec.Revision.Git == nil || build.Spec.Revision.Git.Commit != "abcdef1" {
+		t.Errorf("expected revision pinned to commit abcdef1, got %#v", build.Spec.Revision)
+	}
+	if build.Spec.Source.ContextDir != "subdir" {
+		t.Errorf("expected overridden context dir %q, got %q", "subdir", build.Spec.Source.ContextDir)
+	}
+
+	if _, err := generator.Instantiate(kapi.NewDefaultContext(), &buildapi.BuildRequest{
+		SourceOverrides: &buildapi.SourceOverrides{Ref: "master"},
+	}); err == nil {
+		t.Errorf("expected error for a ref that matches no allowed pattern")
+	}
+
+	// a config without patterns does not accept overrides at all
+	generator = mockBuildGenerator()
+	if _, err := generator.Instantiate(kapi.NewDefaultContext(), &buildapi.BuildRequest{
+		SourceOverrides: &buildapi.SourceOverrides{Ref: "pr/42"},
+	}); err == nil {
+		t.Errorf("expected error for overrides against a config without allowed patterns")
+	}
+}
+
 // TODO(agoldste): I'm not sure the intent of this test. Using the previous logic for
 // the generator, which would try to update the build config before creating
 // the build, I can see why the UpdateBuildConfigFunc is set up to return an
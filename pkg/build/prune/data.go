@@ -61,6 +61,14 @@ func NewFilterBeforePredicate(d time.Duration) FilterPredicate {
 	}
 }
 
+// NewFilterWithoutAnnotationPredicate is a function that returns true if the build does not carry the specified annotation key
+func NewFilterWithoutAnnotationPredicate(key string) FilterPredicate {
+	return func(build *buildapi.Build) bool {
+		_, found := build.Annotations[key]
+		return !found
+	}
+}
+
 // DataSet provides functions for working with build data
 type DataSet interface {
 	GetBuildConfig(build *buildapi.Build) (*buildapi.BuildConfig, bool, error)
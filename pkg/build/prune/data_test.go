@@ -96,6 +96,32 @@ func TestFilterBeforePredicate(t *testing.T) {
 	}
 }
 
+func TestFilterWithoutAnnotationPredicate(t *testing.T) {
+	builds := []*buildapi.Build{
+		{
+			ObjectMeta: kapi.ObjectMeta{
+				Name:        "annotated",
+				Annotations: map[string]string{"keep": ""},
+			},
+		},
+		{
+			ObjectMeta: kapi.ObjectMeta{
+				Name: "plain",
+			},
+		},
+	}
+	filter := &andFilter{
+		filterPredicates: []FilterPredicate{NewFilterWithoutAnnotationPredicate("keep")},
+	}
+	result := filter.Filter(builds)
+	if len(result) != 1 {
+		t.Errorf("Unexpected number of results")
+	}
+	if expected, actual := "plain", result[0].Name; expected != actual {
+		t.Errorf("expected %v, actual %v", expected, actual)
+	}
+}
+
 func TestEmptyDataSet(t *testing.T) {
 	builds := []*buildapi.Build{}
 	buildConfigs := []*buildapi.BuildConfig{}
@@ -39,6 +39,8 @@ type PrunerOptions struct {
 	KeepComplete int
 	// KeepFailed is per BuildConfig how many of the most recent failed builds should be preserved
 	KeepFailed int
+	// KeepAnnotated if set excludes builds carrying the annotation key from the candidate prune set
+	KeepAnnotated string
 	// BuildConfigs is the entire list of buildconfigs across all namespaces in the cluster.
 	BuildConfigs []*buildapi.BuildConfig
 	// Builds is the entire list of builds across all namespaces in the cluster.
@@ -50,8 +52,12 @@ func NewPruner(options PrunerOptions) Pruner {
 	glog.V(1).Infof("Creating build pruner with keepYoungerThan=%v, orphans=%v, keepComplete=%v, keepFailed=%v",
 		options.KeepYoungerThan, options.Orphans, options.KeepComplete, options.KeepFailed)
 
+	filterPredicates := []FilterPredicate{NewFilterBeforePredicate(options.KeepYoungerThan)}
+	if len(options.KeepAnnotated) > 0 {
+		filterPredicates = append(filterPredicates, NewFilterWithoutAnnotationPredicate(options.KeepAnnotated))
+	}
 	filter := &andFilter{
-		filterPredicates: []FilterPredicate{NewFilterBeforePredicate(options.KeepYoungerThan)},
+		filterPredicates: filterPredicates,
 	}
 	builds := filter.Filter(options.Builds)
 	dataSet := NewDataSet(options.BuildConfigs, builds)
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"strconv"
 	"strings"
+	"time"
 
 	kapi "k8s.io/kubernetes/pkg/api"
 	"k8s.io/kubernetes/pkg/labels"
@@ -60,6 +61,45 @@ func BuildNumber(build *buildapi.Build) (int64, error) {
 	return 0, fmt.Errorf("build %s/%s does not have %s annotation", build.Namespace, build.Name, buildapi.BuildNumberAnnotation)
 }
 
+// IsInfrastructureFailure returns true if the provided reason describes a failure
+// caused by the cluster rather than by the build content itself, so the build may
+// succeed if it is retried.
+func IsInfrastructureFailure(reason buildapi.StatusReason) bool {
+	switch reason {
+	case buildapi.StatusReasonCannotCreateBuildPod,
+		buildapi.StatusReasonBuildPodDeleted,
+		buildapi.StatusReasonBuildPodExists,
+		buildapi.StatusReasonExceededRetryTimeout,
+		buildapi.StatusReasonPullBuilderImageFailed,
+		buildapi.StatusReasonPushImageToRegistryFailed,
+		buildapi.StatusReasonFetchSourceFailed:
+		return true
+	}
+	return false
+}
+
+// BuildRetries returns the number of times the build has been retried by its
+// failure policy.
+func BuildRetries(build *buildapi.Build) int32 {
+	if stringNumber, ok := build.Annotations[buildapi.BuildRetriesAnnotation]; ok {
+		if number, err := strconv.ParseInt(stringNumber, 10, 32); err == nil {
+			return int32(number)
+		}
+	}
+	return 0
+}
+
+// BuildRetryNotBefore returns the time before which a retried build must not be
+// started, or nil if the build is not waiting out a retry backoff.
+func BuildRetryNotBefore(build *buildapi.Build) *time.Time {
+	if value, ok := build.Annotations[buildapi.BuildRetryNotBeforeAnnotation]; ok {
+		if notBefore, err := time.Parse(time.RFC3339, value); err == nil {
+			return &notBefore
+		}
+	}
+	return nil
+}
+
 // BuildRunPolicy returns the scheduling policy for the build based on the
 // "queued" label.
 func BuildRunPolicy(build *buildapi.Build) buildapi.BuildRunPolicy {
@@ -0,0 +1,33 @@
+package client
+
+import (
+	authorizationapi "github.com/openshift/origin/pkg/authorization/api"
+)
+
+// BulkSubjectAccessReviews has methods to work with BulkSubjectAccessReview resources in the cluster scope
+type BulkSubjectAccessReviews interface {
+	BulkSubjectAccessReviews() BulkSubjectAccessReviewInterface
+}
+
+// BulkSubjectAccessReviewInterface exposes methods on BulkSubjectAccessReview resources.
+type BulkSubjectAccessReviewInterface interface {
+	Create(review *authorizationapi.BulkSubjectAccessReview) (*authorizationapi.BulkSubjectAccessReviewResponse, error)
+}
+
+// bulkSubjectAccessReviews implements BulkSubjectAccessReviews interface
+type bulkSubjectAccessReviews struct {
+	r *Client
+}
+
+// newBulkSubjectAccessReviews returns a bulkSubjectAccessReviews
+func newBulkSubjectAccessReviews(c *Client) *bulkSubjectAccessReviews {
+	return &bulkSubjectAccessReviews{
+		r: c,
+	}
+}
+
+func (c *bulkSubjectAccessReviews) Create(review *authorizationapi.BulkSubjectAccessReview) (*authorizationapi.BulkSubjectAccessReviewResponse, error) {
+	result := &authorizationapi.BulkSubjectAccessReviewResponse{}
+	err := c.r.Post().Resource("bulkSubjectAccessReviews").Body(review).Do().Into(result)
+	return result, err
+}
@@ -28,6 +28,7 @@ type Interface interface {
 	ImageStreamTagsNamespacer
 	ImageStreamImagesNamespacer
 	ImageStreamSecretsNamespacer
+	RegistryWebhooksNamespacer
 	DeploymentConfigsNamespacer
 	DeploymentLogsNamespacer
 	RoutesNamespacer
@@ -46,6 +47,7 @@ type Interface interface {
 	LocalResourceAccessReviewsNamespacer
 	ResourceAccessReviews
 	SubjectAccessReviews
+	BulkSubjectAccessReviews
 	LocalSubjectAccessReviewsNamespacer
 	SelfSubjectRulesReviewsNamespacer
 	SubjectRulesReviewsNamespacer
@@ -108,6 +110,11 @@ func (c *Client) ImageStreamMappings(namespace string) ImageStreamMappingInterfa
 	return newImageStreamMappings(c, namespace)
 }
 
+// RegistryWebhooks provides a REST client for RegistryWebhook
+func (c *Client) RegistryWebhooks(namespace string) RegistryWebhookInterface {
+	return newRegistryWebhooks(c, namespace)
+}
+
 // ImageStreamTags provides a REST client for ImageStreamTag
 func (c *Client) ImageStreamTags(namespace string) ImageStreamTagInterface {
 	return newImageStreamTags(c, namespace)
@@ -243,6 +250,11 @@ func (c *Client) SubjectAccessReviews() SubjectAccessReviewInterface {
 	return newSubjectAccessReviews(c)
 }
 
+// BulkSubjectAccessReviews provides a REST client for BulkSubjectAccessReviews
+func (c *Client) BulkSubjectAccessReviews() BulkSubjectAccessReviewInterface {
+	return newBulkSubjectAccessReviews(c)
+}
+
 func (c *Client) SelfSubjectRulesReviews(namespace string) SelfSubjectRulesReviewInterface {
 	return newSelfSubjectRulesReviews(c, namespace)
 }
@@ -0,0 +1,73 @@
+package client
+
+import (
+	kapi "k8s.io/kubernetes/pkg/api"
+
+	imageapi "github.com/openshift/origin/pkg/image/api"
+)
+
+// RegistryWebhooksNamespacer has methods to work with RegistryWebhook resources in a namespace
+type RegistryWebhooksNamespacer interface {
+	RegistryWebhooks(namespace string) RegistryWebhookInterface
+}
+
+// RegistryWebhookInterface exposes methods on registryWebhook resources.
+type RegistryWebhookInterface interface {
+	List(opts kapi.ListOptions) (*imageapi.RegistryWebhookList, error)
+	Get(name string) (*imageapi.RegistryWebhook, error)
+	Create(webhook *imageapi.RegistryWebhook) (*imageapi.RegistryWebhook, error)
+	Update(webhook *imageapi.RegistryWebhook) (*imageapi.RegistryWebhook, error)
+	Delete(name string) error
+}
+
+// registryWebhooks implements RegistryWebhookInterface interface
+type registryWebhooks struct {
+	r  *Client
+	ns string
+}
+
+// newRegistryWebhooks returns a registryWebhooks
+func newRegistryWebhooks(c *Client, namespace string) *registryWebhooks {
+	return &registryWebhooks{
+		r:  c,
+		ns: namespace,
+	}
+}
+
+// List returns a list of RegistryWebhooks that match the label and field selectors.
+func (c *registryWebhooks) List(opts kapi.ListOptions) (result *imageapi.RegistryWebhookList, err error) {
+	result = &imageapi.RegistryWebhookList{}
+	err = c.r.Get().
+		Namespace(c.ns).
+		Resource("registryWebhooks").
+		VersionedParams(&opts, kapi.ParameterCodec).
+		Do().
+		Into(result)
+	return
+}
+
+// Get returns information about a particular registry webhook
+func (c *registryWebhooks) Get(name string) (result *imageapi.RegistryWebhook, err error) {
+	result = &imageapi.RegistryWebhook{}
+	err = c.r.Get().Namespace(c.ns).Resource("registryWebhooks").Name(name).Do().Into(result)
+	return
+}
+
+// Create creates a new RegistryWebhook. Returns the server's representation of the RegistryWebhook and error if one occurs.
+func (c *registryWebhooks) Create(webhook *imageapi.RegistryWebhook) (result *imageapi.RegistryWebhook, err error) {
+	result = &imageapi.RegistryWebhook{}
+	err = c.r.Post().Namespace(c.ns).Resource("registryWebhooks").Body(webhook).Do().Into(result)
+	return
+}
+
+// Update updates the RegistryWebhook on the server. Returns the server's representation of the RegistryWebhook and error if one occurs.
+func (c *registryWebhooks) Update(webhook *imageapi.RegistryWebhook) (result *imageapi.RegistryWebhook, err error) {
+	result = &imageapi.RegistryWebhook{}
+	err = c.r.Put().Namespace(c.ns).Resource("registryWebhooks").Name(webhook.Name).Body(webhook).Do().Into(result)
+	return
+}
+
+// Delete takes the name of the RegistryWebhook, and returns an error if one occurs during deletion of the RegistryWebhook
+func (c *registryWebhooks) Delete(name string) error {
+	return c.r.Delete().Namespace(c.ns).Resource("registryWebhooks").Name(name).Do().Error()
+}
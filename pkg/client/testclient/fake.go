@@ -168,6 +168,11 @@ func (c *Fake) ImageStreamMappings(namespace string) client.ImageStreamMappingIn
 	return &FakeImageStreamMappings{Fake: c, Namespace: namespace}
 }
 
+// RegistryWebhooks provides a fake REST client for RegistryWebhooks
+func (c *Fake) RegistryWebhooks(namespace string) client.RegistryWebhookInterface {
+	return &FakeRegistryWebhooks{Fake: c, Namespace: namespace}
+}
+
 // ImageStreamTags provides a fake REST client for ImageStreamTags
 func (c *Fake) ImageStreamTags(namespace string) client.ImageStreamTagInterface {
 	return &FakeImageStreamTags{Fake: c, Namespace: namespace}
@@ -327,6 +332,11 @@ func (c *Fake) SubjectAccessReviews() client.SubjectAccessReviewInterface {
 	return &FakeClusterSubjectAccessReviews{Fake: c}
 }
 
+// BulkSubjectAccessReviews provides a fake REST client for BulkSubjectAccessReviews
+func (c *Fake) BulkSubjectAccessReviews() client.BulkSubjectAccessReviewInterface {
+	return &FakeBulkSubjectAccessReviews{Fake: c}
+}
+
 // ClusterPolicies provides a fake REST client for ClusterPolicies
 func (c *Fake) ClusterPolicies() client.ClusterPolicyInterface {
 	return &FakeClusterPolicies{Fake: c}
@@ -0,0 +1,25 @@
+package testclient
+
+import (
+	"k8s.io/kubernetes/pkg/api/unversioned"
+	"k8s.io/kubernetes/pkg/client/testing/core"
+
+	authorizationapi "github.com/openshift/origin/pkg/authorization/api"
+)
+
+// FakeBulkSubjectAccessReviews implements the BulkSubjectAccessReviews interface.
+// Meant to be embedded into a struct to get a default implementation.
+// This makes faking out just the methods you want to test easier.
+type FakeBulkSubjectAccessReviews struct {
+	Fake *Fake
+}
+
+var bulkSubjectAccessReviewsResource = unversioned.GroupVersionResource{Group: "", Version: "", Resource: "bulksubjectaccessreviews"}
+
+func (c *FakeBulkSubjectAccessReviews) Create(inObj *authorizationapi.BulkSubjectAccessReview) (*authorizationapi.BulkSubjectAccessReviewResponse, error) {
+	obj, err := c.Fake.Invokes(core.NewRootCreateAction(bulkSubjectAccessReviewsResource, inObj), &authorizationapi.BulkSubjectAccessReviewResponse{})
+	if cast, ok := obj.(*authorizationapi.BulkSubjectAccessReviewResponse); ok {
+		return cast, err
+	}
+	return nil, err
+}
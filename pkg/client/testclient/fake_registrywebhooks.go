@@ -0,0 +1,59 @@
+package testclient
+
+import (
+	kapi "k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/api/unversioned"
+	"k8s.io/kubernetes/pkg/client/testing/core"
+
+	imageapi "github.com/openshift/origin/pkg/image/api"
+)
+
+// FakeRegistryWebhooks implements RegistryWebhookInterface. Meant to be embedded into a struct to get a default
+// implementation. This makes faking out just the methods you want to test easier.
+type FakeRegistryWebhooks struct {
+	Fake      *Fake
+	Namespace string
+}
+
+var registryWebhooksResource = unversioned.GroupVersionResource{Group: "", Version: "", Resource: "registrywebhooks"}
+
+func (c *FakeRegistryWebhooks) Get(name string) (*imageapi.RegistryWebhook, error) {
+	obj, err := c.Fake.Invokes(core.NewGetAction(registryWebhooksResource, c.Namespace, name), &imageapi.RegistryWebhook{})
+	if obj == nil {
+		return nil, err
+	}
+
+	return obj.(*imageapi.RegistryWebhook), err
+}
+
+func (c *FakeRegistryWebhooks) List(opts kapi.ListOptions) (*imageapi.RegistryWebhookList, error) {
+	obj, err := c.Fake.Invokes(core.NewListAction(registryWebhooksResource, c.Namespace, opts), &imageapi.RegistryWebhookList{})
+	if obj == nil {
+		return nil, err
+	}
+
+	return obj.(*imageapi.RegistryWebhookList), err
+}
+
+func (c *FakeRegistryWebhooks) Create(inObj *imageapi.RegistryWebhook) (*imageapi.RegistryWebhook, error) {
+	obj, err := c.Fake.Invokes(core.NewCreateAction(registryWebhooksResource, c.Namespace, inObj), inObj)
+	if obj == nil {
+		return nil, err
+	}
+
+	return obj.(*imageapi.RegistryWebhook), err
+}
+
+func (c *FakeRegistryWebhooks) Update(inObj *imageapi.RegistryWebhook) (*imageapi.RegistryWebhook, error) {
+	obj, err := c.Fake.Invokes(core.NewUpdateAction(registryWebhooksResource, c.Namespace, inObj), inObj)
+	if obj == nil {
+		return nil, err
+	}
+
+	return obj.(*imageapi.RegistryWebhook), err
+}
+
+func (c *FakeRegistryWebhooks) Delete(name string) error {
+	_, err := c.Fake.Invokes(core.NewDeleteAction(registryWebhooksResource, c.Namespace, name), &imageapi.RegistryWebhook{})
+	return err
+}
@@ -13,8 +13,10 @@ import (
 	diagnostics "github.com/openshift/origin/pkg/cmd/admin/diagnostics"
 	"github.com/openshift/origin/pkg/cmd/admin/groups"
 	"github.com/openshift/origin/pkg/cmd/admin/migrate"
+	migrateidentities "github.com/openshift/origin/pkg/cmd/admin/migrate/identities"
 	migrateimages "github.com/openshift/origin/pkg/cmd/admin/migrate/images"
 	migratestorage "github.com/openshift/origin/pkg/cmd/admin/migrate/storage"
+	migratestripmetadata "github.com/openshift/origin/pkg/cmd/admin/migrate/stripmetadata"
 	"github.com/openshift/origin/pkg/cmd/admin/network"
 	"github.com/openshift/origin/pkg/cmd/admin/node"
 	"github.com/openshift/origin/pkg/cmd/admin/policy"
@@ -62,9 +64,12 @@ func NewCommandAdmin(name, fullName string, in io.Reader, out io.Writer, errout
 			Message: "Security and Policy:",
 			Commands: []*cobra.Command{
 				project.NewCmdNewProject(project.NewProjectRecommendedName, fullName+" "+project.NewProjectRecommendedName, f, out),
+				project.NewCmdArchiveProject(project.ArchiveProjectRecommendedName, fullName+" "+project.ArchiveProjectRecommendedName, f, out),
+				project.NewCmdUnarchiveProject(project.UnarchiveProjectRecommendedName, fullName+" "+project.UnarchiveProjectRecommendedName, f, out),
 				policy.NewCmdPolicy(policy.PolicyRecommendedName, fullName+" "+policy.PolicyRecommendedName, f, out, errout),
 				groups.NewCmdGroups(groups.GroupsRecommendedName, fullName+" "+groups.GroupsRecommendedName, f, out, errout),
 				cert.NewCmdCert(cert.CertRecommendedName, fullName+" "+cert.CertRecommendedName, out, errout),
+				cert.NewCmdCertificateReport(cert.CertificateReportRecommendedName, fullName+" "+cert.CertificateReportRecommendedName, f, out),
 				admin.NewCommandOverwriteBootstrapPolicy(admin.OverwriteBootstrapPolicyCommandName, fullName+" "+admin.OverwriteBootstrapPolicyCommandName, fullName+" "+admin.CreateBootstrapPolicyFileCommand, out),
 				kubecmd.NewCmdCertificate(f, out),
 			},
@@ -74,6 +79,7 @@ func NewCommandAdmin(name, fullName string, in io.Reader, out io.Writer, errout
 			Commands: []*cobra.Command{
 				admin.NewCommandNodeConfig(admin.NodeConfigCommandName, fullName+" "+admin.NodeConfigCommandName, out),
 				node.NewCommandManageNode(f, node.ManageNodeCommandName, fullName+" "+node.ManageNodeCommandName, out, errout),
+				node.NewCmdNodeLogs(node.NodeLogsRecommendedCommandName, fullName+" "+node.NodeLogsRecommendedCommandName, f, out),
 				cmdutil.ReplaceCommandName("kubectl", fullName, templates.Normalize(kubecmd.NewCmdCordon(f, out))),
 				cmdutil.ReplaceCommandName("kubectl", fullName, templates.Normalize(kubecmd.NewCmdUncordon(f, out))),
 				cmdutil.ReplaceCommandName("kubectl", fullName, kubecmd.NewCmdDrain(f, out, errout)),
@@ -90,8 +96,10 @@ func NewCommandAdmin(name, fullName string, in io.Reader, out io.Writer, errout
 				migrate.NewCommandMigrate(
 					migrate.MigrateRecommendedName, fullName+" "+migrate.MigrateRecommendedName, f, out, errout,
 					// Migration commands
+					migrateidentities.NewCmdMigrateIdentities("identities", fullName+" "+migrate.MigrateRecommendedName+" identities", f, in, out, errout),
 					migrateimages.NewCmdMigrateImageReferences("image-references", fullName+" "+migrate.MigrateRecommendedName+" image-references", f, in, out, errout),
 					migratestorage.NewCmdMigrateAPIStorage("storage", fullName+" "+migrate.MigrateRecommendedName+" storage", f, in, out, errout),
+					migratestripmetadata.NewCmdMigrateStripMetadata("strip-metadata", fullName+" "+migrate.MigrateRecommendedName+" strip-metadata", f, in, out, errout),
 				),
 				top.NewCommandTop(top.TopRecommendedName, fullName+" "+top.TopRecommendedName, f, out, errout),
 			},
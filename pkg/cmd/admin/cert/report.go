@@ -0,0 +1,313 @@
+package cert
+
+import (
+	"crypto/x509"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/golang/glog"
+	"github.com/spf13/cobra"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+	kclientset "k8s.io/kubernetes/pkg/client/clientset_generated/internalclientset"
+	kcmdutil "k8s.io/kubernetes/pkg/kubectl/cmd/util"
+
+	"github.com/openshift/origin/pkg/cmd/server/crypto"
+	"github.com/openshift/origin/pkg/cmd/templates"
+	cmdutil "github.com/openshift/origin/pkg/cmd/util"
+	"github.com/openshift/origin/pkg/cmd/util/clientcmd"
+)
+
+const CertificateReportRecommendedName = "cert"
+
+var (
+	certificateReportLong = templates.LongDesc(`
+		Report on the certificates in use by the cluster
+
+		This command builds an inventory of the certificates the cluster depends on and reports
+		when each of them expires, who issued it, and which host names it serves. Certificates are
+		collected from local directories (master and node configuration directories, router and
+		registry certificate directories) passed via --cert-dir, and from the certificate keys of
+		secrets stored in the cluster, which covers generated serving certificates as well as
+		router and registry certificates kept in secrets.
+
+		When --warn-days is set the command exits with a non-zero status if any certificate
+		expires within that many days, which makes it suitable for periodic monitoring jobs.`)
+
+	certificateReportExample = templates.Examples(`
+		# Report on all certificates stored in secrets in the cluster
+		%[1]s
+
+		# Include the certificates of a master configuration directory
+		%[1]s --cert-dir=/etc/origin/master
+
+		# Scan only local directories and fail if a certificate expires within 30 days
+		%[1]s --secrets=false --cert-dir=/etc/origin/master --cert-dir=/etc/origin/node --warn-days=30
+
+		# Emit the inventory as JSON for further processing
+		%[1]s -o json`)
+)
+
+// CertificateInfo describes a single certificate found while scanning.
+type CertificateInfo struct {
+	// Source identifies where the certificate was found - a file path or a secret key
+	// in the form secret/namespace/name[key].
+	Source string `json:"source"`
+	// Subject is the distinguished name of the certificate.
+	Subject string `json:"subject"`
+	// Issuer is the distinguished name of the certificate signer.
+	Issuer string `json:"issuer"`
+	// NotBefore is the time the certificate becomes valid.
+	NotBefore time.Time `json:"notBefore"`
+	// NotAfter is the time the certificate expires.
+	NotAfter time.Time `json:"notAfter"`
+	// Hostnames are the subject alternative names (DNS names and IP addresses) the
+	// certificate is valid for.
+	Hostnames []string `json:"hostnames,omitempty"`
+}
+
+// CertificateReportOptions contains all the settings for scanning cluster certificates.
+type CertificateReportOptions struct {
+	// CertDirs are local directories scanned recursively for certificate files.
+	CertDirs []string
+	// IncludeSecrets indicates the certificate keys of cluster secrets should be scanned.
+	IncludeSecrets bool
+	// Output is the report format - empty for a table, or "json".
+	Output string
+	// WarnDays causes a non-zero exit status when a certificate expires within this many days.
+	WarnDays int
+
+	Out     io.Writer
+	KClient kclientset.Interface
+}
+
+// NewCmdCertificateReport implements the OpenShift cli adm cert command.
+func NewCmdCertificateReport(name, fullName string, f *clientcmd.Factory, out io.Writer) *cobra.Command {
+	options := &CertificateReportOptions{
+		IncludeSecrets: true,
+	}
+
+	cmd := &cobra.Command{
+		Use:     name,
+		Short:   "Report on certificate expiration across the cluster",
+		Long:    certificateReportLong,
+		Example: fmt.Sprintf(certificateReportExample, fullName),
+		Run: func(cmd *cobra.Command, args []string) {
+			kcmdutil.CheckErr(options.Complete(f, cmd, args, out))
+			kcmdutil.CheckErr(options.Validate())
+			err := options.Run()
+			if err == cmdutil.ErrExit {
+				os.Exit(1)
+			}
+			kcmdutil.CheckErr(err)
+		},
+	}
+
+	cmd.Flags().StringSliceVar(&options.CertDirs, "cert-dir", options.CertDirs, "A local directory to scan recursively for certificate files. May be specified multiple times.")
+	cmd.Flags().BoolVar(&options.IncludeSecrets, "secrets", options.IncludeSecrets, "Scan the certificate keys of secrets stored in the cluster. Set to false for offline use.")
+	cmd.Flags().StringVarP(&options.Output, "output", "o", options.Output, "Output format. Supports 'json', defaults to a table.")
+	cmd.Flags().IntVar(&options.WarnDays, "warn-days", options.WarnDays, "Exit with a non-zero status if any certificate expires within this many days. Defaults to 0 which disables the check.")
+
+	return cmd
+}
+
+// Complete fills in the remaining fields of the options from the factory.
+func (o *CertificateReportOptions) Complete(f *clientcmd.Factory, cmd *cobra.Command, args []string, out io.Writer) error {
+	if len(args) > 0 {
+		return kcmdutil.UsageError(cmd, "no arguments are allowed")
+	}
+	o.Out = out
+	if o.IncludeSecrets {
+		_, kClient, err := f.Clients()
+		if err != nil {
+			return err
+		}
+		o.KClient = kClient
+	}
+	return nil
+}
+
+// Validate ensures the options are internally consistent.
+func (o *CertificateReportOptions) Validate() error {
+	switch o.Output {
+	case "", "json":
+	default:
+		return fmt.Errorf("unsupported output format %q - only 'json' is supported", o.Output)
+	}
+	if o.WarnDays < 0 {
+		return fmt.Errorf("invalid --warn-days: %d - must not be negative", o.WarnDays)
+	}
+	if !o.IncludeSecrets && len(o.CertDirs) == 0 {
+		return fmt.Errorf("nothing to scan: provide --cert-dir or enable --secrets")
+	}
+	return nil
+}
+
+// Run scans the configured sources and prints the certificate report.
+func (o *CertificateReportOptions) Run() error {
+	infos := []CertificateInfo{}
+	for _, dir := range o.CertDirs {
+		dirInfos, err := scanCertDir(dir)
+		if err != nil {
+			return err
+		}
+		infos = append(infos, dirInfos...)
+	}
+	if o.IncludeSecrets {
+		secretInfos, err := scanSecrets(o.KClient)
+		if err != nil {
+			return err
+		}
+		infos = append(infos, secretInfos...)
+	}
+
+	sort.Sort(certificatesByExpiration(infos))
+
+	if o.Output == "json" {
+		data, err := json.MarshalIndent(infos, "", "  ")
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(o.Out, "%s\n", data)
+	} else {
+		w := tabwriter.NewWriter(o.Out, 10, 4, 3, ' ', 0)
+		fmt.Fprintf(w, "SOURCE\tSUBJECT\tISSUER\tEXPIRES\tHOSTNAMES\n")
+		for _, info := range infos {
+			fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n", info.Source, info.Subject, info.Issuer, expirationDisplay(info.NotAfter), strings.Join(info.Hostnames, ","))
+		}
+		w.Flush()
+	}
+
+	if o.WarnDays > 0 {
+		deadline := time.Now().Add(time.Duration(o.WarnDays) * 24 * time.Hour)
+		expiring := 0
+		for _, info := range infos {
+			if info.NotAfter.Before(deadline) {
+				expiring++
+			}
+		}
+		if expiring > 0 {
+			fmt.Fprintf(o.Out, "\nerror: %d certificate(s) expire within %d days\n", expiring, o.WarnDays)
+			return cmdutil.ErrExit
+		}
+	}
+	return nil
+}
+
+// scanCertDir walks a directory and collects every certificate found in files with a
+// certificate extension. Files that do not contain PEM certificates are skipped.
+func scanCertDir(dir string) ([]CertificateInfo, error) {
+	infos := []CertificateInfo{}
+	err := filepath.Walk(dir, func(path string, fileInfo os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if fileInfo.IsDir() {
+			return nil
+		}
+		switch filepath.Ext(path) {
+		case ".crt", ".cert", ".pem":
+		default:
+			return nil
+		}
+		data, err := ioutil.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		certs, err := crypto.CertsFromPEM(data)
+		if err != nil {
+			glog.V(4).Infof("Skipping %s: %v", path, err)
+			return nil
+		}
+		for _, cert := range certs {
+			infos = append(infos, certificateInfo(path, cert))
+		}
+		return nil
+	})
+	return infos, err
+}
+
+// scanSecrets collects the certificates stored under certificate keys of all secrets
+// visible to the client. This covers generated serving certificates as well as router
+// and registry certificates kept in secrets.
+func scanSecrets(kClient kclientset.Interface) ([]CertificateInfo, error) {
+	secrets, err := kClient.Core().Secrets(kapi.NamespaceAll).List(kapi.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	infos := []CertificateInfo{}
+	for i := range secrets.Items {
+		secret := &secrets.Items[i]
+		for key, data := range secret.Data {
+			if !isCertificateKey(secret, key) {
+				continue
+			}
+			certs, err := crypto.CertsFromPEM(data)
+			if err != nil {
+				glog.V(4).Infof("Skipping key %s of secret %s/%s: %v", key, secret.Namespace, secret.Name, err)
+				continue
+			}
+			source := fmt.Sprintf("secret/%s/%s[%s]", secret.Namespace, secret.Name, key)
+			for _, cert := range certs {
+				infos = append(infos, certificateInfo(source, cert))
+			}
+		}
+	}
+	return infos, nil
+}
+
+// isCertificateKey indicates whether a secret key is expected to hold certificates.
+func isCertificateKey(secret *kapi.Secret, key string) bool {
+	if secret.Type == kapi.SecretTypeTLS {
+		return key == kapi.TLSCertKey
+	}
+	switch filepath.Ext(key) {
+	case ".crt", ".cert", ".pem":
+		return true
+	}
+	return false
+}
+
+// certificateInfo converts a parsed certificate into a report entry.
+func certificateInfo(source string, cert *x509.Certificate) CertificateInfo {
+	hostnames := []string{}
+	hostnames = append(hostnames, cert.DNSNames...)
+	for _, ip := range cert.IPAddresses {
+		hostnames = append(hostnames, ip.String())
+	}
+	return CertificateInfo{
+		Source:    source,
+		Subject:   cert.Subject.CommonName,
+		Issuer:    cert.Issuer.CommonName,
+		NotBefore: cert.NotBefore,
+		NotAfter:  cert.NotAfter,
+		Hostnames: hostnames,
+	}
+}
+
+// expirationDisplay renders an expiration date with the number of days remaining, or
+// an expired marker.
+func expirationDisplay(notAfter time.Time) string {
+	remaining := notAfter.Sub(time.Now())
+	if remaining < 0 {
+		return fmt.Sprintf("%s (EXPIRED)", notAfter.Format("2006-01-02"))
+	}
+	return fmt.Sprintf("%s (in %d days)", notAfter.Format("2006-01-02"), int(remaining/(24*time.Hour)))
+}
+
+// certificatesByExpiration sorts report entries by expiration date, soonest first.
+type certificatesByExpiration []CertificateInfo
+
+func (c certificatesByExpiration) Len() int      { return len(c) }
+func (c certificatesByExpiration) Swap(i, j int) { c[i], c[j] = c[j], c[i] }
+func (c certificatesByExpiration) Less(i, j int) bool {
+	return c[i].NotAfter.Before(c[j].NotAfter)
+}
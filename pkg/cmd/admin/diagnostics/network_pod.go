@@ -41,7 +41,7 @@ log the results so that the calling diagnostic can report them.
 var (
 	// availableNetworkPodDiagnostics contains the names of network diagnostics that can be executed
 	// during a single run of diagnostics. Add more diagnostics to the list as they are defined.
-	availableNetworkPodDiagnostics = sets.NewString(networkdiag.CheckNodeNetworkName, networkdiag.CheckPodNetworkName, networkdiag.CheckExternalNetworkName, networkdiag.CheckServiceNetworkName, networkdiag.CollectNetworkInfoName)
+	availableNetworkPodDiagnostics = sets.NewString(networkdiag.CheckNodeNetworkName, networkdiag.CheckPodNetworkName, networkdiag.CheckExternalNetworkName, networkdiag.CheckServiceNetworkName, networkdiag.CheckConnectivityMatrixName, networkdiag.CollectNetworkInfoName)
 )
 
 // NewCommandNetworkPodDiagnostics is the command for running network diagnostics.
@@ -162,6 +162,12 @@ func (o NetworkPodDiagnosticsOptions) buildNetworkPodDiagnostics() ([]types.Diag
 				OSClient:   osClient,
 			})
 
+		case networkdiag.CheckConnectivityMatrixName:
+			diagnostics = append(diagnostics, networkdiag.CheckConnectivityMatrix{
+				KubeClient: kubeClient,
+				OSClient:   osClient,
+			})
+
 		case networkdiag.CollectNetworkInfoName:
 			diagnostics = append(diagnostics, networkdiag.CollectNetworkInfo{
 				KubeClient: kubeClient,
@@ -13,11 +13,14 @@ type SyncBuilder interface {
 	GetGroupMemberExtractor() (interfaces.LDAPMemberExtractor, error)
 }
 
-// PruneBuilder describes an object that can build all the schema-specific parts of an LDAPGroupPruner
+// PruneBuilder describes an object that can build all the schema-specific parts of an
+// LDAPGroupPruner or LDAPGroupMemberPruner
 type PruneBuilder interface {
 	GetGroupLister() (interfaces.LDAPGroupLister, error)
 	GetGroupNameMapper() (interfaces.LDAPGroupNameMapper, error)
 	GetGroupDetector() (interfaces.LDAPGroupDetector, error)
+	GetUserNameMapper() (interfaces.LDAPUserNameMapper, error)
+	GetGroupMemberExtractor() (interfaces.LDAPMemberExtractor, error)
 }
 
 // GroupNameRestrictions desribes an object that holds blacklists and whitelists
@@ -10,6 +10,7 @@ import (
 
 	cmdutil "k8s.io/kubernetes/pkg/kubectl/cmd/util"
 	kerrs "k8s.io/kubernetes/pkg/util/errors"
+	"k8s.io/kubernetes/pkg/util/sets"
 	"k8s.io/kubernetes/pkg/util/validation/field"
 
 	"github.com/openshift/origin/pkg/auth/ldaputil"
@@ -47,7 +48,10 @@ var (
     %[1]s --whitelist=/path/to/whitelist.txt --sync-config=/path/to/ldap-sync-config.yaml --confirm
 
     # Prune all orphaned groups from a list of specific groups specified in a whitelist
-    %[1]s groups/group_name groups/other_name --sync-config=/path/to/ldap-sync-config.yaml --confirm`)
+    %[1]s groups/group_name groups/other_name --sync-config=/path/to/ldap-sync-config.yaml --confirm
+
+    # Additionally remove users missing from the external provider from the synced groups
+    %[1]s --sync-config=/path/to/ldap-sync-config.yaml --prune-members --confirm`)
 )
 
 type PruneOptions struct {
@@ -63,9 +67,24 @@ type PruneOptions struct {
 	// Confirm determines whether or not to write to OpenShift
 	Confirm bool
 
+	// PruneMembers determines whether users missing from the external provider are removed
+	// from the synced groups
+	PruneMembers bool
+
+	// DeactivateUsers determines whether User objects missing from the external provider
+	// are marked as deactivated. Requires PruneMembers.
+	DeactivateUsers bool
+
+	// ProtectedUsers are the names of OpenShift users that are never removed from groups
+	// or deactivated
+	ProtectedUsers []string
+
 	// GroupsInterface is the interface used to interact with OpenShift Group objects
 	GroupInterface osclient.GroupInterface
 
+	// UserInterface is the interface used to interact with OpenShift User objects
+	UserInterface osclient.UserInterface
+
 	// Stderr is the writer to write warnings and errors to
 	Stderr io.Writer
 
@@ -87,6 +106,7 @@ func NewCmdPrune(name, fullName string, f *clientcmd.Factory, out io.Writer) *co
 	whitelistFile := ""
 	blacklistFile := ""
 	configFile := ""
+	protectedUsersFile := ""
 
 	cmd := &cobra.Command{
 		Use:     fmt.Sprintf("%s [WHITELIST] [--whitelist=WHITELIST-FILE] [--blacklist=BLACKLIST-FILE] --sync-config=CONFIG-SOURCE", name),
@@ -94,7 +114,7 @@ func NewCmdPrune(name, fullName string, f *clientcmd.Factory, out io.Writer) *co
 		Long:    pruneLong,
 		Example: fmt.Sprintf(pruneExamples, fullName),
 		Run: func(c *cobra.Command, args []string) {
-			if err := options.Complete(whitelistFile, blacklistFile, configFile, args, f); err != nil {
+			if err := options.Complete(whitelistFile, blacklistFile, configFile, protectedUsersFile, args, f); err != nil {
 				cmdutil.CheckErr(cmdutil.UsageError(c, err.Error()))
 			}
 
@@ -127,10 +147,15 @@ func NewCmdPrune(name, fullName string, f *clientcmd.Factory, out io.Writer) *co
 
 	cmd.Flags().BoolVar(&options.Confirm, "confirm", false, "if true, modify OpenShift groups; if false, display groups")
 
+	cmd.Flags().BoolVar(&options.PruneMembers, "prune-members", false, "if true, also remove users from the synced groups when they no longer exist on the external provider")
+	cmd.Flags().BoolVar(&options.DeactivateUsers, "deactivate-users", false, "if true, mark OpenShift users removed from every synced group as deactivated; requires --prune-members")
+	cmd.Flags().StringVar(&protectedUsersFile, "protect-users", protectedUsersFile, "path to a file of user names that are never removed from groups or deactivated")
+	cmd.MarkFlagFilename("protect-users", "txt")
+
 	return cmd
 }
 
-func (o *PruneOptions) Complete(whitelistFile, blacklistFile, configFile string, args []string, f *clientcmd.Factory) error {
+func (o *PruneOptions) Complete(whitelistFile, blacklistFile, configFile, protectedUsersFile string, args []string, f *clientcmd.Factory) error {
 	var err error
 	o.Whitelist, err = buildOpenShiftGroupNameList(args, whitelistFile)
 	if err != nil {
@@ -142,6 +167,11 @@ func (o *PruneOptions) Complete(whitelistFile, blacklistFile, configFile string,
 		return err
 	}
 
+	o.ProtectedUsers, err = buildNameList([]string{}, protectedUsersFile)
+	if err != nil {
+		return err
+	}
+
 	o.Config, err = decodeSyncConfigFromFile(configFile)
 	if err != nil {
 		return err
@@ -152,6 +182,7 @@ func (o *PruneOptions) Complete(whitelistFile, blacklistFile, configFile string,
 		return err
 	}
 	o.GroupInterface = osClient.Groups()
+	o.UserInterface = osClient.Users()
 
 	return nil
 }
@@ -161,6 +192,12 @@ func (o *PruneOptions) Validate() error {
 	if o.GroupInterface == nil {
 		results.Errors = append(results.Errors, field.Required(field.NewPath("groupInterface"), ""))
 	}
+	if o.DeactivateUsers && !o.PruneMembers {
+		results.Errors = append(results.Errors, field.Invalid(field.NewPath("deactivateUsers"), o.DeactivateUsers, "--deactivate-users requires --prune-members"))
+	}
+	if o.DeactivateUsers && o.UserInterface == nil {
+		results.Errors = append(results.Errors, field.Required(field.NewPath("userInterface"), ""))
+	}
 	// TODO(skuznets): pretty-print validation results
 	if len(results.Errors) > 0 {
 		return fmt.Errorf("validation of LDAP sync config failed: %v", results.Errors.ToAggregate())
@@ -209,6 +246,34 @@ func (o *PruneOptions) Run(cmd *cobra.Command, f *clientcmd.Factory) error {
 
 	// Now we run the pruner and report any errors
 	pruneErrors := pruner.Prune()
+
+	if o.PruneMembers {
+		memberPruner := &syncgroups.LDAPGroupMemberPruner{
+			Host:            clientConfig.Host(),
+			GroupClient:     o.GroupInterface,
+			UserClient:      o.UserInterface,
+			DryRun:          !o.Confirm,
+			DeactivateUsers: o.DeactivateUsers,
+			ProtectedUsers:  sets.NewString(o.ProtectedUsers...),
+
+			Out: o.Out,
+			Err: os.Stderr,
+		}
+		memberPruner.GroupLister = listerMapper
+		memberPruner.GroupNameMapper = listerMapper
+
+		memberPruner.GroupMemberExtractor, err = pruneBuilder.GetGroupMemberExtractor()
+		if err != nil {
+			return err
+		}
+		memberPruner.UserNameMapper, err = pruneBuilder.GetUserNameMapper()
+		if err != nil {
+			return err
+		}
+
+		pruneErrors = append(pruneErrors, memberPruner.Prune()...)
+	}
+
 	return kerrs.NewAggregate(pruneErrors)
 
 }
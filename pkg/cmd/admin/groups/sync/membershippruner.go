@@ -0,0 +1,193 @@
+package syncgroups
+
+import (
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/golang/glog"
+	"gopkg.in/ldap.v2"
+
+	kapierrors "k8s.io/kubernetes/pkg/api/errors"
+	"k8s.io/kubernetes/pkg/util/sets"
+
+	"github.com/openshift/origin/pkg/client"
+	"github.com/openshift/origin/pkg/cmd/admin/groups/sync/interfaces"
+)
+
+// UserDeactivatedAnnotation is set on a User object when membership pruning determined that the
+// user no longer exists on the external provider.  It holds the time of deactivation in the
+// ISO 8601 format.  The annotation is advisory: it marks the user for operators and tooling but
+// does not by itself prevent authentication.
+const UserDeactivatedAnnotation = "user.openshift.io/deactivated"
+
+// LDAPGroupMemberPruner removes users from synced Groups when they no longer exist on the
+// external LDAP server.  Optionally it marks User objects that disappeared from every synced
+// group as deactivated.
+type LDAPGroupMemberPruner struct {
+	// Lists all groups whose membership is to be pruned
+	GroupLister interfaces.LDAPGroupLister
+	// Fetches a group and extracts object metainformation and membership list from a group
+	GroupMemberExtractor interfaces.LDAPMemberExtractor
+	// Maps an LDAP user entry to an OpenShift User's Name
+	UserNameMapper interfaces.LDAPUserNameMapper
+	// Maps an LDAP group enrty to an OpenShift Group's Name
+	GroupNameMapper interfaces.LDAPGroupNameMapper
+	// Allows the Pruner to fetch and update OpenShift Groups
+	GroupClient client.GroupInterface
+	// Allows the Pruner to mark OpenShift Users as deactivated
+	UserClient client.UserInterface
+	// Host stores the address:port of the LDAP server
+	Host string
+	// DryRun indicates that no changes should be made.
+	DryRun bool
+	// DeactivateUsers marks User objects that no longer exist on the external provider
+	DeactivateUsers bool
+	// ProtectedUsers are never removed from groups or deactivated
+	ProtectedUsers sets.String
+
+	// Out is used to provide output while the prune job is happening
+	Out io.Writer
+	Err io.Writer
+}
+
+var _ GroupPruner = &LDAPGroupMemberPruner{}
+
+// Prune removes users that no longer exist upstream from the synced groups.  Groups whose
+// upstream membership cannot be determined are left untouched so that transient lookup
+// failures never remove members.
+func (s *LDAPGroupMemberPruner) Prune() []error {
+	var errors []error
+
+	glog.V(1).Infof("LDAPGroupMemberPruner listing groups to prune with %v", s.GroupLister)
+	ldapGroupUIDs, err := s.GroupLister.ListGroups()
+	if err != nil {
+		errors = append(errors, err)
+		return errors
+	}
+	glog.V(1).Infof("LDAPGroupMemberPruner will attempt to prune members of ldapGroupUIDs %v", ldapGroupUIDs)
+
+	// usernames seen on the LDAP server across all synced groups
+	upstreamUsernames := sets.NewString()
+	// usernames removed from at least one group
+	removedUsernames := sets.NewString()
+
+	for _, ldapGroupUID := range ldapGroupUIDs {
+		glog.V(1).Infof("Checking membership of LDAP group %v", ldapGroupUID)
+
+		memberEntries, err := s.GroupMemberExtractor.ExtractMembers(ldapGroupUID)
+		if err != nil {
+			fmt.Fprintf(s.Err, "Error determining LDAP group membership for %q: %v.\n", ldapGroupUID, err)
+			errors = append(errors, err)
+			continue
+		}
+
+		currentUsernames, err := s.determineUsernames(memberEntries)
+		if err != nil {
+			fmt.Fprintf(s.Err, "Error determining usernames for LDAP group %q: %v.\n", ldapGroupUID, err)
+			errors = append(errors, err)
+			continue
+		}
+		upstreamUsernames.Insert(currentUsernames.List()...)
+
+		groupName, err := s.GroupNameMapper.GroupNameFor(ldapGroupUID)
+		if err != nil {
+			fmt.Fprintf(s.Err, "Error determining OpenShift group name for LDAP group %q: %v.\n", ldapGroupUID, err)
+			errors = append(errors, err)
+			continue
+		}
+
+		group, err := s.GroupClient.Get(groupName)
+		if err != nil {
+			if kapierrors.IsNotFound(err) {
+				continue
+			}
+			fmt.Fprintf(s.Err, "Error retrieving OpenShift group %q: %v.\n", groupName, err)
+			errors = append(errors, err)
+			continue
+		}
+
+		keptUsers := []string{}
+		removedUsers := []string{}
+		for _, user := range group.Users {
+			if currentUsernames.Has(user) || s.ProtectedUsers.Has(user) {
+				keptUsers = append(keptUsers, user)
+				continue
+			}
+			removedUsers = append(removedUsers, user)
+		}
+		if len(removedUsers) == 0 {
+			continue
+		}
+
+		if !s.DryRun {
+			group.Users = keptUsers
+			if _, err := s.GroupClient.Update(group); err != nil {
+				fmt.Fprintf(s.Err, "Error updating OpenShift group %q: %v.\n", groupName, err)
+				errors = append(errors, err)
+				continue
+			}
+		}
+
+		removedUsernames.Insert(removedUsers...)
+		for _, user := range removedUsers {
+			fmt.Fprintf(s.Out, "group/%s user/%s\n", groupName, user)
+		}
+	}
+
+	if s.DeactivateUsers {
+		// only deactivate users that disappeared from every synced group
+		errors = append(errors, s.deactivateUsers(removedUsernames.Difference(upstreamUsernames))...)
+	}
+
+	return errors
+}
+
+// determineUsernames determines the OpenShift Users that correspond to a list of LDAP member entries
+func (s *LDAPGroupMemberPruner) determineUsernames(members []*ldap.Entry) (sets.String, error) {
+	usernames := sets.NewString()
+	for _, member := range members {
+		username, err := s.UserNameMapper.UserNameFor(member)
+		if err != nil {
+			return nil, err
+		}
+		glog.V(2).Infof("Found OpenShift username %q for LDAP user for %v", username, member)
+
+		usernames.Insert(username)
+	}
+	return usernames, nil
+}
+
+// deactivateUsers marks the given User objects with the deactivation annotation
+func (s *LDAPGroupMemberPruner) deactivateUsers(usernames sets.String) []error {
+	var errors []error
+	for _, username := range usernames.List() {
+		user, err := s.UserClient.Get(username)
+		if err != nil {
+			if kapierrors.IsNotFound(err) {
+				continue
+			}
+			fmt.Fprintf(s.Err, "Error retrieving OpenShift user %q: %v.\n", username, err)
+			errors = append(errors, err)
+			continue
+		}
+		if _, deactivated := user.Annotations[UserDeactivatedAnnotation]; deactivated {
+			continue
+		}
+
+		if !s.DryRun {
+			if user.Annotations == nil {
+				user.Annotations = map[string]string{}
+			}
+			user.Annotations[UserDeactivatedAnnotation] = ISO8601(time.Now())
+			if _, err := s.UserClient.Update(user); err != nil {
+				fmt.Fprintf(s.Err, "Error deactivating OpenShift user %q: %v.\n", username, err)
+				errors = append(errors, err)
+				continue
+			}
+		}
+
+		fmt.Fprintf(s.Out, "user/%s deactivated\n", username)
+	}
+	return errors
+}
@@ -0,0 +1,197 @@
+package syncgroups
+
+import (
+	"io/ioutil"
+	"testing"
+
+	"gopkg.in/ldap.v2"
+	kapi "k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/client/testing/core"
+	"k8s.io/kubernetes/pkg/runtime"
+	"k8s.io/kubernetes/pkg/util/sets"
+
+	"github.com/openshift/origin/pkg/client/testclient"
+	userapi "github.com/openshift/origin/pkg/user/api"
+)
+
+func TestPruneMembersRemovesMissingUsers(t *testing.T) {
+	testMemberPruner, tc := newTestMemberPruner()
+	// Member4 no longer exists on the LDAP server
+	testMemberPruner.GroupMemberExtractor.(*TestGroupMemberExtractor).MemberMapping[Group3UID] = []*ldap.Entry{Member3}
+
+	errs := testMemberPruner.Prune()
+	for _, err := range errs {
+		t.Errorf("unexpected prune error: %v", err)
+	}
+
+	updatedGroups := extractUpdatedGroups(tc)
+	if len(updatedGroups) != 1 {
+		t.Fatalf("expected one group update, got: %v", updatedGroups)
+	}
+	if e, a := "os"+Group3UID, updatedGroups[0].Name; e != a {
+		t.Errorf("updated incorrect group:\n\twanted:\n\t%v\n\tgot:\n\t%v\n", e, a)
+	}
+	if e, a := []string{Member3UID}, updatedGroups[0].Users; !sets.NewString(e...).Equal(sets.NewString(a...)) {
+		t.Errorf("incorrect users after prune:\n\twanted:\n\t%v\n\tgot:\n\t%v\n", e, a)
+	}
+
+	if updatedUsers := extractUpdatedUsers(tc); len(updatedUsers) != 0 {
+		t.Errorf("expected no user updates, got: %v", updatedUsers)
+	}
+}
+
+func TestPruneMembersHonorsProtectedUsers(t *testing.T) {
+	testMemberPruner, tc := newTestMemberPruner()
+	testMemberPruner.GroupMemberExtractor.(*TestGroupMemberExtractor).MemberMapping[Group3UID] = []*ldap.Entry{Member3}
+	testMemberPruner.ProtectedUsers = sets.NewString(Member4UID)
+
+	errs := testMemberPruner.Prune()
+	for _, err := range errs {
+		t.Errorf("unexpected prune error: %v", err)
+	}
+
+	if updatedGroups := extractUpdatedGroups(tc); len(updatedGroups) != 0 {
+		t.Errorf("expected no group updates for a protected user, got: %v", updatedGroups)
+	}
+}
+
+// TestPruneMembersExtractionFailsForGroup tests that a failure extracting upstream membership
+// does not remove members from that group or fail the entire prune job
+func TestPruneMembersExtractionFailsForGroup(t *testing.T) {
+	testMemberPruner, tc := newTestMemberPruner()
+	delete(testMemberPruner.GroupMemberExtractor.(*TestGroupMemberExtractor).MemberMapping, Group3UID)
+
+	errs := testMemberPruner.Prune()
+	if len(errs) != 1 {
+		t.Errorf("unexpected prune errors: %v", errs)
+
+	} else if e, a := "no members found for group: "+Group3UID, errs[0].Error(); e != a {
+		t.Errorf("incorrect prune error:\n\twanted:\n\t%v\n\tgot:\n\t%v\n", e, a)
+	}
+
+	if updatedGroups := extractUpdatedGroups(tc); len(updatedGroups) != 0 {
+		t.Errorf("expected no group updates on extraction failure, got: %v", updatedGroups)
+	}
+}
+
+func TestPruneMembersDeactivatesUsers(t *testing.T) {
+	testMemberPruner, tc := newTestMemberPruner()
+	testMemberPruner.GroupMemberExtractor.(*TestGroupMemberExtractor).MemberMapping[Group3UID] = []*ldap.Entry{Member3}
+	testMemberPruner.DeactivateUsers = true
+
+	errs := testMemberPruner.Prune()
+	for _, err := range errs {
+		t.Errorf("unexpected prune error: %v", err)
+	}
+
+	updatedUsers := extractUpdatedUsers(tc)
+	if len(updatedUsers) != 1 {
+		t.Fatalf("expected one user update, got: %v", updatedUsers)
+	}
+	if e, a := Member4UID, updatedUsers[0].Name; e != a {
+		t.Errorf("deactivated incorrect user:\n\twanted:\n\t%v\n\tgot:\n\t%v\n", e, a)
+	}
+	if _, deactivated := updatedUsers[0].Annotations[UserDeactivatedAnnotation]; !deactivated {
+		t.Errorf("expected deactivation annotation on user, got: %v", updatedUsers[0].Annotations)
+	}
+}
+
+// TestPruneMembersDoesNotDeactivateMovedUsers tests that a user removed from one group but still
+// present upstream in another is not deactivated
+func TestPruneMembersDoesNotDeactivateMovedUsers(t *testing.T) {
+	testMemberPruner, tc := newTestMemberPruner()
+	// Member3 left group2 but remains a member of group3
+	testMemberPruner.GroupMemberExtractor.(*TestGroupMemberExtractor).MemberMapping[Group2UID] = []*ldap.Entry{Member2}
+	testMemberPruner.DeactivateUsers = true
+
+	errs := testMemberPruner.Prune()
+	for _, err := range errs {
+		t.Errorf("unexpected prune error: %v", err)
+	}
+
+	if updatedGroups := extractUpdatedGroups(tc); len(updatedGroups) != 1 {
+		t.Errorf("expected one group update, got: %v", updatedGroups)
+	}
+	if updatedUsers := extractUpdatedUsers(tc); len(updatedUsers) != 0 {
+		t.Errorf("expected no user updates for a user still present upstream, got: %v", updatedUsers)
+	}
+}
+
+func TestPruneMembersDryRun(t *testing.T) {
+	testMemberPruner, tc := newTestMemberPruner()
+	testMemberPruner.GroupMemberExtractor.(*TestGroupMemberExtractor).MemberMapping[Group3UID] = []*ldap.Entry{Member3}
+	testMemberPruner.DeactivateUsers = true
+	testMemberPruner.DryRun = true
+
+	errs := testMemberPruner.Prune()
+	for _, err := range errs {
+		t.Errorf("unexpected prune error: %v", err)
+	}
+
+	if updatedGroups := extractUpdatedGroups(tc); len(updatedGroups) != 0 {
+		t.Errorf("expected no group updates during dry run, got: %v", updatedGroups)
+	}
+	if updatedUsers := extractUpdatedUsers(tc); len(updatedUsers) != 0 {
+		t.Errorf("expected no user updates during dry run, got: %v", updatedUsers)
+	}
+}
+
+func extractUpdatedGroups(tc *testclient.Fake) []*userapi.Group {
+	ret := []*userapi.Group{}
+	for _, genericAction := range tc.Actions() {
+		if action, ok := genericAction.(core.UpdateAction); ok {
+			if group, ok := action.GetObject().(*userapi.Group); ok {
+				ret = append(ret, group)
+			}
+		}
+	}
+
+	return ret
+}
+
+func extractUpdatedUsers(tc *testclient.Fake) []*userapi.User {
+	ret := []*userapi.User{}
+	for _, genericAction := range tc.Actions() {
+		if action, ok := genericAction.(core.UpdateAction); ok {
+			if user, ok := action.GetObject().(*userapi.User); ok {
+				ret = append(ret, user)
+			}
+		}
+	}
+
+	return ret
+}
+
+func newTestMemberPruner() (*LDAPGroupMemberPruner, *testclient.Fake) {
+	startingObjects := []runtime.Object{}
+	for _, group := range newDefaultOpenShiftGroups(newTestHost()) {
+		startingObjects = append(startingObjects, group)
+	}
+	for _, username := range []string{Member1UID, Member2UID, Member3UID, Member4UID} {
+		startingObjects = append(startingObjects, &userapi.User{ObjectMeta: kapi.ObjectMeta{Name: username}})
+	}
+
+	tc := testclient.NewSimpleFake(startingObjects...)
+	tc.PrependReactor("update", "groups", func(action core.Action) (handled bool, ret runtime.Object, err error) {
+		updateAction := action.(core.UpdateAction)
+		return true, updateAction.GetObject(), nil
+	})
+	tc.PrependReactor("update", "users", func(action core.Action) (handled bool, ret runtime.Object, err error) {
+		updateAction := action.(core.UpdateAction)
+		return true, updateAction.GetObject(), nil
+	})
+
+	return &LDAPGroupMemberPruner{
+		GroupLister:          newTestLister(),
+		GroupMemberExtractor: newTestMemberExtractor(),
+		UserNameMapper:       newTestUserNameMapper(),
+		GroupNameMapper:      newTestGroupNameMapper(),
+		GroupClient:          tc.Groups(),
+		UserClient:           tc.Users(),
+		Host:                 newTestHost(),
+		ProtectedUsers:       sets.NewString(),
+		Out:                  ioutil.Discard,
+		Err:                  ioutil.Discard,
+	}, tc
+
+}
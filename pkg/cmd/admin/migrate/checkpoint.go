@@ -0,0 +1,164 @@
+package migrate
+
+import (
+	"sync"
+	"time"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/api/errors"
+	kcoreclient "k8s.io/kubernetes/pkg/client/clientset_generated/internalclientset/typed/core/internalversion"
+)
+
+const (
+	// checkpointNamespace is the namespace the checkpoint config map is stored in.
+	checkpointNamespace = "openshift-infra"
+	// checkpointFlushInterval controls how often accumulated progress is written
+	// back to the config map during a run.
+	checkpointFlushInterval = 30 * time.Second
+	// checkpointKeyPrefix marks a value as the last successfully migrated key for
+	// a resource. Keys are NAMESPACE/NAME for namespaced resources or NAME for
+	// cluster scoped resources, matching --from-key / --to-key semantics.
+	checkpointKeyPrefix = "at:"
+)
+
+// checkpointer persists per-resource migration progress in a config map so that
+// an interrupted run can be resumed with --resume. Because workers may complete
+// items slightly out of order, the recorded key is a high water mark - resuming
+// may re-migrate a small number of objects near the boundary, which is safe
+// because migration is idempotent.
+type checkpointer struct {
+	client kcoreclient.ConfigMapsGetter
+	name   string
+
+	lock  sync.Mutex
+	state map[string]string
+	dirty bool
+
+	stopCh chan struct{}
+}
+
+func newCheckpointer(client kcoreclient.ConfigMapsGetter, name string) *checkpointer {
+	return &checkpointer{
+		client: client,
+		name:   name,
+		state:  make(map[string]string),
+	}
+}
+
+// Load reads any previously stored checkpoint. A missing config map is not an
+// error - it simply means no previous run left progress behind.
+func (c *checkpointer) Load() error {
+	cm, err := c.client.ConfigMaps(checkpointNamespace).Get(c.name)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	for k, v := range cm.Data {
+		c.state[k] = v
+	}
+	return nil
+}
+
+// LastKey returns the last recorded key for the provided resource, or the
+// empty string if no progress has been recorded.
+func (c *checkpointer) LastKey(resource string) string {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	v := c.state[resource]
+	if len(v) > len(checkpointKeyPrefix) {
+		return v[len(checkpointKeyPrefix):]
+	}
+	return ""
+}
+
+// Record advances the high water mark for the provided resource. Keys are
+// visited in etcd order, so only forward movement is recorded.
+func (c *checkpointer) Record(resource, key string) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if key <= c.LastKeyLocked(resource) {
+		return
+	}
+	c.state[resource] = checkpointKeyPrefix + key
+	c.dirty = true
+}
+
+// LastKeyLocked is LastKey for callers already holding the lock.
+func (c *checkpointer) LastKeyLocked(resource string) string {
+	v := c.state[resource]
+	if len(v) > len(checkpointKeyPrefix) {
+		return v[len(checkpointKeyPrefix):]
+	}
+	return ""
+}
+
+// Start begins periodically flushing recorded progress until Stop is called.
+func (c *checkpointer) Start() {
+	c.stopCh = make(chan struct{})
+	go func() {
+		ticker := time.NewTicker(checkpointFlushInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				c.Flush()
+			case <-c.stopCh:
+				return
+			}
+		}
+	}()
+}
+
+// Stop halts periodic flushing and performs a final flush.
+func (c *checkpointer) Stop() {
+	if c.stopCh != nil {
+		close(c.stopCh)
+		c.stopCh = nil
+	}
+	c.Flush()
+}
+
+// Flush writes any accumulated progress to the config map, creating it if
+// necessary. Errors are returned but a failed flush only loses resumability,
+// not migration correctness.
+func (c *checkpointer) Flush() error {
+	c.lock.Lock()
+	if !c.dirty {
+		c.lock.Unlock()
+		return nil
+	}
+	data := make(map[string]string, len(c.state))
+	for k, v := range c.state {
+		data[k] = v
+	}
+	c.dirty = false
+	c.lock.Unlock()
+
+	cm, err := c.client.ConfigMaps(checkpointNamespace).Get(c.name)
+	if err != nil {
+		if !errors.IsNotFound(err) {
+			return err
+		}
+		_, err = c.client.ConfigMaps(checkpointNamespace).Create(&kapi.ConfigMap{
+			ObjectMeta: kapi.ObjectMeta{Namespace: checkpointNamespace, Name: c.name},
+			Data:       data,
+		})
+		return err
+	}
+	cm.Data = data
+	_, err = c.client.ConfigMaps(checkpointNamespace).Update(cm)
+	return err
+}
+
+// Clear removes the checkpoint config map after a fully successful run.
+func (c *checkpointer) Clear() error {
+	err := c.client.ConfigMaps(checkpointNamespace).Delete(c.name, nil)
+	if errors.IsNotFound(err) {
+		return nil
+	}
+	return err
+}
@@ -0,0 +1,219 @@
+package identities
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/spf13/cobra"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+	kerrors "k8s.io/kubernetes/pkg/api/errors"
+	kcmdutil "k8s.io/kubernetes/pkg/kubectl/cmd/util"
+	"k8s.io/kubernetes/pkg/kubectl/resource"
+	"k8s.io/kubernetes/pkg/runtime"
+	"k8s.io/kubernetes/pkg/util/sets"
+
+	"github.com/openshift/origin/pkg/auth/userregistry/identitymapper"
+	"github.com/openshift/origin/pkg/client"
+	"github.com/openshift/origin/pkg/cmd/admin/migrate"
+	"github.com/openshift/origin/pkg/cmd/templates"
+	"github.com/openshift/origin/pkg/cmd/util/clientcmd"
+	userapi "github.com/openshift/origin/pkg/user/api"
+)
+
+var (
+	internalMigrateIdentitiesLong = templates.LongDesc(`
+		Re-map identities to users derived from a user name mapping
+
+		This command recomputes the user name for existing identities using the same
+		claim template, lowercase and prefix options accepted by the identity provider
+		userNameMapping configuration, and re-points identities whose current user does
+		not match. It is intended to be run after changing the user name mapping of an
+		identity provider so existing logins land on the newly derived users.
+
+		For each identity that maps to a different user name, the target user is created
+		if needed, the identity is added to it, and the identity is removed from its
+		previous user. The previous user itself is not deleted. The default is a dry-run;
+		pass --confirm to apply the changes.`)
+
+	internalMigrateIdentitiesExample = templates.Examples(`
+		# Show which identities of the "corp_ldap" provider would be re-mapped to lower case user names
+	  %[1]s --provider=corp_ldap --lowercase
+
+	  # Re-map identities to user names derived from their email claim
+	  %[1]s --provider=corp_sso --template='{email}' --confirm`)
+)
+
+type MigrateIdentityOptions struct {
+	migrate.ResourceOptions
+
+	// Provider limits migration to identities of the named identity provider.
+	Provider string
+	// Template, Lowercase and Prefix mirror the identity provider userNameMapping
+	// configuration options.
+	Template  string
+	Lowercase bool
+	Prefix    string
+
+	Client  client.Interface
+	mapping *identitymapper.UserNameMapping
+}
+
+// NewCmdMigrateIdentities implements a MigrateIdentities command
+func NewCmdMigrateIdentities(name, fullName string, f *clientcmd.Factory, in io.Reader, out, errout io.Writer) *cobra.Command {
+	options := &MigrateIdentityOptions{
+		ResourceOptions: migrate.ResourceOptions{
+			In:      in,
+			Out:     out,
+			ErrOut:  errout,
+			Include: []string{"identities"},
+		},
+	}
+	cmd := &cobra.Command{
+		Use:     name,
+		Short:   "Re-map identities to users derived from a user name mapping",
+		Long:    internalMigrateIdentitiesLong,
+		Example: fmt.Sprintf(internalMigrateIdentitiesExample, fullName),
+		Run: func(cmd *cobra.Command, args []string) {
+			kcmdutil.CheckErr(options.Complete(f, cmd, args))
+			kcmdutil.CheckErr(options.Validate())
+			kcmdutil.CheckErr(options.Run())
+		},
+	}
+	options.ResourceOptions.Bind(cmd)
+	cmd.Flags().StringVar(&options.Provider, "provider", options.Provider, "Only migrate identities of this identity provider")
+	cmd.Flags().StringVar(&options.Template, "template", options.Template, "Derive user names from this claim template, e.g. '{email}'")
+	cmd.Flags().BoolVar(&options.Lowercase, "lowercase", options.Lowercase, "Force derived user names to lower case")
+	cmd.Flags().StringVar(&options.Prefix, "prefix", options.Prefix, "Prepend this prefix to derived user names")
+
+	return cmd
+}
+
+func (o *MigrateIdentityOptions) Complete(f *clientcmd.Factory, c *cobra.Command, args []string) error {
+	if len(args) > 0 {
+		return fmt.Errorf("no arguments are supported")
+	}
+
+	o.mapping = &identitymapper.UserNameMapping{
+		Template:  o.Template,
+		Lowercase: o.Lowercase,
+		Prefix:    o.Prefix,
+	}
+
+	o.ResourceOptions.SaveFn = o.save
+	if err := o.ResourceOptions.Complete(f, c); err != nil {
+		return err
+	}
+
+	osclient, _, err := f.Clients()
+	if err != nil {
+		return err
+	}
+	o.Client = osclient
+
+	return nil
+}
+
+func (o MigrateIdentityOptions) Validate() error {
+	if !identitymapper.IsValidUserNameTemplate(o.Template) {
+		return fmt.Errorf("--template claim tokens must be of the form {claim} with balanced braces")
+	}
+	if len(o.Template) == 0 && !o.Lowercase && len(o.Prefix) == 0 {
+		return fmt.Errorf("at least one of --template, --lowercase or --prefix must be specified")
+	}
+	return o.ResourceOptions.Validate()
+}
+
+func (o MigrateIdentityOptions) Run() error {
+	return o.ResourceOptions.Visitor().Visit(func(info *resource.Info) (migrate.Reporter, error) {
+		return o.transform(info.Object)
+	})
+}
+
+// transform reports whether the identity maps to a different user name under the
+// configured user name mapping.
+func (o *MigrateIdentityOptions) transform(obj runtime.Object) (migrate.Reporter, error) {
+	identity, ok := obj.(*userapi.Identity)
+	if !ok {
+		return nil, nil
+	}
+	if len(o.Provider) > 0 && identity.ProviderName != o.Provider {
+		return reporter(false), nil
+	}
+	return reporter(o.mapping.UserNameFor(identity) != identity.User.Name), nil
+}
+
+// reporter implements the Reporter interface for a boolean.
+type reporter bool
+
+func (r reporter) Changed() bool {
+	return bool(r)
+}
+
+// save re-points the identity at the user with the derived name, creating the
+// user if needed and removing the identity from its previous user.
+func (o *MigrateIdentityOptions) save(info *resource.Info, reporter migrate.Reporter) error {
+	identity, ok := info.Object.(*userapi.Identity)
+	if !ok {
+		return fmt.Errorf("unrecognized object %#v", info.Object)
+	}
+
+	desiredUserName := o.mapping.UserNameFor(identity)
+	previousUserName := identity.User.Name
+
+	// get or create the target user, making sure it references the identity
+	user, err := o.Client.Users().Get(desiredUserName)
+	switch {
+	case kerrors.IsNotFound(err):
+		user, err = o.Client.Users().Create(&userapi.User{
+			ObjectMeta: kapi.ObjectMeta{Name: desiredUserName},
+			Identities: []string{identity.Name},
+		})
+		if err != nil {
+			return migrate.DefaultRetriable(info, err)
+		}
+	case err != nil:
+		return migrate.DefaultRetriable(info, err)
+	default:
+		if !sets.NewString(user.Identities...).Has(identity.Name) {
+			user.Identities = append(user.Identities, identity.Name)
+			user, err = o.Client.Users().Update(user)
+			if err != nil {
+				return migrate.DefaultRetriable(info, err)
+			}
+		}
+	}
+
+	// re-point the identity at the target user
+	identity.User = kapi.ObjectReference{Name: user.Name, UID: user.UID}
+	updated, err := o.Client.Identities().Update(identity)
+	if err != nil {
+		return migrate.DefaultRetriable(info, err)
+	}
+	info.Refresh(updated, true)
+
+	// remove the identity from the previous user, if any
+	if len(previousUserName) > 0 && previousUserName != desiredUserName {
+		previousUser, err := o.Client.Users().Get(previousUserName)
+		if err != nil {
+			if kerrors.IsNotFound(err) {
+				return nil
+			}
+			return err
+		}
+		remaining := []string{}
+		for _, name := range previousUser.Identities {
+			if name != identity.Name {
+				remaining = append(remaining, name)
+			}
+		}
+		if len(remaining) != len(previousUser.Identities) {
+			previousUser.Identities = remaining
+			if _, err := o.Client.Users().Update(previousUser); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
@@ -4,6 +4,8 @@ import (
 	"fmt"
 	"io"
 	"strings"
+	"sync"
+	"time"
 
 	"github.com/golang/glog"
 	"github.com/spf13/cobra"
@@ -13,6 +15,7 @@ import (
 	"k8s.io/kubernetes/pkg/api/unversioned"
 	"k8s.io/kubernetes/pkg/kubectl"
 	"k8s.io/kubernetes/pkg/kubectl/resource"
+	"k8s.io/kubernetes/pkg/util/flowcontrol"
 	"k8s.io/kubernetes/pkg/util/sets"
 
 	cmdutil "github.com/openshift/origin/pkg/cmd/util"
@@ -60,6 +63,19 @@ type ResourceOptions struct {
 	FilterFn  MigrateFilterFunc
 	DryRun    bool
 	Summarize bool
+
+	// Workers is the number of parallel workers used to process objects.
+	Workers int
+	// RateLimit bounds the total number of objects processed per second across
+	// all workers. Zero means no limit.
+	RateLimit float32
+	// Resume skips objects recorded as migrated by a previous interrupted run.
+	Resume bool
+	// CheckpointName, when set by a command, enables storing per-resource
+	// progress in a config map of that name so runs can be resumed.
+	CheckpointName string
+
+	checkpoint *checkpointer
 }
 
 func (o *ResourceOptions) Bind(c *cobra.Command) {
@@ -71,6 +87,9 @@ func (o *ResourceOptions) Bind(c *cobra.Command) {
 	c.Flags().StringVar(&o.FromKey, "from-key", o.FromKey, "If specified, only migrate items with a key (namespace/name or name) greater than or equal to this value")
 	c.Flags().StringVar(&o.ToKey, "to-key", o.ToKey, "If specified, only migrate items with a key (namespace/name or name) less than this value")
 
+	c.Flags().IntVar(&o.Workers, "workers", o.Workers, "The number of parallel workers to use. Defaults to 1.")
+	c.Flags().Float32Var(&o.RateLimit, "rate-limit", o.RateLimit, "The maximum number of objects to process per second across all workers. Zero means no limit.")
+
 	usage := "Filename, directory, or URL to docker-compose.yml file to use"
 	kubectl.AddJsonFilenameFlag(c, &o.Filenames, usage)
 	c.MarkFlagRequired("filename")
@@ -131,12 +150,41 @@ func (o *ResourceOptions) Complete(f *clientcmd.Factory, c *cobra.Command) error
 		}
 	}
 
-	oclient, _, err := f.Clients()
+	oclient, kclient, err := f.Clients()
 	if err != nil {
 		return err
 	}
 	mapper, _ := f.Object()
 
+	if len(o.CheckpointName) > 0 {
+		o.checkpoint = newCheckpointer(kclient.Core(), o.CheckpointName)
+		if o.Resume {
+			if err := o.checkpoint.Load(); err != nil {
+				return fmt.Errorf("unable to load the migration checkpoint: %v", err)
+			}
+			// skip anything at or below the recorded high water mark for its resource
+			previousFilter := o.FilterFn
+			o.FilterFn = func(info *resource.Info) (bool, error) {
+				last := o.checkpoint.LastKey(info.Mapping.Resource)
+				if len(last) > 0 {
+					var key string
+					if info.Mapping.Scope.Name() == meta.RESTScopeNameNamespace {
+						key = info.Namespace + "/" + info.Name
+					} else {
+						key = info.Name
+					}
+					if key <= last {
+						return false, nil
+					}
+				}
+				if previousFilter != nil {
+					return previousFilter(info)
+				}
+				return true, nil
+			}
+		}
+	}
+
 	resourceNames := sets.NewString()
 	for i, s := range o.Include {
 		if resourceNames.Has(s) {
@@ -217,17 +265,29 @@ func (o *ResourceOptions) Validate() error {
 	if len(o.Filenames) == 0 && len(o.Include) == 0 {
 		return fmt.Errorf("you must specify at least one resource or resource type to migrate with --include or --filenames")
 	}
+	if o.Workers < 0 {
+		return fmt.Errorf("--workers must not be negative")
+	}
+	if o.RateLimit < 0 {
+		return fmt.Errorf("--rate-limit must not be negative")
+	}
+	if o.Resume && o.checkpoint == nil {
+		return fmt.Errorf("--resume is not supported by this command")
+	}
 	return nil
 }
 
 func (o *ResourceOptions) Visitor() *ResourceVisitor {
 	return &ResourceVisitor{
-		Out:      o.Out,
-		Builder:  o.Builder,
-		SaveFn:   o.SaveFn,
-		PrintFn:  o.PrintFn,
-		FilterFn: o.FilterFn,
-		DryRun:   o.DryRun,
+		Out:        o.Out,
+		Builder:    o.Builder,
+		SaveFn:     o.SaveFn,
+		PrintFn:    o.PrintFn,
+		FilterFn:   o.FilterFn,
+		DryRun:     o.DryRun,
+		Workers:    o.Workers,
+		RateLimit:  o.RateLimit,
+		Checkpoint: o.checkpoint,
 	}
 }
 
@@ -241,6 +301,10 @@ type ResourceVisitor struct {
 	FilterFn MigrateFilterFunc
 
 	DryRun bool
+
+	Workers    int
+	RateLimit  float32
+	Checkpoint *checkpointer
 }
 
 func (o *ResourceVisitor) Visit(fn MigrateVisitFunc) error {
@@ -271,31 +335,87 @@ func (o *ResourceVisitor) Visit(fn MigrateVisitFunc) error {
 		actionFn:  actionFn,
 		dryRun:    dryRun,
 
+		startedAt: time.Now(),
+
+		checkpoint: o.Checkpoint,
+
 		resourcesWithErrors: sets.NewString(),
 	}
 
+	var limiter flowcontrol.RateLimiter
+	if o.RateLimit > 0 {
+		limiter = flowcontrol.NewTokenBucketRateLimiter(o.RateLimit, int(o.RateLimit)+1)
+		defer limiter.Stop()
+	}
+	workers := o.Workers
+	if workers < 1 {
+		workers = 1
+	}
+
+	work := make(chan *resource.Info, 2*workers)
+	wg := &sync.WaitGroup{}
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for info := range work {
+				if limiter != nil {
+					limiter.Accept()
+				}
+				t.attempt(info, 10)
+			}
+		}()
+	}
+
+	progressDone := make(chan struct{})
+	go func() {
+		ticker := time.NewTicker(10 * time.Second)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				t.progress()
+			case <-progressDone:
+				return
+			}
+		}
+	}()
+
+	if o.Checkpoint != nil && !dryRun {
+		o.Checkpoint.Start()
+	}
+
 	err := result.Visit(func(info *resource.Info, err error) error {
 		if err == nil && o.FilterFn != nil {
 			var ok bool
-			t.found++
+			t.foundOne()
 			if ok, err = o.FilterFn(info); err == nil && !ok {
-				t.ignored++
-				if glog.V(2) {
-					t.report("ignored:", info, nil)
-				}
+				t.ignoredOne(info)
 				return nil
 			}
 		}
 		if err != nil {
-			t.resourcesWithErrors.Insert(info.Mapping.Resource)
-			t.errors++
-			t.report("error:", info, err)
+			t.erroredOne(info, err)
 			return nil
 		}
-		t.attempt(info, 10)
+		work <- info
 		return nil
 	})
 
+	close(work)
+	wg.Wait()
+	close(progressDone)
+
+	if o.Checkpoint != nil && !dryRun {
+		o.Checkpoint.Stop()
+		// a fully successful run leaves nothing to resume from
+		if err == nil && t.errors == 0 {
+			if clearErr := o.Checkpoint.Clear(); clearErr != nil {
+				glog.V(2).Infof("unable to remove the migration checkpoint: %v", clearErr)
+			}
+		}
+	}
+
 	if summarize {
 		if dryRun {
 			fmt.Fprintf(out, "summary (dry run): total=%d errors=%d ignored=%d unchanged=%d migrated=%d\n", t.found, t.errors, t.ignored, t.unchanged, t.found-t.errors-t.unchanged-t.ignored)
@@ -361,21 +481,26 @@ const (
 )
 
 // migrateTracker abstracts transforming and saving resources and can be used to keep track
-// of how many total resources have been updated.
+// of how many total resources have been updated. Its counters and output are guarded by a
+// lock so that multiple workers may process items concurrently.
 type migrateTracker struct {
 	out       io.Writer
 	migrateFn MigrateVisitFunc
 	actionFn  MigrateActionFunc
 	dryRun    bool
 
-	found, ignored, unchanged, errors int
-	retries                           int
+	startedAt time.Time
+
+	checkpoint *checkpointer
+
+	lock                                         sync.Mutex
+	found, processed, ignored, unchanged, errors int
 
 	resourcesWithErrors sets.String
 }
 
 // report prints a message to out that includes info about the current resource. If the optional error is
-// provided it will be written as well.
+// provided it will be written as well. Callers must hold the tracker lock.
 func (t *migrateTracker) report(prefix string, info *resource.Info, err error) {
 	ns := info.Namespace
 	if len(ns) > 0 {
@@ -388,17 +513,66 @@ func (t *migrateTracker) report(prefix string, info *resource.Info, err error) {
 	}
 }
 
+// foundOne records that an item was returned by the server.
+func (t *migrateTracker) foundOne() {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	t.found++
+}
+
+// ignoredOne records that an item was filtered out prior to processing.
+func (t *migrateTracker) ignoredOne(info *resource.Info) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	t.ignored++
+	if glog.V(2) {
+		t.report("ignored:", info, nil)
+	}
+}
+
+// erroredOne records that an item could not be retrieved from the server.
+func (t *migrateTracker) erroredOne(info *resource.Info, err error) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	t.resourcesWithErrors.Insert(info.Mapping.Resource)
+	t.errors++
+	t.report("error:", info, err)
+}
+
+// progress prints a single line describing the rate of the migration and, once the listers
+// have run ahead of the workers, an estimate of the remaining time.
+func (t *migrateTracker) progress() {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	elapsed := time.Since(t.startedAt)
+	if elapsed <= 0 || t.processed == 0 {
+		return
+	}
+	rate := float64(t.processed) / elapsed.Seconds()
+	remaining := t.found - t.ignored - t.processed
+	if remaining > 0 && rate > 0 {
+		eta := time.Duration(float64(remaining)/rate) * time.Second
+		fmt.Fprintf(t.out, "progress: %d processed, %d errors, %.1f/s, %s elapsed, about %s remaining\n", t.processed, t.errors, rate, elapsed.Truncate(time.Second), eta)
+		return
+	}
+	fmt.Fprintf(t.out, "progress: %d processed, %d errors, %.1f/s, %s elapsed\n", t.processed, t.errors, rate, elapsed.Truncate(time.Second))
+}
+
 // attempt will try to invoke the migrateFn and saveFn on info, retrying any recalculation requests up
 // to retries times.
 func (t *migrateTracker) attempt(info *resource.Info, retries int) {
+	result, err := t.try(info, retries)
+
+	t.lock.Lock()
+	defer t.lock.Unlock()
 	t.found++
-	t.retries = retries
-	result, err := t.try(info)
+	t.processed++
 	switch {
 	case err != nil:
 		t.resourcesWithErrors.Insert(info.Mapping.Resource)
 		t.errors++
 		t.report("error:", info, err)
+		return
 	case result == attemptResultIgnore:
 		t.ignored++
 		if glog.V(2) {
@@ -418,11 +592,20 @@ func (t *migrateTracker) attempt(info *resource.Info, retries int) {
 			}
 		}
 	}
+	if t.checkpoint != nil && !t.dryRun {
+		var key string
+		if info.Mapping.Scope.Name() == meta.RESTScopeNameNamespace {
+			key = info.Namespace + "/" + info.Name
+		} else {
+			key = info.Name
+		}
+		t.checkpoint.Record(info.Mapping.Resource, key)
+	}
 }
 
 // try will mutate the info and attempt to save, recalculating if there are any retries left.
 // The result of the attempt or an error will be returned.
-func (t *migrateTracker) try(info *resource.Info) (attemptResult, error) {
+func (t *migrateTracker) try(info *resource.Info, retries int) (attemptResult, error) {
 	reporter, err := t.migrateFn(info)
 	if err != nil {
 		return attemptResultError, err
@@ -439,11 +622,13 @@ func (t *migrateTracker) try(info *resource.Info) (attemptResult, error) {
 				return attemptResultUnchanged, nil
 			}
 			if canRetry(err) {
-				if t.retries > 0 {
+				if retries > 0 {
 					if glog.V(1) && err != ErrRecalculate {
+						t.lock.Lock()
 						t.report("retry:", info, err)
+						t.lock.Unlock()
 					}
-					result, err := t.try(info)
+					result, err := t.try(info, retries-1)
 					switch result {
 					case attemptResultUnchanged, attemptResultIgnore:
 						result = attemptResultSuccess
@@ -37,6 +37,11 @@ var (
 		By default, events are not migrated since they expire within a very short period of time. If you
 		have significantly increased the expiration time of events, run a migration with --include=events
 
+		Multiple objects may be migrated in parallel by passing --workers, and the total request rate
+		may be bounded with --rate-limit. When changes are being persisted the command records its
+		per-resource progress in a config map, and an interrupted run may be continued by passing
+		--resume. A fully successful run removes the recorded progress.
+
 		WARNING: This is a slow command and will put significant load on an API server. It may also
 		result in significant intra-cluster traffic.`)
 
@@ -51,7 +56,13 @@ var (
 	  %[1]s --include=pods --confirm
 
 	  # Only pods that are in namespaces starting with "bar"
-	  %[1]s --include=pods --confirm --from-key=bar/ --to-key=bar/\xFF`)
+	  %[1]s --include=pods --confirm --from-key=bar/ --to-key=bar/\xFF
+
+	  # Migrate all objects using ten workers, limited to fifty updates per second
+	  %[1]s --confirm --workers=10 --rate-limit=50
+
+	  # Continue a previously interrupted migration
+	  %[1]s --confirm --workers=10 --resume`)
 )
 
 type MigrateAPIStorageOptions struct {
@@ -66,7 +77,9 @@ func NewCmdMigrateAPIStorage(name, fullName string, f *clientcmd.Factory, in io.
 			Out:    out,
 			ErrOut: errout,
 
-			Include: []string{"*"},
+			Include:        []string{"*"},
+			Workers:        1,
+			CheckpointName: "migrate-storage",
 			DefaultExcludes: []unversioned.GroupResource{
 				// openshift resources:
 				{Resource: "appliedclusterresourcequotas"},
@@ -168,6 +181,7 @@ func NewCmdMigrateAPIStorage(name, fullName string, f *clientcmd.Factory, in io.
 		},
 	}
 	options.ResourceOptions.Bind(cmd)
+	cmd.Flags().BoolVar(&options.Resume, "resume", false, "If true, skip objects already recorded as migrated by a previous interrupted run.")
 
 	return cmd
 }
@@ -0,0 +1,136 @@
+package stripmetadata
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/ghodss/yaml"
+	"github.com/spf13/cobra"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/api/unversioned"
+	kcmdutil "k8s.io/kubernetes/pkg/kubectl/cmd/util"
+	"k8s.io/kubernetes/pkg/kubectl/resource"
+	"k8s.io/kubernetes/pkg/runtime"
+
+	"github.com/openshift/origin/pkg/cmd/admin/migrate"
+	"github.com/openshift/origin/pkg/cmd/templates"
+	"github.com/openshift/origin/pkg/cmd/util/clientcmd"
+)
+
+var (
+	internalMigrateStripMetadataLong = templates.LongDesc(`
+		Print API objects with cluster-specific metadata removed
+
+		This command retrieves API objects reachable by the caller and prints them with the
+		fields that tie them to this particular cluster removed - UID, resource version,
+		self link, creation timestamp, generation, and status. The output is suitable for
+		re-creating the objects in another project or cluster and replaces the deprecated
+		'oc export' workflow.
+
+		To operate on a subset of resources, use the --include flag. Use --all-namespaces to
+		strip objects across every namespace the caller can view.`)
+
+	internalMigrateStripMetadataExample = templates.Examples(`
+		# Print all deployment configs in the current project without cluster metadata
+	  %[1]s --include=deploymentconfigs
+
+	  # Print routes and services ready for import into another cluster
+	  %[1]s --include=routes,services`)
+)
+
+type MigrateStripMetadataOptions struct {
+	migrate.ResourceOptions
+
+	// KeepNamespace preserves the namespace field on the printed objects.
+	KeepNamespace bool
+}
+
+// NewCmdMigrateStripMetadata implements a MigrateStripMetadata command
+func NewCmdMigrateStripMetadata(name, fullName string, f *clientcmd.Factory, in io.Reader, out, errout io.Writer) *cobra.Command {
+	options := &MigrateStripMetadataOptions{
+		ResourceOptions: migrate.ResourceOptions{
+			In:     in,
+			Out:    out,
+			ErrOut: errout,
+
+			Include: []string{"deploymentconfigs", "buildconfigs", "imagestreams", "services", "routes", "configmaps", "serviceaccounts", "secrets"},
+		},
+	}
+	cmd := &cobra.Command{
+		Use:     name,
+		Short:   "Print API objects with cluster-specific metadata removed",
+		Long:    internalMigrateStripMetadataLong,
+		Example: fmt.Sprintf(internalMigrateStripMetadataExample, fullName),
+		Run: func(cmd *cobra.Command, args []string) {
+			kcmdutil.CheckErr(options.Complete(f, cmd, args))
+			kcmdutil.CheckErr(options.Validate())
+			kcmdutil.CheckErr(options.Run())
+		},
+	}
+	options.ResourceOptions.Bind(cmd)
+	cmd.Flags().BoolVar(&options.KeepNamespace, "keep-namespace", false, "Preserve the namespace field on the printed objects")
+
+	return cmd
+}
+
+func (o *MigrateStripMetadataOptions) Complete(f *clientcmd.Factory, c *cobra.Command, args []string) error {
+	o.ResourceOptions.PrintFn = o.print
+	if err := o.ResourceOptions.Complete(f, c); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (o MigrateStripMetadataOptions) Validate() error {
+	return o.ResourceOptions.Validate()
+}
+
+func (o MigrateStripMetadataOptions) Run() error {
+	return o.ResourceOptions.Visitor().Visit(func(info *resource.Info) (migrate.Reporter, error) {
+		return o.transform(info.Object)
+	})
+}
+
+// transform removes the cluster-specific metadata of the provided object in place.
+func (o *MigrateStripMetadataOptions) transform(obj runtime.Object) (migrate.Reporter, error) {
+	meta, err := kapi.ObjectMetaFor(obj)
+	if err != nil {
+		return nil, err
+	}
+	meta.UID = ""
+	meta.ResourceVersion = ""
+	meta.SelfLink = ""
+	meta.CreationTimestamp = unversioned.Time{}
+	meta.DeletionTimestamp = nil
+	meta.Generation = 0
+	if !o.KeepNamespace {
+		meta.Namespace = ""
+	}
+	delete(meta.Annotations, "kubectl.kubernetes.io/last-applied-configuration")
+	return reporter(true), nil
+}
+
+// print writes the transformed object to the output stream as a YAML document.
+func (o *MigrateStripMetadataOptions) print(info *resource.Info, _ migrate.Reporter) error {
+	data, err := runtime.Encode(kapi.Codecs.LegacyCodec(info.Mapping.GroupVersionKind.GroupVersion()), info.Object)
+	if err != nil {
+		return err
+	}
+	out, err := yaml.JSONToYAML(data)
+	if err != nil {
+		return err
+	}
+	if _, err := fmt.Fprintln(o.Out, "---"); err != nil {
+		return err
+	}
+	_, err = o.Out.Write(out)
+	return err
+}
+
+// reporter implements the Reporter interface for a boolean.
+type reporter bool
+
+func (r reporter) Changed() bool {
+	return bool(r)
+}
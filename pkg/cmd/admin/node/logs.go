@@ -0,0 +1,134 @@
+package node
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+
+	"github.com/spf13/cobra"
+
+	kclientset "k8s.io/kubernetes/pkg/client/clientset_generated/internalclientset"
+	kcmdutil "k8s.io/kubernetes/pkg/kubectl/cmd/util"
+
+	"github.com/openshift/origin/pkg/cmd/templates"
+	"github.com/openshift/origin/pkg/cmd/util/clientcmd"
+)
+
+const NodeLogsRecommendedCommandName = "node-logs"
+
+var (
+	nodeLogsLong = templates.LongDesc(`
+		Display and filter node logs
+
+		This command retrieves journald logs from a node through the API server node proxy,
+		so administrators can debug node services without SSH access. Access is controlled
+		by the ability to access the node proxy subresource.`)
+
+	nodeLogsExample = templates.Examples(`
+		# Get the node service logs from a node
+	  %[1]s <mynode> --unit=atomic-openshift-node
+
+	  # Get the last 100 docker log entries from a node
+	  %[1]s <mynode> --unit=docker --tail=100
+
+	  # Stream kernel messages from a node since one hour ago
+	  %[1]s <mynode> --since=-1h --follow`)
+)
+
+// NodeLogsOptions holds values for the node-logs command
+type NodeLogsOptions struct {
+	NodeName string
+	Units    []string
+	Since    string
+	Until    string
+	Tail     int
+	Follow   bool
+
+	KubeClient kclientset.Interface
+	Out        io.Writer
+}
+
+// NewCmdNodeLogs implements the node-logs command
+func NewCmdNodeLogs(name, fullName string, f *clientcmd.Factory, out io.Writer) *cobra.Command {
+	options := &NodeLogsOptions{}
+
+	cmd := &cobra.Command{
+		Use:     fmt.Sprintf("%s NODE", name),
+		Short:   "Display and filter node logs",
+		Long:    nodeLogsLong,
+		Example: fmt.Sprintf(nodeLogsExample, fullName),
+		Run: func(c *cobra.Command, args []string) {
+			kcmdutil.CheckErr(options.Complete(f, c, args, out))
+			kcmdutil.CheckErr(options.Validate())
+			kcmdutil.CheckErr(options.RunNodeLogs())
+		},
+	}
+
+	cmd.Flags().StringSliceVar(&options.Units, "unit", options.Units, "Return log entries from the specified unit(s)")
+	cmd.Flags().StringVar(&options.Since, "since", options.Since, "Return log entries on or after the specified date, in journalctl date format")
+	cmd.Flags().StringVar(&options.Until, "until", options.Until, "Return log entries on or before the specified date, in journalctl date format")
+	cmd.Flags().IntVar(&options.Tail, "tail", options.Tail, "Return up to this many of the most recent log entries")
+	cmd.Flags().BoolVarP(&options.Follow, "follow", "f", options.Follow, "Stream log entries as they are appended to the journal")
+
+	return cmd
+}
+
+// Complete fills in missing values from the factory and arguments
+func (o *NodeLogsOptions) Complete(f *clientcmd.Factory, cmd *cobra.Command, args []string, out io.Writer) error {
+	if len(args) != 1 {
+		return kcmdutil.UsageError(cmd, "a node name is required")
+	}
+	o.NodeName = args[0]
+	o.Out = out
+
+	_, kc, err := f.Clients()
+	if err != nil {
+		return err
+	}
+	o.KubeClient = kc
+
+	return nil
+}
+
+// Validate ensures the provided flags make sense
+func (o *NodeLogsOptions) Validate() error {
+	if o.Tail < 0 {
+		return errors.New("--tail must not be negative")
+	}
+	return nil
+}
+
+// RunNodeLogs retrieves the journal from the node and copies it to out
+func (o *NodeLogsOptions) RunNodeLogs() error {
+	req := o.KubeClient.Core().RESTClient().Get().
+		Resource("nodes").
+		Name(o.NodeName).
+		SubResource("proxy").
+		Suffix("journal")
+
+	for _, unit := range o.Units {
+		req.Param("unit", unit)
+	}
+	if len(o.Since) > 0 {
+		req.Param("since", o.Since)
+	}
+	if len(o.Until) > 0 {
+		req.Param("until", o.Until)
+	}
+	if o.Tail > 0 {
+		req.Param("tail", strconv.Itoa(o.Tail))
+	}
+	if o.Follow {
+		req.Param("follow", "true")
+	}
+
+	in, err := req.Stream()
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+
+	_, err = io.Copy(o.Out, in)
+	return err
+}
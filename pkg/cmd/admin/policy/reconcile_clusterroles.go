@@ -10,10 +10,12 @@ import (
 	kapi "k8s.io/kubernetes/pkg/api"
 	kapierrors "k8s.io/kubernetes/pkg/api/errors"
 	kcmdutil "k8s.io/kubernetes/pkg/kubectl/cmd/util"
+	"k8s.io/kubernetes/pkg/runtime"
 	kerrors "k8s.io/kubernetes/pkg/util/errors"
 	"k8s.io/kubernetes/pkg/util/sets"
 
 	authorizationapi "github.com/openshift/origin/pkg/authorization/api"
+	authorizationapiv1 "github.com/openshift/origin/pkg/authorization/api/v1"
 	"github.com/openshift/origin/pkg/authorization/rulevalidation"
 	"github.com/openshift/origin/pkg/client"
 	"github.com/openshift/origin/pkg/cmd/server/bootstrappolicy"
@@ -27,6 +29,11 @@ import (
 // ReconcileProtectAnnotation is the name of an annotation which prevents reconciliation if set to "true"
 const ReconcileProtectAnnotation = "openshift.io/reconcile-protect"
 
+// ReconcileLastAppliedAnnotation holds the default rules applied by the most recent
+// reconciliation, so later reconciliations can tell rules an admin added apart from
+// rules left over from an older set of defaults
+const ReconcileLastAppliedAnnotation = "openshift.io/reconcile-last-applied"
+
 // ReconcileClusterRolesRecommendedName is the recommended command name
 const ReconcileClusterRolesRecommendedName = "reconcile-cluster-roles"
 
@@ -55,6 +62,11 @@ var (
 
 		Cluster roles with the annotation %s set to "true" are skipped.
 
+		The defaults applied by each reconciliation are recorded in the %s annotation.
+		When that annotation is present, a later reconciliation preserves rules an admin
+		added on top of the defaults, even with --additive-only=false, while still
+		removing rules that only came from an older set of defaults.
+
 		You can see which cluster roles have recommended changed by choosing an output type.`)
 
 	reconcileExample = templates.Examples(`
@@ -83,7 +95,7 @@ func NewCmdReconcileClusterRoles(name, fullName string, f *clientcmd.Factory, ou
 	cmd := &cobra.Command{
 		Use:     name + " [ClusterRoleName]...",
 		Short:   "Update cluster roles to match the recommended bootstrap policy",
-		Long:    fmt.Sprintf(reconcileLong, ReconcileProtectAnnotation),
+		Long:    fmt.Sprintf(reconcileLong, ReconcileProtectAnnotation, ReconcileLastAppliedAnnotation),
 		Example: fmt.Sprintf(reconcileExample, fullName),
 		Run: func(cmd *cobra.Command, args []string) {
 			if err := o.Complete(cmd, f, args); err != nil {
@@ -199,6 +211,9 @@ func (o *ReconcileClusterRolesOptions) ChangedClusterRoles() ([]*authorizationap
 
 		actualClusterRole, err := o.RoleClient.Get(expectedClusterRole.Name)
 		if kapierrors.IsNotFound(err) {
+			if err := setLastAppliedRules(expectedClusterRole, expectedClusterRole.Rules); err != nil {
+				return nil, nil, err
+			}
 			changedRoles = append(changedRoles, expectedClusterRole)
 			continue
 		}
@@ -210,6 +225,9 @@ func (o *ReconcileClusterRolesOptions) ChangedClusterRoles() ([]*authorizationap
 			if actualClusterRole.Annotations[ReconcileProtectAnnotation] == "true" {
 				skippedRoles = append(skippedRoles, reconciledClusterRole)
 			} else {
+				if err := setLastAppliedRules(reconciledClusterRole, expectedClusterRole.Rules); err != nil {
+					return nil, nil, err
+				}
 				changedRoles = append(changedRoles, reconciledClusterRole)
 			}
 		}
@@ -239,22 +257,63 @@ func computeReconciledRole(expected authorizationapi.ClusterRole, actual authori
 	_, extraRules := rulevalidation.Covers(expected.Rules, actual.Rules)
 	_, missingRules := rulevalidation.Covers(actual.Rules, expected.Rules)
 
+	// Keep all extra rules in union mode.  Otherwise, three-way merge against the
+	// defaults recorded by the last reconciliation: extra rules the admin added on
+	// top of those defaults are kept, extra rules that only came from an older set
+	// of defaults are removed.
+	keptExtraRules := extraRules
+	if !union {
+		keptExtraRules = nil
+		if lastAppliedRules, ok := getLastAppliedRules(actual.Annotations[ReconcileLastAppliedAnnotation]); ok {
+			_, keptExtraRules = rulevalidation.Covers(lastAppliedRules, extraRules)
+		}
+	}
+	_, removableExtraRules := rulevalidation.Covers(keptExtraRules, extraRules)
+
 	// We need to reconcile:
 	// 1. if we're missing rules
 	// 2. if there are extra rules we need to remove
 	// 3. if we are missing annotations
-	needsReconciliation := (len(missingRules) > 0) || (!union && len(extraRules) > 0) || missingAnnotationKeys
+	needsReconciliation := (len(missingRules) > 0) || (len(removableExtraRules) > 0) || missingAnnotationKeys
 
 	if !needsReconciliation {
 		return nil, false
 	}
 
-	if union {
-		expected.Rules = append(expected.Rules, extraRules...)
-	}
+	expected.Rules = append(expected.Rules, keptExtraRules...)
 	return &expected, true
 }
 
+// setLastAppliedRules records the given default rules in the role's
+// ReconcileLastAppliedAnnotation annotation
+func setLastAppliedRules(role *authorizationapi.ClusterRole, rules []authorizationapi.PolicyRule) error {
+	data, err := runtime.Encode(kapi.Codecs.LegacyCodec(authorizationapiv1.SchemeGroupVersion), &authorizationapi.ClusterRole{Rules: rules})
+	if err != nil {
+		return err
+	}
+	if role.Annotations == nil {
+		role.Annotations = map[string]string{}
+	}
+	role.Annotations[ReconcileLastAppliedAnnotation] = string(data)
+	return nil
+}
+
+// getLastAppliedRules returns the default rules recorded by a previous reconciliation, if any
+func getLastAppliedRules(lastApplied string) ([]authorizationapi.PolicyRule, bool) {
+	if len(lastApplied) == 0 {
+		return nil, false
+	}
+	obj, err := runtime.Decode(kapi.Codecs.UniversalDecoder(), []byte(lastApplied))
+	if err != nil {
+		return nil, false
+	}
+	role, ok := obj.(*authorizationapi.ClusterRole)
+	if !ok {
+		return nil, false
+	}
+	return role.Rules, true
+}
+
 // ReplaceChangedRoles will reconcile all the changed roles back to the recommended bootstrap policy
 func (o *ReconcileClusterRolesOptions) ReplaceChangedRoles(changedRoles []*authorizationapi.ClusterRole) error {
 	errs := []error{}
@@ -278,6 +337,10 @@ func (o *ReconcileClusterRolesOptions) ReplaceChangedRoles(changedRoles []*autho
 
 		role.Rules = changedRoles[i].Rules
 		role.Annotations = mergeAnnotations(changedRoles[i].Annotations, role.Annotations)
+		// the recorded defaults must reflect this reconciliation, not an earlier one
+		if lastApplied, ok := changedRoles[i].Annotations[ReconcileLastAppliedAnnotation]; ok {
+			role.Annotations[ReconcileLastAppliedAnnotation] = lastApplied
+		}
 		updatedRole, err := o.RoleClient.Update(role)
 		if err != nil {
 			errs = append(errs, err)
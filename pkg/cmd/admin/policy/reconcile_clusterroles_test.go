@@ -6,6 +6,7 @@ import (
 	kapi "k8s.io/kubernetes/pkg/api"
 	"k8s.io/kubernetes/pkg/util/sets"
 
+	_ "github.com/openshift/origin/pkg/api/install"
 	authorizationapi "github.com/openshift/origin/pkg/authorization/api"
 )
 
@@ -23,6 +24,16 @@ func rules(resources ...string) []authorizationapi.PolicyRule {
 
 type ss map[string]string
 
+// lastApplied returns annotations recording the given resources as the defaults
+// applied by a previous reconciliation
+func lastApplied(resources ...string) ss {
+	r := &authorizationapi.ClusterRole{}
+	if err := setLastAppliedRules(r, rules(resources...)); err != nil {
+		panic(err)
+	}
+	return r.Annotations
+}
+
 func TestComputeReconciledRole(t *testing.T) {
 	tests := map[string]struct {
 		expectedRole *authorizationapi.ClusterRole
@@ -232,6 +243,30 @@ func TestComputeReconciledRole(t *testing.T) {
 			expectedReconciledRole:       role(rules("pods", "nodes", "secrets", "images", "projects"), ss{"color": "red", "team": "pm"}, ss{"description": "fancy", "system": "false", "owner": "admin", "vip": "yes", "rate": "down", "up": "true"}),
 			expectedReconciliationNeeded: true,
 		},
+		"stale default rules removed without union": {
+			expectedRole: role(rules("a"), nil, nil),
+			actualRole:   role(rules("a", "b"), nil, lastApplied("a", "b")),
+			union:        false,
+
+			expectedReconciledRole:       role(rules("a"), nil, lastApplied("a", "b")),
+			expectedReconciliationNeeded: true,
+		},
+		"admin added rules preserved without union": {
+			expectedRole: role(rules("a"), nil, nil),
+			actualRole:   role(rules("a", "b"), nil, lastApplied("a")),
+			union:        false,
+
+			expectedReconciledRole:       nil,
+			expectedReconciliationNeeded: false,
+		},
+		"admin added rules preserved while stale defaults removed without union": {
+			expectedRole: role(rules("c"), nil, nil),
+			actualRole:   role(rules("a", "b"), nil, lastApplied("a")),
+			union:        false,
+
+			expectedReconciledRole:       role(rules("c", "b"), nil, lastApplied("a")),
+			expectedReconciliationNeeded: true,
+		},
 	}
 
 	for k, tc := range tests {
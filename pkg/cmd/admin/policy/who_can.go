@@ -11,7 +11,9 @@ import (
 	kapi "k8s.io/kubernetes/pkg/api"
 	"k8s.io/kubernetes/pkg/api/meta"
 	"k8s.io/kubernetes/pkg/api/unversioned"
+	kclientset "k8s.io/kubernetes/pkg/client/clientset_generated/internalclientset"
 	kcmdutil "k8s.io/kubernetes/pkg/kubectl/cmd/util"
+	"k8s.io/kubernetes/pkg/util/sets"
 
 	authorizationapi "github.com/openshift/origin/pkg/authorization/api"
 	"github.com/openshift/origin/pkg/client"
@@ -24,6 +26,7 @@ type whoCanOptions struct {
 	allNamespaces    bool
 	bindingNamespace string
 	client           *client.Client
+	kubeClient       kclientset.Interface
 
 	verb         string
 	resource     unversioned.GroupVersionResource
@@ -44,7 +47,7 @@ func NewCmdWhoCan(name, fullName string, f *clientcmd.Factory, out io.Writer) *c
 			}
 
 			var err error
-			options.client, _, err = f.Clients()
+			options.client, options.kubeClient, err = f.Clients()
 			kcmdutil.CheckErr(err)
 
 			options.bindingNamespace, _, err = f.DefaultNamespace()
@@ -54,7 +57,7 @@ func NewCmdWhoCan(name, fullName string, f *clientcmd.Factory, out io.Writer) *c
 		},
 	}
 
-	cmd.Flags().BoolVar(&options.allNamespaces, "all-namespaces", options.allNamespaces, "If true, list who can perform the specified action in all namespaces.")
+	cmd.Flags().BoolVar(&options.allNamespaces, "all-namespaces", options.allNamespaces, "If true, list who can perform the specified action in all namespaces, including a per-namespace breakdown of subjects without cluster-wide access.")
 
 	return cmd
 }
@@ -141,5 +144,61 @@ func (o *whoCanOptions) run() error {
 		fmt.Printf("Error during evaluation, results may not be complete: %s\n", resourceAccessReviewResponse.EvaluationError)
 	}
 
+	if o.allNamespaces {
+		return o.printNamespaceMatrix(authorizationAttributes, resourceAccessReviewResponse)
+	}
+
+	return nil
+}
+
+// printNamespaceMatrix prints a deduplicated matrix of subjects to the namespaces in
+// which they can perform the action. Subjects that already hold cluster-wide access are
+// omitted since every namespace would be listed for them.
+func (o *whoCanOptions) printNamespaceMatrix(action authorizationapi.Action, clusterResponse *authorizationapi.ResourceAccessReviewResponse) error {
+	namespaces, err := o.kubeClient.Core().Namespaces().List(kapi.ListOptions{})
+	if err != nil {
+		return err
+	}
+
+	userNamespaces := map[string]sets.String{}
+	groupNamespaces := map[string]sets.String{}
+	for _, namespace := range namespaces.Items {
+		response, err := o.client.LocalResourceAccessReviews(namespace.Name).Create(&authorizationapi.LocalResourceAccessReview{Action: action})
+		if err != nil {
+			return err
+		}
+		for _, user := range response.Users.List() {
+			if clusterResponse.Users.Has(user) {
+				continue
+			}
+			if userNamespaces[user] == nil {
+				userNamespaces[user] = sets.String{}
+			}
+			userNamespaces[user].Insert(namespace.Name)
+		}
+		for _, group := range response.Groups.List() {
+			if clusterResponse.Groups.Has(group) {
+				continue
+			}
+			if groupNamespaces[group] == nil {
+				groupNamespaces[group] = sets.String{}
+			}
+			groupNamespaces[group].Insert(namespace.Name)
+		}
+	}
+
+	if len(userNamespaces) == 0 && len(groupNamespaces) == 0 {
+		fmt.Printf("No additional subjects have namespace-scoped access.\n")
+		return nil
+	}
+
+	fmt.Printf("Namespace-scoped access:\n")
+	for _, user := range sets.StringKeySet(userNamespaces).List() {
+		fmt.Printf("  user %s: %s\n", user, strings.Join(userNamespaces[user].List(), ", "))
+	}
+	for _, group := range sets.StringKeySet(groupNamespaces).List() {
+		fmt.Printf("  group %s: %s\n", group, strings.Join(groupNamespaces[group].List(), ", "))
+	}
+
 	return nil
 }
@@ -0,0 +1,134 @@
+package project
+
+import (
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/spf13/cobra"
+
+	kcmdutil "k8s.io/kubernetes/pkg/kubectl/cmd/util"
+
+	"github.com/openshift/origin/pkg/client"
+	"github.com/openshift/origin/pkg/cmd/templates"
+	"github.com/openshift/origin/pkg/cmd/util/clientcmd"
+
+	projectapi "github.com/openshift/origin/pkg/project/api"
+)
+
+const (
+	ArchiveProjectRecommendedName   = "archive-project"
+	UnarchiveProjectRecommendedName = "unarchive-project"
+)
+
+type ArchiveProjectOptions struct {
+	ProjectName string
+
+	Client client.Interface
+
+	Out io.Writer
+}
+
+var archiveProjectLong = templates.LongDesc(`
+	Archive a project
+
+	Archiving a project scales its workloads down to zero, disables its routes and blocks
+	new builds and other writes, while retaining all of its data (persistent volume claims,
+	image streams, configuration). The process is reversible with the unarchive-project
+	command.`)
+
+var unarchiveProjectLong = templates.LongDesc(`
+	Unarchive a project
+
+	Unarchiving a previously archived project restores its workloads to the replica counts
+	they had when the project was archived and allows writes to it again.`)
+
+// NewCmdArchiveProject implements the OpenShift cli archive-project command
+func NewCmdArchiveProject(name, fullName string, f *clientcmd.Factory, out io.Writer) *cobra.Command {
+	options := &ArchiveProjectOptions{Out: out}
+
+	cmd := &cobra.Command{
+		Use:   name + " NAME",
+		Short: "Archive a project, scaling its workloads to zero",
+		Long:  archiveProjectLong,
+		Run: func(cmd *cobra.Command, args []string) {
+			if err := options.complete(args, f); err != nil {
+				kcmdutil.CheckErr(kcmdutil.UsageError(cmd, err.Error()))
+			}
+			kcmdutil.CheckErr(options.RunArchive())
+		},
+	}
+
+	return cmd
+}
+
+// NewCmdUnarchiveProject implements the OpenShift cli unarchive-project command
+func NewCmdUnarchiveProject(name, fullName string, f *clientcmd.Factory, out io.Writer) *cobra.Command {
+	options := &ArchiveProjectOptions{Out: out}
+
+	cmd := &cobra.Command{
+		Use:   name + " NAME",
+		Short: "Restore an archived project",
+		Long:  unarchiveProjectLong,
+		Run: func(cmd *cobra.Command, args []string) {
+			if err := options.complete(args, f); err != nil {
+				kcmdutil.CheckErr(kcmdutil.UsageError(cmd, err.Error()))
+			}
+			kcmdutil.CheckErr(options.RunUnarchive())
+		},
+	}
+
+	return cmd
+}
+
+func (o *ArchiveProjectOptions) complete(args []string, f *clientcmd.Factory) error {
+	if len(args) != 1 {
+		return errors.New("you must specify one argument: project name")
+	}
+	o.ProjectName = args[0]
+
+	var err error
+	o.Client, _, err = f.Clients()
+	return err
+}
+
+func (o *ArchiveProjectOptions) RunArchive() error {
+	project, err := o.Client.Projects().Get(o.ProjectName)
+	if err != nil {
+		return err
+	}
+
+	if project.Annotations[projectapi.ProjectArchived] == "true" {
+		return fmt.Errorf("project %v is already archived", o.ProjectName)
+	}
+
+	if project.Annotations == nil {
+		project.Annotations = map[string]string{}
+	}
+	project.Annotations[projectapi.ProjectArchived] = "true"
+	if _, err := o.Client.Projects().Update(project); err != nil {
+		return err
+	}
+
+	fmt.Fprintf(o.Out, "Archived project %v; its workloads will be scaled to zero\n", o.ProjectName)
+	return nil
+}
+
+func (o *ArchiveProjectOptions) RunUnarchive() error {
+	project, err := o.Client.Projects().Get(o.ProjectName)
+	if err != nil {
+		return err
+	}
+
+	if project.Annotations[projectapi.ProjectArchived] != "true" {
+		return fmt.Errorf("project %v is not archived", o.ProjectName)
+	}
+
+	delete(project.Annotations, projectapi.ProjectArchived)
+	if _, err := o.Client.Projects().Update(project); err != nil {
+		return err
+	}
+
+	fmt.Fprintf(o.Out, "Unarchived project %v; its workloads will be restored\n", o.ProjectName)
+	return nil
+}
@@ -13,6 +13,7 @@ import (
 	kcmdutil "k8s.io/kubernetes/pkg/kubectl/cmd/util"
 
 	buildapi "github.com/openshift/origin/pkg/build/api"
+	buildapiv1 "github.com/openshift/origin/pkg/build/api/v1"
 	"github.com/openshift/origin/pkg/build/prune"
 	"github.com/openshift/origin/pkg/client"
 	"github.com/openshift/origin/pkg/cmd/templates"
@@ -44,6 +45,8 @@ type PruneBuildsOptions struct {
 	KeepYoungerThan time.Duration
 	KeepComplete    int
 	KeepFailed      int
+	KeepAnnotated   string
+	Output          string
 	Namespace       string
 
 	OSClient client.Interface
@@ -76,6 +79,8 @@ func NewCmdPruneBuilds(f *clientcmd.Factory, parentName, name string, out io.Wri
 	cmd.Flags().DurationVar(&opts.KeepYoungerThan, "keep-younger-than", opts.KeepYoungerThan, "Specify the minimum age of a Build for it to be considered a candidate for pruning.")
 	cmd.Flags().IntVar(&opts.KeepComplete, "keep-complete", opts.KeepComplete, "Per BuildConfig, specify the number of builds whose status is complete that will be preserved.")
 	cmd.Flags().IntVar(&opts.KeepFailed, "keep-failed", opts.KeepFailed, "Per BuildConfig, specify the number of builds whose status is failed, error, or cancelled that will be preserved.")
+	cmd.Flags().StringVar(&opts.KeepAnnotated, "keep-annotated", opts.KeepAnnotated, "Specify an annotation key. Builds carrying the annotation are never considered candidates for pruning.")
+	cmd.Flags().StringVarP(&opts.Output, "output", "o", opts.Output, "Output format of the prune report. Supports 'json', which groups the candidates by namespace with estimated space reclaimed. Defaults to a tabular listing.")
 
 	return cmd
 }
@@ -117,6 +122,11 @@ func (o PruneBuildsOptions) Validate() error {
 	if o.KeepFailed < 0 {
 		return fmt.Errorf("--keep-failed must be greater than or equal to 0")
 	}
+	switch o.Output {
+	case "", "json":
+	default:
+		return fmt.Errorf("--output must be 'json' or empty")
+	}
 	return nil
 }
 
@@ -145,23 +155,32 @@ func (o PruneBuildsOptions) Run() error {
 		Orphans:         o.Orphans,
 		KeepComplete:    o.KeepComplete,
 		KeepFailed:      o.KeepFailed,
+		KeepAnnotated:   o.KeepAnnotated,
 		BuildConfigs:    buildConfigs,
 		Builds:          builds,
 	}
 	pruner := prune.NewPruner(options)
 
-	w := tabwriter.NewWriter(o.Out, 10, 4, 3, ' ', 0)
-	defer w.Flush()
-
-	buildDeleter := &describingBuildDeleter{w: w}
-
+	var delegate prune.BuildDeleter
 	if o.Confirm {
-		buildDeleter.delegate = prune.NewBuildDeleter(o.OSClient)
+		delegate = prune.NewBuildDeleter(o.OSClient)
 	} else {
 		fmt.Fprintln(os.Stderr, "Dry run enabled - no modifications will be made. Add --confirm to remove builds")
 	}
 
-	return pruner.Prune(buildDeleter)
+	if o.Output == "json" {
+		report := NewPruneReport()
+		buildDeleter := &reportingBuildDeleter{report: report, delegate: delegate}
+		if err := pruner.Prune(buildDeleter); err != nil {
+			return err
+		}
+		return report.Write(o.Out)
+	}
+
+	w := tabwriter.NewWriter(o.Out, 10, 4, 3, ' ', 0)
+	defer w.Flush()
+
+	return pruner.Prune(&describingBuildDeleter{w: w, delegate: delegate})
 }
 
 // describingBuildDeleter prints information about each build it removes.
@@ -192,3 +211,24 @@ func (p *describingBuildDeleter) DeleteBuild(build *buildapi.Build) error {
 
 	return nil
 }
+
+// reportingBuildDeleter records each build it removes in a structured report.
+// If a delegate exists, its DeleteBuild function is invoked prior to returning.
+type reportingBuildDeleter struct {
+	report   *PruneReport
+	delegate prune.BuildDeleter
+}
+
+var _ prune.BuildDeleter = &reportingBuildDeleter{}
+
+func (p *reportingBuildDeleter) DeleteBuild(build *buildapi.Build) error {
+	// Pruning a build only removes the stored object; any image the build pushed
+	// remains in the registry until images are pruned.
+	p.report.Add(build.Namespace, build.Name, estimatedEtcdBytes(kapi.Codecs.LegacyCodec(buildapiv1.SchemeGroupVersion), build), 0)
+
+	if p.delegate == nil {
+		return nil
+	}
+
+	return p.delegate.DeleteBuild(build)
+}
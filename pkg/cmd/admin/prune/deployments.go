@@ -10,6 +10,7 @@ import (
 	"github.com/spf13/cobra"
 
 	kapi "k8s.io/kubernetes/pkg/api"
+	kapiv1 "k8s.io/kubernetes/pkg/api/v1"
 	kclientset "k8s.io/kubernetes/pkg/client/clientset_generated/internalclientset"
 	kcmdutil "k8s.io/kubernetes/pkg/kubectl/cmd/util"
 
@@ -44,6 +45,8 @@ type PruneDeploymentsOptions struct {
 	KeepYoungerThan time.Duration
 	KeepComplete    int
 	KeepFailed      int
+	KeepAnnotated   string
+	Output          string
 	Namespace       string
 
 	OSClient client.Interface
@@ -78,6 +81,8 @@ func NewCmdPruneDeployments(f *clientcmd.Factory, parentName, name string, out i
 	cmd.Flags().DurationVar(&opts.KeepYoungerThan, "keep-younger-than", opts.KeepYoungerThan, "Specify the minimum age of a deployment for it to be considered a candidate for pruning.")
 	cmd.Flags().IntVar(&opts.KeepComplete, "keep-complete", opts.KeepComplete, "Per DeploymentConfig, specify the number of deployments whose status is complete that will be preserved whose replica size is 0.")
 	cmd.Flags().IntVar(&opts.KeepFailed, "keep-failed", opts.KeepFailed, "Per DeploymentConfig, specify the number of deployments whose status is failed that will be preserved whose replica size is 0.")
+	cmd.Flags().StringVar(&opts.KeepAnnotated, "keep-annotated", opts.KeepAnnotated, "Specify an annotation key. Deployments carrying the annotation are never considered candidates for pruning.")
+	cmd.Flags().StringVarP(&opts.Output, "output", "o", opts.Output, "Output format of the prune report. Supports 'json', which groups the candidates by namespace with estimated space reclaimed. Defaults to a tabular listing.")
 
 	return cmd
 }
@@ -120,6 +125,11 @@ func (o PruneDeploymentsOptions) Validate() error {
 	if o.KeepFailed < 0 {
 		return fmt.Errorf("--keep-failed must be greater than or equal to 0")
 	}
+	switch o.Output {
+	case "", "json":
+	default:
+		return fmt.Errorf("--output must be 'json' or empty")
+	}
 	return nil
 }
 
@@ -148,23 +158,32 @@ func (o PruneDeploymentsOptions) Run() error {
 		Orphans:           o.Orphans,
 		KeepComplete:      o.KeepComplete,
 		KeepFailed:        o.KeepFailed,
+		KeepAnnotated:     o.KeepAnnotated,
 		DeploymentConfigs: deploymentConfigs,
 		Deployments:       deployments,
 	}
 	pruner := prune.NewPruner(options)
 
-	w := tabwriter.NewWriter(o.Out, 10, 4, 3, ' ', 0)
-	defer w.Flush()
-
-	deploymentDeleter := &describingDeploymentDeleter{w: w}
-
+	var delegate prune.DeploymentDeleter
 	if o.Confirm {
-		deploymentDeleter.delegate = prune.NewDeploymentDeleter(o.KClient.Core(), o.KClient.Core())
+		delegate = prune.NewDeploymentDeleter(o.KClient.Core(), o.KClient.Core())
 	} else {
 		fmt.Fprintln(os.Stderr, "Dry run enabled - no modifications will be made. Add --confirm to remove deployments")
 	}
 
-	return pruner.Prune(deploymentDeleter)
+	if o.Output == "json" {
+		report := NewPruneReport()
+		deploymentDeleter := &reportingDeploymentDeleter{report: report, delegate: delegate}
+		if err := pruner.Prune(deploymentDeleter); err != nil {
+			return err
+		}
+		return report.Write(o.Out)
+	}
+
+	w := tabwriter.NewWriter(o.Out, 10, 4, 3, ' ', 0)
+	defer w.Flush()
+
+	return pruner.Prune(&describingDeploymentDeleter{w: w, delegate: delegate})
 }
 
 // describingDeploymentDeleter prints information about each deployment it removes.
@@ -195,3 +214,23 @@ func (p *describingDeploymentDeleter) DeleteDeployment(deployment *kapi.Replicat
 
 	return nil
 }
+
+// reportingDeploymentDeleter records each deployment it removes in a structured report.
+// If a delegate exists, its DeleteDeployment function is invoked prior to returning.
+type reportingDeploymentDeleter struct {
+	report   *PruneReport
+	delegate prune.DeploymentDeleter
+}
+
+var _ prune.DeploymentDeleter = &reportingDeploymentDeleter{}
+
+func (p *reportingDeploymentDeleter) DeleteDeployment(deployment *kapi.ReplicationController) error {
+	// Deployments occupy no registry storage of their own.
+	p.report.Add(deployment.Namespace, deployment.Name, estimatedEtcdBytes(kapi.Codecs.LegacyCodec(kapiv1.SchemeGroupVersion), deployment), 0)
+
+	if p.delegate == nil {
+		return nil
+	}
+
+	return p.delegate.DeleteDeployment(deployment)
+}
@@ -0,0 +1,84 @@
+package prune
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+
+	"k8s.io/kubernetes/pkg/runtime"
+)
+
+// PruneReport is a structured summary of a prune operation, grouping the
+// candidates for removal by namespace along with rough space estimates.
+type PruneReport struct {
+	// Namespaces summarizes the prune candidates per namespace, sorted by namespace name.
+	Namespaces []NamespacePruneReport `json:"namespaces"`
+	// TotalCount is the total number of objects that would be removed.
+	TotalCount int `json:"totalCount"`
+	// EstimatedEtcdBytes is the total serialized size of the objects that would be removed.
+	EstimatedEtcdBytes int64 `json:"estimatedEtcdBytes"`
+	// EstimatedRegistryBytes is the total registry storage attributed to the objects that
+	// would be removed. Builds and deployments do not own registry blobs directly, so this
+	// is zero unless the pruned objects record image data.
+	EstimatedRegistryBytes int64 `json:"estimatedRegistryBytes"`
+}
+
+// NamespacePruneReport summarizes the prune candidates within a single namespace.
+type NamespacePruneReport struct {
+	// Namespace is the namespace the candidates belong to.
+	Namespace string `json:"namespace"`
+	// Names lists the candidates in the order they would be removed.
+	Names []string `json:"names"`
+	// Count is the number of objects that would be removed from the namespace.
+	Count int `json:"count"`
+	// EstimatedEtcdBytes is the serialized size of the namespace's candidates.
+	EstimatedEtcdBytes int64 `json:"estimatedEtcdBytes"`
+	// EstimatedRegistryBytes is the registry storage attributed to the namespace's candidates.
+	EstimatedRegistryBytes int64 `json:"estimatedRegistryBytes"`
+}
+
+// NewPruneReport returns an empty report ready to accumulate prune candidates.
+func NewPruneReport() *PruneReport {
+	return &PruneReport{Namespaces: []NamespacePruneReport{}}
+}
+
+// Add records a prune candidate and its space estimates in the report.
+func (r *PruneReport) Add(namespace, name string, etcdBytes, registryBytes int64) {
+	i := sort.Search(len(r.Namespaces), func(i int) bool { return r.Namespaces[i].Namespace >= namespace })
+	if i == len(r.Namespaces) || r.Namespaces[i].Namespace != namespace {
+		r.Namespaces = append(r.Namespaces, NamespacePruneReport{})
+		copy(r.Namespaces[i+1:], r.Namespaces[i:])
+		r.Namespaces[i] = NamespacePruneReport{Namespace: namespace}
+	}
+	nsReport := &r.Namespaces[i]
+	nsReport.Names = append(nsReport.Names, name)
+	nsReport.Count++
+	nsReport.EstimatedEtcdBytes += etcdBytes
+	nsReport.EstimatedRegistryBytes += registryBytes
+
+	r.TotalCount++
+	r.EstimatedEtcdBytes += etcdBytes
+	r.EstimatedRegistryBytes += registryBytes
+}
+
+// Write marshals the report as indented JSON to the provided writer.
+func (r *PruneReport) Write(out io.Writer) error {
+	data, err := json.MarshalIndent(r, "", "  ")
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintf(out, "%s\n", data)
+	return err
+}
+
+// estimatedEtcdBytes returns the serialized size of the object as a rough
+// approximation of the space it occupies in etcd. Objects that fail to encode
+// contribute a zero estimate.
+func estimatedEtcdBytes(codec runtime.Codec, obj runtime.Object) int64 {
+	data, err := runtime.Encode(codec, obj)
+	if err != nil {
+		return 0
+	}
+	return int64(len(data))
+}
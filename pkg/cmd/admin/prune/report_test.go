@@ -0,0 +1,56 @@
+package prune
+
+import (
+	"bytes"
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func TestPruneReportAdd(t *testing.T) {
+	report := NewPruneReport()
+	report.Add("b", "build-1", 100, 0)
+	report.Add("a", "build-2", 50, 10)
+	report.Add("b", "build-3", 25, 0)
+
+	if report.TotalCount != 3 {
+		t.Errorf("expected 3 candidates, got %d", report.TotalCount)
+	}
+	if report.EstimatedEtcdBytes != 175 {
+		t.Errorf("expected 175 etcd bytes, got %d", report.EstimatedEtcdBytes)
+	}
+	if report.EstimatedRegistryBytes != 10 {
+		t.Errorf("expected 10 registry bytes, got %d", report.EstimatedRegistryBytes)
+	}
+
+	if len(report.Namespaces) != 2 {
+		t.Fatalf("expected 2 namespaces, got %#v", report.Namespaces)
+	}
+	if expected, actual := "a", report.Namespaces[0].Namespace; expected != actual {
+		t.Errorf("expected namespaces sorted by name, got %v before %v", actual, report.Namespaces[1].Namespace)
+	}
+	if expected, actual := []string{"build-1", "build-3"}, report.Namespaces[1].Names; !reflect.DeepEqual(expected, actual) {
+		t.Errorf("expected %v, actual %v", expected, actual)
+	}
+	if report.Namespaces[1].Count != 2 || report.Namespaces[1].EstimatedEtcdBytes != 125 {
+		t.Errorf("unexpected namespace totals: %#v", report.Namespaces[1])
+	}
+}
+
+func TestPruneReportWrite(t *testing.T) {
+	report := NewPruneReport()
+	report.Add("foo", "build-1", 100, 0)
+
+	out := &bytes.Buffer{}
+	if err := report.Write(out); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	decoded := &PruneReport{}
+	if err := json.Unmarshal(out.Bytes(), decoded); err != nil {
+		t.Fatalf("Unexpected error decoding report: %v", err)
+	}
+	if !reflect.DeepEqual(report, decoded) {
+		t.Errorf("expected %#v, actual %#v", report, decoded)
+	}
+}
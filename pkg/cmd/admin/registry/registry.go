@@ -194,6 +194,8 @@ func NewCmdRegistry(f *clientcmd.Factory, parentName, name string, out, errout i
 	cfg.Action.BindForOutput(cmd.Flags())
 	cmd.Flags().String("output-version", "", "The preferred API versions of the output objects")
 
+	cmd.AddCommand(NewCmdRegistryRestore(f, parentName+" "+name, RestoreRecommendedName, out))
+
 	return cmd
 }
 
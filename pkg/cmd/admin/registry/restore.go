@@ -0,0 +1,327 @@
+package registry
+
+import (
+	"crypto/x509"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/golang/glog"
+	"github.com/spf13/cobra"
+
+	"github.com/docker/distribution/registry/api/errcode"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+	kerrors "k8s.io/kubernetes/pkg/api/errors"
+	"k8s.io/kubernetes/pkg/client/restclient"
+	kcmdutil "k8s.io/kubernetes/pkg/kubectl/cmd/util"
+	knet "k8s.io/kubernetes/pkg/util/net"
+
+	"github.com/openshift/origin/pkg/client"
+	"github.com/openshift/origin/pkg/cmd/templates"
+	"github.com/openshift/origin/pkg/cmd/util/clientcmd"
+	imageapi "github.com/openshift/origin/pkg/image/api"
+)
+
+const (
+	// RestoreRecommendedName is the recommended command name
+	RestoreRecommendedName = "restore"
+	// RestoreImageStreamTagRecommendedName is the recommended command name
+	RestoreImageStreamTagRecommendedName = "istag"
+)
+
+var restoreLong = templates.LongDesc(`
+	Restore soft-deleted content of the integrated registry
+
+	When the registry is configured with a repository trash retention window, the storage of
+	repositories whose image stream has been deleted is kept in a trash location instead of
+	being orphaned. The commands here allow administrators to bring that content back before
+	the retention window passes.`)
+
+// NewCmdRegistryRestore implements the restore command group of the registry command.
+func NewCmdRegistryRestore(f *clientcmd.Factory, parentName, name string, out io.Writer) *cobra.Command {
+	cmds := &cobra.Command{
+		Use:   name,
+		Short: "Restore soft-deleted content of the integrated registry",
+		Long:  restoreLong,
+		Run:   kcmdutil.DefaultSubCommandRun(out),
+	}
+
+	cmds.AddCommand(NewCmdRestoreImageStreamTag(f, parentName+" "+name, RestoreImageStreamTagRecommendedName, out))
+	return cmds
+}
+
+var (
+	restoreImageStreamTagLong = templates.LongDesc(`
+		Restore an image stream tag from the registry trash
+
+		This command asks the integrated registry to move the soft-deleted repository of the
+		image stream out of the trash and recreates the given tag from the restored manifest.
+		The image stream is created if it no longer exists. The registry keeps soft-deleted
+		repositories only for the configured retention window; after that only a new push can
+		recreate the repository.
+
+		The registry must be reached directly, so the registry route or service address has to
+		be passed via --registry-url. Restoring requires the same privileges as pruning images.`)
+
+	restoreImageStreamTagExample = templates.Examples(`
+		# Restore the tag 'latest' of the image stream 'myapp' in project 'myproject'
+	  %[1]s %[2]s myproject/myapp:latest --registry-url=docker-registry.default.svc:5000`)
+)
+
+// RestoreImageStreamTagOptions holds all the required options for restoring an image stream tag.
+type RestoreImageStreamTagOptions struct {
+	Namespace string
+	Name      string
+	Tag       string
+
+	RegistryUrl string
+	CABundle    string
+
+	OSClient       *client.Client
+	RegistryClient *http.Client
+
+	Out io.Writer
+}
+
+// NewCmdRestoreImageStreamTag implements the istag subcommand of the restore command.
+func NewCmdRestoreImageStreamTag(f *clientcmd.Factory, parentName, name string, out io.Writer) *cobra.Command {
+	opts := &RestoreImageStreamTagOptions{}
+
+	cmd := &cobra.Command{
+		Use:     fmt.Sprintf("%s [NAMESPACE/]NAME:TAG", name),
+		Short:   "Restore an image stream tag from the registry trash",
+		Long:    restoreImageStreamTagLong,
+		Example: fmt.Sprintf(restoreImageStreamTagExample, parentName, name),
+		Run: func(cmd *cobra.Command, args []string) {
+			kcmdutil.CheckErr(opts.Complete(f, cmd, args, out))
+			kcmdutil.CheckErr(opts.Validate())
+			kcmdutil.CheckErr(opts.Run())
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.RegistryUrl, "registry-url", opts.RegistryUrl, "The address to use when contacting the registry. Required, because the deleted image stream no longer advertises a registry.")
+	cmd.Flags().StringVar(&opts.CABundle, "certificate-authority", opts.CABundle, "The path to a certificate authority bundle to use when communicating with the registry. Defaults to the certificate authority data from the current user's config file.")
+
+	return cmd
+}
+
+// Complete turns a partially defined RestoreImageStreamTagOptions into a solvent structure.
+func (o *RestoreImageStreamTagOptions) Complete(f *clientcmd.Factory, cmd *cobra.Command, args []string, out io.Writer) error {
+	if len(args) != 1 {
+		return kcmdutil.UsageError(cmd, "an image stream tag of the form [NAMESPACE/]NAME:TAG is required")
+	}
+
+	istag := args[0]
+	if parts := strings.SplitN(istag, "/", 2); len(parts) == 2 {
+		o.Namespace = parts[0]
+		istag = parts[1]
+	} else {
+		namespace, _, err := f.DefaultNamespace()
+		if err != nil {
+			return err
+		}
+		o.Namespace = namespace
+	}
+	name, tag, err := imageapi.ParseImageStreamTagName(istag)
+	if err != nil {
+		return kcmdutil.UsageError(cmd, "%v", err)
+	}
+	o.Name = name
+	o.Tag = tag
+	o.Out = out
+
+	osClient, registryClient, err := getRestoreClients(f, o.CABundle)
+	if err != nil {
+		return err
+	}
+	o.OSClient = osClient
+	o.RegistryClient = registryClient
+
+	return nil
+}
+
+// Validate ensures that a RestoreImageStreamTagOptions is valid and can be used to execute the restore.
+func (o RestoreImageStreamTagOptions) Validate() error {
+	if len(o.RegistryUrl) == 0 {
+		return fmt.Errorf("--registry-url must be specified")
+	}
+	return nil
+}
+
+// Run contains all the necessary functionality for the OpenShift cli registry restore istag command.
+func (o RestoreImageStreamTagOptions) Run() error {
+	repo := fmt.Sprintf("%s/%s", o.Namespace, o.Name)
+
+	restored, err := restoreFromRegistry(o.RegistryClient, o.RegistryUrl, repo)
+	if err != nil {
+		return err
+	}
+
+	imageName, ok := restored.Tags[o.Tag]
+	if !ok {
+		tags := []string{}
+		for tag := range restored.Tags {
+			tags = append(tags, tag)
+		}
+		sort.Strings(tags)
+		return fmt.Errorf("the restored repository %s carries no tag %q (restored tags: %s)", repo, o.Tag, strings.Join(tags, ", "))
+	}
+
+	image, err := o.OSClient.Images().Get(imageName)
+	if err != nil {
+		if kerrors.IsNotFound(err) {
+			return fmt.Errorf("image %s referenced by tag %q no longer exists; it may have been pruned", imageName, o.Tag)
+		}
+		return err
+	}
+
+	if _, err := o.OSClient.ImageStreams(o.Namespace).Get(o.Name); err != nil {
+		if !kerrors.IsNotFound(err) {
+			return err
+		}
+		stream := &imageapi.ImageStream{ObjectMeta: kapi.ObjectMeta{Name: o.Name}}
+		if _, err := o.OSClient.ImageStreams(o.Namespace).Create(stream); err != nil {
+			return err
+		}
+	}
+
+	mapping := &imageapi.ImageStreamMapping{
+		ObjectMeta: kapi.ObjectMeta{Namespace: o.Namespace, Name: o.Name},
+		Image:      *image,
+		Tag:        o.Tag,
+	}
+	if err := o.OSClient.ImageStreamMappings(o.Namespace).Create(mapping); err != nil {
+		return err
+	}
+
+	fmt.Fprintf(o.Out, "imagestreamtag %s/%s:%s restored (image %s)\n", o.Namespace, o.Name, o.Tag, imageName)
+	return nil
+}
+
+// restoredRepository mirrors the response of the registry's repository restore endpoint.
+type restoredRepository struct {
+	Version int               `json:"schemaVersion"`
+	Name    string            `json:"name"`
+	Tags    map[string]string `json:"tags"`
+}
+
+// restoreFromRegistry asks the registry at registryURL to restore repo from its trash and
+// returns the tags the restored repository carries.
+func restoreFromRegistry(registryClient *http.Client, registryURL, repo string) (*restoredRepository, error) {
+	restoreFunc := func(proto string) (*restoredRepository, error) {
+		req, err := http.NewRequest("PUT", fmt.Sprintf("%s://%s/admin/repositories/%s", proto, registryURL, repo), nil)
+		if err != nil {
+			return nil, fmt.Errorf("error creating request: %v", err)
+		}
+
+		resp, err := registryClient.Do(req)
+		if err != nil {
+			return nil, fmt.Errorf("error sending request: %v", err)
+		}
+		defer resp.Body.Close()
+
+		switch {
+		case resp.StatusCode == http.StatusNotFound:
+			return nil, fmt.Errorf("the registry has no trash entry for repository %s; either its image stream was not soft-deleted or the retention window has passed", repo)
+		case resp.StatusCode != http.StatusOK:
+			var response errcode.Errors
+			decoder := json.NewDecoder(resp.Body)
+			if err := decoder.Decode(&response); err != nil {
+				return nil, fmt.Errorf(resp.Status)
+			}
+			return nil, &response
+		}
+
+		restored := &restoredRepository{}
+		if err := json.NewDecoder(resp.Body).Decode(restored); err != nil {
+			return nil, fmt.Errorf("error decoding restore response: %v", err)
+		}
+		return restored, nil
+	}
+
+	var err error
+	for _, proto := range []string{"https", "http"} {
+		glog.V(4).Infof("Trying %s for %s", proto, registryURL)
+		var restored *restoredRepository
+		restored, err = restoreFunc(proto)
+		if err == nil {
+			return restored, nil
+		}
+
+		if _, ok := err.(*errcode.Errors); ok {
+			// we got a response back from the registry, so return it
+			return nil, err
+		}
+
+		glog.V(4).Infof("Error with %s for %s: %v", proto, registryURL, err)
+	}
+	return nil, err
+}
+
+// getRestoreClients returns an OpenShift client and a client prepared to talk to the Docker
+// registry with the user's bearer token.
+func getRestoreClients(f *clientcmd.Factory, caBundle string) (*client.Client, *http.Client, error) {
+	clientConfig, err := f.ClientConfig()
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(clientConfig.BearerToken) == 0 {
+		return nil, nil, errors.New("you must use a client config with a token")
+	}
+	osClient, _, err := f.Clients()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// copy the config and zero out everything the registry login must not use
+	registryClientConfig := *clientConfig
+	registryClientConfig.BearerToken = ""
+	registryClientConfig.CertFile = ""
+	registryClientConfig.CertData = []byte{}
+	registryClientConfig.KeyFile = ""
+	registryClientConfig.KeyData = []byte{}
+
+	// we have to set a username to something for the Docker login
+	// but it's not actually used
+	registryClientConfig.Username = "unused"
+
+	// set the "password" to be the token
+	registryClientConfig.Password = clientConfig.BearerToken
+
+	tlsConfig, err := restclient.TLSConfigFor(&registryClientConfig)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// if the user specified a CA on the command line, add it to the
+	// client config's CA roots
+	if tlsConfig != nil && len(caBundle) > 0 {
+		data, err := ioutil.ReadFile(caBundle)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		if tlsConfig.RootCAs == nil {
+			tlsConfig.RootCAs = x509.NewCertPool()
+		}
+
+		tlsConfig.RootCAs.AppendCertsFromPEM(data)
+	}
+
+	transport := knet.SetTransportDefaults(&http.Transport{
+		TLSClientConfig: tlsConfig,
+	})
+
+	wrappedTransport, err := restclient.HTTPWrappersForConfig(&registryClientConfig, transport)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return osClient, &http.Client{Transport: wrappedTransport}, nil
+}
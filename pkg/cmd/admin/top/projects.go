@@ -0,0 +1,227 @@
+package top
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/spf13/cobra"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/api/resource"
+	kclientset "k8s.io/kubernetes/pkg/client/clientset_generated/internalclientset"
+	"k8s.io/kubernetes/pkg/kubectl"
+	kcmd "k8s.io/kubernetes/pkg/kubectl/cmd"
+	kcmdutil "k8s.io/kubernetes/pkg/kubectl/cmd/util"
+	"k8s.io/kubernetes/pkg/kubectl/metricsutil"
+	"k8s.io/kubernetes/pkg/labels"
+
+	metrics_api "k8s.io/heapster/metrics/apis/metrics/v1alpha1"
+
+	"github.com/openshift/origin/pkg/client"
+	"github.com/openshift/origin/pkg/cmd/templates"
+	"github.com/openshift/origin/pkg/cmd/util/clientcmd"
+	projectapi "github.com/openshift/origin/pkg/project/api"
+)
+
+const TopProjectsRecommendedName = "projects"
+
+var (
+	topProjectsLong = templates.LongDesc(`
+		Show usage statistics for Projects
+
+		This command aggregates pod CPU and memory usage per project from the cluster
+		metrics pipeline and shows it next to the requests recorded by resource quotas,
+		so projects that request far more than they actually use stand out.
+
+		Due to the metrics pipeline delay, usage may be unavailable for a few minutes
+		after pod creation.`)
+
+	topProjectsExample = templates.Examples(`
+		# Show usage statistics for all projects
+  	%[1]s %[2]s`)
+)
+
+// NewCmdTopProjects implements the OpenShift cli top projects command.
+func NewCmdTopProjects(f *clientcmd.Factory, parentName, name string, out io.Writer) *cobra.Command {
+	opts := &TopProjectsOptions{}
+	cmd := &cobra.Command{
+		Use:     name,
+		Short:   "Show usage statistics for Projects",
+		Long:    topProjectsLong,
+		Example: fmt.Sprintf(topProjectsExample, parentName, name),
+		Run: func(cmd *cobra.Command, args []string) {
+			kcmdutil.CheckErr(opts.Complete(f, cmd, args, out))
+			kcmdutil.CheckErr(opts.Run())
+		},
+	}
+	opts.HeapsterOptions.Bind(cmd.Flags())
+
+	return cmd
+}
+
+type TopProjectsOptions struct {
+	HeapsterOptions kcmd.HeapsterTopOptions
+
+	// internal values
+	Projects *projectapi.ProjectList
+	Metrics  []metrics_api.PodMetrics
+	Quotas   *kapi.ResourceQuotaList
+
+	// helpers
+	out           io.Writer
+	osClient      client.Interface
+	kClient       kclientset.Interface
+	metricsClient *metricsutil.HeapsterMetricsClient
+}
+
+// Complete turns a partially defined TopProjectsOptions into a solvent structure
+// which can be validated and used for showing project usage.
+func (o *TopProjectsOptions) Complete(f *clientcmd.Factory, cmd *cobra.Command, args []string, out io.Writer) error {
+	if len(args) > 0 {
+		return kcmdutil.UsageError(cmd, "no arguments are supported")
+	}
+
+	osClient, kClient, err := f.Clients()
+	if err != nil {
+		return err
+	}
+	o.out = out
+	o.osClient = osClient
+	o.kClient = kClient
+	o.metricsClient = metricsutil.NewHeapsterMetricsClient(kClient.Core(), o.HeapsterOptions.Namespace, o.HeapsterOptions.Scheme, o.HeapsterOptions.Service, o.HeapsterOptions.Port)
+
+	o.Projects, err = osClient.Projects().List(kapi.ListOptions{})
+	if err != nil {
+		return err
+	}
+
+	o.Metrics, err = o.metricsClient.GetPodMetrics(kapi.NamespaceAll, "", true, labels.Everything())
+	if err != nil {
+		return err
+	}
+
+	o.Quotas, err = kClient.Core().ResourceQuotas(kapi.NamespaceAll).List(kapi.ListOptions{})
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// projectUsage aggregates the observed usage and the quota requests of a single project.
+type projectUsage struct {
+	name         string
+	pods         int
+	usage        kapi.ResourceList
+	requestsUsed kapi.ResourceList
+	requestsHard kapi.ResourceList
+}
+
+func (o TopProjectsOptions) Run() error {
+	usage, err := o.projectUsages()
+	if err != nil {
+		return err
+	}
+
+	w := kubectl.GetNewTabWriter(o.out)
+	defer w.Flush()
+
+	fmt.Fprintf(w, "NAME\tPODS\tCPU(cores)\tMEMORY(bytes)\tCPU REQUEST\tMEMORY REQUEST\n")
+	for _, project := range usage {
+		fmt.Fprintf(w, "%s\t%d\t%s\t%s\t%s\t%s\n",
+			project.name,
+			project.pods,
+			formatResourceUsage(kapi.ResourceCPU, project.usage[kapi.ResourceCPU]),
+			formatResourceUsage(kapi.ResourceMemory, project.usage[kapi.ResourceMemory]),
+			formatQuotaUsage(kapi.ResourceCPU, project),
+			formatQuotaUsage(kapi.ResourceMemory, project),
+		)
+	}
+	return nil
+}
+
+// projectUsages aggregates the listed metrics and quotas per project.
+func (o TopProjectsOptions) projectUsages() ([]*projectUsage, error) {
+	usageByProject := map[string]*projectUsage{}
+	ordered := []*projectUsage{}
+	for i := range o.Projects.Items {
+		usage := &projectUsage{
+			name:         o.Projects.Items[i].Name,
+			usage:        kapi.ResourceList{},
+			requestsUsed: kapi.ResourceList{},
+			requestsHard: kapi.ResourceList{},
+		}
+		usageByProject[usage.name] = usage
+		ordered = append(ordered, usage)
+	}
+
+	for i := range o.Metrics {
+		usage, exists := usageByProject[o.Metrics[i].Namespace]
+		if !exists {
+			continue
+		}
+		usage.pods++
+		for _, container := range o.Metrics[i].Containers {
+			var containerUsage kapi.ResourceList
+			if err := kapi.Scheme.Convert(&container.Usage, &containerUsage, nil); err != nil {
+				return nil, err
+			}
+			for _, resourceName := range metricsutil.MeasuredResources {
+				quantity := usage.usage[resourceName]
+				quantity.Add(containerUsage[resourceName])
+				usage.usage[resourceName] = quantity
+			}
+		}
+	}
+
+	for i := range o.Quotas.Items {
+		quota := &o.Quotas.Items[i]
+		usage, exists := usageByProject[quota.Namespace]
+		if !exists {
+			continue
+		}
+		for _, resourceName := range metricsutil.MeasuredResources {
+			for _, quotaResource := range []kapi.ResourceName{
+				kapi.ResourceName("requests." + string(resourceName)),
+				resourceName,
+			} {
+				hard, hasHard := quota.Status.Hard[quotaResource]
+				if !hasHard {
+					continue
+				}
+				// prefer the most restrictive quota when several cover the resource
+				if existing, exists := usage.requestsHard[resourceName]; exists && existing.Cmp(hard) <= 0 {
+					break
+				}
+				usage.requestsHard[resourceName] = hard
+				usage.requestsUsed[resourceName] = quota.Status.Used[quotaResource]
+				break
+			}
+		}
+	}
+
+	return ordered, nil
+}
+
+// formatResourceUsage renders a usage quantity the same way 'top pod' does.
+func formatResourceUsage(resourceName kapi.ResourceName, quantity resource.Quantity) string {
+	switch resourceName {
+	case kapi.ResourceCPU:
+		return fmt.Sprintf("%vm", quantity.MilliValue())
+	case kapi.ResourceMemory:
+		return fmt.Sprintf("%vMi", quantity.Value()/(1024*1024))
+	default:
+		return quantity.String()
+	}
+}
+
+// formatQuotaUsage renders the used/hard request totals recorded by the most
+// restrictive quota covering the resource, or a dash when no quota covers it.
+func formatQuotaUsage(resourceName kapi.ResourceName, usage *projectUsage) string {
+	hard, exists := usage.requestsHard[resourceName]
+	if !exists {
+		return "-"
+	}
+	used := usage.requestsUsed[resourceName]
+	return fmt.Sprintf("%s/%s", used.String(), hard.String())
+}
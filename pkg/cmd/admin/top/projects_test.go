@@ -0,0 +1,117 @@
+package top
+
+import (
+	"testing"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/api/resource"
+	"k8s.io/kubernetes/pkg/api/v1"
+
+	metrics_api "k8s.io/heapster/metrics/apis/metrics/v1alpha1"
+
+	_ "github.com/openshift/origin/pkg/api/install"
+	projectapi "github.com/openshift/origin/pkg/project/api"
+)
+
+func TestProjectsTop(t *testing.T) {
+	options := TopProjectsOptions{
+		Projects: &projectapi.ProjectList{
+			Items: []projectapi.Project{
+				{ObjectMeta: kapi.ObjectMeta{Name: "busy"}},
+				{ObjectMeta: kapi.ObjectMeta{Name: "idle"}},
+			},
+		},
+		Metrics: []metrics_api.PodMetrics{
+			{
+				ObjectMeta: v1.ObjectMeta{Name: "pod-1", Namespace: "busy"},
+				Containers: []metrics_api.ContainerMetrics{
+					{
+						Name: "app",
+						Usage: v1.ResourceList{
+							v1.ResourceCPU:    resource.MustParse("200m"),
+							v1.ResourceMemory: resource.MustParse("256Mi"),
+						},
+					},
+					{
+						Name: "sidecar",
+						Usage: v1.ResourceList{
+							v1.ResourceCPU:    resource.MustParse("100m"),
+							v1.ResourceMemory: resource.MustParse("64Mi"),
+						},
+					},
+				},
+			},
+			{
+				ObjectMeta: v1.ObjectMeta{Name: "pod-2", Namespace: "busy"},
+				Containers: []metrics_api.ContainerMetrics{
+					{
+						Name: "app",
+						Usage: v1.ResourceList{
+							v1.ResourceCPU:    resource.MustParse("50m"),
+							v1.ResourceMemory: resource.MustParse("128Mi"),
+						},
+					},
+				},
+			},
+			{
+				ObjectMeta: v1.ObjectMeta{Name: "pod-3", Namespace: "unlisted"},
+				Containers: []metrics_api.ContainerMetrics{
+					{
+						Name: "app",
+						Usage: v1.ResourceList{
+							v1.ResourceCPU:    resource.MustParse("1"),
+							v1.ResourceMemory: resource.MustParse("1Gi"),
+						},
+					},
+				},
+			},
+		},
+		Quotas: &kapi.ResourceQuotaList{
+			Items: []kapi.ResourceQuota{
+				{
+					ObjectMeta: kapi.ObjectMeta{Name: "quota", Namespace: "busy"},
+					Status: kapi.ResourceQuotaStatus{
+						Hard: kapi.ResourceList{
+							kapi.ResourceRequestsCPU:    resource.MustParse("2"),
+							kapi.ResourceRequestsMemory: resource.MustParse("2Gi"),
+						},
+						Used: kapi.ResourceList{
+							kapi.ResourceRequestsCPU:    resource.MustParse("1500m"),
+							kapi.ResourceRequestsMemory: resource.MustParse("1Gi"),
+						},
+					},
+				},
+			},
+		},
+	}
+
+	usage, err := options.projectUsages()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(usage) != 2 {
+		t.Fatalf("expected 2 projects, got %d", len(usage))
+	}
+
+	busy := usage[0]
+	if busy.name != "busy" || busy.pods != 2 {
+		t.Errorf("expected 2 pods in project busy, got %#v", busy)
+	}
+	if cpu := busy.usage[kapi.ResourceCPU]; cpu.MilliValue() != 350 {
+		t.Errorf("expected 350m cpu usage, got %s", cpu.String())
+	}
+	if memory := busy.usage[kapi.ResourceMemory]; memory.Value() != 448*1024*1024 {
+		t.Errorf("expected 448Mi memory usage, got %s", memory.String())
+	}
+	if quotaUsage := formatQuotaUsage(kapi.ResourceCPU, busy); quotaUsage != "1500m/2" {
+		t.Errorf("expected cpu quota usage 1500m/2, got %s", quotaUsage)
+	}
+
+	idle := usage[1]
+	if idle.pods != 0 {
+		t.Errorf("expected no pods in project idle, got %d", idle.pods)
+	}
+	if quotaUsage := formatQuotaUsage(kapi.ResourceCPU, idle); quotaUsage != "-" {
+		t.Errorf("expected no cpu quota for project idle, got %s", quotaUsage)
+	}
+}
@@ -31,6 +31,7 @@ func NewCommandTop(name, fullName string, f *clientcmd.Factory, out, errOut io.W
 
 	cmds.AddCommand(NewCmdTopImages(f, fullName, TopImagesRecommendedName, out))
 	cmds.AddCommand(NewCmdTopImageStreams(f, fullName, TopImageStreamsRecommendedName, out))
+	cmds.AddCommand(NewCmdTopProjects(f, fullName, TopProjectsRecommendedName, out))
 	cmdTopNode := kcmd.NewCmdTopNode(f, out)
 	cmdTopNode.Long = templates.LongDesc(cmdTopNode.Long)
 	cmdTopNode.Example = templates.Examples(cmdTopNode.Example)
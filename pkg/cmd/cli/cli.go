@@ -162,6 +162,7 @@ func NewCommandCLI(name, fullName string, in io.Reader, out, errout io.Writer) *
 				observe.NewCmdObserve(fullName, f, out, errout),
 				policy.NewCmdPolicy(policy.PolicyRecommendedName, fullName+" "+policy.PolicyRecommendedName, f, out, errout),
 				cmd.NewCmdConvert(fullName, f, out),
+				cmd.NewCmdValidate(cmd.ValidateRecommendedName, fullName, f, out, errout),
 				importer.NewCmdImport(fullName, f, in, out, errout),
 			},
 		},
@@ -15,6 +15,7 @@ import (
 	cmdutil "github.com/openshift/origin/pkg/cmd/util"
 	"github.com/openshift/origin/pkg/cmd/util/clientcmd"
 	"github.com/openshift/origin/pkg/route/api"
+	"github.com/openshift/origin/pkg/service/controller/servingcert"
 	fileutil "github.com/openshift/origin/pkg/util/file"
 )
 
@@ -283,7 +284,9 @@ var (
 
 		Specify the service (either just its name or using type/name syntax) that the
 		generated route should expose via the --service flag. A destination CA certificate
-		is needed for reencrypt routes, specify one with the --dest-ca-cert flag.`)
+		is needed for reencrypt routes, specify one with the --dest-ca-cert flag, or use
+		--use-service-ca to read it from the service serving cert signer's CA configmap
+		when the service uses a generated serving certificate.`)
 
 	reencryptRouteExample = templates.Examples(`
 		# Create a route named "my-route" that exposes the frontend service.
@@ -291,7 +294,11 @@ var (
 
 	  # Create a reencrypt route that exposes the frontend service and re-use
 	  # the service name as the route name.
-	  %[1]s create route reencrypt --service=frontend --dest-ca-cert cert.cert`)
+	  %[1]s create route reencrypt --service=frontend --dest-ca-cert cert.cert
+
+	  # Create a reencrypt route to a service that uses a generated serving
+	  # certificate, taking the destination CA from the service signing CA configmap.
+	  %[1]s create route reencrypt --service=frontend --use-service-ca`)
 )
 
 // NewCmdCreateReencryptRoute is a macro command to create a reencrypt route.
@@ -323,8 +330,8 @@ func NewCmdCreateReencryptRoute(fullName string, f *clientcmd.Factory, out io.Wr
 	cmd.Flags().String("ca-cert", "", "Path to a CA certificate file.")
 	cmd.MarkFlagFilename("ca-cert")
 	cmd.Flags().String("dest-ca-cert", "", "Path to a CA certificate file, used for securing the connection from the router to the destination.")
-	cmd.MarkFlagRequired("dest-ca-cert")
 	cmd.MarkFlagFilename("dest-ca-cert")
+	cmd.Flags().Bool("use-service-ca", false, fmt.Sprintf("Populate the destination CA certificate from the %q configmap published by the service serving cert signer.", servingcert.ServingCertCAConfigMapName))
 	cmd.Flags().String("wildcard-policy", "", "Sets the WildcardPolicy for the hostname, the default is \"None\". valid values are \"None\" and \"Subdomain\"")
 
 	return cmd
@@ -379,11 +386,31 @@ func CreateReencryptRoute(f *clientcmd.Factory, out io.Writer, cmd *cobra.Comman
 		return err
 	}
 	route.Spec.TLS.CACertificate = string(caCert)
-	destCACert, err := fileutil.LoadData(kcmdutil.GetFlagString(cmd, "dest-ca-cert"))
-	if err != nil {
-		return err
+
+	destCACertFile := kcmdutil.GetFlagString(cmd, "dest-ca-cert")
+	useServiceCA := kcmdutil.GetFlagBool(cmd, "use-service-ca")
+	switch {
+	case useServiceCA && len(destCACertFile) > 0:
+		return fmt.Errorf("--dest-ca-cert and --use-service-ca are mutually exclusive")
+	case useServiceCA:
+		configMap, err := kc.Core().ConfigMaps(ns).Get(servingcert.ServingCertCAConfigMapName)
+		if err != nil {
+			return fmt.Errorf("unable to read the service signing CA configmap %q: %v", servingcert.ServingCertCAConfigMapName, err)
+		}
+		caBundle := configMap.Data[servingcert.ServingCertCAKey]
+		if len(caBundle) == 0 {
+			return fmt.Errorf("configmap %q does not contain the %q key", servingcert.ServingCertCAConfigMapName, servingcert.ServingCertCAKey)
+		}
+		route.Spec.TLS.DestinationCACertificate = caBundle
+	case len(destCACertFile) > 0:
+		destCACert, err := fileutil.LoadData(destCACertFile)
+		if err != nil {
+			return err
+		}
+		route.Spec.TLS.DestinationCACertificate = string(destCACert)
+	default:
+		return fmt.Errorf("a destination CA certificate is needed for reencrypt routes, specify one with --dest-ca-cert or --use-service-ca")
 	}
-	route.Spec.TLS.DestinationCACertificate = string(destCACert)
 
 	insecurePolicy := kcmdutil.GetFlagString(cmd, "insecure-policy")
 	if len(insecurePolicy) > 0 {
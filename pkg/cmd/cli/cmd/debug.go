@@ -22,6 +22,7 @@ import (
 	"k8s.io/kubernetes/pkg/kubectl/resource"
 	"k8s.io/kubernetes/pkg/runtime"
 	"k8s.io/kubernetes/pkg/util/interrupt"
+	"k8s.io/kubernetes/pkg/util/sets"
 	"k8s.io/kubernetes/pkg/util/term"
 	"k8s.io/kubernetes/pkg/watch"
 
@@ -58,6 +59,7 @@ type DebugOptions struct {
 	KeepReadiness      bool
 	KeepInitContainers bool
 	OneContainer       bool
+	RelaxSecurity      bool
 	NodeName           string
 	AddEnv             []kapi.EnvVar
 	RemoveEnv          []string
@@ -162,6 +164,7 @@ func NewCmdDebug(fullName string, f *clientcmd.Factory, in io.Reader, out, errou
 	cmd.Flags().StringVar(&options.NodeName, "node-name", "", "Set a specific node to run on - by default the pod will run on any valid node")
 	cmd.Flags().BoolVar(&options.AsRoot, "as-root", false, "If true, try to run the container as the root user")
 	cmd.Flags().Int64Var(&options.AsUser, "as-user", -1, "Try to run the container as a specific user UID (note: admins may limit your ability to use this flag)")
+	cmd.Flags().BoolVar(&options.RelaxSecurity, "relax-security", false, "If the pod is rejected by the cluster security policy, remove only the rejected security sensitive fields (root user, privileged mode, hostPath volumes, host namespaces), explain each adjustment and retry")
 
 	cmd.Flags().StringVarP(&options.Filename, "filename", "f", "", "Filename or URL to file to read a template")
 	cmd.MarkFlagFilename("filename", "yaml", "yml", "json")
@@ -331,7 +334,13 @@ func (o *DebugOptions) Debug() error {
 	fmt.Fprintf(o.Attach.Err, "Debugging with pod/%s, original command: %s\n", pod.Name, commandString)
 	pod, err := o.createPod(pod)
 	if err != nil {
-		return err
+		if !o.RelaxSecurity || !kapierrors.IsForbidden(err) {
+			return err
+		}
+		pod, err = o.createPodRelaxed(pod, err)
+		if err != nil {
+			return err
+		}
 	}
 
 	// ensure the pod is cleaned up on shutdown
@@ -627,6 +636,141 @@ func (o *DebugOptions) createPod(pod *kapi.Pod) (*kapi.Pod, error) {
 	return o.Attach.PodClient.Pods(namespace).Create(pod)
 }
 
+// securityRelaxations are applied in order when a debug pod is rejected by the cluster
+// security policy. Each step only removes fields from the pod spec and is explained to
+// the user before the creation is retried.
+var securityRelaxations = []struct {
+	note  string
+	apply func(pod *kapi.Pod) bool
+}{
+	{"dropping the explicit root user from the container security context", relaxRootUser},
+	{"dropping privileged mode and added capabilities", relaxPrivileges},
+	{"removing hostPath volumes and their volume mounts", relaxHostPathVolumes},
+	{"dropping the host network, PID and IPC namespaces", relaxHostNamespaces},
+}
+
+// createPodRelaxed retries creating a debug pod that was rejected by the cluster security
+// policy, progressively removing the security sensitive parts of the spec the user is not
+// permitted to use. Every adjustment is explained on stderr; if the pod is still rejected
+// after all relaxations have been applied the last rejection is returned.
+func (o *DebugOptions) createPodRelaxed(pod *kapi.Pod, lastErr error) (*kapi.Pod, error) {
+	for _, relaxation := range securityRelaxations {
+		if !relaxation.apply(pod) {
+			continue
+		}
+		fmt.Fprintf(o.Attach.Err, "warning: The pod was rejected by the cluster security policy, retrying after %s ...\n", relaxation.note)
+		created, err := o.createPod(pod)
+		if err == nil {
+			return created, nil
+		}
+		if !kapierrors.IsForbidden(err) {
+			return nil, err
+		}
+		lastErr = err
+	}
+	return nil, lastErr
+}
+
+// podContainers returns pointers to every init container and container of the pod.
+func podContainers(pod *kapi.Pod) []*kapi.Container {
+	containers := []*kapi.Container{}
+	for i := range pod.Spec.InitContainers {
+		containers = append(containers, &pod.Spec.InitContainers[i])
+	}
+	for i := range pod.Spec.Containers {
+		containers = append(containers, &pod.Spec.Containers[i])
+	}
+	return containers
+}
+
+// relaxRootUser removes an explicit root user (UID 0) from the container security contexts.
+func relaxRootUser(pod *kapi.Pod) bool {
+	changed := false
+	for _, container := range podContainers(pod) {
+		sc := container.SecurityContext
+		if sc == nil {
+			continue
+		}
+		if sc.RunAsUser != nil && *sc.RunAsUser == 0 {
+			sc.RunAsUser = nil
+			changed = true
+		}
+	}
+	return changed
+}
+
+// relaxPrivileges removes privileged mode and added capabilities from the container
+// security contexts.
+func relaxPrivileges(pod *kapi.Pod) bool {
+	changed := false
+	for _, container := range podContainers(pod) {
+		sc := container.SecurityContext
+		if sc == nil {
+			continue
+		}
+		if sc.Privileged != nil && *sc.Privileged {
+			sc.Privileged = nil
+			changed = true
+		}
+		if sc.Capabilities != nil && len(sc.Capabilities.Add) > 0 {
+			sc.Capabilities.Add = nil
+			changed = true
+		}
+	}
+	return changed
+}
+
+// relaxHostPathVolumes removes hostPath volumes from the pod along with the volume
+// mounts that reference them.
+func relaxHostPathVolumes(pod *kapi.Pod) bool {
+	removed := sets.NewString()
+	volumes := []kapi.Volume{}
+	for _, volume := range pod.Spec.Volumes {
+		if volume.HostPath != nil {
+			removed.Insert(volume.Name)
+			continue
+		}
+		volumes = append(volumes, volume)
+	}
+	if removed.Len() == 0 {
+		return false
+	}
+	pod.Spec.Volumes = volumes
+	for _, container := range podContainers(pod) {
+		mounts := []kapi.VolumeMount{}
+		for _, mount := range container.VolumeMounts {
+			if removed.Has(mount.Name) {
+				continue
+			}
+			mounts = append(mounts, mount)
+		}
+		container.VolumeMounts = mounts
+	}
+	return true
+}
+
+// relaxHostNamespaces removes use of the host network, PID and IPC namespaces from the pod.
+func relaxHostNamespaces(pod *kapi.Pod) bool {
+	sc := pod.Spec.SecurityContext
+	if sc == nil {
+		return false
+	}
+	changed := false
+	if sc.HostNetwork {
+		sc.HostNetwork = false
+		changed = true
+	}
+	if sc.HostPID {
+		sc.HostPID = false
+		changed = true
+	}
+	if sc.HostIPC {
+		sc.HostIPC = false
+		changed = true
+	}
+	return changed
+}
+
 func containerForName(pod *kapi.Pod, name string) *kapi.Container {
 	for i, c := range pod.Spec.Containers {
 		if c.Name == name {
@@ -7,7 +7,12 @@ import (
 	"io/ioutil"
 	"os"
 	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
 
+	"github.com/ghodss/yaml"
 	"github.com/spf13/cobra"
 
 	kapi "k8s.io/kubernetes/pkg/api"
@@ -30,7 +35,10 @@ var (
 		is when you mount a secret or config map into a container.
 
 		You can limit which keys are extracted with the --keys=NAME flag, or set the directory to extract to
-		with --to=DIRECTORY.`)
+		with --to=DIRECTORY. Multiple objects may be extracted at once, either by naming several resources or
+		by using a label selector. The --format flag switches between writing files (the default), printing
+		the keys as shell environment variables, or printing them as YAML. Keys can be renamed as they are
+		extracted with --rename, which accepts the {name} and {key} placeholders.`)
 
 	extractExample = templates.Examples(`
 		# extract the secret "test" to the current directory
@@ -40,13 +48,29 @@ var (
 	  %[1]s extract configmap/nginx --to=/tmp
 
 	  # extract only the key "nginx.conf" from config map "nginx" to the /tmp directory
-	  %[1]s extract configmap/nginx --to=/tmp --keys=nginx.conf`)
+	  %[1]s extract configmap/nginx --to=/tmp --keys=nginx.conf
+
+	  # extract all config maps labeled app=guestbook as environment variables
+	  %[1]s extract configmaps -l app=guestbook --format=env
+
+	  # extract two secrets, prefixing each file with the name of its secret
+	  %[1]s extract secret/app secret/db --rename='{name}.{key}'`)
+)
+
+// supported output formats for extracted keys
+const (
+	extractFormatFiles = "files"
+	extractFormatEnv   = "env"
+	extractFormatYAML  = "yaml"
 )
 
 type ExtractOptions struct {
 	Out, Err        io.Writer
 	Filenames       []string
 	OnlyKeys        []string
+	Selector        string
+	Format          string
+	Rename          string
 	TargetDirectory string
 	Overwrite       bool
 
@@ -59,6 +83,7 @@ func NewCmdExtract(fullName string, f *clientcmd.Factory, in io.Reader, out, err
 		Out: out,
 		Err: errOut,
 
+		Format:          extractFormatFiles,
 		TargetDirectory: ".",
 	}
 	cmd := &cobra.Command{
@@ -82,6 +107,9 @@ func NewCmdExtract(fullName string, f *clientcmd.Factory, in io.Reader, out, err
 	cmd.Flags().StringSliceVarP(&options.Filenames, "filename", "f", options.Filenames, "Filename, directory, or URL to file to identify to extract the resource.")
 	cmd.MarkFlagFilename("filename")
 	cmd.Flags().StringSliceVar(&options.OnlyKeys, "keys", options.OnlyKeys, "An optional list of keys to extract (default is all keys).")
+	cmd.Flags().StringVarP(&options.Selector, "selector", "l", options.Selector, "Selector (label query) to filter on.")
+	cmd.Flags().StringVar(&options.Format, "format", options.Format, "Output format. One of: files|env|yaml. 'env' and 'yaml' print to standard output instead of writing files.")
+	cmd.Flags().StringVar(&options.Rename, "rename", options.Rename, "Rename keys as they are extracted. May contain the {name} and {key} placeholders, e.g. '{name}.{key}'.")
 	kcmdutil.AddPrinterFlags(cmd)
 	return cmd
 }
@@ -98,7 +126,8 @@ func (o *ExtractOptions) Complete(f *clientcmd.Factory, in io.Reader, out io.Wri
 	b := resource.NewBuilder(mapper, typer, resource.ClientMapperFunc(f.ClientForMapping), kapi.Codecs.UniversalDecoder()).
 		NamespaceParam(cmdNamespace).DefaultNamespace().
 		FilenameParam(explicit, &resource.FilenameOptions{Recursive: false, Filenames: o.Filenames}).
-		ResourceNames("", args...).
+		SelectorParam(o.Selector).
+		ResourceTypeOrNameArgs(true, args...).
 		ContinueOnError().
 		Flatten()
 
@@ -107,9 +136,19 @@ func (o *ExtractOptions) Complete(f *clientcmd.Factory, in io.Reader, out io.Wri
 }
 
 func (o *ExtractOptions) Validate() error {
-	// determine if output location is valid before continuing
-	if _, err := os.Stat(o.TargetDirectory); err != nil {
-		return err
+	switch o.Format {
+	case extractFormatFiles, extractFormatEnv, extractFormatYAML:
+	default:
+		return fmt.Errorf("--format must be one of: %s|%s|%s", extractFormatFiles, extractFormatEnv, extractFormatYAML)
+	}
+	if len(o.Rename) > 0 && !strings.Contains(o.Rename, "{key}") {
+		return fmt.Errorf("--rename must contain the {key} placeholder")
+	}
+	if o.Format == extractFormatFiles {
+		// determine if output location is valid before continuing
+		if _, err := os.Stat(o.TargetDirectory); err != nil {
+			return err
+		}
 	}
 	return nil
 }
@@ -134,16 +173,29 @@ func (o *ExtractOptions) Run() error {
 			return nil
 		}
 		count++
-		var errs []error
+
+		selected := map[string][]byte{}
 		for k, v := range contents {
 			if contains.Len() == 0 || contains.Has(k) {
-				target := filepath.Join(o.TargetDirectory, k)
-				if err := writeToDisk(target, v, o.Overwrite, o.Out); err != nil {
-					if os.IsExist(err) {
-						err = fmt.Errorf("file exists, pass --confirm to overwrite")
-					}
-					errs = append(errs, fmt.Errorf("%s: %v", k, err))
+				selected[renameKey(o.Rename, info.Name, k)] = v
+			}
+		}
+
+		switch o.Format {
+		case extractFormatEnv:
+			return writeEnv(o.Out, selected)
+		case extractFormatYAML:
+			return writeYAMLDoc(o.Out, selected)
+		}
+
+		var errs []error
+		for _, k := range sortedKeys(selected) {
+			target := filepath.Join(o.TargetDirectory, k)
+			if err := writeToDisk(target, selected[k], o.Overwrite, o.Out); err != nil {
+				if os.IsExist(err) {
+					err = fmt.Errorf("file exists, pass --confirm to overwrite")
 				}
+				errs = append(errs, fmt.Errorf("%s: %v", k, err))
 			}
 		}
 		if len(errs) > 0 {
@@ -160,6 +212,59 @@ func (o *ExtractOptions) Run() error {
 	return nil
 }
 
+// renameKey applies the rename template to a key, substituting the {name} and
+// {key} placeholders. An empty template returns the key unchanged.
+func renameKey(template, objectName, key string) string {
+	if len(template) == 0 {
+		return key
+	}
+	return strings.NewReplacer("{name}", objectName, "{key}", key).Replace(template)
+}
+
+func sortedKeys(contents map[string][]byte) []string {
+	keys := make([]string, 0, len(contents))
+	for k := range contents {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+var invalidEnvCharacters = regexp.MustCompile(`[^a-zA-Z0-9_]`)
+
+// writeEnv prints the contents as shell environment variables. Characters that
+// are not valid in environment variable names are replaced with underscores,
+// and values containing newlines are quoted.
+func writeEnv(out io.Writer, contents map[string][]byte) error {
+	for _, k := range sortedKeys(contents) {
+		value := string(contents[k])
+		if strings.ContainsAny(value, "\n\r") {
+			value = strconv.Quote(value)
+		}
+		if _, err := fmt.Fprintf(out, "%s=%s\n", invalidEnvCharacters.ReplaceAllString(k, "_"), value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// writeYAMLDoc prints the contents as a single YAML document
+func writeYAMLDoc(out io.Writer, contents map[string][]byte) error {
+	doc := map[string]string{}
+	for k, v := range contents {
+		doc[k] = string(v)
+	}
+	data, err := yaml.Marshal(doc)
+	if err != nil {
+		return err
+	}
+	if _, err := fmt.Fprintln(out, "---"); err != nil {
+		return err
+	}
+	_, err = out.Write(data)
+	return err
+}
+
 func writeToDisk(path string, data []byte, overwrite bool, out io.Writer) error {
 	if overwrite {
 		if err := ioutil.WriteFile(path, data, 0600); err != nil {
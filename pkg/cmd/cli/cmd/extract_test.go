@@ -0,0 +1,40 @@
+package cmd
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestExtractRenameKey(t *testing.T) {
+	testCases := []struct {
+		template string
+		name     string
+		key      string
+		expected string
+	}{
+		{"", "app", "config.yml", "config.yml"},
+		{"{key}", "app", "config.yml", "config.yml"},
+		{"{name}.{key}", "app", "config.yml", "app.config.yml"},
+		{"local_{key}", "app", "config.yml", "local_config.yml"},
+	}
+	for _, tc := range testCases {
+		if got := renameKey(tc.template, tc.name, tc.key); got != tc.expected {
+			t.Errorf("renameKey(%q, %q, %q) = %q, expected %q", tc.template, tc.name, tc.key, got, tc.expected)
+		}
+	}
+}
+
+func TestExtractWriteEnv(t *testing.T) {
+	out := &bytes.Buffer{}
+	contents := map[string][]byte{
+		"database-url": []byte("postgres://db:5432"),
+		"ca.crt":       []byte("line1\nline2"),
+	}
+	if err := writeEnv(out, contents); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expected := "ca_crt=\"line1\\nline2\"\ndatabase_url=postgres://db:5432\n"
+	if out.String() != expected {
+		t.Errorf("unexpected output:\n got %q\nwant %q", out.String(), expected)
+	}
+}
@@ -42,7 +42,10 @@ var (
 	  %[1]s login localhost:8443 --certificate-authority=/path/to/cert.crt
 
 	  # Log in to the given server with the given credentials (will not prompt interactively)
-	  %[1]s login localhost:8443 --username=myuser --password=mypass`)
+	  %[1]s login localhost:8443 --username=myuser --password=mypass
+
+	  # Log in from a terminal without a browser by entering a code on another device
+	  %[1]s login localhost:8443 --device-code`)
 )
 
 // NewCmdLogin implements the OpenShift cli login command
@@ -90,6 +93,7 @@ func NewCmdLogin(fullName string, f *osclientcmd.Factory, reader io.Reader, out
 	// Login is the only command that can negotiate a session token against the auth server using basic auth
 	cmds.Flags().StringVarP(&options.Username, "username", "u", "", "Username, will prompt if not provided")
 	cmds.Flags().StringVarP(&options.Password, "password", "p", "", "Password, will prompt if not provided")
+	cmds.Flags().BoolVar(&options.DeviceCode, "device-code", false, "Authenticate by entering a code on another device instead of providing credentials here")
 
 	return cmds
 }
@@ -47,9 +47,10 @@ type LoginOptions struct {
 	APIVersion  unversioned.GroupVersion
 
 	// flags and printing helpers
-	Username string
-	Password string
-	Project  string
+	Username   string
+	Password   string
+	Project    string
+	DeviceCode bool
 
 	// infra
 	StartingKubeConfig *kclientcmdapi.Config
@@ -223,7 +224,12 @@ func (o *LoginOptions) gatherAuthInfo() error {
 	clientConfig.KeyData = []byte{}
 	clientConfig.CertFile = o.CertFile
 	clientConfig.KeyFile = o.KeyFile
-	token, err := tokencmd.RequestToken(o.Config, o.Reader, o.Username, o.Password)
+	var token string
+	if o.DeviceCode {
+		token, err = tokencmd.RequestDeviceToken(o.Config, o.Out)
+	} else {
+		token, err = tokencmd.RequestToken(o.Config, o.Reader, o.Username, o.Password)
+	}
 	if err != nil {
 		// if internal error occurs, suggest making sure
 		// client is connecting to the right host:port
@@ -9,12 +9,16 @@ import (
 
 	kapi "k8s.io/kubernetes/pkg/api"
 	"k8s.io/kubernetes/pkg/api/meta"
+	clientset "k8s.io/kubernetes/pkg/client/clientset_generated/internalclientset"
 	kcmd "k8s.io/kubernetes/pkg/kubectl/cmd"
 	kcmdutil "k8s.io/kubernetes/pkg/kubectl/cmd/util"
 	"k8s.io/kubernetes/pkg/kubectl/resource"
+	"k8s.io/kubernetes/pkg/labels"
 	"k8s.io/kubernetes/pkg/runtime"
 
 	buildapi "github.com/openshift/origin/pkg/build/api"
+	buildutil "github.com/openshift/origin/pkg/build/util"
+	"github.com/openshift/origin/pkg/client"
 	"github.com/openshift/origin/pkg/cmd/templates"
 	"github.com/openshift/origin/pkg/cmd/util/clientcmd"
 	deployapi "github.com/openshift/origin/pkg/deploy/api"
@@ -62,6 +66,15 @@ type OpenShiftLogsOptions struct {
 	// KubeLogOptions contains all the necessary options for
 	// running the upstream logs command.
 	KubeLogOptions *kcmd.LogsOptions
+
+	// AllPods fans the log request out to every pod belonging to the requested resource.
+	AllPods bool
+
+	Namespace    string
+	Info         *resource.Info
+	KubeClient   clientset.Interface
+	OriginClient *client.Client
+	Out          io.Writer
 }
 
 // NewCmdLogs creates a new logs command that supports OpenShift resources.
@@ -86,6 +99,7 @@ func NewCmdLogs(name, baseName string, f *clientcmd.Factory, out io.Writer) *cob
 	}
 
 	cmd.Flags().Int64("version", 0, "View the logs of a particular build or deployment by version if greater than zero")
+	cmd.Flags().BoolVar(&o.AllPods, "all-pods", false, "Aggregate the logs of every pod belonging to the specified deployment config, replication controller, or build config")
 
 	return cmd
 }
@@ -117,6 +131,16 @@ func (o *OpenShiftLogsOptions) Complete(f *clientcmd.Factory, cmd *cobra.Command
 		return errors.New("expected a resource")
 	}
 
+	o.Namespace = namespace
+	o.Info = infos[0]
+	o.Out = out
+	if o.KubeClient, err = f.ClientSet(); err != nil {
+		return err
+	}
+	if o.OriginClient, _, err = f.Clients(); err != nil {
+		return err
+	}
+
 	version := kcmdutil.GetFlagInt64(cmd, "version")
 	_, resource := meta.KindToResource(infos[0].Mapping.GroupVersionKind)
 	gr := resource.GroupResource()
@@ -185,6 +209,9 @@ func (o OpenShiftLogsOptions) Validate() error {
 // RunLog will run the upstream logs command and may use an OpenShift
 // logOptions object.
 func (o OpenShiftLogsOptions) RunLog() error {
+	if o.AllPods {
+		return o.runAggregatedLog()
+	}
 	if o.Options != nil {
 		// Use our own options object.
 		o.KubeLogOptions.Options = o.Options
@@ -192,3 +219,82 @@ func (o OpenShiftLogsOptions) RunLog() error {
 	_, err := o.KubeLogOptions.RunLogs()
 	return err
 }
+
+// runAggregatedLog prints the logs of every pod belonging to the requested resource,
+// each section prefixed with the pod name.
+func (o OpenShiftLogsOptions) runAggregatedLog() error {
+	podLogOptions := o.KubeLogOptions.Options.(*kapi.PodLogOptions)
+	if podLogOptions.Follow {
+		return errors.New("cannot use --follow with --all-pods")
+	}
+
+	podNames, err := o.podNames()
+	if err != nil {
+		return err
+	}
+	if len(podNames) == 0 {
+		return fmt.Errorf("no pods found for %s/%s", o.Info.Mapping.Resource, o.Info.Name)
+	}
+	for _, name := range podNames {
+		fmt.Fprintf(o.Out, "==> %s <==\n", name)
+		req := o.KubeClient.Core().Pods(o.Namespace).GetLogs(name, podLogOptions)
+		readCloser, err := req.Stream()
+		if err != nil {
+			fmt.Fprintf(o.Out, "error: %v\n", err)
+			continue
+		}
+		_, err = io.Copy(o.Out, readCloser)
+		readCloser.Close()
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// podNames resolves the pods belonging to the requested resource.
+func (o OpenShiftLogsOptions) podNames() ([]string, error) {
+	_, res := meta.KindToResource(o.Info.Mapping.GroupVersionKind)
+	gr := res.GroupResource()
+
+	var selector labels.Selector
+	switch {
+	case deployapi.IsResourceOrLegacy("deploymentconfig", gr):
+		dc, err := o.OriginClient.DeploymentConfigs(o.Namespace).Get(o.Info.Name)
+		if err != nil {
+			return nil, err
+		}
+		selector = labels.SelectorFromSet(dc.Spec.Selector)
+
+	case gr == kapi.Resource("replicationcontrollers"):
+		rc, err := o.KubeClient.Core().ReplicationControllers(o.Namespace).Get(o.Info.Name)
+		if err != nil {
+			return nil, err
+		}
+		selector = labels.SelectorFromSet(rc.Spec.Selector)
+
+	case buildapi.IsResourceOrLegacy("buildconfig", gr):
+		builds, err := o.OriginClient.Builds(o.Namespace).List(kapi.ListOptions{LabelSelector: buildutil.BuildConfigSelector(o.Info.Name)})
+		if err != nil {
+			return nil, err
+		}
+		names := []string{}
+		for i := range builds.Items {
+			names = append(names, buildapi.GetBuildPodName(&builds.Items[i]))
+		}
+		return names, nil
+
+	default:
+		return nil, fmt.Errorf("--all-pods is not supported for %s", gr)
+	}
+
+	pods, err := o.KubeClient.Core().Pods(o.Namespace).List(kapi.ListOptions{LabelSelector: selector})
+	if err != nil {
+		return nil, err
+	}
+	names := []string{}
+	for i := range pods.Items {
+		names = append(names, pods.Items[i].Name)
+	}
+	return names, nil
+}
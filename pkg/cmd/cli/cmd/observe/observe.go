@@ -31,6 +31,7 @@ import (
 	"k8s.io/kubernetes/pkg/kubectl/resource"
 	"k8s.io/kubernetes/pkg/runtime"
 	"k8s.io/kubernetes/pkg/util/jsonpath"
+	"k8s.io/kubernetes/pkg/util/wait"
 	"k8s.io/kubernetes/pkg/watch"
 
 	"github.com/openshift/origin/pkg/cmd/templates"
@@ -197,6 +198,7 @@ func NewCmdObserve(fullName string, f *clientcmd.Factory, out, errOut io.Writer)
 
 	// add additional arguments / info to the server
 	cmd.Flags().StringVar(&options.templateType, "output", options.templateType, "Controls the template type used for the --argument flags. Supported values are gotemplate and jsonpath.")
+	cmd.Flags().StringVar(&options.objectTemplate, "object-template", "", "A Go template executed against the full object for each event. Its output is written to stdout in place of invoking a command, or in addition to one.")
 	cmd.Flags().BoolVar(&options.strictTemplates, "strict-templates", false, "If true return an error on any field or map key that is not missing in a template.")
 	cmd.Flags().VarP(&options.templates, "argument", "a", "Template for the arguments to be passed to each command in the format defined by --output.")
 	cmd.Flags().StringVar(&options.typeEnvVar, "type-env-var", "", "The name of an env var to set with the type of event received ('Sync', 'Updated', 'Deleted', 'Added') to the reaction command or --delete.")
@@ -214,6 +216,10 @@ func NewCmdObserve(fullName string, f *clientcmd.Factory, out, errOut io.Writer)
 	cmd.Flags().BoolVar(&options.printMetricsOnExit, "print-metrics-on-exit", false, "If true, on exit write all metrics to stdout.")
 	cmd.Flags().StringVar(&options.listenAddr, "listen-addr", options.listenAddr, "The name of an interface to listen on to expose metrics and health checking.")
 
+	// hooks for lifecycle and failures
+	cmd.Flags().Var(&options.exitCommand, "exit-command", "A command to run when observe terminates for any reason. The exit reason is passed as the first argument. Specify multiple times to add arguments.")
+	cmd.Flags().Var(&options.alertCommand, "alert-command", "A command to run each time a reaction command fails. The error message is passed as the first argument. Specify multiple times to add arguments.")
+
 	// additional debug output
 	cmd.Flags().BoolVar(&options.noHeaders, "no-headers", false, "If true, skip printing information about each event prior to executing the command.")
 
@@ -242,6 +248,10 @@ type ObserveOptions struct {
 	typeEnvVar    string
 	deleteCommand stringSliceFlag
 
+	// hooks for lifecycle and failures
+	exitCommand  stringSliceFlag
+	alertCommand stringSliceFlag
+
 	// controls whether deletes are included
 	nameSyncCommand stringSliceFlag
 
@@ -263,6 +273,10 @@ type ObserveOptions struct {
 	printer         ColumnPrinter
 	strictTemplates bool
 
+	// objectTemplate is executed against the full object for each event
+	objectTemplate         string
+	compiledObjectTemplate *template.Template
+
 	argumentStore *objectArgumentsStore
 	// knownObjects is nil if we do not need to track deletions
 	knownObjects knownObjects
@@ -335,6 +349,19 @@ func (o *ObserveOptions) Complete(f *clientcmd.Factory, cmd *cobra.Command, args
 		return fmt.Errorf("template type %q not recognized - valid values are jsonpath and gotemplate", o.templateType)
 	}
 	o.printer = NewVersionedColumnPrinter(o.printer, o.mapping.ObjectConvertor, version.GroupVersion())
+
+	if len(o.objectTemplate) > 0 {
+		t := template.New("object")
+		if !o.strictTemplates {
+			t.Option("missingkey=zero")
+		}
+		compiled, err := t.Parse(o.objectTemplate)
+		if err != nil {
+			return fmt.Errorf("unable to parse --object-template: %v", err)
+		}
+		o.compiledObjectTemplate = compiled
+	}
+
 	o.out, o.errOut = out, errOut
 	if o.noHeaders {
 		o.debugOut = ioutil.Discard
@@ -390,7 +417,16 @@ func (o *ObserveOptions) Validate(args []string) error {
 	return nil
 }
 
-func (o *ObserveOptions) Run() error {
+func (o *ObserveOptions) Run() (err error) {
+	// notify the exit hook with the reason the command is terminating
+	defer func() {
+		reason := "exit"
+		if err != nil {
+			reason = err.Error()
+		}
+		o.runHook(o.exitCommand, reason)
+	}()
+
 	if len(o.deleteCommand) > 0 && len(o.nameSyncCommand) == 0 {
 		fmt.Fprintf(o.errOut, "warning: If you are modifying resources outside of %q, you should use the --names command to ensure you don't miss deletions that occur while the command is not running.\n", o.mapping.Resource)
 	}
@@ -434,15 +470,33 @@ func (o *ObserveOptions) Run() error {
 			defer lock.Unlock()
 			o.dumpMetrics()
 			fmt.Fprintf(o.errOut, "Shutting down after %s ...\n", o.exitAfterPeriod)
+			o.runHook(o.exitCommand, fmt.Sprintf("exited after %s", o.exitAfterPeriod))
 			os.Exit(0)
 		}()
 	}
 
 	defer o.dumpMetrics()
 
-	// start the reflector
+	// start the reflector, retrying watch failures with an exponential backoff
+	// with jitter so that a flapping server is not overwhelmed
 	reflector := cache.NewNamedReflector("observer", lw, nil, store, o.resyncPeriod)
-	reflector.Run()
+	go func() {
+		delay := time.Second
+		for {
+			start := time.Now()
+			if err := reflector.ListAndWatch(wait.NeverStop); err != nil {
+				glog.V(2).Infof("Watch failed: %v", err)
+			}
+			// a watch that survived for a while earns a fresh backoff
+			if time.Since(start) > time.Minute {
+				delay = time.Second
+			}
+			time.Sleep(wait.Jitter(delay, 0.5))
+			if delay < 30*time.Second {
+				delay *= 2
+			}
+		}
+	}()
 
 	if o.once {
 		// wait until the reflector reports it has completed the initial list and the
@@ -579,7 +633,7 @@ func (o *ObserveOptions) calculateArguments(delta cache.Delta) (runtime.Object,
 		} else {
 			saved := objectArguments{key: key, arguments: arguments}
 			// only cache the object data if the commands will be using it.
-			if len(o.objectEnvVar) > 0 {
+			if len(o.objectEnvVar) > 0 || o.compiledObjectTemplate != nil {
 				saved.output = output
 			}
 			o.argumentStore.Put(key, saved)
@@ -647,6 +701,18 @@ func (o *ObserveOptions) next(deltaType cache.DeltaType, obj runtime.Object, out
 
 	args = append(args, arguments...)
 
+	if o.compiledObjectTemplate != nil && len(output) > 0 {
+		obj := map[string]interface{}{}
+		if err := json.Unmarshal(output, &obj); err != nil {
+			return o.handleCommandError(fmt.Errorf("unable to decode object for --object-template: %v", err))
+		}
+		buf := &bytes.Buffer{}
+		if err := o.compiledObjectTemplate.Execute(buf, obj); err != nil {
+			return o.handleCommandError(fmt.Errorf("error executing --object-template: %v", err))
+		}
+		fmt.Fprintln(o.out, buf.String())
+	}
+
 	if len(command) == 0 {
 		fmt.Fprintf(o.debugOut, "# %s %s %s\t%s\n", time.Now().Format(time.RFC3339), outType, resourceVersion, printCommandLine(command, args...))
 		return nil
@@ -687,12 +753,28 @@ func (o *ObserveOptions) handleCommandError(err error) error {
 	}
 	o.observedErrors++
 	fmt.Fprintf(o.errOut, "error: %v\n", err)
+	o.runHook(o.alertCommand, err.Error())
 	if o.maximumErrors == -1 || o.observedErrors < o.maximumErrors {
 		return nil
 	}
 	return fmt.Errorf("reached maximum error limit of %d, exiting", o.maximumErrors)
 }
 
+// runHook invokes the provided hook command with the given arguments. Hooks are
+// best effort - a failing hook is reported but never alters the outcome of the
+// command being observed.
+func (o *ObserveOptions) runHook(hook stringSliceFlag, args ...string) {
+	if len(hook) == 0 {
+		return
+	}
+	cmd := exec.Command(hook[0], append(append([]string{}, hook[1:]...), args...)...)
+	cmd.Stdout = o.errOut
+	cmd.Stderr = o.errOut
+	if err := cmd.Run(); err != nil {
+		fmt.Fprintf(o.errOut, "error: hook %q failed: %v\n", hook[0], err)
+	}
+}
+
 func (o *ObserveOptions) dumpMetrics() {
 	if !o.printMetricsOnExit {
 		return
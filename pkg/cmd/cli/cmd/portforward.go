@@ -0,0 +1,185 @@
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"net/url"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/client/unversioned/portforward"
+	"k8s.io/kubernetes/pkg/client/unversioned/remotecommand"
+	kubecmd "k8s.io/kubernetes/pkg/kubectl/cmd"
+	kcmdutil "k8s.io/kubernetes/pkg/kubectl/cmd/util"
+
+	"github.com/openshift/origin/pkg/cmd/templates"
+	"github.com/openshift/origin/pkg/cmd/util"
+	"github.com/openshift/origin/pkg/cmd/util/clientcmd"
+)
+
+var (
+	portForwardLong = templates.LongDesc(`
+		Forward 1 or more local ports to a pod
+
+		This command forwards local ports to a pod for the specified resource. It works with
+		pods, services, routes, deployment configs, replication controllers and other resources
+		that resolve to pods. Any of the aforementioned resources (apart from pods) will be
+		resolved to a ready pod; if the pod dies while the session is active, a new pod is
+		resolved automatically and forwarding resumes.`)
+
+	portForwardExample = templates.Examples(`
+		# Listens on ports 5000 and 6000 locally, forwarding data to/from ports 5000 and 6000 in the pod
+	  %[1]s port-forward mypod 5000 6000
+
+	  # Listens on port 8888 locally, forwarding to 5000 in the pod
+	  %[1]s port-forward mypod 8888:5000
+
+	  # Listens on port 5432 locally, forwarding to the database pod behind a service
+	  %[1]s port-forward svc/postgresql 5432
+
+	  # Listens on a random port locally, forwarding to 5000 in the pod
+	  %[1]s port-forward mypod :5000
+
+	  # Listens on a random port locally, forwarding to 5000 in the pod
+	  %[1]s port-forward mypod 0:5000`)
+)
+
+// PortForwardOptions declare the arguments accepted by the port-forward command
+type PortForwardOptions struct {
+	Namespace string
+	Resource  string
+	Ports     []string
+	Timeout   int
+
+	// Retarget indicates the target was resolved through another resource and a new pod may
+	// be resolved if the current one goes away mid-session
+	Retarget bool
+
+	Factory *clientcmd.Factory
+	Forward *kubecmd.PortForwardOptions
+	ErrOut  io.Writer
+}
+
+// NewCmdPortForward implements the OpenShift cli port-forward command
+func NewCmdPortForward(fullName string, f *clientcmd.Factory, out, errout io.Writer) *cobra.Command {
+	options := &PortForwardOptions{
+		Timeout: 10,
+		Factory: f,
+		ErrOut:  errout,
+	}
+
+	cmd := &cobra.Command{
+		Use:     "port-forward RESOURCE [LOCAL_PORT:]REMOTE_PORT [...[LOCAL_PORT_N:]REMOTE_PORT_N]",
+		Short:   "Forward one or more local ports to a pod",
+		Long:    portForwardLong,
+		Example: fmt.Sprintf(portForwardExample, fullName),
+		Run: func(cmd *cobra.Command, args []string) {
+			if err := options.Complete(f, args, out, errout); err != nil {
+				kcmdutil.CheckErr(kcmdutil.UsageError(cmd, err.Error()))
+			}
+			kcmdutil.CheckErr(options.RunPortForward())
+		},
+	}
+	cmd.Flags().IntVar(&options.Timeout, "timeout", 10, "Request timeout for obtaining a pod from the server; defaults to 10 seconds")
+
+	return cmd
+}
+
+// Complete resolves the requested resource and fills in the forwarding options
+func (o *PortForwardOptions) Complete(f *clientcmd.Factory, args []string, out, errout io.Writer) error {
+	if len(args) < 2 {
+		return fmt.Errorf("you must specify a resource and at least 1 PORT")
+	}
+	o.Resource = args[0]
+	o.Ports = args[1:]
+
+	var err error
+	o.Namespace, _, err = f.DefaultNamespace()
+	if err != nil {
+		return err
+	}
+
+	mapper, _ := f.Object()
+	resourceType, _, err := util.ResolveResource(kapi.Resource("pods"), o.Resource, mapper)
+	if err != nil {
+		return err
+	}
+	o.Retarget = resourceType != kapi.Resource("pods")
+
+	clientset, err := f.ClientSet()
+	if err != nil {
+		return err
+	}
+	config, err := f.ClientConfig()
+	if err != nil {
+		return err
+	}
+	restClient, err := f.RESTClient()
+	if err != nil {
+		return err
+	}
+
+	o.Forward = &kubecmd.PortForwardOptions{
+		Namespace:  o.Namespace,
+		Ports:      o.Ports,
+		PodClient:  clientset.Core(),
+		Config:     config,
+		RESTClient: restClient,
+		PortForwarder: &defaultPortForwarder{
+			cmdOut: out,
+			cmdErr: errout,
+		},
+	}
+
+	return nil
+}
+
+// RunPortForward forwards ports to the resolved pod, resolving a replacement pod and
+// resuming the session if the current pod goes away while forwarding
+func (o *PortForwardOptions) RunPortForward() error {
+	for {
+		podName, err := o.Factory.PodForResource(o.Resource, time.Duration(o.Timeout)*time.Second)
+		if err != nil {
+			return err
+		}
+
+		forward := *o.Forward
+		forward.PodName = podName
+		forward.StopChannel = make(chan struct{}, 1)
+		forward.ReadyChannel = make(chan struct{})
+
+		err = forward.RunPortForward()
+		if err == nil {
+			return nil
+		}
+		if !o.Retarget {
+			return err
+		}
+
+		// only resume when the failure was the resolved pod going away
+		pod, getErr := o.Forward.PodClient.Pods(o.Namespace).Get(podName)
+		if getErr == nil && pod.DeletionTimestamp == nil && pod.Status.Phase == kapi.PodRunning {
+			return err
+		}
+		fmt.Fprintf(o.ErrOut, "Connection to pod %s lost, resolving a new pod for %s\n", podName, o.Resource)
+	}
+}
+
+// defaultPortForwarder dials the pod's portforward subresource and runs the forwarding loop
+type defaultPortForwarder struct {
+	cmdOut, cmdErr io.Writer
+}
+
+func (f *defaultPortForwarder) ForwardPorts(method string, url *url.URL, opts kubecmd.PortForwardOptions) error {
+	dialer, err := remotecommand.NewExecutor(opts.Config, method, url)
+	if err != nil {
+		return err
+	}
+	fw, err := portforward.New(dialer, opts.Ports, opts.StopChannel, opts.ReadyChannel, f.cmdOut, f.cmdErr)
+	if err != nil {
+		return err
+	}
+	return fw.ForwardPorts()
+}
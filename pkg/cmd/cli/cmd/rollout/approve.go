@@ -0,0 +1,146 @@
+package rollout
+
+import (
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/openshift/origin/pkg/cmd/templates"
+	"github.com/spf13/cobra"
+	"k8s.io/kubernetes/pkg/api/meta"
+	kcmdutil "k8s.io/kubernetes/pkg/kubectl/cmd/util"
+	"k8s.io/kubernetes/pkg/kubectl/resource"
+	"k8s.io/kubernetes/pkg/runtime"
+
+	"github.com/openshift/origin/pkg/client"
+	"github.com/openshift/origin/pkg/cmd/util/clientcmd"
+	deployapi "github.com/openshift/origin/pkg/deploy/api"
+)
+
+var (
+	rolloutApproveLong = templates.LongDesc(`
+		Approve the pending images of a deployment config and start a new rollout
+
+		Image change triggers marked with approvalRequired do not roll out new images
+		automatically. Instead, the deployment config records the new images as pending
+		in its status. This command applies the pending images and starts a new rollout.`)
+
+	rolloutApproveExample = templates.Examples(`
+		# Approve the pending images recorded on the nginx deployment config
+  	%[1]s rollout approve dc/nginx`)
+)
+
+// RolloutApproveOptions holds all the options for the `rollout approve` command.
+type RolloutApproveOptions struct {
+	mapper meta.RESTMapper
+	typer  runtime.ObjectTyper
+	infos  []*resource.Info
+
+	out    io.Writer
+	output string
+
+	oc              client.Interface
+	baseCommandName string
+}
+
+// NewCmdRolloutApprove implements the oc rollout approve subcommand.
+func NewCmdRolloutApprove(fullName string, f *clientcmd.Factory, out io.Writer) *cobra.Command {
+	opts := &RolloutApproveOptions{
+		baseCommandName: fullName,
+	}
+
+	cmd := &cobra.Command{
+		Use:     "approve DEPLOYMENTCONFIG",
+		Short:   "Approve the pending images of a deployment config and start a new rollout",
+		Long:    rolloutApproveLong,
+		Example: fmt.Sprintf(rolloutApproveExample, fullName),
+		Run: func(cmd *cobra.Command, args []string) {
+			err := opts.Complete(f, cmd, args, out)
+			kcmdutil.CheckErr(err)
+
+			if err := opts.Validate(); err != nil {
+				kcmdutil.CheckErr(kcmdutil.UsageError(cmd, err.Error()))
+			}
+
+			err = opts.RunRolloutApprove()
+			kcmdutil.CheckErr(err)
+		},
+		ValidArgs: []string{"deploymentconfig"},
+	}
+
+	kcmdutil.AddPrinterFlags(cmd)
+
+	return cmd
+}
+
+func (o *RolloutApproveOptions) Complete(f *clientcmd.Factory, cmd *cobra.Command, args []string, out io.Writer) error {
+	if len(args) != 1 {
+		return errors.New("one deployment config name is needed as argument.")
+	}
+
+	namespace, _, err := f.DefaultNamespace()
+	if err != nil {
+		return err
+	}
+
+	o.oc, _, err = f.Clients()
+	if err != nil {
+		return err
+	}
+
+	o.mapper, o.typer = f.Object()
+	o.infos, err = resource.NewBuilder(o.mapper, o.typer, resource.ClientMapperFunc(f.ClientForMapping), f.Decoder(true)).
+		ContinueOnError().
+		NamespaceParam(namespace).
+		ResourceNames("deploymentconfigs", args[0]).
+		SingleResourceType().
+		Do().Infos()
+	if err != nil {
+		return err
+	}
+
+	o.out = out
+	o.output = kcmdutil.GetFlagString(cmd, "output")
+
+	return nil
+}
+
+func (o RolloutApproveOptions) Validate() error {
+	if len(o.infos) != 1 {
+		return errors.New("a deployment config name is required.")
+	}
+	return nil
+}
+
+func (o RolloutApproveOptions) RunRolloutApprove() error {
+	info := o.infos[0]
+	config, ok := info.Object.(*deployapi.DeploymentConfig)
+	if !ok {
+		return fmt.Errorf("%s is not a deployment config", info.Name)
+	}
+
+	if config.Spec.Paused {
+		return fmt.Errorf("cannot deploy a paused deployment config")
+	}
+
+	if len(config.Status.PendingImages) == 0 {
+		return fmt.Errorf("deployment config %q has no pending images to approve", config.Name)
+	}
+
+	request := &deployapi.DeploymentRequest{
+		Name:    config.Name,
+		Latest:  true,
+		Force:   false,
+		Approve: true,
+	}
+
+	dc, err := o.oc.DeploymentConfigs(config.Namespace).Instantiate(request)
+	if err != nil {
+		return err
+	}
+
+	info.Refresh(dc, true)
+
+	kcmdutil.PrintSuccess(o.mapper, o.output == "name", o.out, info.Mapping.Resource, info.Name, false, "approved")
+	return nil
+}
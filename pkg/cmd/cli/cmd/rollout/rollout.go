@@ -7,9 +7,12 @@ import (
 	"github.com/spf13/cobra"
 	"k8s.io/kubernetes/pkg/kubectl/cmd/rollout"
 	cmdutil "k8s.io/kubernetes/pkg/kubectl/cmd/util"
+	"k8s.io/kubernetes/pkg/kubectl/resource"
 
 	"github.com/openshift/origin/pkg/cmd/templates"
 	"github.com/openshift/origin/pkg/cmd/util/clientcmd"
+	deployapi "github.com/openshift/origin/pkg/deploy/api"
+	deploycmd "github.com/openshift/origin/pkg/deploy/cmd"
 )
 
 var (
@@ -50,6 +53,7 @@ func NewCmdRollout(fullName string, f *clientcmd.Factory, out, errOut io.Writer)
 	cmd.AddCommand(NewCmdRolloutStatus(fullName, f, out))
 	cmd.AddCommand(NewCmdRolloutCancel(fullName, f, out))
 	cmd.AddCommand(NewCmdRolloutRetry(fullName, f, out))
+	cmd.AddCommand(NewCmdRolloutApprove(fullName, f, out))
 
 	return cmd
 }
@@ -59,14 +63,19 @@ var (
 		View the history of rollouts for a specific deployment config
 
 		You can also view more detailed information for a specific revision
-		by using the --revision flag.`)
+		by using the --revision flag. Combining --revision with --diff renders
+		a structured diff of the pod templates of two revisions, covering
+		images, environment variables, and compute resources.`)
 
 	rolloutHistoryExample = templates.Examples(`
 		# View the rollout history of a deployment
 	  %[1]s rollout history dc/nginx
 
 	  # View the details of deployment revision 3
-	  %[1]s rollout history dc/nginx --revision=3`)
+	  %[1]s rollout history dc/nginx --revision=3
+
+	  # View what changed between deployment revisions 3 and 5
+	  %[1]s rollout history dc/nginx --revision=3 --diff=5`)
 )
 
 // NewCmdRolloutHistory is a wrapper for the Kubernetes cli rollout history command
@@ -75,9 +84,69 @@ func NewCmdRolloutHistory(fullName string, f *clientcmd.Factory, out io.Writer)
 	cmd.Long = rolloutHistoryLong
 	cmd.Example = fmt.Sprintf(rolloutHistoryExample, fullName)
 	cmd.ValidArgs = append(cmd.ValidArgs, "deploymentconfig")
+	cmd.Flags().Int64("diff", 0, "Show a diff of the pod templates between --revision and this revision. Only supported for deployment configs.")
+
+	// Deployment config revision diffing is not part of the upstream history
+	// command, handle it here and fall through for everything else.
+	kubeRun := cmd.Run
+	cmd.Run = func(cmd *cobra.Command, args []string) {
+		if cmdutil.GetFlagInt64(cmd, "diff") != 0 {
+			cmdutil.CheckErr(runHistoryDiff(f, cmd, out, args))
+			return
+		}
+		kubeRun(cmd, args)
+	}
 	return cmd
 }
 
+// runHistoryDiff renders a diff of the pod templates of two deployment config revisions
+func runHistoryDiff(f *clientcmd.Factory, cmd *cobra.Command, out io.Writer, args []string) error {
+	baseRevision := cmdutil.GetFlagInt64(cmd, "revision")
+	diffRevision := cmdutil.GetFlagInt64(cmd, "diff")
+	if baseRevision <= 0 {
+		return cmdutil.UsageError(cmd, "--diff requires --revision to identify the revision to compare against")
+	}
+	if diffRevision <= 0 {
+		return cmdutil.UsageError(cmd, "--diff must be a positive revision number")
+	}
+	if len(args) != 1 {
+		return cmdutil.UsageError(cmd, "a single deployment config is required")
+	}
+
+	namespace, _, err := f.DefaultNamespace()
+	if err != nil {
+		return err
+	}
+	mapper, typer := f.Object()
+	infos, err := resource.NewBuilder(mapper, typer, resource.ClientMapperFunc(f.ClientForMapping), f.Decoder(true)).
+		NamespaceParam(namespace).
+		ResourceNames("deploymentconfigs", args[0]).
+		SingleResourceType().
+		Do().Infos()
+	if err != nil {
+		return err
+	}
+	if len(infos) != 1 {
+		return fmt.Errorf("a deployment config name is required")
+	}
+	info := infos[0]
+	if _, ok := info.Object.(*deployapi.DeploymentConfig); !ok {
+		return fmt.Errorf("--diff is only supported for deployment configs")
+	}
+
+	oc, kc, err := f.Clients()
+	if err != nil {
+		return err
+	}
+	viewer := deploycmd.NewDeploymentConfigHistoryViewer(oc, kc).(*deploycmd.DeploymentConfigHistoryViewer)
+	diff, err := viewer.ViewHistoryDiff(info.Namespace, info.Name, baseRevision, diffRevision)
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprint(out, diff)
+	return err
+}
+
 var (
 	rolloutPauseLong = templates.LongDesc(`
     Mark the provided resource as paused
@@ -3,6 +3,7 @@ package cmd
 import (
 	"fmt"
 	"io"
+	"strings"
 	"time"
 
 	"github.com/spf13/cobra"
@@ -11,6 +12,7 @@ import (
 	kcmdutil "k8s.io/kubernetes/pkg/kubectl/cmd/util"
 	"k8s.io/kubernetes/pkg/util/term"
 
+	"github.com/openshift/origin/pkg/client"
 	"github.com/openshift/origin/pkg/cmd/templates"
 	"github.com/openshift/origin/pkg/cmd/util"
 	"github.com/openshift/origin/pkg/cmd/util/clientcmd"
@@ -19,6 +21,11 @@ import (
 const (
 	RshRecommendedName = "rsh"
 	DefaultShell       = "/bin/sh"
+
+	// RshRecordAnnotationPrefix is the prefix of the annotation written to the target pod
+	// when --record is specified. The full annotation key is suffixed with the container
+	// name, and the value records who ran what, and when.
+	RshRecordAnnotationPrefix = "rsh.openshift.io/session-"
 )
 
 var (
@@ -47,6 +54,9 @@ var (
 	  # Run the command 'cat /etc/resolv.conf' inside pod 'foo'
 	  %[1]s foo cat /etc/resolv.conf
 
+	  # Run 'id' in every container of pod 'foo'
+	  %[1]s --all-containers foo id
+
 	  # See the configuration of your internal registry
 	  %[1]s dc/docker-registry cat config.yml
 
@@ -56,10 +66,13 @@ var (
 
 // RshOptions declare the arguments accepted by the Rsh command
 type RshOptions struct {
-	ForceTTY   bool
-	DisableTTY bool
-	Executable string
-	Timeout    int
+	ForceTTY      bool
+	DisableTTY    bool
+	AllContainers bool
+	Record        bool
+	Executable    string
+	Timeout       int
+	Client        *client.Client
 	*kubecmd.ExecOptions
 }
 
@@ -97,6 +110,8 @@ func NewCmdRsh(name string, parent string, f *clientcmd.Factory, in io.Reader, o
 	}
 	cmd.Flags().BoolVarP(&options.ForceTTY, "tty", "t", false, "Force a pseudo-terminal to be allocated")
 	cmd.Flags().BoolVarP(&options.DisableTTY, "no-tty", "T", false, "Disable pseudo-terminal allocation")
+	cmd.Flags().BoolVar(&options.AllContainers, "all-containers", false, "Run the command in every container of the pod; disables TTY allocation")
+	cmd.Flags().BoolVar(&options.Record, "record", false, "Record who ran what in an annotation on the pod, for audit purposes")
 	cmd.Flags().StringVar(&options.Executable, "shell", DefaultShell, "Path to the shell command")
 	cmd.Flags().IntVar(&options.Timeout, "timeout", 10, "Request timeout for obtaining a pod from the server; defaults to 10 seconds")
 	cmd.Flags().StringVarP(&options.ContainerName, "container", "c", "", "Container name; defaults to first container")
@@ -109,6 +124,10 @@ func (o *RshOptions) Complete(f *clientcmd.Factory, cmd *cobra.Command, args []s
 	switch {
 	case o.ForceTTY && o.DisableTTY:
 		return kcmdutil.UsageError(cmd, "you may not specify -t and -T together")
+	case o.AllContainers:
+		// fanning out to multiple containers cannot share a single terminal
+		o.TTY = false
+		o.Stdin = false
 	case o.ForceTTY:
 		o.TTY = true
 	case o.DisableTTY:
@@ -146,12 +165,25 @@ func (o *RshOptions) Complete(f *clientcmd.Factory, cmd *cobra.Command, args []s
 	}
 	o.PodClient = client
 
+	o.Client, _, err = f.Clients()
+	if err != nil {
+		return err
+	}
+
 	o.PodName, err = f.PodForResource(resource, time.Duration(o.Timeout)*time.Second)
 	return err
 }
 
 // Validate ensures that RshOptions are valid
 func (o *RshOptions) Validate() error {
+	if o.AllContainers {
+		if o.ForceTTY {
+			return fmt.Errorf("you may not specify -t and --all-containers together")
+		}
+		if len(o.ContainerName) > 0 {
+			return fmt.Errorf("you may not specify -c and --all-containers together")
+		}
+	}
 	return o.ExecOptions.Validate()
 }
 
@@ -162,5 +194,57 @@ func (o *RshOptions) Run() error {
 		termsh := fmt.Sprintf("TERM=%q %s", util.Env("TERM", "xterm"), DefaultShell)
 		o.Command = append(o.Command, "-c", termsh)
 	}
-	return o.ExecOptions.Run()
+
+	if !o.AllContainers {
+		if err := o.record(o.ContainerName); err != nil {
+			return err
+		}
+		return o.ExecOptions.Run()
+	}
+
+	pod, err := o.PodClient.Pods(o.Namespace).Get(o.PodName)
+	if err != nil {
+		return err
+	}
+	var errs []string
+	for _, container := range pod.Spec.Containers {
+		fmt.Fprintf(o.Out, "# %s\n", container.Name)
+		o.ContainerName = container.Name
+		if err := o.record(container.Name); err != nil {
+			return err
+		}
+		if err := o.ExecOptions.Run(); err != nil {
+			errs = append(errs, fmt.Sprintf("%s: %v", container.Name, err))
+		}
+	}
+	if len(errs) > 0 {
+		return fmt.Errorf("command failed in the following containers:\n%s", strings.Join(errs, "\n"))
+	}
+	return nil
+}
+
+// record annotates the target pod with the identity of the caller, the command being run,
+// and the time it was run, so that cluster audit tooling can reconstruct exec activity.
+func (o *RshOptions) record(containerName string) error {
+	if !o.Record {
+		return nil
+	}
+	me, err := o.Client.Users().Get("~")
+	if err != nil {
+		return err
+	}
+	pod, err := o.PodClient.Pods(o.Namespace).Get(o.PodName)
+	if err != nil {
+		return err
+	}
+	if pod.Annotations == nil {
+		pod.Annotations = map[string]string{}
+	}
+	key := RshRecordAnnotationPrefix + containerName
+	if len(containerName) == 0 {
+		key = RshRecordAnnotationPrefix + pod.Spec.Containers[0].Name
+	}
+	pod.Annotations[key] = fmt.Sprintf("user=%s time=%s command=%q", me.Name, time.Now().UTC().Format(time.RFC3339), strings.Join(o.Command, " "))
+	_, err = o.PodClient.Pods(o.Namespace).Update(pod)
+	return err
 }
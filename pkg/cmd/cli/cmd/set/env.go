@@ -93,6 +93,7 @@ func NewCmdEnv(fullName string, f *clientcmd.Factory, in io.Reader, out, errout
 	cmd.Flags().StringP("prefix", "", "", "Prefix to append to variable names")
 	cmd.Flags().StringArrayVarP(&env, "env", "e", env, "Specify a key-value pair for an environment variable to set into each container.")
 	cmd.Flags().Bool("list", false, "If true, display the environment and any changes in the standard format")
+	cmd.Flags().Bool("diff", false, "If true, display a unified diff of the changes that would be made without applying them.")
 	cmd.Flags().Bool("resolve", false, "If true, show secret or configmap references when listing variables")
 	cmd.Flags().StringP("selector", "l", "", "Selector (label query) to filter on")
 	cmd.Flags().Bool("all", false, "If true, select all resources in the namespace of the specified resource types")
@@ -236,6 +237,7 @@ func RunEnv(f *clientcmd.Factory, in io.Reader, out, errout io.Writer, cmd *cobr
 
 	containerMatch := kcmdutil.GetFlagString(cmd, "containers")
 	list := kcmdutil.GetFlagBool(cmd, "list")
+	diff := kcmdutil.GetFlagBool(cmd, "diff")
 	resolve := kcmdutil.GetFlagBool(cmd, "resolve")
 	selector := kcmdutil.GetFlagString(cmd, "selector")
 	all := kcmdutil.GetFlagBool(cmd, "all")
@@ -248,6 +250,12 @@ func RunEnv(f *clientcmd.Factory, in io.Reader, out, errout io.Writer, cmd *cobr
 	if list && len(outputFormat) > 0 {
 		return kcmdutil.UsageError(cmd, "--list and --output may not be specified together")
 	}
+	if diff && len(outputFormat) > 0 {
+		return kcmdutil.UsageError(cmd, "--diff and --output may not be specified together")
+	}
+	if diff && list {
+		return kcmdutil.UsageError(cmd, "--diff and --list may not be specified together")
+	}
 
 	clientConfig, err := f.ClientConfig()
 	if err != nil {
@@ -504,6 +512,16 @@ updates:
 		if err != nil {
 			return err
 		}
+		if diff {
+			if string(patchBytes) == "{}" || len(patchBytes) == 0 {
+				fmt.Fprintf(errout, "info: %s %q was not changed\n", info.Mapping.Resource, info.Name)
+				continue
+			}
+			if err := printUnifiedDiff(out, &Patch{Info: info, Before: oldData[i], After: newData, Patch: patchBytes}); err != nil {
+				return err
+			}
+			continue
+		}
 		obj, err := resource.NewHelper(info.Client, info.Mapping).Patch(info.Namespace, info.Name, kapi.StrategicMergePatchType, patchBytes)
 		if err != nil {
 			handlePodUpdateError(errout, err, "environment variables")
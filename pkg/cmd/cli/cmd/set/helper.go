@@ -5,6 +5,8 @@ import (
 	"io"
 	"strings"
 
+	"github.com/ghodss/yaml"
+
 	kapi "k8s.io/kubernetes/pkg/api"
 	"k8s.io/kubernetes/pkg/api/errors"
 	kcmdutil "k8s.io/kubernetes/pkg/kubectl/cmd/util"
@@ -171,3 +173,163 @@ func CalculatePatches(infos []*resource.Info, encoder runtime.Encoder, mutateFn
 	}
 	return patches
 }
+
+// diffContext is the number of unchanged lines shown around each hunk of a
+// unified diff.
+const diffContext = 3
+
+// printPatchDiffs writes a unified diff for each patch describing the change
+// that would be applied. Patches with errors are reported on errOut and cause
+// a non-nil error to be returned once all patches have been printed.
+func printPatchDiffs(out, errOut io.Writer, patches []*Patch) error {
+	failed := false
+	for _, patch := range patches {
+		info := patch.Info
+		if patch.Err != nil {
+			failed = true
+			fmt.Fprintf(errOut, "error: %s/%s %v\n", info.Mapping.Resource, info.Name, patch.Err)
+			continue
+		}
+		if string(patch.Patch) == "{}" || len(patch.Patch) == 0 {
+			fmt.Fprintf(errOut, "info: %s %q was not changed\n", info.Mapping.Resource, info.Name)
+			continue
+		}
+		if err := printUnifiedDiff(out, patch); err != nil {
+			return err
+		}
+	}
+	if failed {
+		return fmt.Errorf("some resources could not be diffed")
+	}
+	return nil
+}
+
+// printUnifiedDiff writes a unified diff of the before and after states of a
+// patched object, serialized as YAML.
+func printUnifiedDiff(out io.Writer, patch *Patch) error {
+	before, err := yaml.JSONToYAML(patch.Before)
+	if err != nil {
+		return err
+	}
+	after, err := yaml.JSONToYAML(patch.After)
+	if err != nil {
+		return err
+	}
+	name := fmt.Sprintf("%s/%s", patch.Info.Mapping.Resource, patch.Info.Name)
+	fmt.Fprintf(out, "--- %s (current)\n+++ %s (updated)\n", name, name)
+	writeUnifiedDiff(out, splitDiffLines(string(before)), splitDiffLines(string(after)))
+	return nil
+}
+
+func splitDiffLines(s string) []string {
+	return strings.Split(strings.TrimSuffix(s, "\n"), "\n")
+}
+
+// diffOp is a single line of a diff: kept (' '), removed ('-'), or added ('+').
+type diffOp struct {
+	kind byte
+	text string
+}
+
+// diffLines computes a line based diff of a and b using a longest common
+// subsequence.
+func diffLines(a, b []string) []diffOp {
+	lcs := make([][]int, len(a)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(b)+1)
+	}
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			switch {
+			case a[i] == b[j]:
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			case lcs[i+1][j] >= lcs[i][j+1]:
+				lcs[i][j] = lcs[i+1][j]
+			default:
+				lcs[i][j] = lcs[i][j+1]
+			}
+		}
+	}
+
+	var ops []diffOp
+	i, j := 0, 0
+	for i < len(a) && j < len(b) {
+		switch {
+		case a[i] == b[j]:
+			ops = append(ops, diffOp{' ', a[i]})
+			i++
+			j++
+		case lcs[i+1][j] >= lcs[i][j+1]:
+			ops = append(ops, diffOp{'-', a[i]})
+			i++
+		default:
+			ops = append(ops, diffOp{'+', b[j]})
+			j++
+		}
+	}
+	for ; i < len(a); i++ {
+		ops = append(ops, diffOp{'-', a[i]})
+	}
+	for ; j < len(b); j++ {
+		ops = append(ops, diffOp{'+', b[j]})
+	}
+	return ops
+}
+
+// writeUnifiedDiff prints the changed hunks of a line diff between a and b in
+// unified format.
+func writeUnifiedDiff(out io.Writer, a, b []string) {
+	ops := diffLines(a, b)
+
+	// group changed lines into hunks including surrounding context
+	type hunk struct{ start, end int }
+	var hunks []hunk
+	for i, op := range ops {
+		if op.kind == ' ' {
+			continue
+		}
+		start := i - diffContext
+		if start < 0 {
+			start = 0
+		}
+		end := i + diffContext + 1
+		if end > len(ops) {
+			end = len(ops)
+		}
+		if len(hunks) > 0 && start <= hunks[len(hunks)-1].end {
+			hunks[len(hunks)-1].end = end
+			continue
+		}
+		hunks = append(hunks, hunk{start: start, end: end})
+	}
+
+	// track the original line number each op corresponds to
+	aLine := make([]int, len(ops))
+	bLine := make([]int, len(ops))
+	ai, bi := 1, 1
+	for i, op := range ops {
+		aLine[i], bLine[i] = ai, bi
+		if op.kind != '+' {
+			ai++
+		}
+		if op.kind != '-' {
+			bi++
+		}
+	}
+
+	for _, h := range hunks {
+		aCount, bCount := 0, 0
+		for _, op := range ops[h.start:h.end] {
+			if op.kind != '+' {
+				aCount++
+			}
+			if op.kind != '-' {
+				bCount++
+			}
+		}
+		fmt.Fprintf(out, "@@ -%d,%d +%d,%d @@\n", aLine[h.start], aCount, bLine[h.start], bCount)
+		for _, op := range ops[h.start:h.end] {
+			fmt.Fprintf(out, "%c%s\n", op.kind, op.text)
+		}
+	}
+}
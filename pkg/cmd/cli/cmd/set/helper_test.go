@@ -0,0 +1,73 @@
+package set
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestWriteUnifiedDiff(t *testing.T) {
+	tests := []struct {
+		name string
+
+		a, b string
+
+		expected string
+	}{
+		{
+			name:     "no changes",
+			a:        "one\ntwo\nthree",
+			b:        "one\ntwo\nthree",
+			expected: "",
+		},
+		{
+			name: "changed line",
+			a:    "one\ntwo\nthree",
+			b:    "one\n2\nthree",
+			expected: `@@ -1,3 +1,3 @@
+ one
+-two
++2
+ three
+`,
+		},
+		{
+			name: "added line at end",
+			a:    "one\ntwo",
+			b:    "one\ntwo\nthree",
+			expected: `@@ -1,2 +1,3 @@
+ one
+ two
++three
+`,
+		},
+		{
+			name: "distant changes produce separate hunks",
+			a:    "a\n1\nb\nc\nd\ne\nf\ng\nh\n2\ni",
+			b:    "a\nONE\nb\nc\nd\ne\nf\ng\nh\nTWO\ni",
+			expected: `@@ -1,5 +1,5 @@
+ a
+-1
++ONE
+ b
+ c
+ d
+@@ -7,5 +7,5 @@
+ f
+ g
+ h
+-2
++TWO
+ i
+`,
+		},
+	}
+
+	for _, test := range tests {
+		buf := &bytes.Buffer{}
+		writeUnifiedDiff(buf, strings.Split(test.a, "\n"), strings.Split(test.b, "\n"))
+		if buf.String() != test.expected {
+			t.Errorf("%s: expected:\n%s\ngot:\n%s", test.name, test.expected, buf.String())
+		}
+	}
+}
@@ -85,6 +85,7 @@ type ProbeOptions struct {
 
 	PrintObject            func([]*resource.Info) error
 	UpdatePodSpecForObject func(runtime.Object, func(spec *kapi.PodSpec) error) (bool, error)
+	Diff                   bool
 
 	Readiness bool
 	Liveness  bool
@@ -143,6 +144,7 @@ func NewCmdProbe(fullName string, f *clientcmd.Factory, out, errOut io.Writer) *
 	cmd.Flags().StringSliceVarP(&options.Filenames, "filename", "f", options.Filenames, "Filename, directory, or URL to file to use to edit the resource.")
 
 	cmd.Flags().BoolVar(&options.Remove, "remove", options.Remove, "If true, remove the specified probe(s).")
+	cmd.Flags().BoolVar(&options.Diff, "diff", options.Diff, "If true, display a unified diff of the changes that would be made without applying them.")
 	cmd.Flags().BoolVar(&options.Readiness, "readiness", options.Readiness, "Set or remove a readiness probe to indicate when this container should receive traffic")
 	cmd.Flags().BoolVar(&options.Liveness, "liveness", options.Liveness, "Set or remove a liveness probe to verify this container is running")
 
@@ -195,6 +197,9 @@ func (o *ProbeOptions) Complete(f *clientcmd.Factory, cmd *cobra.Command, args [
 
 	output := kcmdutil.GetFlagString(cmd, "output")
 	if len(output) > 0 {
+		if o.Diff {
+			return fmt.Errorf("--diff and --output may not be specified together")
+		}
 		o.PrintObject = func(infos []*resource.Info) error {
 			return f.PrintResourceInfos(cmd, infos, o.Out)
 		}
@@ -319,6 +324,9 @@ func (o *ProbeOptions) Run() error {
 		return fmt.Errorf("%s/%s is not a pod or does not have a pod template", infos[0].Mapping.Resource, infos[0].Name)
 	}
 
+	if o.Diff {
+		return printPatchDiffs(o.Out, o.Err, patches)
+	}
 	if o.PrintObject != nil {
 		return o.PrintObject(infos)
 	}
@@ -89,6 +89,7 @@ type BackendsOptions struct {
 
 	PrintTable  bool
 	PrintObject func(runtime.Object) error
+	Diff        bool
 
 	Transform BackendTransform
 }
@@ -124,6 +125,7 @@ func NewCmdRouteBackends(fullName string, f *clientcmd.Factory, out, errOut io.W
 	cmd.Flags().BoolVar(&options.Transform.Adjust, "adjust", options.Transform.Adjust, "Adjust a single backend using an absolute or relative weight. If the primary backend is selected and there is more than one alternate an error will be returned.")
 	cmd.Flags().BoolVar(&options.Transform.Zero, "zero", options.Transform.Zero, "If true, set the weight of all backends to zero.")
 	cmd.Flags().BoolVar(&options.Transform.Equal, "equal", options.Transform.Equal, "If true, set the weight of all backends to 100.")
+	cmd.Flags().BoolVar(&options.Diff, "diff", options.Diff, "If true, display a unified diff of the changes that would be made without applying them.")
 
 	cmd.MarkFlagFilename("filename", "yaml", "yml", "json")
 
@@ -177,6 +179,9 @@ func (o *BackendsOptions) Complete(f *clientcmd.Factory, cmd *cobra.Command, arg
 
 	output := kcmdutil.GetFlagString(cmd, "output")
 	if len(output) != 0 {
+		if o.Diff {
+			return fmt.Errorf("--diff and --output may not be specified together")
+		}
 		o.PrintObject = func(obj runtime.Object) error { return f.PrintObject(cmd, mapper, obj, o.Out) }
 	}
 
@@ -214,6 +219,9 @@ func (o *BackendsOptions) Run() error {
 	if singleItemImplied && len(patches) == 0 {
 		return fmt.Errorf("%s/%s is not a deployment config or build config", infos[0].Mapping.Resource, infos[0].Name)
 	}
+	if o.Diff {
+		return printPatchDiffs(o.Out, o.Err, patches)
+	}
 	if o.PrintObject != nil {
 		object, err := resource.AsVersionedObject(infos, !singleItemImplied, o.OutputVersion, kapi.Codecs.LegacyCodec(o.OutputVersion))
 		if err != nil {
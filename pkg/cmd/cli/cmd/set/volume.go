@@ -33,6 +33,12 @@ import (
 const (
 	volumePrefix    = "volume-"
 	storageAnnClass = "volume.beta.kubernetes.io/storage-class"
+	// snapshotAnnSource asks the provisioner to restore the claim from the named
+	// snapshot. Only honored by storage backends that support snapshots.
+	snapshotAnnSource = "snapshot.alpha.kubernetes.io/snapshot"
+	// cloneAnnSource asks the provisioner to clone the claim from the named
+	// persistent volume claim. Only honored by storage backends that support cloning.
+	cloneAnnSource = "volume.alpha.kubernetes.io/clone-from-pvc"
 )
 
 var (
@@ -89,6 +95,10 @@ var (
 	  # (and by removing the volume "v1" if no other containers have volume mounts that reference it)
 	  %[1]s volume dc/registry --remove --name=v1 --containers=c1
 
+	  # Create a new claim cloned from an existing claim and mount it under /var/lib/registry
+	  # (requires a storage backend that supports cloning)
+	  %[1]s volume dc/registry-debug --add --clone-from=pvc/registry-storage -m /var/lib/registry
+
 	  # Add new volume based on a more complex volume source (Git repo, AWS EBS, GCE PD,
 	  # Ceph, Gluster, NFS, ISCSI, ...)
 	  %[1]s volume dc/registry --add -m /repo --source=<json-string>`)
@@ -120,6 +130,7 @@ type VolumeOptions struct {
 	Name          string
 	Containers    string
 	Confirm       bool
+	Diff          bool
 	Output        string
 	PrintObject   func([]*resource.Info) error
 	OutputVersion unversioned.GroupVersion
@@ -143,6 +154,7 @@ type AddVolumeOptions struct {
 	ClaimSize   string
 	ClaimMode   string
 	ClaimClass  string
+	CloneFrom   string
 
 	TypeChanged bool
 }
@@ -183,6 +195,7 @@ func NewCmdVolume(fullName string, f *clientcmd.Factory, out, errOut io.Writer)
 	cmd.Flags().StringVar(&opts.Name, "name", "", "Name of the volume. If empty, auto generated for add operation")
 	cmd.Flags().StringVarP(&opts.Containers, "containers", "c", "*", "The names of containers in the selected pod templates to change - may use wildcards")
 	cmd.Flags().BoolVar(&opts.Confirm, "confirm", false, "If true, confirm that you really want to remove multiple volumes")
+	cmd.Flags().BoolVar(&opts.Diff, "diff", false, "If true, display a unified diff of the changes that would be made without applying them.")
 
 	cmd.Flags().StringVarP(&addOpts.Type, "type", "t", "", "Type of the volume source for add operation. Supported options: emptyDir, hostPath, secret, configmap, persistentVolumeClaim")
 	cmd.Flags().StringVarP(&addOpts.MountPath, "mount-path", "m", "", "Mount path inside the container. Optional param for --add or --remove")
@@ -195,6 +208,7 @@ func NewCmdVolume(fullName string, f *clientcmd.Factory, out, errOut io.Writer)
 	cmd.Flags().StringVar(&addOpts.ClaimClass, "claim-class", "", "StorageClass to use for the persistent volume claim")
 	cmd.Flags().StringVar(&addOpts.ClaimSize, "claim-size", "", "If specified along with a persistent volume type, create a new claim with the given size in bytes. Accepts SI notation: 10, 10G, 10Gi")
 	cmd.Flags().StringVar(&addOpts.ClaimMode, "claim-mode", "ReadWriteOnce", "Set the access mode of the claim to be created. Valid values are ReadWriteOnce (rwo), ReadWriteMany (rwm), or ReadOnlyMany (rom)")
+	cmd.Flags().StringVar(&addOpts.CloneFrom, "clone-from", "", "Create a new persistent volume claim from an existing source, specified as pvc/<name> or snapshot/<name>. The storage backend must support cloning or snapshot restore")
 	cmd.Flags().StringVar(&addOpts.Source, "source", "", "Details of volume source as json string. This can be used if the required volume type is not supported by --type option. (e.g.: '{\"gitRepo\": {\"repository\": <git-url>, \"revision\": <commit-hash>}}')")
 
 	kcmdutil.AddPrinterFlags(cmd)
@@ -241,6 +255,12 @@ func (v *VolumeOptions) Validate(cmd *cobra.Command, args []string) error {
 	if v.List && len(output) > 0 {
 		return errors.New("--list and --output may not be specified together")
 	}
+	if v.Diff && len(output) > 0 {
+		return errors.New("--diff and --output may not be specified together")
+	}
+	if v.Diff && v.List {
+		return errors.New("--diff and --list may not be specified together")
+	}
 
 	err := v.AddOpts.Validate(v.Add)
 	if err != nil {
@@ -255,7 +275,7 @@ func (v *VolumeOptions) Validate(cmd *cobra.Command, args []string) error {
 
 func (a *AddVolumeOptions) Validate(isAddOp bool) error {
 	if isAddOp {
-		if len(a.Type) == 0 && (len(a.ClaimName) > 0 || len(a.ClaimSize) > 0) {
+		if len(a.Type) == 0 && (len(a.ClaimName) > 0 || len(a.ClaimSize) > 0 || len(a.CloneFrom) > 0) {
 			a.Type = "persistentvolumeclaim"
 			a.TypeChanged = true
 		}
@@ -313,8 +333,13 @@ func (a *AddVolumeOptions) Validate(isAddOp bool) error {
 					}
 				}
 			case "persistentvolumeclaim", "pvc":
-				if len(a.ClaimName) == 0 && len(a.ClaimSize) == 0 {
-					return errors.New("must provide --claim-name or --claim-size (to create a new claim) for --type=pvc")
+				if len(a.ClaimName) == 0 && len(a.ClaimSize) == 0 && len(a.CloneFrom) == 0 {
+					return errors.New("must provide --claim-name, --claim-size or --clone-from (to create a new claim) for --type=pvc")
+				}
+				if len(a.CloneFrom) > 0 {
+					if _, _, err := parseCloneRef(a.CloneFrom); err != nil {
+						return err
+					}
 				}
 				if len(a.DefaultMode) > 0 {
 					return errors.New("--default-mode is only available for secrets and configmaps")
@@ -322,8 +347,8 @@ func (a *AddVolumeOptions) Validate(isAddOp bool) error {
 			default:
 				return errors.New("invalid volume type. Supported types: emptyDir, hostPath, secret, persistentVolumeClaim")
 			}
-		} else if len(a.Path) > 0 || len(a.SecretName) > 0 || len(a.ClaimName) > 0 {
-			return errors.New("--path|--secret-name|--claim-name are only valid for --type option")
+		} else if len(a.Path) > 0 || len(a.SecretName) > 0 || len(a.ClaimName) > 0 || len(a.CloneFrom) > 0 {
+			return errors.New("--path|--secret-name|--claim-name|--clone-from are only valid for --type option")
 		}
 
 		if len(a.Source) > 0 {
@@ -347,12 +372,12 @@ func (a *AddVolumeOptions) Validate(isAddOp bool) error {
 			if selectedLowerType != "persistentvolumeclaim" && selectedLowerType != "pvc" {
 				return errors.New("must provide --type as persistentVolumeClaim")
 			}
-			if len(a.ClaimSize) == 0 {
+			if len(a.ClaimSize) == 0 && len(a.CloneFrom) == 0 {
 				return errors.New("must provide --claim-size to create new pvc with claim-class")
 			}
 		}
-	} else if len(a.Source) > 0 || len(a.Path) > 0 || len(a.SecretName) > 0 || len(a.ConfigMapName) > 0 || len(a.ClaimName) > 0 || a.Overwrite {
-		return errors.New("--type|--path|--configmap-name|--secret-name|--claim-name|--source|--overwrite are only valid for --add operation")
+	} else if len(a.Source) > 0 || len(a.Path) > 0 || len(a.SecretName) > 0 || len(a.ConfigMapName) > 0 || len(a.ClaimName) > 0 || len(a.CloneFrom) > 0 || a.Overwrite {
+		return errors.New("--type|--path|--configmap-name|--secret-name|--claim-name|--clone-from|--source|--overwrite are only valid for --add operation")
 	}
 	return nil
 }
@@ -399,17 +424,24 @@ func (v *VolumeOptions) Complete(f *clientcmd.Factory, cmd *cobra.Command, out,
 	if len(v.AddOpts.Source) > 0 {
 		v.AddOpts.Type = ""
 	}
-	if len(v.AddOpts.ClaimSize) > 0 {
+	if len(v.AddOpts.ClaimSize) > 0 || len(v.AddOpts.CloneFrom) > 0 {
 		v.AddOpts.CreateClaim = true
 		if len(v.AddOpts.ClaimName) == 0 {
 			v.AddOpts.ClaimName = kapi.SimpleNameGenerator.GenerateName("pvc-")
 		}
+	}
+	if len(v.AddOpts.ClaimSize) > 0 {
 		q, err := kresource.ParseQuantity(v.AddOpts.ClaimSize)
 		if err != nil {
 			return fmt.Errorf("--claim-size is not valid: %v", err)
 		}
 		v.AddOpts.ClaimSize = q.String()
 	}
+	if len(v.AddOpts.CloneFrom) > 0 {
+		if err := v.resolveCloneSource(); err != nil {
+			return err
+		}
+	}
 	if len(v.AddOpts.DefaultMode) == 0 {
 		v.AddOpts.DefaultMode = "644"
 	}
@@ -478,6 +510,9 @@ func (v *VolumeOptions) RunVolume(args []string) error {
 	if patchError != nil {
 		return patchError
 	}
+	if v.Diff {
+		return printPatchDiffs(v.Out, v.Err, patches)
+	}
 	if v.PrintObject != nil {
 		return v.PrintObject(infos)
 	}
@@ -611,6 +646,62 @@ func (v *VolumeOptions) printVolumes(infos []*resource.Info) []error {
 	return listingErrors
 }
 
+// parseCloneRef splits the argument to --clone-from into a source kind and name.
+// A bare name refers to a persistent volume claim.
+func parseCloneRef(ref string) (string, string, error) {
+	parts := strings.Split(ref, "/")
+	switch len(parts) {
+	case 1:
+		if len(parts[0]) == 0 {
+			return "", "", fmt.Errorf("--clone-from is not valid: %s", ref)
+		}
+		return "pvc", parts[0], nil
+	case 2:
+		kind, name := strings.ToLower(parts[0]), parts[1]
+		if kind == "persistentvolumeclaim" {
+			kind = "pvc"
+		}
+		if (kind != "pvc" && kind != "snapshot") || len(name) == 0 {
+			return "", "", fmt.Errorf("--clone-from must be specified as pvc/<name> or snapshot/<name>: %s", ref)
+		}
+		return kind, name, nil
+	default:
+		return "", "", fmt.Errorf("--clone-from must be specified as pvc/<name> or snapshot/<name>: %s", ref)
+	}
+}
+
+// resolveCloneSource fills in claim defaults from the clone source. When cloning
+// from an existing claim, its requested size and storage class carry over unless
+// overridden on the command line. Snapshot sources cannot be introspected, so a
+// size must be given explicitly.
+func (v *VolumeOptions) resolveCloneSource() error {
+	kind, name, err := parseCloneRef(v.AddOpts.CloneFrom)
+	if err != nil {
+		return err
+	}
+	if kind != "pvc" {
+		if len(v.AddOpts.ClaimSize) == 0 {
+			return errors.New("must provide --claim-size when cloning from a snapshot")
+		}
+		return nil
+	}
+	source, err := v.Client.PersistentVolumeClaims(v.DefaultNamespace).Get(name)
+	if err != nil {
+		return fmt.Errorf("unable to retrieve clone source persistent volume claim %s: %v", name, err)
+	}
+	if len(v.AddOpts.ClaimSize) == 0 {
+		val, ok := source.Spec.Resources.Requests[kapi.ResourceStorage]
+		if !ok {
+			return fmt.Errorf("clone source %s has no storage request, provide --claim-size", name)
+		}
+		v.AddOpts.ClaimSize = val.String()
+	}
+	if len(v.AddOpts.ClaimClass) == 0 {
+		v.AddOpts.ClaimClass = source.Annotations[storageAnnClass]
+	}
+	return nil
+}
+
 func (v *AddVolumeOptions) createClaim() *kapi.PersistentVolumeClaim {
 	pvc := &kapi.PersistentVolumeClaim{
 		ObjectMeta: kapi.ObjectMeta{
@@ -630,6 +721,18 @@ func (v *AddVolumeOptions) createClaim() *kapi.PersistentVolumeClaim {
 			storageAnnClass: v.ClaimClass,
 		}
 	}
+	if len(v.CloneFrom) > 0 {
+		// validated in AddVolumeOptions.Validate
+		kind, name, _ := parseCloneRef(v.CloneFrom)
+		sourceAnn := cloneAnnSource
+		if kind == "snapshot" {
+			sourceAnn = snapshotAnnSource
+		}
+		if pvc.Annotations == nil {
+			pvc.Annotations = map[string]string{}
+		}
+		pvc.Annotations[sourceAnn] = name
+	}
 	return pvc
 }
 
@@ -175,6 +175,27 @@ func TestCreateClaim(t *testing.T) {
 	}
 }
 
+func TestCreateClaimCloneFrom(t *testing.T) {
+	addOpts := &AddVolumeOptions{
+		Type:      "persistentVolumeClaim",
+		ClaimName: "foo-vol",
+		ClaimSize: "5G",
+		CloneFrom: "pvc/source-vol",
+		MountPath: "/sandbox",
+	}
+
+	pvc := addOpts.createClaim()
+	if pvc.Annotations[cloneAnnSource] != "source-vol" {
+		t.Errorf("Expected clone source annotation to be source-vol, got %q", pvc.Annotations[cloneAnnSource])
+	}
+
+	addOpts.CloneFrom = "snapshot/nightly"
+	pvc = addOpts.createClaim()
+	if pvc.Annotations[snapshotAnnSource] != "nightly" {
+		t.Errorf("Expected snapshot source annotation to be nightly, got %q", pvc.Annotations[snapshotAnnSource])
+	}
+}
+
 func TestValidateAddOptions(t *testing.T) {
 	tests := []struct {
 		name          string
@@ -184,7 +205,22 @@ func TestValidateAddOptions(t *testing.T) {
 		{
 			"using existing pvc",
 			&AddVolumeOptions{Type: "persistentVolumeClaim"},
-			errors.New("must provide --claim-name or --claim-size (to create a new claim) for --type=pvc"),
+			errors.New("must provide --claim-name, --claim-size or --clone-from (to create a new claim) for --type=pvc"),
+		},
+		{
+			"creating pvc cloned from another pvc",
+			&AddVolumeOptions{Type: "persistentVolumeClaim", CloneFrom: "pvc/sandbox-pvc"},
+			nil,
+		},
+		{
+			"creating pvc cloned from a snapshot",
+			&AddVolumeOptions{Type: "persistentVolumeClaim", ClaimSize: "5G", CloneFrom: "snapshot/nightly"},
+			nil,
+		},
+		{
+			"error creating pvc with bad clone source kind",
+			&AddVolumeOptions{Type: "persistentVolumeClaim", CloneFrom: "secret/foo"},
+			errors.New("--clone-from must be specified as pvc/<name> or snapshot/<name>: secret/foo"),
 		},
 		{
 			"creating new pvc",
@@ -1,6 +1,7 @@
 package cmd
 
 import (
+	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
@@ -11,6 +12,7 @@ import (
 	kapi "k8s.io/kubernetes/pkg/api"
 	kcmdutil "k8s.io/kubernetes/pkg/kubectl/cmd/util"
 
+	osgraph "github.com/openshift/origin/pkg/api/graph"
 	loginutil "github.com/openshift/origin/pkg/cmd/cli/cmd/login/util"
 	"github.com/openshift/origin/pkg/cmd/cli/describe"
 	"github.com/openshift/origin/pkg/cmd/templates"
@@ -31,7 +33,9 @@ var (
 		%[1]s describe deploymentConfig, %[1]s describe service).
 
 		You can specify an output format of "-o dot" to have this command output the generated status
-		graph in DOT format that is suitable for use by the "dot" command.`)
+		graph in DOT format that is suitable for use by the "dot" command. An output format of
+		"-o json" prints the identified issues as a structured list of findings with severity
+		levels, suitable for consumption by dashboards or CI health gates.`)
 
 	statusExample = templates.Examples(`
 		# See an overview of the current project.
@@ -40,10 +44,23 @@ var (
 	  # Export the overview of the current project in an svg file.
 	  %[1]s -o dot | dot -T svg -o project.svg
 
+	  # See the identified issues of the current project as machine-readable findings.
+	  %[1]s -o json
+
 	  # See an overview of the current project including details for any identified issues.
 	  %[1]s -v`)
 )
 
+// statusReport is the structure emitted by 'status -o json'. It aggregates the findings
+// of status analysis together with per-severity counts for easy thresholding.
+type statusReport struct {
+	Namespace string                   `json:"namespace"`
+	Errors    int                      `json:"errors"`
+	Warnings  int                      `json:"warnings"`
+	Info      int                      `json:"info"`
+	Findings  []describe.StatusFinding `json:"findings"`
+}
+
 // StatusOptions contains all the necessary options for the Openshift cli status command.
 type StatusOptions struct {
 	namespace     string
@@ -82,7 +99,7 @@ func NewCmdStatus(name, baseCLIName, fullName string, f *clientcmd.Factory, out
 		},
 	}
 
-	cmd.Flags().StringVarP(&opts.outputFormat, "output", "o", opts.outputFormat, "Output format. One of: dot.")
+	cmd.Flags().StringVarP(&opts.outputFormat, "output", "o", opts.outputFormat, "Output format. One of: dot|json.")
 	cmd.Flags().BoolVarP(&opts.verbose, "verbose", "v", opts.verbose, "See details for resolving issues.")
 	cmd.Flags().BoolVar(&opts.allNamespaces, "all-namespaces", false, "If true, display status for all namespaces (must have cluster admin)")
 
@@ -161,11 +178,11 @@ func (o *StatusOptions) Complete(f *clientcmd.Factory, cmd *cobra.Command, baseC
 
 // Validate validates the options for the Openshift cli status command.
 func (o StatusOptions) Validate() error {
-	if len(o.outputFormat) != 0 && o.outputFormat != "dot" {
+	if len(o.outputFormat) != 0 && o.outputFormat != "dot" && o.outputFormat != "json" {
 		return fmt.Errorf("invalid output format provided: %s", o.outputFormat)
 	}
 	if len(o.outputFormat) > 0 && o.verbose {
-		return errors.New("cannot provide suggestions when output format is dot")
+		return errors.New("cannot provide suggestions when an output format is specified")
 	}
 	return nil
 }
@@ -193,6 +210,27 @@ func (o StatusOptions) RunStatus() error {
 			return err
 		}
 		s = string(data)
+	case "json":
+		findings, err := o.describer.Findings(o.namespace)
+		if err != nil {
+			return err
+		}
+		report := statusReport{Namespace: o.namespace, Findings: findings}
+		for _, finding := range findings {
+			switch finding.Severity {
+			case osgraph.ErrorSeverity:
+				report.Errors++
+			case osgraph.WarningSeverity:
+				report.Warnings++
+			case osgraph.InfoSeverity:
+				report.Info++
+			}
+		}
+		data, err := json.MarshalIndent(report, "", "  ")
+		if err != nil {
+			return err
+		}
+		s = string(data) + "\n"
 	default:
 		return fmt.Errorf("invalid output format provided: %s", o.outputFormat)
 	}
@@ -4,8 +4,10 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"io/ioutil"
 	"strings"
 
+	"github.com/ghodss/yaml"
 	"github.com/golang/glog"
 	"github.com/spf13/cobra"
 
@@ -14,6 +16,7 @@ import (
 	"k8s.io/kubernetes/pkg/api/unversioned"
 	"k8s.io/kubernetes/pkg/client/retry"
 	kcmdutil "k8s.io/kubernetes/pkg/kubectl/cmd/util"
+	utilerrors "k8s.io/kubernetes/pkg/util/errors"
 	"k8s.io/kubernetes/pkg/util/sets"
 
 	"github.com/openshift/origin/pkg/client"
@@ -35,11 +38,41 @@ type TagOptions struct {
 	namespace    string
 
 	referencePolicy string
+	fromFile        string
+	dryRun          bool
 
 	ref            imageapi.DockerImageReference
 	sourceKind     string
 	destNamespace  []string
 	destNameAndTag []string
+
+	// actions holds the resolved source/destination pairs loaded from a
+	// promotion manifest; when empty, Run applies the single source to every
+	// destination
+	actions []tagAction
+}
+
+// tagAction is one resolved source to destination tag operation
+type tagAction struct {
+	sourceKind     string
+	ref            imageapi.DockerImageReference
+	destNamespace  string
+	destNameAndTag string
+}
+
+// promotionManifest is the file format accepted by --from-file: a list of
+// source to destination tag pairs that are validated together and then applied
+// in order
+type promotionManifest struct {
+	Tags []promotionTag `json:"tags"`
+}
+
+// promotionTag is a single entry of a promotion manifest
+type promotionTag struct {
+	// From is the source, in the same form as the SOURCE argument
+	From string `json:"from"`
+	// To is the destination, in the same form as the DEST argument
+	To string `json:"to"`
 }
 
 var (
@@ -55,7 +88,11 @@ var (
 		certificate, or is only served over HTTP. Pass --scheduled to have the server
 		regularly check the tag for updates and import the latest version (which can
 		then trigger builds and deployments). Note that --scheduled is only allowed for
-		Docker images.`)
+		Docker images.
+
+		To promote many images at once, pass --from-file with a manifest listing
+		source and destination pairs. The whole manifest is validated before any tag
+		is applied, and --dry-run prints the changes each entry would make.`)
 
 	tagExample = templates.Examples(`
 		# Tag the current image for the image stream 'openshift/ruby' and tag '2.0' into the image stream 'yourproject/ruby with tag 'tip'.
@@ -70,9 +107,16 @@ var (
 	  # Tag an external Docker image and request pullthrough for it.
 	  %[1]s tag --source=docker openshift/origin:latest yourproject/ruby:tip --reference-policy=local
 
+	  # Track a tag from a shared base image namespace, updating whenever it changes.
+	  %[1]s tag --alias openshift/ruby:2.0 yourproject/ruby:base
+
 
 	  # Remove the specified spec tag from an image stream.
-	  %[1]s tag openshift/origin:latest -d`)
+	  %[1]s tag openshift/origin:latest -d
+
+	  # Apply a manifest of source/destination pairs, showing the changes first.
+	  %[1]s tag --from-file promotion.yaml --dry-run
+	  %[1]s tag --from-file promotion.yaml`)
 )
 
 const (
@@ -98,11 +142,13 @@ func NewCmdTag(fullName string, f *clientcmd.Factory, out io.Writer) *cobra.Comm
 
 	cmd.Flags().StringVar(&opts.sourceKind, "source", opts.sourceKind, "Optional hint for the source type; valid values are 'imagestreamtag', 'istag', 'imagestreamimage', 'isimage', and 'docker'.")
 	cmd.Flags().BoolVarP(&opts.deleteTag, "delete", "d", opts.deleteTag, "Delete the provided spec tags.")
-	cmd.Flags().BoolVar(&opts.aliasTag, "alias", false, "Should the destination tag be updated whenever the source tag changes. Applies only to a single image stream. Defaults to false.")
+	cmd.Flags().BoolVar(&opts.aliasTag, "alias", false, "Should the destination tag be updated whenever the source tag changes. The source may be a tag in another image stream or namespace if you are allowed to pull from it. Defaults to false.")
 	cmd.Flags().BoolVar(&opts.referenceTag, "reference", false, "Should the destination tag continue to pull from the source namespace. Defaults to false.")
 	cmd.Flags().BoolVar(&opts.scheduleTag, "scheduled", false, "Set a Docker image to be periodically imported from a remote repository. Defaults to false.")
 	cmd.Flags().BoolVar(&opts.insecureTag, "insecure", false, "Set to true if importing the specified Docker image requires HTTP or has a self-signed certificate. Defaults to false.")
 	cmd.Flags().StringVar(&opts.referencePolicy, "reference-policy", sourceReferencePolicy, "Allow to request pullthrough for external image when set to 'local'. Defaults to 'source'.")
+	cmd.Flags().StringVar(&opts.fromFile, "from-file", "", "A YAML or JSON file with a 'tags' list of {from, to} pairs to apply as a batch. The whole file is validated before any tag is changed.")
+	cmd.Flags().BoolVar(&opts.dryRun, "dry-run", false, "Print the tag operations that would be made, without changing anything.")
 
 	return cmd
 }
@@ -148,9 +194,12 @@ func determineSourceKind(f *clientcmd.Factory, input string) string {
 
 // Complete completes all the required options for the tag command.
 func (o *TagOptions) Complete(f *clientcmd.Factory, cmd *cobra.Command, args []string, out io.Writer) error {
-	if len(args) < 2 && (len(args) < 1 && !o.deleteTag) {
+	if len(o.fromFile) == 0 && len(args) < 2 && (len(args) < 1 && !o.deleteTag) {
 		return kcmdutil.UsageError(cmd, "you must specify a source and at least one destination or one or more tags to delete")
 	}
+	if len(o.fromFile) > 0 && len(args) > 0 {
+		return kcmdutil.UsageError(cmd, "--from-file may not be combined with SOURCE and DEST arguments")
+	}
 
 	// Setup writer.
 	o.out = out
@@ -170,85 +219,16 @@ func (o *TagOptions) Complete(f *clientcmd.Factory, cmd *cobra.Command, args []s
 		}
 	}
 
+	// Populate source and destinations from a promotion manifest.
+	if len(o.fromFile) > 0 {
+		return o.completeBatch(f, cmd)
+	}
+
 	// Populate source.
 	if !o.deleteTag {
-		source := args[0]
-		glog.V(3).Infof("Using %q as a source tag", source)
-
-		sourceKind := o.sourceKind
-		if len(sourceKind) > 0 {
-			sourceKind = determineSourceKind(f, sourceKind)
-		}
-		if len(sourceKind) > 0 {
-			validSources := sets.NewString("imagestreamtag", "istag", "imagestreamimage", "isimage", "docker", "dockerimage")
-			if !validSources.Has(strings.ToLower(sourceKind)) {
-				kcmdutil.CheckErr(kcmdutil.UsageError(cmd, "invalid source %q; valid values are %v", o.sourceKind, strings.Join(validSources.List(), ", ")))
-			}
-		}
-
-		ref, err := imageapi.ParseDockerImageReference(source)
+		sourceKind, ref, err := o.resolveSource(f, cmd, args[0])
 		if err != nil {
-			return fmt.Errorf("invalid SOURCE: %v", err)
-		}
-		switch sourceKind {
-		case "ImageStreamTag", "ImageStreamImage":
-			if len(ref.Registry) > 0 {
-				return fmt.Errorf("server in SOURCE is only allowed when providing a Docker image")
-			}
-			if ref.Namespace == imageapi.DockerDefaultNamespace {
-				ref.Namespace = o.namespace
-			}
-			if sourceKind == "ImageStreamTag" {
-				if len(ref.Tag) == 0 {
-					return fmt.Errorf("--source=ImageStreamTag requires a valid <name>:<tag> in SOURCE")
-				}
-			} else {
-				if len(ref.ID) == 0 {
-					return fmt.Errorf("--source=ImageStreamImage requires a valid <name>@<id> in SOURCE")
-				}
-			}
-		case "":
-			if len(ref.Registry) > 0 {
-				sourceKind = "DockerImage"
-				break
-			}
-			if len(ref.ID) > 0 {
-				sourceKind = "ImageStreamImage"
-				break
-			}
-			if len(ref.Tag) > 0 {
-				sourceKind = "ImageStreamTag"
-				break
-			}
-			sourceKind = "DockerImage"
-		}
-
-		// if we are not aliasing the tag, specify the exact value to copy
-		if sourceKind == "ImageStreamTag" && !o.aliasTag {
-			srcNamespace := ref.Namespace
-			if len(srcNamespace) == 0 {
-				srcNamespace = o.namespace
-			}
-			is, err := o.osClient.ImageStreams(srcNamespace).Get(ref.Name)
-			if err != nil {
-				return err
-			}
-			event := imageapi.LatestTaggedImage(is, ref.Tag)
-			if event == nil {
-				return fmt.Errorf("%q is not currently pointing to an image, cannot use it as the source of a tag", args[0])
-			}
-			if len(event.Image) == 0 {
-				imageRef, err := imageapi.ParseDockerImageReference(event.DockerImageReference)
-				if err != nil {
-					return fmt.Errorf("the image stream tag %q has an invalid pull spec and cannot be used to tag: %v", args[0], err)
-				}
-				ref = imageRef
-				sourceKind = "DockerImage"
-			} else {
-				ref.ID = event.Image
-				ref.Tag = ""
-				sourceKind = "ImageStreamImage"
-			}
+			return err
 		}
 
 		args = args[1:]
@@ -271,23 +251,136 @@ func (o *TagOptions) Complete(f *clientcmd.Factory, cmd *cobra.Command, args []s
 	return nil
 }
 
-// isCrossImageStream verifies if destination is the same image stream as source. Returns true
-// if any of the destination image stream is different and error from parsing
-// image stream tag.
-func isCrossImageStream(namespace string, srcRef imageapi.DockerImageReference, destNamespace []string, destNameAndTag []string) (bool, error) {
-	for i, ns := range destNamespace {
-		if namespace != ns {
-			return true, nil
+// completeBatch loads the promotion manifest referenced by --from-file and
+// resolves every entry, reporting all invalid entries together so that a
+// partially valid manifest is never applied.
+func (o *TagOptions) completeBatch(f *clientcmd.Factory, cmd *cobra.Command) error {
+	data, err := ioutil.ReadFile(o.fromFile)
+	if err != nil {
+		return err
+	}
+	manifest := &promotionManifest{}
+	if err := yaml.Unmarshal(data, manifest); err != nil {
+		return fmt.Errorf("cannot parse %s: %v", o.fromFile, err)
+	}
+	if len(manifest.Tags) == 0 {
+		return fmt.Errorf("no tags defined in %s", o.fromFile)
+	}
+
+	errs := []error{}
+	for i, entry := range manifest.Tags {
+		if len(entry.From) == 0 {
+			errs = append(errs, fmt.Errorf("tags[%d]: from is required", i))
+			continue
 		}
-		name, _, ok := imageapi.SplitImageStreamTag(destNameAndTag[i])
-		if !ok {
-			return false, fmt.Errorf("%q must be of the form <stream_name>:<tag>", destNameAndTag[i])
+		if len(entry.To) == 0 {
+			errs = append(errs, fmt.Errorf("tags[%d]: to is required", i))
+			continue
+		}
+		sourceKind, ref, err := o.resolveSource(f, cmd, entry.From)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("tags[%d]: %v", i, err))
+			continue
+		}
+		destNamespace, destNameAndTag, err := parseStreamName(o.namespace, entry.To)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("tags[%d]: %v", i, err))
+			continue
+		}
+		if _, _, ok := imageapi.SplitImageStreamTag(destNameAndTag); !ok {
+			errs = append(errs, fmt.Errorf("tags[%d]: %q must be of the form <stream_name>:<tag>", i, entry.To))
+			continue
+		}
+		o.destNamespace = append(o.destNamespace, destNamespace)
+		o.destNameAndTag = append(o.destNameAndTag, destNameAndTag)
+		o.actions = append(o.actions, tagAction{sourceKind: sourceKind, ref: ref, destNamespace: destNamespace, destNameAndTag: destNameAndTag})
+	}
+	return utilerrors.NewAggregate(errs)
+}
+
+// resolveSource resolves the given source into a source kind and image
+// reference, contacting the server when the exact image behind a tag is
+// needed.
+func (o *TagOptions) resolveSource(f *clientcmd.Factory, cmd *cobra.Command, source string) (string, imageapi.DockerImageReference, error) {
+	glog.V(3).Infof("Using %q as a source tag", source)
+
+	sourceKind := o.sourceKind
+	if len(sourceKind) > 0 {
+		sourceKind = determineSourceKind(f, sourceKind)
+	}
+	if len(sourceKind) > 0 {
+		validSources := sets.NewString("imagestreamtag", "istag", "imagestreamimage", "isimage", "docker", "dockerimage")
+		if !validSources.Has(strings.ToLower(sourceKind)) {
+			kcmdutil.CheckErr(kcmdutil.UsageError(cmd, "invalid source %q; valid values are %v", o.sourceKind, strings.Join(validSources.List(), ", ")))
+		}
+	}
+
+	ref, err := imageapi.ParseDockerImageReference(source)
+	if err != nil {
+		return "", ref, fmt.Errorf("invalid SOURCE: %v", err)
+	}
+	switch sourceKind {
+	case "ImageStreamTag", "ImageStreamImage":
+		if len(ref.Registry) > 0 {
+			return "", ref, fmt.Errorf("server in SOURCE is only allowed when providing a Docker image")
+		}
+		if ref.Namespace == imageapi.DockerDefaultNamespace {
+			ref.Namespace = o.namespace
+		}
+		if sourceKind == "ImageStreamTag" {
+			if len(ref.Tag) == 0 {
+				return "", ref, fmt.Errorf("--source=ImageStreamTag requires a valid <name>:<tag> in SOURCE")
+			}
+		} else {
+			if len(ref.ID) == 0 {
+				return "", ref, fmt.Errorf("--source=ImageStreamImage requires a valid <name>@<id> in SOURCE")
+			}
+		}
+	case "":
+		if len(ref.Registry) > 0 {
+			sourceKind = "DockerImage"
+			break
+		}
+		if len(ref.ID) > 0 {
+			sourceKind = "ImageStreamImage"
+			break
+		}
+		if len(ref.Tag) > 0 {
+			sourceKind = "ImageStreamTag"
+			break
+		}
+		sourceKind = "DockerImage"
+	}
+
+	// if we are not aliasing the tag, specify the exact value to copy
+	if sourceKind == "ImageStreamTag" && !o.aliasTag {
+		srcNamespace := ref.Namespace
+		if len(srcNamespace) == 0 {
+			srcNamespace = o.namespace
+		}
+		is, err := o.osClient.ImageStreams(srcNamespace).Get(ref.Name)
+		if err != nil {
+			return "", ref, err
 		}
-		if srcRef.Name != name {
-			return true, nil
+		event := imageapi.LatestTaggedImage(is, ref.Tag)
+		if event == nil {
+			return "", ref, fmt.Errorf("%q is not currently pointing to an image, cannot use it as the source of a tag", source)
+		}
+		if len(event.Image) == 0 {
+			imageRef, err := imageapi.ParseDockerImageReference(event.DockerImageReference)
+			if err != nil {
+				return "", ref, fmt.Errorf("the image stream tag %q has an invalid pull spec and cannot be used to tag: %v", source, err)
+			}
+			ref = imageRef
+			sourceKind = "DockerImage"
+		} else {
+			ref.ID = event.Image
+			ref.Tag = ""
+			sourceKind = "ImageStreamImage"
 		}
 	}
-	return false, nil
+
+	return sourceKind, ref, nil
 }
 
 // Validate validates all the required options for the tag command.
@@ -310,6 +403,9 @@ func (o TagOptions) Validate() error {
 
 	// Validate source tag based on --delete usage.
 	if o.deleteTag {
+		if len(o.fromFile) > 0 {
+			return errors.New("--delete may not be combined with --from-file")
+		}
 		if len(o.sourceKind) > 0 {
 			return errors.New("cannot specify a source kind when deleting")
 		}
@@ -319,7 +415,7 @@ func (o TagOptions) Validate() error {
 		if o.scheduleTag || o.insecureTag {
 			return errors.New("cannot set flags for importing images when deleting a tag")
 		}
-	} else {
+	} else if len(o.fromFile) == 0 {
 		if len(o.sourceKind) == 0 {
 			return errors.New("a source kind is required")
 		}
@@ -342,13 +438,6 @@ func (o TagOptions) Validate() error {
 		if o.scheduleTag || o.insecureTag {
 			return errors.New("cannot set a Docker image tag as an alias and also set import flags")
 		}
-		cross, err := isCrossImageStream(o.namespace, o.ref, o.destNamespace, o.destNameAndTag)
-		if err != nil {
-			return err
-		}
-		if cross {
-			return errors.New("cannot set alias across different Image Streams")
-		}
 	}
 
 	return nil
@@ -363,21 +452,42 @@ func (o TagOptions) Run() error {
 	case localReferencePolicy:
 		tagReferencePolicy = imageapi.LocalTagReferencePolicy
 	}
-	for i, destNameAndTag := range o.destNameAndTag {
+	// when no promotion manifest was loaded, apply the single source to every
+	// destination
+	actions := o.actions
+	if len(actions) == 0 {
+		for i, destNameAndTag := range o.destNameAndTag {
+			actions = append(actions, tagAction{sourceKind: o.sourceKind, ref: o.ref, destNamespace: o.destNamespace[i], destNameAndTag: destNameAndTag})
+		}
+	}
+
+	for _, action := range actions {
+		sourceKind := action.sourceKind
+		ref := action.ref
+		destNamespace := action.destNamespace
+		destNameAndTag := action.destNameAndTag
+
 		destName, destTag, ok := imageapi.SplitImageStreamTag(destNameAndTag)
 		if !ok {
 			return fmt.Errorf("%q must be of the form <stream_name>:<tag>", destNameAndTag)
 		}
 
+		if o.dryRun {
+			if err := o.printDryRunDiff(ref, destNamespace, destName, destTag); err != nil {
+				return err
+			}
+			continue
+		}
+
 		err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
-			isc := o.osClient.ImageStreams(o.destNamespace[i])
+			isc := o.osClient.ImageStreams(destNamespace)
 
 			if o.deleteTag {
 				// new server support
-				err := o.osClient.ImageStreamTags(o.destNamespace[i]).Delete(destName, destTag)
+				err := o.osClient.ImageStreamTags(destNamespace).Delete(destName, destTag)
 				switch {
 				case err == nil:
-					fmt.Fprintf(o.out, "Deleted tag %s/%s.\n", o.destNamespace[i], destNameAndTag)
+					fmt.Fprintf(o.out, "Deleted tag %s/%s.\n", destNamespace, destNameAndTag)
 					return nil
 
 				case kerrors.IsMethodNotSupported(err), kerrors.IsForbidden(err):
@@ -402,7 +512,7 @@ func (o TagOptions) Run() error {
 
 				// The user wants to delete a spec tag.
 				if _, ok := target.Spec.Tags[destTag]; !ok {
-					return fmt.Errorf("destination tag %s/%s does not exist.\n", o.destNamespace[i], destNameAndTag)
+					return fmt.Errorf("destination tag %s/%s does not exist.\n", destNamespace, destNameAndTag)
 				}
 				delete(target.Spec.Tags, destTag)
 
@@ -410,7 +520,7 @@ func (o TagOptions) Run() error {
 					return err
 				}
 
-				fmt.Fprintf(o.out, "Deleted tag %s/%s.\n", o.destNamespace[i], destNameAndTag)
+				fmt.Fprintf(o.out, "Deleted tag %s/%s.\n", destNamespace, destNameAndTag)
 				return nil
 			}
 
@@ -418,7 +528,7 @@ func (o TagOptions) Run() error {
 			istag := &imageapi.ImageStreamTag{
 				ObjectMeta: kapi.ObjectMeta{
 					Name:      destNameAndTag,
-					Namespace: o.destNamespace[i],
+					Namespace: destNamespace,
 				},
 				Tag: &imageapi.TagReference{
 					Reference: o.referenceTag,
@@ -430,12 +540,12 @@ func (o TagOptions) Run() error {
 						Type: tagReferencePolicy,
 					},
 					From: &kapi.ObjectReference{
-						Kind: o.sourceKind,
+						Kind: sourceKind,
 					},
 				},
 			}
-			localRef := o.ref
-			switch o.sourceKind {
+			localRef := ref
+			switch sourceKind {
 			case "DockerImage":
 				istag.Tag.From.Name = localRef.Exact()
 				gen := int64(0)
@@ -443,38 +553,38 @@ func (o TagOptions) Run() error {
 
 			default:
 				istag.Tag.From.Name = localRef.NameString()
-				istag.Tag.From.Namespace = o.ref.Namespace
-				if len(o.ref.Namespace) == 0 && o.destNamespace[i] != o.namespace {
+				istag.Tag.From.Namespace = ref.Namespace
+				if len(ref.Namespace) == 0 && destNamespace != o.namespace {
 					istag.Tag.From.Namespace = o.namespace
 				}
 			}
 
 			msg := ""
-			sameNamespace := o.namespace == o.destNamespace[i]
+			sameNamespace := o.namespace == destNamespace
 			if o.aliasTag {
 				if sameNamespace {
-					msg = fmt.Sprintf("Tag %s set up to track %s.", destNameAndTag, o.ref.Exact())
+					msg = fmt.Sprintf("Tag %s set up to track %s.", destNameAndTag, ref.Exact())
 				} else {
-					msg = fmt.Sprintf("Tag %s/%s set up to track %s.", o.destNamespace[i], destNameAndTag, o.ref.Exact())
+					msg = fmt.Sprintf("Tag %s/%s set up to track %s.", destNamespace, destNameAndTag, ref.Exact())
 				}
 			} else {
 				if istag.Tag.ImportPolicy.Scheduled {
 					if sameNamespace {
-						msg = fmt.Sprintf("Tag %s set to import %s periodically.", destNameAndTag, o.ref.Exact())
+						msg = fmt.Sprintf("Tag %s set to import %s periodically.", destNameAndTag, ref.Exact())
 					} else {
-						msg = fmt.Sprintf("Tag %s/%s set to %s periodically.", o.destNamespace[i], destNameAndTag, o.ref.Exact())
+						msg = fmt.Sprintf("Tag %s/%s set to %s periodically.", destNamespace, destNameAndTag, ref.Exact())
 					}
 				} else {
 					if sameNamespace {
-						msg = fmt.Sprintf("Tag %s set to %s.", destNameAndTag, o.ref.Exact())
+						msg = fmt.Sprintf("Tag %s set to %s.", destNameAndTag, ref.Exact())
 					} else {
-						msg = fmt.Sprintf("Tag %s/%s set to %s.", o.destNamespace[i], destNameAndTag, o.ref.Exact())
+						msg = fmt.Sprintf("Tag %s/%s set to %s.", destNamespace, destNameAndTag, ref.Exact())
 					}
 				}
 			}
 
 			// supported by new servers.
-			_, err := o.osClient.ImageStreamTags(o.destNamespace[i]).Update(istag)
+			_, err := o.osClient.ImageStreamTags(destNamespace).Update(istag)
 			switch {
 			case err == nil:
 				fmt.Fprintln(o.out, msg)
@@ -482,7 +592,7 @@ func (o TagOptions) Run() error {
 
 			case kerrors.IsMethodNotSupported(err), kerrors.IsForbidden(err), kerrors.IsNotFound(err):
 				// if we got one of these errors, it possible that a Create will do what we need.  Try that
-				_, err := o.osClient.ImageStreamTags(o.destNamespace[i]).Create(istag)
+				_, err := o.osClient.ImageStreamTags(destNamespace).Create(istag)
 				switch {
 				case err == nil:
 					fmt.Fprintln(o.out, msg)
@@ -505,7 +615,8 @@ func (o TagOptions) Run() error {
 			if kerrors.IsNotFound(err) {
 				target = &imageapi.ImageStream{
 					ObjectMeta: kapi.ObjectMeta{
-						Name: destName,
+						Namespace: destNamespace,
+						Name:      destName,
 					},
 				}
 			} else if err != nil {
@@ -546,3 +657,33 @@ func (o TagOptions) Run() error {
 
 	return nil
 }
+
+// printDryRunDiff prints what the destination tag currently points at and what
+// it would be changed to, without modifying anything.
+func (o TagOptions) printDryRunDiff(ref imageapi.DockerImageReference, destNamespace, destName, destTag string) error {
+	current := "<none>"
+	is, err := o.osClient.ImageStreams(destNamespace).Get(destName)
+	switch {
+	case kerrors.IsNotFound(err):
+	case err != nil:
+		return err
+	default:
+		if existing, ok := is.Spec.Tags[destTag]; ok && existing.From != nil {
+			current = existing.From.Name
+			if len(existing.From.Namespace) > 0 {
+				current = existing.From.Namespace + "/" + current
+			}
+		}
+	}
+
+	desired := ref.Exact()
+	if o.deleteTag {
+		desired = "<deleted>"
+	}
+	if current == desired {
+		fmt.Fprintf(o.out, "%s/%s:%s unchanged (%s) (dry run)\n", destNamespace, destName, destTag, current)
+	} else {
+		fmt.Fprintf(o.out, "%s/%s:%s %s -> %s (dry run)\n", destNamespace, destName, destTag, current, desired)
+	}
+	return nil
+}
@@ -122,7 +122,12 @@ func TestTag(t *testing.T) {
 				destNamespace:   []string{"yourproject"},
 				destNameAndTag:  []string{"rails:tip"},
 			},
-			validateErr: "cannot set alias across different Image Streams",
+			expectedActions: []testAction{
+				{verb: "update", resource: "imagestreamtags"},
+				{verb: "create", resource: "imagestreamtags"},
+				{verb: "get", resource: "imagestreams"},
+				{verb: "update", resource: "imagestreams"},
+			},
 		},
 		"alias tag across image streams": {
 			data: []runtime.Object{streams[3], streams[0]},
@@ -139,7 +144,12 @@ func TestTag(t *testing.T) {
 				destNamespace:   []string{"yourproject"},
 				destNameAndTag:  []string{"python:alias"},
 			},
-			validateErr: "cannot set alias across different Image Streams",
+			expectedActions: []testAction{
+				{verb: "update", resource: "imagestreamtags"},
+				{verb: "create", resource: "imagestreamtags"},
+				{verb: "get", resource: "imagestreams"},
+				{verb: "create", resource: "imagestreams"},
+			},
 		},
 		"add old": {
 			data: []runtime.Object{streams[0]},
@@ -0,0 +1,309 @@
+package cmd
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"k8s.io/kubernetes/pkg/api/unversioned"
+	"k8s.io/kubernetes/pkg/api/validation"
+	"k8s.io/kubernetes/pkg/apimachinery/registered"
+	kcmdutil "k8s.io/kubernetes/pkg/kubectl/cmd/util"
+	kjson "k8s.io/kubernetes/pkg/runtime/serializer/json"
+	utilerrors "k8s.io/kubernetes/pkg/util/errors"
+	utilyaml "k8s.io/kubernetes/pkg/util/yaml"
+
+	"github.com/openshift/origin/pkg/api/latest"
+	"github.com/openshift/origin/pkg/client"
+	"github.com/openshift/origin/pkg/cmd/templates"
+	"github.com/openshift/origin/pkg/cmd/util/clientcmd"
+)
+
+const ValidateRecommendedName = "validate"
+
+var (
+	validateLong = templates.LongDesc(`
+		Validate resource manifests against a cached server schema
+
+		This command validates OpenShift and Kubernetes objects in the given files or
+		directories against the swagger schema of a server, including origin-specific
+		required fields, without contacting the cluster. It is intended for use in
+		pre-commit hooks and CI pipelines.
+
+		The schema is read from the local cache directory. Run this command once with
+		--refresh-schema while logged in to download the schemas the given manifests
+		need; subsequent runs work entirely offline. Objects whose group or version is
+		unknown to the client are skipped with a warning.`)
+
+	validateExample = templates.Examples(`
+		# Validate every manifest in a directory tree
+	  %[1]s validate -f config/
+
+	  # Validate a single file and update the cached schemas from the server first
+	  %[1]s validate -f deployment.yaml --refresh-schema`)
+)
+
+// validateSchemaFileName is the name of each cached schema document, matching the
+// layout kubectl uses for its --schema-cache-dir.
+const validateSchemaFileName = "schema.json"
+
+type ValidateOptions struct {
+	Filenames      []string
+	SchemaCacheDir string
+	RefreshSchema  bool
+
+	Client *client.Client
+	Out    io.Writer
+	Err    io.Writer
+}
+
+// NewCmdValidate creates a command that validates manifests offline against cached
+// server schemas.
+func NewCmdValidate(name, fullName string, f *clientcmd.Factory, out, errout io.Writer) *cobra.Command {
+	options := &ValidateOptions{
+		Out: out,
+		Err: errout,
+	}
+	cmd := &cobra.Command{
+		Use:     name + " -f FILENAME",
+		Short:   "Validate resource manifests against a cached server schema",
+		Long:    validateLong,
+		Example: fmt.Sprintf(validateExample, fullName),
+		Run: func(cmd *cobra.Command, args []string) {
+			kcmdutil.CheckErr(options.Complete(f, cmd, args))
+			kcmdutil.CheckErr(options.Run())
+		},
+	}
+	cmd.Flags().StringSliceVarP(&options.Filenames, "filename", "f", options.Filenames, "Filename or directory to validate. Directories are searched recursively for .json, .yaml and .yml files.")
+	cmd.MarkFlagRequired("filename")
+	cmd.Flags().StringVar(&options.SchemaCacheDir, "schema-cache-dir", "~/.kube/schema", "Directory holding the cached schemas.")
+	cmd.Flags().BoolVar(&options.RefreshSchema, "refresh-schema", false, "Download the schemas the given manifests need from the server before validating.")
+	return cmd
+}
+
+func (o *ValidateOptions) Complete(f *clientcmd.Factory, cmd *cobra.Command, args []string) error {
+	if len(args) > 0 {
+		return kcmdutil.UsageError(cmd, "no arguments are supported; use -f to select files")
+	}
+	if len(o.Filenames) == 0 {
+		return kcmdutil.UsageError(cmd, "at least one filename or directory is required")
+	}
+	if strings.HasPrefix(o.SchemaCacheDir, "~/") {
+		o.SchemaCacheDir = filepath.Join(os.Getenv("HOME"), o.SchemaCacheDir[2:])
+	}
+	if o.RefreshSchema {
+		osClient, _, err := f.Clients()
+		if err != nil {
+			return err
+		}
+		o.Client = osClient
+	}
+	return nil
+}
+
+func (o *ValidateOptions) Run() error {
+	files, err := collectManifestFiles(o.Filenames)
+	if err != nil {
+		return err
+	}
+	if len(files) == 0 {
+		return fmt.Errorf("no .json, .yaml or .yml files found in %s", strings.Join(o.Filenames, ", "))
+	}
+
+	validator := &cachedSchemaValidator{cacheDir: o.SchemaCacheDir, client: o.Client, refresh: o.RefreshSchema}
+
+	errs := []error{}
+	validated := 0
+	for _, file := range files {
+		documents, err := readManifestDocuments(file)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%s: %v", file, err))
+			continue
+		}
+		for i, document := range documents {
+			gvk, err := kjson.DefaultMetaFactory.Interpret(document)
+			if err != nil {
+				errs = append(errs, fmt.Errorf("%s: document %d: %v", file, i+1, err))
+				continue
+			}
+			if !latest.OriginKind(*gvk) && !registered.IsEnabledVersion(gvk.GroupVersion()) {
+				fmt.Fprintf(o.Err, "warning: %s: document %d: skipping unknown type %s\n", file, i+1, gvk.String())
+				continue
+			}
+			if err := validator.ValidateBytes(document); err != nil {
+				errs = append(errs, fmt.Errorf("%s: document %d (%s): %v", file, i+1, gvk.Kind, err))
+				continue
+			}
+			validated++
+		}
+	}
+
+	if len(errs) > 0 {
+		return utilerrors.NewAggregate(errs)
+	}
+	fmt.Fprintf(o.Out, "validated %d objects in %d files\n", validated, len(files))
+	return nil
+}
+
+// collectManifestFiles expands the provided paths into a list of manifest files,
+// searching directories recursively.
+func collectManifestFiles(paths []string) ([]string, error) {
+	files := []string{}
+	for _, p := range paths {
+		info, err := os.Stat(p)
+		if err != nil {
+			return nil, err
+		}
+		if !info.IsDir() {
+			files = append(files, p)
+			continue
+		}
+		err = filepath.Walk(p, func(walked string, info os.FileInfo, err error) error {
+			if err != nil {
+				return err
+			}
+			if info.IsDir() {
+				return nil
+			}
+			switch filepath.Ext(walked) {
+			case ".json", ".yaml", ".yml":
+				files = append(files, walked)
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	return files, nil
+}
+
+// readManifestDocuments reads a manifest file and returns each document in it as JSON.
+func readManifestDocuments(file string) ([][]byte, error) {
+	f, err := os.Open(file)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	documents := [][]byte{}
+	reader := utilyaml.NewYAMLReader(bufio.NewReader(f))
+	for {
+		document, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		if len(bytes.TrimSpace(document)) == 0 {
+			continue
+		}
+		jsonDocument, err := utilyaml.ToJSON(document)
+		if err != nil {
+			return nil, err
+		}
+		documents = append(documents, jsonDocument)
+	}
+	return documents, nil
+}
+
+// cachedSchemaValidator validates documents against swagger schemas stored in the
+// local cache directory, using the same layout as kubectl's --schema-cache-dir
+// plus an oapi prefix for origin types. It only contacts the server when refresh
+// is set, and caches parsed schemas for the duration of a run.
+type cachedSchemaValidator struct {
+	cacheDir string
+	client   *client.Client
+	refresh  bool
+
+	schemas map[string]validation.Schema
+}
+
+func (v *cachedSchemaValidator) ValidateBytes(data []byte) error {
+	gvk, err := kjson.DefaultMetaFactory.Interpret(data)
+	if err != nil {
+		return err
+	}
+	// lists carry items of other types which are validated via the delegate, so
+	// the list type itself may be unknown to a schema; skip types we can't place
+	if !latest.OriginKind(*gvk) && !registered.IsEnabledVersion(gvk.GroupVersion()) {
+		return nil
+	}
+
+	schema, err := v.schemaFor(*gvk)
+	if err != nil {
+		return err
+	}
+	return schema.ValidateBytes(data)
+}
+
+// schemaFor returns the parsed swagger schema covering the specified group version kind.
+func (v *cachedSchemaValidator) schemaFor(gvk unversioned.GroupVersionKind) (validation.Schema, error) {
+	prefix := schemaCachePrefix(gvk)
+	groupVersion := gvk.GroupVersion().String()
+	cacheFile := path.Join(v.cacheDir, prefix, groupVersion, validateSchemaFileName)
+	if v.schemas == nil {
+		v.schemas = map[string]validation.Schema{}
+	}
+	if schema, exists := v.schemas[cacheFile]; exists {
+		return schema, nil
+	}
+
+	if v.refresh {
+		if err := v.downloadSchema(prefix, groupVersion, cacheFile); err != nil {
+			return nil, err
+		}
+	}
+	schemaData, err := ioutil.ReadFile(cacheFile)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, fmt.Errorf("no cached schema at %s; run this command with --refresh-schema while logged in to download it", cacheFile)
+		}
+		return nil, err
+	}
+	schema, err := validation.NewSwaggerSchemaFromBytes(schemaData, v)
+	if err != nil {
+		return nil, err
+	}
+	v.schemas[cacheFile] = schema
+	return schema, nil
+}
+
+// downloadSchema fetches a swagger schema document from the server and stores it
+// at the cache location used for offline validation.
+func (v *cachedSchemaValidator) downloadSchema(prefix, groupVersion, cacheFile string) error {
+	schemaData, err := v.client.RESTClient.Get().
+		AbsPath(path.Join("/swaggerapi", prefix, groupVersion)).
+		Do().
+		Raw()
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(cacheFile), 0755); err != nil {
+		return err
+	}
+	return ioutil.WriteFile(cacheFile, schemaData, 0644)
+}
+
+// schemaCachePrefix returns the swagger path prefix the schema for a kind is
+// served and cached under: oapi for origin types, api for the core kube group,
+// and apis for everything else, matching the layout kubectl caches schemas in.
+func schemaCachePrefix(gvk unversioned.GroupVersionKind) string {
+	switch {
+	case latest.OriginKind(gvk):
+		return "oapi"
+	case len(gvk.Group) == 0:
+		return "api"
+	default:
+		return "apis"
+	}
+}
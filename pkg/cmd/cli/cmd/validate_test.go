@@ -0,0 +1,63 @@
+package cmd
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"k8s.io/kubernetes/pkg/api/unversioned"
+
+	_ "github.com/openshift/origin/pkg/api/install"
+)
+
+func TestSchemaCachePrefix(t *testing.T) {
+	testCases := []struct {
+		gvk      unversioned.GroupVersionKind
+		expected string
+	}{
+		{unversioned.GroupVersionKind{Version: "v1", Kind: "DeploymentConfig"}, "oapi"},
+		{unversioned.GroupVersionKind{Version: "v1", Kind: "Pod"}, "api"},
+		{unversioned.GroupVersionKind{Group: "extensions", Version: "v1beta1", Kind: "Deployment"}, "apis"},
+	}
+	for _, test := range testCases {
+		if actual := schemaCachePrefix(test.gvk); actual != test.expected {
+			t.Errorf("%s: expected prefix %q, got %q", test.gvk.String(), test.expected, actual)
+		}
+	}
+}
+
+func TestReadManifestDocuments(t *testing.T) {
+	file, err := ioutil.TempFile("", "validate")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer os.Remove(file.Name())
+	manifest := `apiVersion: v1
+kind: Pod
+metadata:
+  name: one
+---
+apiVersion: v1
+kind: Service
+metadata:
+  name: two
+---
+`
+	if _, err := file.WriteString(manifest); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	file.Close()
+
+	documents, err := readManifestDocuments(file.Name())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(documents) != 2 {
+		t.Fatalf("expected 2 documents, got %d", len(documents))
+	}
+	for i, document := range documents {
+		if len(document) == 0 || document[0] != '{' {
+			t.Errorf("document %d was not converted to JSON: %q", i, string(document))
+		}
+	}
+}
@@ -2,9 +2,11 @@ package cmd
 
 import (
 	"bufio"
+	"encoding/json"
 	"fmt"
 	"io"
 	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
 	kclientcmd "k8s.io/kubernetes/pkg/client/unversioned/clientcmd"
@@ -257,32 +259,6 @@ func NewCmdExec(fullName string, f *clientcmd.Factory, cmdIn io.Reader, cmdOut,
 	return cmd
 }
 
-var (
-	portForwardLong = templates.LongDesc(`Forward 1 or more local ports to a pod`)
-
-	portForwardExample = templates.Examples(`
-		# Listens on ports 5000 and 6000 locally, forwarding data to/from ports 5000 and 6000 in the pod
-	  %[1]s port-forward mypod 5000 6000
-
-	  # Listens on port 8888 locally, forwarding to 5000 in the pod
-	  %[1]s port-forward mypod 8888:5000
-
-	  # Listens on a random port locally, forwarding to 5000 in the pod
-	  %[1]s port-forward mypod :5000
-
-	  # Listens on a random port locally, forwarding to 5000 in the pod
-	  %[1]s port-forward mypod 0:5000`)
-)
-
-// NewCmdPortForward is a wrapper for the Kubernetes cli port-forward command
-func NewCmdPortForward(fullName string, f *clientcmd.Factory, out, errout io.Writer) *cobra.Command {
-	cmd := kcmd.NewCmdPortForward(f, out, errout)
-	cmd.Long = portForwardLong
-	cmd.Example = fmt.Sprintf(portForwardExample, fullName)
-	cmd.Flag("pod").Usage = cmd.Flag("pod").Usage + " (deprecated)"
-	return cmd
-}
-
 var (
 	describeLong = templates.LongDesc(`
 		Show details of a specific resource
@@ -343,6 +319,10 @@ var (
 		Note that scaling a deployment configuration with no deployments will update the
 		desired replicas in the configuration template.
 
+		With --wait, the command blocks until the active deployment has the requested
+		number of ready replicas, and fails with an error if that does not happen within
+		the timeout.
+
 		Supported resources:
 		%q`)
 
@@ -358,6 +338,10 @@ var (
 	  %[1]s scale --replicas=10 dc bar`)
 )
 
+// defaultScaleWaitTimeout bounds how long `scale --wait` blocks when no explicit
+// --timeout is given.
+const defaultScaleWaitTimeout = 10 * time.Minute
+
 // NewCmdScale is a wrapper for the Kubernetes cli scale command
 func NewCmdScale(fullName string, f *clientcmd.Factory, out io.Writer) *cobra.Command {
 	cmd := kcmd.NewCmdScale(f, out)
@@ -365,6 +349,18 @@ func NewCmdScale(fullName string, f *clientcmd.Factory, out io.Writer) *cobra.Co
 	cmd.Short = "Change the number of pods in a deployment"
 	cmd.Long = fmt.Sprintf(scaleLong, cmd.ValidArgs)
 	cmd.Example = fmt.Sprintf(scaleExample, fullName)
+	cmd.Flags().Bool("wait", false, "If true, wait for the new size to be reached by ready replicas before returning. Defaults to a 10 minute timeout unless --timeout is set.")
+	// --wait is sugar for the upstream --timeout flag, which drives the wait in
+	// the scaler for each resource type
+	run := cmd.Run
+	cmd.Run = func(c *cobra.Command, args []string) {
+		if kcmdutil.GetFlagBool(c, "wait") && kcmdutil.GetFlagDuration(c, "timeout") == 0 {
+			if err := c.Flags().Set("timeout", defaultScaleWaitTimeout.String()); err != nil {
+				kcmdutil.CheckErr(err)
+			}
+		}
+		run(c, args)
+	}
 	return cmd
 }
 
@@ -383,9 +379,18 @@ var (
 
 	  # Auto scale a replication controller "foo", with the number of pods between
 		# 1 to 5, target CPU utilization at 80%%
-	  %[1]s autoscale rc/foo --max=5 --cpu-percent=80`)
+	  %[1]s autoscale rc/foo --max=5 --cpu-percent=80
+
+	  # Auto scale a deployment config "frontend" on a custom metric gathered by the
+		# cluster metrics stack (Hawkular or Prometheus)
+	  %[1]s autoscale dc/frontend --max=10 --custom-metric=http_requests_per_second=100`)
 )
 
+// HPACustomMetricsAnnotation carries the custom metric targets understood by the
+// cluster metrics adapter (Hawkular or Prometheus). The value is a JSON document of
+// the form {"items":[{"name":<metric>,"value":<target>}]}.
+const HPACustomMetricsAnnotation = "alpha/target.custom-metrics.podautoscaler.kubernetes.io"
+
 // NewCmdAutoscale is a wrapper for the Kubernetes cli autoscale command
 func NewCmdAutoscale(fullName string, f *clientcmd.Factory, out io.Writer) *cobra.Command {
 	cmd := kcmd.NewCmdAutoscale(f, out)
@@ -393,9 +398,71 @@ func NewCmdAutoscale(fullName string, f *clientcmd.Factory, out io.Writer) *cobr
 	cmd.Long = autoScaleLong
 	cmd.Example = fmt.Sprintf(autoScaleExample, fullName)
 	cmd.ValidArgs = append(cmd.ValidArgs, "deploymentconfig")
+
+	customMetrics := []string{}
+	cmd.Flags().StringSliceVar(&customMetrics, "custom-metric", customMetrics, "Scale on a custom metric from the cluster metrics stack instead of CPU, in the form name=target. May be repeated.")
+	run := cmd.Run
+	cmd.Run = func(cmd *cobra.Command, args []string) {
+		run(cmd, args)
+		if len(customMetrics) == 0 {
+			return
+		}
+		kcmdutil.CheckErr(annotateHPAWithCustomMetrics(f, cmd, args, customMetrics))
+	}
 	return cmd
 }
 
+// annotateHPAWithCustomMetrics attaches the custom metric targets to the horizontal pod
+// autoscaler created by the autoscale command, where the metrics adapter will pick them up.
+func annotateHPAWithCustomMetrics(f *clientcmd.Factory, cmd *cobra.Command, args []string, customMetrics []string) error {
+	type metricTarget struct {
+		Name  string `json:"name"`
+		Value string `json:"value"`
+	}
+	targets := struct {
+		Items []metricTarget `json:"items"`
+	}{}
+	for _, metric := range customMetrics {
+		parts := strings.SplitN(metric, "=", 2)
+		if len(parts) != 2 || len(parts[0]) == 0 || len(parts[1]) == 0 {
+			return fmt.Errorf("--custom-metric must be of the form name=target: %q", metric)
+		}
+		targets.Items = append(targets.Items, metricTarget{Name: parts[0], Value: parts[1]})
+	}
+	value, err := json.Marshal(targets)
+	if err != nil {
+		return err
+	}
+
+	name := kcmdutil.GetFlagString(cmd, "name")
+	if len(name) == 0 {
+		if len(args) == 0 {
+			return fmt.Errorf("unable to determine the name of the autoscaler to annotate")
+		}
+		segments := strings.Split(args[len(args)-1], "/")
+		name = segments[len(segments)-1]
+	}
+
+	namespace, _, err := f.DefaultNamespace()
+	if err != nil {
+		return err
+	}
+	client, err := f.ClientSet()
+	if err != nil {
+		return err
+	}
+	hpa, err := client.Autoscaling().HorizontalPodAutoscalers(namespace).Get(name)
+	if err != nil {
+		return err
+	}
+	if hpa.Annotations == nil {
+		hpa.Annotations = map[string]string{}
+	}
+	hpa.Annotations[HPACustomMetricsAnnotation] = string(value)
+	_, err = client.Autoscaling().HorizontalPodAutoscalers(namespace).Update(hpa)
+	return err
+}
+
 var (
 	runLong = templates.LongDesc(`
 		Create and run a particular image, possibly replicated
@@ -245,6 +245,8 @@ func printTriggers(triggers []deployapi.DeploymentTriggerPolicy, w *tabwriter.Wr
 				name, tag, _ := imageapi.SplitImageStreamTag(t.ImageChangeParams.From.Name)
 				labels = append(labels, fmt.Sprintf("Image(%s@%s, auto=%v)", name, tag, t.ImageChangeParams.Automatic))
 			}
+		case deployapi.DeploymentTriggerOnWebHook:
+			labels = append(labels, "WebHook")
 		}
 	}
 
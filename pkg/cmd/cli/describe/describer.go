@@ -46,6 +46,7 @@ func describerMap(c *client.Client, kclient kclientset.Interface, host string, w
 		imageapi.Kind("ImageStream"):                    &ImageStreamDescriber{c},
 		imageapi.Kind("ImageStreamTag"):                 &ImageStreamTagDescriber{c},
 		imageapi.Kind("ImageStreamImage"):               &ImageStreamImageDescriber{c},
+		imageapi.Kind("RegistryWebhook"):                &RegistryWebhookDescriber{c},
 		routeapi.Kind("Route"):                          &RouteDescriber{c, kclient},
 		projectapi.Kind("Project"):                      &ProjectDescriber{c, kclient},
 		templateapi.Kind("Template"):                    &TemplateDescriber{c, meta.NewAccessor(), kapi.Scheme, nil},
@@ -139,6 +140,13 @@ func (d *BuildDescriber) Describe(namespace, name string, settings kctl.Describe
 		}
 		formatString(out, "Status", status)
 
+		if len(build.Status.Reason) > 0 {
+			formatString(out, "Reason", string(build.Status.Reason))
+		}
+		if build.Status.QueuePosition > 0 {
+			formatString(out, "Queue Position", build.Status.QueuePosition)
+		}
+
 		if build.Status.StartTimestamp != nil && !build.Status.StartTimestamp.IsZero() {
 			formatString(out, "Started", build.Status.StartTimestamp.Time.Format(time.RFC1123))
 		}
@@ -683,6 +691,35 @@ func (d *ImageStreamDescriber) Describe(namespace, name string, settings kctl.De
 	})
 }
 
+// RegistryWebhookDescriber generates information about a RegistryWebhook
+type RegistryWebhookDescriber struct {
+	client.Interface
+}
+
+// Describe returns the description of a registryWebhook
+func (d *RegistryWebhookDescriber) Describe(namespace, name string, settings kctl.DescriberSettings) (string, error) {
+	c := d.RegistryWebhooks(namespace)
+	webhook, err := c.Get(name)
+	if err != nil {
+		return "", err
+	}
+
+	return tabbedString(func(out *tabwriter.Writer) error {
+		formatMeta(out, webhook.ObjectMeta)
+		formatString(out, "URL", webhook.Spec.URL)
+		events := strings.Join(webhook.Spec.Events, ", ")
+		if len(events) == 0 {
+			events = "<all>"
+		}
+		formatString(out, "Events", events)
+		formatString(out, "Insecure", strconv.FormatBool(webhook.Spec.Insecure))
+		if len(webhook.Spec.Secret) > 0 {
+			formatString(out, "Signature", "HMAC-SHA256")
+		}
+		return nil
+	})
+}
+
 // RouteDescriber generates information about a Route
 type RouteDescriber struct {
 	client.Interface
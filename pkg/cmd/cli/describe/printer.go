@@ -35,6 +35,7 @@ var (
 	imageStreamTagColumns   = []string{"NAME", "DOCKER REF", "UPDATED", "IMAGENAME"}
 	imageStreamImageColumns = []string{"NAME", "DOCKER REF", "UPDATED", "IMAGENAME"}
 	imageStreamColumns      = []string{"NAME", "DOCKER REPO", "TAGS", "UPDATED"}
+	registryWebhookColumns  = []string{"NAME", "URL", "EVENTS"}
 	projectColumns          = []string{"NAME", "DISPLAY NAME", "STATUS"}
 	routeColumns            = []string{"NAME", "HOST/PORT", "PATH", "SERVICES", "PORT", "TERMINATION", "WILDCARD"}
 	deploymentConfigColumns = []string{"NAME", "REVISION", "DESIRED", "CURRENT", "TRIGGERED BY"}
@@ -85,6 +86,8 @@ func NewHumanReadablePrinter(printOptions kctl.PrintOptions) *kctl.HumanReadable
 	p.Handler(imageColumns, printImageList)
 	p.Handler(imageStreamColumns, printImageStream)
 	p.Handler(imageStreamColumns, printImageStreamList)
+	p.Handler(registryWebhookColumns, printRegistryWebhook)
+	p.Handler(registryWebhookColumns, printRegistryWebhookList)
 	p.Handler(projectColumns, printProject)
 	p.Handler(projectColumns, printProjectList)
 	p.Handler(routeColumns, printRoute)
@@ -269,12 +272,38 @@ func printBuild(build *buildapi.Build, w io.Writer, opts kctl.PrintOptions) erro
 	if _, err := fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s", name, buildapi.StrategyType(build.Spec.Strategy), from, status, created, duration); err != nil {
 		return err
 	}
+	if opts.Wide {
+		if _, err := fmt.Fprintf(w, "\t%s", describeBuildTriggerShort(build)); err != nil {
+			return err
+		}
+	}
 	if err := appendItemLabels(build.Labels, w, opts.ColumnLabels, opts.ShowLabels); err != nil {
 		return err
 	}
 	return nil
 }
 
+// describeBuildTriggerShort renders the causes that triggered a build in a compact form
+// suitable for a single column.
+func describeBuildTriggerShort(build *buildapi.Build) string {
+	causes := sets.NewString()
+	for _, cause := range build.Spec.TriggeredBy {
+		switch {
+		case cause.ImageChangeBuild != nil:
+			causes.Insert("imagechange")
+		case cause.GenericWebHook != nil, cause.GitHubWebHook != nil, cause.GitLabWebHook != nil, cause.BitbucketWebHook != nil:
+			causes.Insert("webhook")
+		case cause.Message == buildapi.BuildTriggerCauseManualMsg:
+			causes.Insert("manual")
+		case cause.Message == buildapi.BuildTriggerCauseConfigMsg:
+			causes.Insert("config")
+		case len(cause.Message) > 0:
+			causes.Insert(cause.Message)
+		}
+	}
+	return strings.Join(causes.List(), ",")
+}
+
 func describeSourceShort(spec buildapi.CommonSpec) string {
 	var from string
 	switch source := spec.Source; {
@@ -383,6 +412,15 @@ func printImageStreamTag(ist *imageapi.ImageStreamTag, w io.Writer, opts kctl.Pr
 	if _, err := fmt.Fprintf(w, "%s\t%s\t%s\t%s", name, ist.Image.DockerImageReference, created, ist.Image.Name); err != nil {
 		return err
 	}
+	if opts.Wide {
+		severity := "<none>"
+		if report := ist.Image.VulnerabilityReport; report != nil {
+			severity = string(report.HighestSeverity)
+		}
+		if _, err := fmt.Fprintf(w, "\t%s", severity); err != nil {
+			return err
+		}
+	}
 	if err := appendItemLabels(ist.Labels, w, opts.ColumnLabels, opts.ShowLabels); err != nil {
 		return err
 	}
@@ -478,6 +516,35 @@ func printImageStreamList(streams *imageapi.ImageStreamList, w io.Writer, opts k
 	return nil
 }
 
+func printRegistryWebhook(webhook *imageapi.RegistryWebhook, w io.Writer, opts kctl.PrintOptions) error {
+	name := formatResourceName(opts.Kind, webhook.Name, opts.WithKind)
+	events := strings.Join(webhook.Spec.Events, ",")
+	if len(events) == 0 {
+		events = "<all>"
+	}
+	if opts.WithNamespace {
+		if _, err := fmt.Fprintf(w, "%s\t", webhook.Namespace); err != nil {
+			return err
+		}
+	}
+	if _, err := fmt.Fprintf(w, "%s\t%s\t%s", name, webhook.Spec.URL, events); err != nil {
+		return err
+	}
+	if err := appendItemLabels(webhook.Labels, w, opts.ColumnLabels, opts.ShowLabels); err != nil {
+		return err
+	}
+	return nil
+}
+
+func printRegistryWebhookList(list *imageapi.RegistryWebhookList, w io.Writer, opts kctl.PrintOptions) error {
+	for _, webhook := range list.Items {
+		if err := printRegistryWebhook(&webhook, w, opts); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func printProject(project *projectapi.Project, w io.Writer, opts kctl.PrintOptions) error {
 	name := formatResourceName(opts.Kind, project.Name, opts.WithKind)
 	_, err := fmt.Fprintf(w, "%s\t%s\t%s", name, project.Annotations[oapi.OpenShiftDisplayName], project.Status.Phase)
@@ -670,6 +737,29 @@ func printDeploymentConfig(dc *deployapi.DeploymentConfig, w io.Writer, opts kct
 	if _, err := fmt.Fprintf(w, "%s\t%d\t%s\t%d\t%s", name, dc.Status.LatestVersion, desired, dc.Status.UpdatedReplicas, trigger); err != nil {
 		return err
 	}
+	if opts.Wide {
+		var lastRollout string
+		for _, condition := range dc.Status.Conditions {
+			if condition.Type == deployapi.DeploymentProgressing {
+				lastRollout = fmt.Sprintf("%s ago", formatRelativeTime(condition.LastUpdateTime.Time))
+			}
+		}
+		var lastTrigger string
+		if details := dc.Status.Details; details != nil {
+			causes := sets.NewString()
+			for _, cause := range details.Causes {
+				if cause.ImageTrigger != nil {
+					causes.Insert(fmt.Sprintf("image(%s)", cause.ImageTrigger.From.Name))
+					continue
+				}
+				causes.Insert(strings.ToLower(string(cause.Type)))
+			}
+			lastTrigger = strings.Join(causes.List(), ",")
+		}
+		if _, err := fmt.Fprintf(w, "\t%s\t%s", lastRollout, lastTrigger); err != nil {
+			return err
+		}
+	}
 	err := appendItemLabels(dc.Labels, w, opts.ColumnLabels, opts.ShowLabels)
 	return err
 }
@@ -390,6 +390,62 @@ func printMarkerSuggestions(markers []osgraph.Marker, suggest bool, out *tabwrit
 	return suggestionAmount
 }
 
+// StatusFinding is a single marker from status analysis in a form suitable for machine
+// consumption, e.g. by dashboards or CI health gates.
+type StatusFinding struct {
+	// Resource identifies the object the finding is attached to, if any.
+	Resource string `json:"resource,omitempty"`
+	// Severity is one of info, warning or error.
+	Severity osgraph.Severity `json:"severity"`
+	// Key is a short identifier for the class of finding.
+	Key string `json:"key"`
+	// Message is a human-readable description of the finding.
+	Message string `json:"message"`
+	// Suggestion is a command that may resolve the finding, if one is known.
+	Suggestion string `json:"suggestion,omitempty"`
+}
+
+// Findings returns the same status markers Describe prints, ordered by decreasing
+// severity, without rendering them for human consumption.
+func (d *ProjectStatusDescriber) Findings(namespace string) ([]StatusFinding, error) {
+	g, forbiddenResources, err := d.MakeGraph(namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	var f formatter = namespacedFormatter{}
+	if namespace != kapi.NamespaceAll {
+		f = namespacedFormatter{currentNamespace: namespace}
+	}
+
+	allMarkers := osgraph.Markers{}
+	allMarkers = append(allMarkers, createForbiddenMarkers(forbiddenResources)...)
+	for _, scanner := range getMarkerScanners(d.LogsCommandName, d.SecurityPolicyCommandFormat, d.SetProbeCommandName, forbiddenResources) {
+		allMarkers = append(allMarkers, scanner(g, f)...)
+	}
+	allMarkers = allMarkers.FilterByNamespace(namespace)
+
+	sort.Stable(osgraph.ByKey(allMarkers))
+	sort.Stable(osgraph.ByNodeID(allMarkers))
+
+	findings := []StatusFinding{}
+	for _, severity := range []osgraph.Severity{osgraph.ErrorSeverity, osgraph.WarningSeverity, osgraph.InfoSeverity} {
+		for _, marker := range allMarkers.BySeverity(severity) {
+			finding := StatusFinding{
+				Severity:   marker.Severity,
+				Key:        marker.Key,
+				Message:    marker.Message,
+				Suggestion: string(marker.Suggestion),
+			}
+			if marker.Node != nil {
+				finding.Resource = f.ResourceName(marker.Node)
+			}
+			findings = append(findings, finding)
+		}
+	}
+	return findings, nil
+}
+
 func createForbiddenMarkers(forbiddenResources sets.String) []osgraph.Marker {
 	markers := []osgraph.Marker{}
 	for forbiddenResource := range forbiddenResources {
@@ -117,10 +117,37 @@ func Execute(configFile io.Reader) {
 		pruneAccessRecords,
 	)
 
+	app.RegisterRoute(
+		// PUT /admin/repositories/<name>
+		adminRouter.Path("/repositories/{name:"+reference.NameRegexp.String()+"}").Methods("PUT"),
+		// handler restoring a soft-deleted repository from the trash
+		server.RepositoryRestoreDispatcher,
+		// repo name not required in url
+		handlers.NameNotRequired,
+		// custom access records
+		pruneAccessRecords,
+	)
+
+	// Soft-delete the storage of repositories whose image stream has been removed and purge
+	// it once the retention window passes.
+	if value := os.Getenv(server.RepositoryTrashRetentionEnvVar); len(value) > 0 {
+		retention, err := time.ParseDuration(value)
+		if err != nil {
+			log.Fatalf("invalid %s: %v", server.RepositoryTrashRetentionEnvVar, err)
+		}
+		if err := server.StartTrashSweeper(ctx, registryClient, retention); err != nil {
+			log.Fatalf("error starting the repository trash sweeper: %v", err)
+		}
+	}
+
 	// Registry extensions endpoint provides extra functionality to handle the image
 	// signatures.
 	server.RegisterSignatureHandler(app)
 
+	// Registry extensions endpoint reporting per-repository blob count and
+	// storage usage.
+	server.RegisterStorageUsageHandler(app)
+
 	// Advertise features supported by OpenShift
 	if app.Config.HTTP.Headers == nil {
 		app.Config.HTTP.Headers = http.Header{}
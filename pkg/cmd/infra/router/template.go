@@ -4,14 +4,19 @@ import (
 	"errors"
 	"fmt"
 	"os"
+	"os/signal"
 	"strconv"
 	"strings"
+	"syscall"
 	"time"
 
 	"github.com/golang/glog"
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
 
+	kapi "k8s.io/kubernetes/pkg/api"
+	kcoreclient "k8s.io/kubernetes/pkg/client/clientset_generated/internalclientset/typed/core/internalversion"
+	"k8s.io/kubernetes/pkg/client/record"
 	cmdutil "k8s.io/kubernetes/pkg/kubectl/cmd/util"
 	ktypes "k8s.io/kubernetes/pkg/types"
 	"k8s.io/kubernetes/pkg/util/sets"
@@ -63,6 +68,7 @@ type TemplateRouter struct {
 	TemplateFile            string
 	ReloadScript            string
 	ReloadInterval          time.Duration
+	DrainPeriod             time.Duration
 	DefaultCertificate      string
 	DefaultCertificatePath  string
 	DefaultCertificateDir   string
@@ -85,6 +91,19 @@ func reloadInterval() time.Duration {
 	return value
 }
 
+// drainPeriod returns how long the router retains removed routes and endpoints
+// in drain mode before purging them. The period is based on an environment
+// variable or the default of zero, which disables draining.
+func drainPeriod() time.Duration {
+	period := util.Env("ROUTER_DRAIN_PERIOD", "0s")
+	value, err := time.ParseDuration(period)
+	if err != nil {
+		glog.Warningf("Invalid ROUTER_DRAIN_PERIOD %q, draining is disabled ...", period)
+		value = 0
+	}
+	return value
+}
+
 func (o *TemplateRouter) Bind(flag *pflag.FlagSet) {
 	flag.StringVar(&o.RouterName, "name", util.Env("ROUTER_SERVICE_NAME", "public"), "The name the router will identify itself with in the route status")
 	flag.StringVar(&o.RouterCanonicalHostname, "router-canonical-hostname", util.Env("ROUTER_CANONICAL_HOSTNAME", ""), "CanonicalHostname is the external host name for the router that can be used as a CNAME for the host requested for this route. This value is optional and may not be set in all cases.")
@@ -95,6 +114,7 @@ func (o *TemplateRouter) Bind(flag *pflag.FlagSet) {
 	flag.StringVar(&o.TemplateFile, "template", util.Env("TEMPLATE_FILE", ""), "The path to the template file to use")
 	flag.StringVar(&o.ReloadScript, "reload", util.Env("RELOAD_SCRIPT", ""), "The path to the reload script to use")
 	flag.DurationVar(&o.ReloadInterval, "interval", reloadInterval(), "Controls how often router reloads are invoked. Mutiple router reload requests are coalesced for the duration of this interval since the last reload time.")
+	flag.DurationVar(&o.DrainPeriod, "drain-period", drainPeriod(), "Controls how long removed routes and endpoints are kept in drain mode before being purged from the router configuration, and how long the router waits for in-flight requests to complete on shutdown. Defaults to 0 which disables draining.")
 	flag.BoolVar(&o.ExtendedValidation, "extended-validation", util.Env("EXTENDED_VALIDATION", "true") == "true", "If set, then an additional extended validation step is performed on all routes admitted in by this router. Defaults to true and enables the extended validation checks.")
 	flag.BoolVar(&o.BindPortsAfterSync, "bind-ports-after-sync", util.Env("ROUTER_BIND_PORTS_AFTER_SYNC", "") == "true", "Bind ports only after route state has been synchronized")
 	flag.StringVar(&o.MaxConnections, "max-connections", util.Env("ROUTER_MAX_CONNECTIONS", ""), "Specifies the maximum number of concurrent connections.")
@@ -171,6 +191,10 @@ func (o *TemplateRouterOptions) Complete() error {
 		return fmt.Errorf("invalid reload interval: %v - must be a positive duration", nsecs)
 	}
 
+	if o.DrainPeriod < 0 {
+		return fmt.Errorf("invalid drain period: %v - must not be negative", o.DrainPeriod)
+	}
+
 	if len(routerCanonicalHostname) > 0 {
 		if errs := validation.IsDNS1123Subdomain(routerCanonicalHostname); len(errs) != 0 {
 			return fmt.Errorf("invalid canonical hostname: %s", routerCanonicalHostname)
@@ -203,7 +227,8 @@ func (o *TemplateRouterOptions) Validate() error {
 	return nil
 }
 
-// Run launches a template router using the provided options. It never exits.
+// Run launches a template router using the provided options. It blocks until
+// the process receives a termination signal.
 func (o *TemplateRouterOptions) Run() error {
 	var reloadCallbacks []func()
 	switch {
@@ -249,7 +274,7 @@ func (o *TemplateRouterOptions) Run() error {
 				exported = append(exported, i)
 			}
 		}
-		_, err := haproxy.NewPrometheusCollector(haproxy.PrometheusOptions{
+		e, err := haproxy.NewPrometheusCollector(haproxy.PrometheusOptions{
 			// Only template router customizers who alter the image should need this
 			ScrapeURI: util.Env("ROUTER_METRICS_HAPROXY_SCRAPE_URI", ""),
 			// Only template router customizers who alter the image should need this
@@ -262,18 +287,16 @@ func (o *TemplateRouterOptions) Run() error {
 		if err != nil {
 			return err
 		}
-		//reloadCallbacks = append(reloadCallbacks, e.CollectNow)
-	}
-	if len(o.ListenAddr) > 0 {
-		metrics.Listen(o.ListenAddr, o.StatsUsername, o.StatsPassword)
+		// ensure the metrics endpoint reflects the new set of routes immediately after a reload
+		reloadCallbacks = append(reloadCallbacks, e.CollectNow)
 	}
-
 	pluginCfg := templateplugin.TemplatePluginConfig{
 		WorkingDir:             o.WorkingDir,
 		TemplatePath:           o.TemplateFile,
 		ReloadScriptPath:       o.ReloadScript,
 		ReloadInterval:         o.ReloadInterval,
 		ReloadCallbacks:        reloadCallbacks,
+		DrainPeriod:            o.DrainPeriod,
 		DefaultCertificate:     o.DefaultCertificate,
 		DefaultCertificatePath: o.DefaultCertificatePath,
 		DefaultCertificateDir:  o.DefaultCertificateDir,
@@ -298,7 +321,15 @@ func (o *TemplateRouterOptions) Run() error {
 		return err
 	}
 
-	statusPlugin := controller.NewStatusAdmitter(templatePlugin, oc, o.RouterName, o.RouterCanonicalHostname)
+	if len(o.ListenAddr) > 0 {
+		metrics.Listen(o.ListenAddr, o.StatsUsername, o.StatsPassword, templateplugin.StatusHandler(templatePlugin))
+	}
+
+	eventBroadcaster := record.NewBroadcaster()
+	eventBroadcaster.StartRecordingToSink(&kcoreclient.EventSinkImpl{Interface: kc.Core().Events("")})
+	eventRecorder := eventBroadcaster.NewRecorder(kapi.EventSource{Component: "router"})
+
+	statusPlugin := controller.NewStatusAdmitter(templatePlugin, oc, o.RouterName, o.RouterCanonicalHostname).WithEventRecorder(eventRecorder)
 	var nextPlugin router.Plugin = statusPlugin
 	if o.ExtendedValidation {
 		nextPlugin = controller.NewExtendedValidator(nextPlugin, controller.RejectionRecorder(statusPlugin))
@@ -312,5 +343,18 @@ func (o *TemplateRouterOptions) Run() error {
 
 	proc.StartReaper()
 
-	select {}
+	// Shut down gracefully on SIGTERM. By the time the signal arrives the
+	// endpoints for the router pod have been removed, so waiting for the drain
+	// period lets the backend finish serving in-flight requests instead of
+	// cutting connections off mid-request.
+	termination := make(chan os.Signal, 1)
+	signal.Notify(termination, syscall.SIGTERM, syscall.SIGINT)
+	sig := <-termination
+	if o.DrainPeriod > 0 {
+		glog.Infof("Received signal %s - waiting %s for in-flight requests to complete before shutting down", sig, o.DrainPeriod)
+		time.Sleep(o.DrainPeriod)
+	} else {
+		glog.Infof("Received signal %s - shutting down", sig)
+	}
+	return nil
 }
@@ -17,6 +17,8 @@ const CreateBootstrapProjectTemplateCommand = "create-bootstrap-project-template
 
 type CreateBootstrapProjectTemplateOptions struct {
 	Name string
+	// NetworkPolicies if true includes the preset NetworkPolicies that isolate new projects by default.
+	NetworkPolicies bool
 }
 
 func NewCommandCreateBootstrapProjectTemplate(f *clientcmd.Factory, commandName string, fullName string, out io.Writer) *cobra.Command {
@@ -44,6 +46,7 @@ func NewCommandCreateBootstrapProjectTemplate(f *clientcmd.Factory, commandName
 	}
 
 	cmd.Flags().StringVar(&options.Name, "name", delegated.DefaultTemplateName, "The name of the template to output.")
+	cmd.Flags().BoolVar(&options.NetworkPolicies, "with-network-policies", options.NetworkPolicies, "If true, include preset NetworkPolicies that isolate new projects by default. Only effective with the networkpolicy SDN plugin.")
 	cmdutil.AddPrinterFlags(cmd)
 
 	// Default to JSON
@@ -68,5 +71,10 @@ func (o CreateBootstrapProjectTemplateOptions) Validate(args []string) error {
 func (o CreateBootstrapProjectTemplateOptions) CreateBootstrapProjectTemplate() (*templateapi.Template, error) {
 	template := delegated.DefaultTemplate()
 	template.Name = o.Name
+	if o.NetworkPolicies {
+		if err := delegated.AddNetworkPolicyIsolationToTemplate(template); err != nil {
+			return nil, err
+		}
+	}
 	return template, nil
 }
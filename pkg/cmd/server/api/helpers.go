@@ -173,6 +173,9 @@ func GetMasterFileReferences(config *MasterConfig) []*string {
 			case (*RequestHeaderIdentityProvider):
 				refs = append(refs, &provider.ClientCA)
 
+			case (*ClientCertIdentityProvider):
+				refs = append(refs, &provider.ClientCA)
+
 			case (*HTPasswdPasswordIdentityProvider):
 				refs = append(refs, &provider.File)
 
@@ -260,6 +263,9 @@ func GetMasterFileReferences(config *MasterConfig) []*string {
 		refs = append(refs, &config.ControllerConfig.ServiceServingCert.Signer.CertFile)
 		refs = append(refs, &config.ControllerConfig.ServiceServingCert.Signer.KeyFile)
 	}
+	if config.ControllerConfig.ServiceServingCert.ExternalSigner != nil {
+		refs = append(refs, &config.ControllerConfig.ServiceServingCert.ExternalSigner.CABundleFile)
+	}
 
 	refs = append(refs, &config.AuditConfig.AuditFilePath)
 
@@ -443,18 +449,21 @@ func GetOAuthClientCertCAs(options MasterConfig) ([]*x509.Certificate, error) {
 	if options.OAuthConfig != nil {
 		for _, identityProvider := range options.OAuthConfig.IdentityProviders {
 
+			caFile := ""
 			switch provider := identityProvider.Provider.(type) {
 			case (*RequestHeaderIdentityProvider):
-				caFile := provider.ClientCA
-				if len(caFile) == 0 {
-					continue
-				}
-				certs, err := cmdutil.CertificatesFromFile(caFile)
-				if err != nil {
-					return nil, fmt.Errorf("Error reading %s: %s", caFile, err)
-				}
-				allCerts = append(allCerts, certs...)
+				caFile = provider.ClientCA
+			case (*ClientCertIdentityProvider):
+				caFile = provider.ClientCA
+			}
+			if len(caFile) == 0 {
+				continue
+			}
+			certs, err := cmdutil.CertificatesFromFile(caFile)
+			if err != nil {
+				return nil, fmt.Errorf("Error reading %s: %s", caFile, err)
 			}
+			allCerts = append(allCerts, certs...)
 		}
 	}
 
@@ -529,6 +538,7 @@ func IsIdentityProviderType(provider runtime.Object) bool {
 	switch provider.(type) {
 	case
 		(*RequestHeaderIdentityProvider),
+		(*ClientCertIdentityProvider),
 		(*BasicAuthPasswordIdentityProvider),
 		(*AllowAllPasswordIdentityProvider),
 		(*DenyAllPasswordIdentityProvider),
@@ -47,6 +47,7 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 		&LDAPPasswordIdentityProvider{},
 		&KeystonePasswordIdentityProvider{},
 		&RequestHeaderIdentityProvider{},
+		&ClientCertIdentityProvider{},
 		&GitHubIdentityProvider{},
 		&GitLabIdentityProvider{},
 		&GoogleIdentityProvider{},
@@ -262,6 +262,9 @@ func fuzzInternalObject(t *testing.T, forVersion unversioned.GroupVersion, item
 			if obj.ScheduledImageImportMinimumIntervalSeconds == 0 {
 				obj.ScheduledImageImportMinimumIntervalSeconds = 15 * 60
 			}
+			if obj.ExternalImageChangeTriggerIntervalSeconds == 0 {
+				obj.ExternalImageChangeTriggerIntervalSeconds = 15 * 60
+			}
 			obj.AllowedRegistriesForImport = &configapi.AllowedRegistries{
 				{DomainName: "docker.io"},
 				{DomainName: "gcr.io"},
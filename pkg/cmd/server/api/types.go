@@ -333,6 +333,12 @@ type MasterConfig struct {
 	// Setting this value non-negative forces pauseControllers=true. This value defaults off (0, or
 	// omitted) and controller election can be disabled with -1.
 	ControllerLeaseTTL int
+	// BuildControllerShards divides namespaces into the given number of shards by hash
+	// when greater than 1. Each running master competes for a leader lease per shard and
+	// the build controllers only process builds and build configs in namespaces belonging
+	// to the shards this master holds, allowing the build workload to be split between
+	// multiple masters.
+	BuildControllerShards int
 	// TODO: the next field added to controllers must be added to a new controllers struct
 
 	// AdmissionConfig contains admission control plugin configuration.
@@ -399,6 +405,10 @@ type MasterConfig struct {
 	// AuditConfig holds information related to auditing capabilities.
 	AuditConfig AuditConfig
 
+	// SessionRecordingConfig holds configuration for recording interactive exec and
+	// attach sessions. If nil, sessions are not recorded.
+	SessionRecordingConfig *SessionRecordingConfig
+
 	// EnableTemplateServiceBroker is a temporary switch which enables TemplateServiceBroker.
 	EnableTemplateServiceBroker bool
 }
@@ -441,6 +451,20 @@ type AuditConfig struct {
 	MaximumFileSizeMegabytes int
 }
 
+// SessionRecordingConfig holds configuration for recording interactive exec and attach
+// sessions proxied through the master. Transcripts capture the raw upgraded connection
+// bytes in both directions together with the identity of the authenticated user.
+type SessionRecordingConfig struct {
+	// Enabled turns session recording on.
+	Enabled bool
+	// RecordingDirPath is a directory where one transcript file is written per
+	// recorded session.
+	RecordingDirPath string
+	// WebhookURL is an endpoint that receives each transcript as a JSON document
+	// when the session ends.
+	WebhookURL string
+}
+
 // JenkinsPipelineConfig holds configuration for the Jenkins pipeline strategy
 type JenkinsPipelineConfig struct {
 	// AutoProvisionEnabled determines whether a Jenkins server will be spawned from the provided
@@ -479,6 +503,32 @@ type ImagePolicyConfig struct {
 	// this policy - typically only administrators or system integrations will have those
 	// permissions.
 	AllowedRegistriesForImport *AllowedRegistries
+	// ImportProxies holds per-registry proxy and certificate authority settings used when
+	// importing images. This allows imports from registries behind different proxies to
+	// work simultaneously, instead of relying on proxy environment variables on the master.
+	ImportProxies []RegistryProxyConfig
+	// ExternalImageChangeTriggerRegistries is the list of registry hostnames that are polled
+	// for digest changes of images referenced directly by ImageChange triggers on build
+	// configurations, without requiring the images to be mirrored into an image stream.
+	// If empty, external images are not polled.
+	ExternalImageChangeTriggerRegistries []string
+	// ExternalImageChangeTriggerIntervalSeconds is the number of seconds between polls of the
+	// registries listed in ExternalImageChangeTriggerRegistries. The default value is 15 minutes.
+	ExternalImageChangeTriggerIntervalSeconds int
+}
+
+// RegistryProxyConfig describes how the image importer reaches a single registry.
+type RegistryProxyConfig struct {
+	// DomainName specifies a domain name for the registry the settings apply to.
+	// In case the registry uses a non-standard (80 or 443) port, the port should be
+	// included in the domain name as well.
+	DomainName string
+	// ProxyURL is the URL of the proxy to use when contacting this registry.
+	// If empty, the registry is contacted directly.
+	ProxyURL string
+	// CA is the path to a PEM-encoded certificate bundle used to verify the serving
+	// certificate of this registry. If empty, the system roots are used.
+	CA string
 }
 
 // AllowedRegistries represents a list of registries allowed for the image import.
@@ -508,6 +558,12 @@ type ProjectConfig struct {
 	// If it is not specified, a default template is used.
 	ProjectRequestTemplate string
 
+	// ProjectRequestApprovalWebhookURL is an optional URL of an external approval system that is
+	// consulted before a project request is fulfilled. The system responds with an approval
+	// decision (Pending, Approved or Denied); the project and template objects are only created
+	// once the request is approved. If empty, project requests are approved immediately.
+	ProjectRequestApprovalWebhookURL string
+
 	// SecurityAllocator controls the automatic allocation of UIDs and MCS labels to a project. If nil, allocation is disabled.
 	SecurityAllocator *SecurityAllocator
 }
@@ -799,6 +855,20 @@ type OAuthConfig struct {
 
 	// Templates allow you to customize pages like the login page.
 	Templates *OAuthTemplates
+
+	// LoginLockout, when set, temporarily locks out user names and source IPs with too
+	// many consecutive failed logins against password identity providers. If nil, failed
+	// logins are not throttled.
+	LoginLockout *LoginLockoutConfig
+}
+
+// LoginLockoutConfig holds failed-login throttling settings for password identity providers.
+type LoginLockoutConfig struct {
+	// MaxFailures is the number of consecutive failed logins for a user name or source IP
+	// after which further attempts are locked out.
+	MaxFailures int
+	// LockDurationSeconds is the number of seconds a lockout lasts.
+	LockDurationSeconds int
 }
 
 type OAuthTemplates struct {
@@ -884,10 +954,26 @@ type IdentityProvider struct {
 	UseAsLogin bool
 	// MappingMethod determines how identities from this provider are mapped to users
 	MappingMethod string
+	// UserNameMapping controls how user names are derived from the identities this provider
+	// returns. If unspecified, the identity's preferred user name is used unchanged.
+	UserNameMapping *UserNameMappingConfig
 	// Provider contains the information about how to set up a specific identity provider
 	Provider runtime.Object
 }
 
+// UserNameMappingConfig controls how user names are derived from identities
+type UserNameMappingConfig struct {
+	// Template derives the user name from identity claims. Tokens of the form {claim} are
+	// replaced with the matching entry from the identity's extra claims, and {providerUserName}
+	// is replaced with the provider's user name. If empty, or if the template expands to an
+	// empty string, the identity's preferred user name is used.
+	Template string
+	// Lowercase forces derived user names to lower case
+	Lowercase bool
+	// Prefix is prepended to every derived user name
+	Prefix string
+}
+
 type BasicAuthPasswordIdentityProvider struct {
 	unversioned.TypeMeta
 
@@ -989,6 +1075,24 @@ type RequestHeaderIdentityProvider struct {
 	EmailHeaders []string
 }
 
+// ClientCertIdentityProvider maps verified x509 client certificates presented during API
+// authentication to users and groups using configurable subject attribute rules
+type ClientCertIdentityProvider struct {
+	unversioned.TypeMeta
+
+	// ClientCA is a file with the trusted signer certs used to verify presented client certificates. Required.
+	ClientCA string
+
+	// UsernameAttribute is the certificate subject attribute whose value becomes the user name.
+	// Supported values are "CN" and "emailAddress". If empty, "CN" is used.
+	UsernameAttribute string
+	// GroupAttributes is the list of certificate subject attributes whose values become group names.
+	// Supported values are "O" and "OU". If empty, "O" is used.
+	GroupAttributes []string
+	// GroupPrefix is an optional prefix prepended to every group name extracted from the certificate
+	GroupPrefix string
+}
+
 type GitHubIdentityProvider struct {
 	unversioned.TypeMeta
 
@@ -1388,6 +1492,41 @@ type ControllerConfig struct {
 	// ServiceServingCert holds configuration for service serving cert signer which creates cert/key pairs for
 	// pods fulfilling a service to serve with.
 	ServiceServingCert ServiceServingCert
+
+	// DeployerPodTemplate sets cluster defaults for select fields of the deployer pods created
+	// for deployments. Values set on an individual deployment config take precedence over
+	// these defaults.
+	DeployerPodTemplate *DeployerPodTemplateConfig
+}
+
+// DeployerPodTemplateConfig holds cluster defaults for select fields of deployer pods.
+type DeployerPodTemplateConfig struct {
+	// NodeSelector replaces the node selector deployer pods inherit from the deployment's
+	// pod template.
+	NodeSelector map[string]string
+	// Tolerations are applied to deployer pods via the
+	// scheduler.alpha.kubernetes.io/tolerations annotation.
+	Tolerations []TolerationConfig
+	// ServiceAccountName overrides the service account deployer pods run as.
+	ServiceAccountName string
+	// Requests holds default resource requests for the deployer pod container, keyed by
+	// resource name with resource quantity strings as values.
+	Requests map[string]string
+	// Limits holds default resource limits for the deployer pod container, keyed by
+	// resource name with resource quantity strings as values.
+	Limits map[string]string
+}
+
+// TolerationConfig describes a single pod toleration.
+type TolerationConfig struct {
+	// Key is the taint key the toleration applies to. Empty means match all keys.
+	Key string
+	// Operator is Exists or Equal. Defaults to Equal.
+	Operator string
+	// Value is the taint value the toleration matches. Only valid with the Equal operator.
+	Value string
+	// Effect is NoSchedule, PreferNoSchedule or an empty string to match all effects.
+	Effect string
 }
 
 // ServiceServingCert holds configuration for service serving cert signer which creates cert/key pairs for
@@ -1396,6 +1535,21 @@ type ServiceServingCert struct {
 	// Signer holds the signing information used to automatically sign serving certificates.
 	// If this value is nil, then certs are not signed automatically.
 	Signer *CertInfo
+
+	// ExternalSigner delegates serving certificate signing to an external CA exposing a
+	// CFSSL-compatible signing API instead of the built-in self-signed CA. When set, it
+	// takes precedence over Signer.
+	ExternalSigner *ExternalServingCertSigner
+}
+
+// ExternalServingCertSigner describes an external CA used to sign serving certificates.
+type ExternalServingCertSigner struct {
+	// SignURL is the URL of a CFSSL-compatible signing endpoint.
+	SignURL string
+
+	// CABundleFile is a file containing the PEM encoded certificate chain of the external CA.
+	// It is published to clients that need to verify serving certificates.
+	CABundleFile string
 }
 
 // DefaultAdmissionConfig can be used to enable or disable various admission plugins.
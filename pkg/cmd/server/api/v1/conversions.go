@@ -189,6 +189,9 @@ func addDefaultingFuncs(scheme *runtime.Scheme) error {
 			if obj.ScheduledImageImportMinimumIntervalSeconds == 0 {
 				obj.ScheduledImageImportMinimumIntervalSeconds = 15 * 60
 			}
+			if obj.ExternalImageChangeTriggerIntervalSeconds == 0 {
+				obj.ExternalImageChangeTriggerIntervalSeconds = 15 * 60
+			}
 		},
 		func(obj *DNSConfig) {
 			if len(obj.BindNetwork) == 0 {
@@ -29,6 +29,7 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 		&LDAPPasswordIdentityProvider{},
 		&KeystonePasswordIdentityProvider{},
 		&RequestHeaderIdentityProvider{},
+		&ClientCertIdentityProvider{},
 		&GitHubIdentityProvider{},
 		&GitLabIdentityProvider{},
 		&GoogleIdentityProvider{},
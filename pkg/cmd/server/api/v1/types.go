@@ -200,6 +200,12 @@ type MasterConfig struct {
 	// Setting this value non-negative forces pauseControllers=true. This value defaults off (0, or
 	// omitted) and controller election can be disabled with -1.
 	ControllerLeaseTTL int `json:"controllerLeaseTTL"`
+	// BuildControllerShards divides namespaces into the given number of shards by hash
+	// when greater than 1. Each running master competes for a leader lease per shard and
+	// the build controllers only process builds and build configs in namespaces belonging
+	// to the shards this master holds, allowing the build workload to be split between
+	// multiple masters.
+	BuildControllerShards int `json:"buildControllerShards,omitempty"`
 
 	// AdmissionConfig contains admission control plugin configuration.
 	AdmissionConfig AdmissionConfig `json:"admissionConfig"`
@@ -267,6 +273,10 @@ type MasterConfig struct {
 	// AuditConfig holds information related to auditing capabilities.
 	AuditConfig AuditConfig `json:"auditConfig"`
 
+	// SessionRecordingConfig holds configuration for recording interactive exec and
+	// attach sessions. If nil, sessions are not recorded.
+	SessionRecordingConfig *SessionRecordingConfig `json:"sessionRecordingConfig,omitempty"`
+
 	// EnableTemplateServiceBroker is a temporary switch which enables TemplateServiceBroker.
 	EnableTemplateServiceBroker bool `json:"enableTemplateServiceBroker"`
 }
@@ -309,6 +319,20 @@ type AuditConfig struct {
 	MaximumFileSizeMegabytes int `json:"maximumFileSizeMegabytes"`
 }
 
+// SessionRecordingConfig holds configuration for recording interactive exec and attach
+// sessions proxied through the master. Transcripts capture the raw upgraded connection
+// bytes in both directions together with the identity of the authenticated user.
+type SessionRecordingConfig struct {
+	// Enabled turns session recording on.
+	Enabled bool `json:"enabled"`
+	// RecordingDirPath is a directory where one transcript file is written per
+	// recorded session.
+	RecordingDirPath string `json:"recordingDirPath"`
+	// WebhookURL is an endpoint that receives each transcript as a JSON document
+	// when the session ends.
+	WebhookURL string `json:"webhookURL"`
+}
+
 // JenkinsPipelineConfig holds configuration for the Jenkins pipeline strategy
 type JenkinsPipelineConfig struct {
 	// AutoProvisionEnabled determines whether a Jenkins server will be spawned from the provided
@@ -348,6 +372,32 @@ type ImagePolicyConfig struct {
 	// this policy - typically only administrators or system integrations will have those
 	// permissions.
 	AllowedRegistriesForImport *AllowedRegistries `json:"allowedRegistriesForImport,omitempty"`
+	// ImportProxies holds per-registry proxy and certificate authority settings used when
+	// importing images. This allows imports from registries behind different proxies to
+	// work simultaneously, instead of relying on proxy environment variables on the master.
+	ImportProxies []RegistryProxyConfig `json:"importProxies,omitempty"`
+	// ExternalImageChangeTriggerRegistries is the list of registry hostnames that are polled
+	// for digest changes of images referenced directly by ImageChange triggers on build
+	// configurations, without requiring the images to be mirrored into an image stream.
+	// If empty, external images are not polled.
+	ExternalImageChangeTriggerRegistries []string `json:"externalImageChangeTriggerRegistries,omitempty"`
+	// ExternalImageChangeTriggerIntervalSeconds is the number of seconds between polls of the
+	// registries listed in ExternalImageChangeTriggerRegistries. The default value is 15 minutes.
+	ExternalImageChangeTriggerIntervalSeconds int `json:"externalImageChangeTriggerIntervalSeconds,omitempty"`
+}
+
+// RegistryProxyConfig describes how the image importer reaches a single registry.
+type RegistryProxyConfig struct {
+	// DomainName specifies a domain name for the registry the settings apply to.
+	// In case the registry uses a non-standard (80 or 443) port, the port should be
+	// included in the domain name as well.
+	DomainName string `json:"domainName"`
+	// ProxyURL is the URL of the proxy to use when contacting this registry.
+	// If empty, the registry is contacted directly.
+	ProxyURL string `json:"proxyURL,omitempty"`
+	// CA is the path to a PEM-encoded certificate bundle used to verify the serving
+	// certificate of this registry. If empty, the system roots are used.
+	CA string `json:"ca,omitempty"`
 }
 
 // AllowedRegistries represents a list of registries allowed for the image import.
@@ -378,6 +428,12 @@ type ProjectConfig struct {
 	// If it is not specified, a default template is used.
 	ProjectRequestTemplate string `json:"projectRequestTemplate"`
 
+	// ProjectRequestApprovalWebhookURL is an optional URL of an external approval system that is
+	// consulted before a project request is fulfilled. The system responds with an approval
+	// decision (Pending, Approved or Denied); the project and template objects are only created
+	// once the request is approved. If empty, project requests are approved immediately.
+	ProjectRequestApprovalWebhookURL string `json:"projectRequestApprovalWebhookURL,omitempty"`
+
 	// SecurityAllocator controls the automatic allocation of UIDs and MCS labels to a project. If nil, allocation is disabled.
 	SecurityAllocator *SecurityAllocator `json:"securityAllocator"`
 }
@@ -705,6 +761,20 @@ type OAuthConfig struct {
 
 	// Templates allow you to customize pages like the login page.
 	Templates *OAuthTemplates `json:"templates"`
+
+	// LoginLockout, when set, temporarily locks out user names and source IPs with too
+	// many consecutive failed logins against password identity providers. If nil, failed
+	// logins are not throttled.
+	LoginLockout *LoginLockoutConfig `json:"loginLockout,omitempty"`
+}
+
+// LoginLockoutConfig holds failed-login throttling settings for password identity providers.
+type LoginLockoutConfig struct {
+	// MaxFailures is the number of consecutive failed logins for a user name or source IP
+	// after which further attempts are locked out.
+	MaxFailures int `json:"maxFailures"`
+	// LockDurationSeconds is the number of seconds a lockout lasts.
+	LockDurationSeconds int `json:"lockDurationSeconds"`
 }
 
 // OAuthTemplates allow for customization of pages like the login page
@@ -795,10 +865,26 @@ type IdentityProvider struct {
 	UseAsLogin bool `json:"login"`
 	// MappingMethod determines how identities from this provider are mapped to users
 	MappingMethod string `json:"mappingMethod"`
+	// UserNameMapping controls how user names are derived from the identities this provider
+	// returns. If unspecified, the identity's preferred user name is used unchanged.
+	UserNameMapping *UserNameMappingConfig `json:"userNameMapping,omitempty"`
 	// Provider contains the information about how to set up a specific identity provider
 	Provider runtime.RawExtension `json:"provider"`
 }
 
+// UserNameMappingConfig controls how user names are derived from identities
+type UserNameMappingConfig struct {
+	// Template derives the user name from identity claims. Tokens of the form {claim} are
+	// replaced with the matching entry from the identity's extra claims, and {providerUserName}
+	// is replaced with the provider's user name. If empty, or if the template expands to an
+	// empty string, the identity's preferred user name is used.
+	Template string `json:"template"`
+	// Lowercase forces derived user names to lower case
+	Lowercase bool `json:"lowercase"`
+	// Prefix is prepended to every derived user name
+	Prefix string `json:"prefix"`
+}
+
 // BasicAuthPasswordIdentityProvider provides identities for users authenticating using HTTP basic auth credentials
 type BasicAuthPasswordIdentityProvider struct {
 	unversioned.TypeMeta `json:",inline"`
@@ -908,6 +994,24 @@ type RequestHeaderIdentityProvider struct {
 	EmailHeaders []string `json:"emailHeaders"`
 }
 
+// ClientCertIdentityProvider maps verified x509 client certificates presented during API
+// authentication to users and groups using configurable subject attribute rules
+type ClientCertIdentityProvider struct {
+	unversioned.TypeMeta `json:",inline"`
+
+	// ClientCA is a file with the trusted signer certs used to verify presented client certificates. Required.
+	ClientCA string `json:"clientCA"`
+
+	// UsernameAttribute is the certificate subject attribute whose value becomes the user name.
+	// Supported values are "CN" and "emailAddress". If empty, "CN" is used.
+	UsernameAttribute string `json:"usernameAttribute"`
+	// GroupAttributes is the list of certificate subject attributes whose values become group names.
+	// Supported values are "O" and "OU". If empty, "O" is used.
+	GroupAttributes []string `json:"groupAttributes"`
+	// GroupPrefix is an optional prefix prepended to every group name extracted from the certificate
+	GroupPrefix string `json:"groupPrefix"`
+}
+
 // GitHubIdentityProvider provides identities for users authenticating using GitHub credentials
 type GitHubIdentityProvider struct {
 	unversioned.TypeMeta `json:",inline"`
@@ -1326,6 +1430,41 @@ type ControllerConfig struct {
 	// ServiceServingCert holds configuration for service serving cert signer which creates cert/key pairs for
 	// pods fulfilling a service to serve with.
 	ServiceServingCert ServiceServingCert `json:"serviceServingCert"`
+
+	// DeployerPodTemplate sets cluster defaults for select fields of the deployer pods created
+	// for deployments. Values set on an individual deployment config take precedence over
+	// these defaults.
+	DeployerPodTemplate *DeployerPodTemplateConfig `json:"deployerPodTemplate,omitempty"`
+}
+
+// DeployerPodTemplateConfig holds cluster defaults for select fields of deployer pods.
+type DeployerPodTemplateConfig struct {
+	// NodeSelector replaces the node selector deployer pods inherit from the deployment's
+	// pod template.
+	NodeSelector map[string]string `json:"nodeSelector,omitempty"`
+	// Tolerations are applied to deployer pods via the
+	// scheduler.alpha.kubernetes.io/tolerations annotation.
+	Tolerations []TolerationConfig `json:"tolerations,omitempty"`
+	// ServiceAccountName overrides the service account deployer pods run as.
+	ServiceAccountName string `json:"serviceAccountName,omitempty"`
+	// Requests holds default resource requests for the deployer pod container, keyed by
+	// resource name with resource quantity strings as values.
+	Requests map[string]string `json:"requests,omitempty"`
+	// Limits holds default resource limits for the deployer pod container, keyed by
+	// resource name with resource quantity strings as values.
+	Limits map[string]string `json:"limits,omitempty"`
+}
+
+// TolerationConfig describes a single pod toleration.
+type TolerationConfig struct {
+	// Key is the taint key the toleration applies to. Empty means match all keys.
+	Key string `json:"key,omitempty"`
+	// Operator is Exists or Equal. Defaults to Equal.
+	Operator string `json:"operator,omitempty"`
+	// Value is the taint value the toleration matches. Only valid with the Equal operator.
+	Value string `json:"value,omitempty"`
+	// Effect is NoSchedule, PreferNoSchedule or an empty string to match all effects.
+	Effect string `json:"effect,omitempty"`
 }
 
 // ServiceServingCert holds configuration for service serving cert signer which creates cert/key pairs for
@@ -1334,6 +1473,21 @@ type ServiceServingCert struct {
 	// Signer holds the signing information used to automatically sign serving certificates.
 	// If this value is nil, then certs are not signed automatically.
 	Signer *CertInfo `json:"signer"`
+
+	// ExternalSigner delegates serving certificate signing to an external CA exposing a
+	// CFSSL-compatible signing API instead of the built-in self-signed CA. When set, it
+	// takes precedence over Signer.
+	ExternalSigner *ExternalServingCertSigner `json:"externalSigner,omitempty"`
+}
+
+// ExternalServingCertSigner describes an external CA used to sign serving certificates.
+type ExternalServingCertSigner struct {
+	// SignURL is the URL of a CFSSL-compatible signing endpoint.
+	SignURL string `json:"signURL"`
+
+	// CABundleFile is a file containing the PEM encoded certificate chain of the external CA.
+	// It is published to clients that need to verify serving certificates.
+	CABundleFile string `json:"caBundleFile"`
 }
 
 // DefaultAdmissionConfig can be used to enable or disable various admission plugins.
@@ -12,6 +12,7 @@ import (
 
 	apiserveroptions "k8s.io/kubernetes/cmd/kube-apiserver/app/options"
 	controlleroptions "k8s.io/kubernetes/cmd/kube-controller-manager/app/options"
+	"k8s.io/kubernetes/pkg/api/resource"
 	kvalidation "k8s.io/kubernetes/pkg/api/validation"
 	"k8s.io/kubernetes/pkg/serviceaccount"
 	knet "k8s.io/kubernetes/pkg/util/net"
@@ -65,6 +66,10 @@ func ValidateMasterConfig(config *api.MasterConfig, fldPath *field.Path) Validat
 		validationResults.AddErrors(field.Invalid(fldPath.Child("controllerLeaseTTL"), config.ControllerLeaseTTL, "TTL must be -1 (disabled), 0 (default), or between 10 and 300 seconds"))
 	}
 
+	if config.BuildControllerShards < 0 || config.BuildControllerShards > 256 {
+		validationResults.AddErrors(field.Invalid(fldPath.Child("buildControllerShards"), config.BuildControllerShards, "must be between 0 (disabled) and 256 shards"))
+	}
+
 	validationResults.AddErrors(ValidateDisabledFeatures(config.DisabledFeatures, fldPath.Child("disabledFeatures"))...)
 
 	if config.AssetConfig != nil {
@@ -194,6 +199,10 @@ func ValidateMasterConfig(config *api.MasterConfig, fldPath *field.Path) Validat
 
 	validationResults.Append(ValidateAuditConfig(config.AuditConfig, fldPath.Child("auditConfig")))
 
+	if config.SessionRecordingConfig != nil {
+		validationResults.Append(ValidateSessionRecordingConfig(*config.SessionRecordingConfig, fldPath.Child("sessionRecordingConfig")))
+	}
+
 	validationResults.Append(ValidateMasterAuthConfig(config.AuthConfig, fldPath.Child("authConfig")))
 
 	return validationResults
@@ -245,6 +254,23 @@ func ValidateAuditConfig(config api.AuditConfig, fldPath *field.Path) Validation
 	return validationResults
 }
 
+func ValidateSessionRecordingConfig(config api.SessionRecordingConfig, fldPath *field.Path) ValidationResults {
+	validationResults := ValidationResults{}
+
+	if !config.Enabled {
+		return validationResults
+	}
+	if len(config.RecordingDirPath) == 0 && len(config.WebhookURL) == 0 {
+		validationResults.AddErrors(field.Required(fldPath.Child("recordingDirPath"), "session recording requires a recording directory or a webhook URL"))
+	}
+	if len(config.WebhookURL) > 0 {
+		_, urlErrs := ValidateSecureURL(config.WebhookURL, fldPath.Child("webhookURL"))
+		validationResults.AddErrors(urlErrs...)
+	}
+
+	return validationResults
+}
+
 func ValidateControllerConfig(config api.ControllerConfig, fldPath *field.Path) ValidationResults {
 	validationResults := ValidationResults{}
 
@@ -252,9 +278,70 @@ func ValidateControllerConfig(config api.ControllerConfig, fldPath *field.Path)
 		validationResults.AddErrors(ValidateCertInfo(*config.ServiceServingCert.Signer, true, fldPath.Child("serviceServingCert.signer"))...)
 	}
 
+	if config.ServiceServingCert.ExternalSigner != nil {
+		if len(config.ServiceServingCert.ExternalSigner.SignURL) == 0 {
+			validationResults.AddErrors(field.Required(fldPath.Child("serviceServingCert.externalSigner.signURL"), ""))
+		} else {
+			_, urlErrs := ValidateURL(config.ServiceServingCert.ExternalSigner.SignURL, fldPath.Child("serviceServingCert.externalSigner.signURL"))
+			validationResults.AddErrors(urlErrs...)
+		}
+		if len(config.ServiceServingCert.ExternalSigner.CABundleFile) == 0 {
+			validationResults.AddErrors(field.Required(fldPath.Child("serviceServingCert.externalSigner.caBundleFile"), ""))
+		} else {
+			validationResults.AddErrors(ValidateFile(config.ServiceServingCert.ExternalSigner.CABundleFile, fldPath.Child("serviceServingCert.externalSigner.caBundleFile"))...)
+		}
+	}
+
+	if config.DeployerPodTemplate != nil {
+		validationResults.AddErrors(ValidateDeployerPodTemplateConfig(config.DeployerPodTemplate, fldPath.Child("deployerPodTemplate"))...)
+	}
+
 	return validationResults
 }
 
+func ValidateDeployerPodTemplateConfig(config *api.DeployerPodTemplateConfig, fldPath *field.Path) field.ErrorList {
+	errs := field.ErrorList{}
+
+	if len(config.ServiceAccountName) > 0 {
+		for _, msg := range kvalidation.ValidateServiceAccountName(config.ServiceAccountName, false) {
+			errs = append(errs, field.Invalid(fldPath.Child("serviceAccountName"), config.ServiceAccountName, msg))
+		}
+	}
+
+	for i, toleration := range config.Tolerations {
+		idxPath := fldPath.Child("tolerations").Index(i)
+		switch toleration.Operator {
+		case "", "Equal", "Exists":
+		default:
+			errs = append(errs, field.NotSupported(idxPath.Child("operator"), toleration.Operator, []string{"Equal", "Exists"}))
+		}
+		if toleration.Operator == "Exists" && len(toleration.Value) > 0 {
+			errs = append(errs, field.Invalid(idxPath.Child("value"), toleration.Value, "value must be empty when operator is Exists"))
+		}
+		switch toleration.Effect {
+		case "", "NoSchedule", "PreferNoSchedule":
+		default:
+			errs = append(errs, field.NotSupported(idxPath.Child("effect"), toleration.Effect, []string{"NoSchedule", "PreferNoSchedule"}))
+		}
+	}
+
+	for _, resourceList := range []struct {
+		name       string
+		quantities map[string]string
+	}{
+		{"requests", config.Requests},
+		{"limits", config.Limits},
+	} {
+		for name, value := range resourceList.quantities {
+			if _, err := resource.ParseQuantity(value); err != nil {
+				errs = append(errs, field.Invalid(fldPath.Child(resourceList.name).Key(name), value, err.Error()))
+			}
+		}
+	}
+
+	return errs
+}
+
 func ValidateAPILevels(apiLevels []string, knownAPILevels, deadAPILevels []string, fldPath *field.Path) ValidationResults {
 	validationResults := ValidationResults{}
 
@@ -496,6 +583,28 @@ func ValidateImagePolicyConfig(config api.ImagePolicyConfig, fldPath *field.Path
 			}
 		}
 	}
+	for i, proxy := range config.ImportProxies {
+		proxyPath := fldPath.Child("importProxies").Index(i)
+		if len(proxy.DomainName) == 0 {
+			errs = append(errs, field.Invalid(proxyPath.Child("domainName"), proxy.DomainName, "cannot be an empty string"))
+		}
+		if len(proxy.ProxyURL) > 0 {
+			if _, urlErrs := ValidateURL(proxy.ProxyURL, proxyPath.Child("proxyURL")); len(urlErrs) > 0 {
+				errs = append(errs, urlErrs...)
+			}
+		}
+		if len(proxy.CA) > 0 {
+			errs = append(errs, ValidateFile(proxy.CA, proxyPath.Child("ca"))...)
+		}
+	}
+	for i, registry := range config.ExternalImageChangeTriggerRegistries {
+		if len(registry) == 0 {
+			errs = append(errs, field.Invalid(fldPath.Child("externalImageChangeTriggerRegistries").Index(i), registry, "cannot be an empty string"))
+		}
+	}
+	if config.ExternalImageChangeTriggerIntervalSeconds <= 0 {
+		errs = append(errs, field.Invalid(fldPath.Child("externalImageChangeTriggerIntervalSeconds"), config.ExternalImageChangeTriggerIntervalSeconds, "must be a positive integer"))
+	}
 	return errs
 }
 
@@ -622,6 +731,12 @@ func ValidateProjectConfig(config api.ProjectConfig, fldPath *field.Path) Valida
 		validationResults.AddErrors(field.Invalid(fldPath.Child("projectRequestTemplate"), config.ProjectRequestTemplate, "must be in the form: namespace/templateName"))
 	}
 
+	if len(config.ProjectRequestApprovalWebhookURL) > 0 {
+		if _, urlErrs := ValidateURL(config.ProjectRequestApprovalWebhookURL, fldPath.Child("projectRequestApprovalWebhookURL")); len(urlErrs) > 0 {
+			validationResults.AddErrors(urlErrs...)
+		}
+	}
+
 	if len(config.DefaultNodeSelector) > 0 {
 		_, err := labelselector.Parse(config.DefaultNodeSelector)
 		if err != nil {
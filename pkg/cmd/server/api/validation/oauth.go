@@ -11,6 +11,7 @@ import (
 	"k8s.io/kubernetes/pkg/util/validation/field"
 
 	"github.com/openshift/origin/pkg/auth/authenticator/redirector"
+	"github.com/openshift/origin/pkg/auth/authenticator/request/x509request"
 	"github.com/openshift/origin/pkg/auth/server/errorpage"
 	"github.com/openshift/origin/pkg/auth/server/login"
 	"github.com/openshift/origin/pkg/auth/server/selectprovider"
@@ -49,6 +50,15 @@ func ValidateOAuthConfig(config *api.OAuthConfig, fldPath *field.Path) Validatio
 
 	validationResults.AddErrors(validateGrantConfig(config.GrantConfig, fldPath.Child("grantConfig"))...)
 
+	if config.LoginLockout != nil {
+		if config.LoginLockout.MaxFailures <= 0 {
+			validationResults.AddErrors(field.Invalid(fldPath.Child("loginLockout", "maxFailures"), config.LoginLockout.MaxFailures, "must be a positive integer"))
+		}
+		if config.LoginLockout.LockDurationSeconds <= 0 {
+			validationResults.AddErrors(field.Invalid(fldPath.Child("loginLockout", "lockDurationSeconds"), config.LoginLockout.LockDurationSeconds, "must be a positive integer"))
+		}
+	}
+
 	providerNames := sets.NewString()
 	redirectingIdentityProviders := []string{}
 
@@ -163,6 +173,10 @@ func ValidateIdentityProvider(identityProvider api.IdentityProvider, fldPath *fi
 		validationResults.AddErrors(field.NotSupported(fldPath.Child("mappingMethod"), identityProvider.MappingMethod, validMappingMethods.List()))
 	}
 
+	if identityProvider.UserNameMapping != nil {
+		validationResults.AddErrors(ValidateUserNameMapping(identityProvider.UserNameMapping, fldPath.Child("userNameMapping"))...)
+	}
+
 	providerPath := fldPath.Child("provider")
 	if !api.IsIdentityProviderType(identityProvider.Provider) {
 		validationResults.AddErrors(field.Invalid(fldPath.Child("provider"), identityProvider.Provider, fmt.Sprintf("%v is invalid in this context", identityProvider.Provider)))
@@ -171,6 +185,9 @@ func ValidateIdentityProvider(identityProvider api.IdentityProvider, fldPath *fi
 		case (*api.RequestHeaderIdentityProvider):
 			validationResults.Append(ValidateRequestHeaderIdentityProvider(provider, identityProvider, fldPath))
 
+		case (*api.ClientCertIdentityProvider):
+			validationResults.Append(ValidateClientCertIdentityProvider(provider, identityProvider, fldPath))
+
 		case (*api.BasicAuthPasswordIdentityProvider):
 			validationResults.AddErrors(ValidateRemoteConnectionInfo(provider.RemoteConnectionInfo, providerPath)...)
 
@@ -201,6 +218,16 @@ func ValidateIdentityProvider(identityProvider api.IdentityProvider, fldPath *fi
 	return validationResults
 }
 
+func ValidateUserNameMapping(mapping *api.UserNameMappingConfig, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if !identitymapper.IsValidUserNameTemplate(mapping.Template) {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("template"), mapping.Template, "claim tokens must be of the form {claim} with balanced braces"))
+	}
+
+	return allErrs
+}
+
 func ValidateLDAPIdentityProvider(provider *api.LDAPPasswordIdentityProvider, fldPath *field.Path) ValidationResults {
 	validationResults := ValidationResults{}
 
@@ -302,6 +329,46 @@ func ValidateRequestHeaderIdentityProvider(provider *api.RequestHeaderIdentityPr
 	return validationResults
 }
 
+var (
+	validClientCertUsernameAttributes = sets.NewString(
+		x509request.SubjectAttributeCommonName,
+		x509request.SubjectAttributeEmailAddress,
+	)
+	validClientCertGroupAttributes = sets.NewString(
+		x509request.SubjectAttributeOrganization,
+		x509request.SubjectAttributeOrganizationalUnit,
+	)
+)
+
+func ValidateClientCertIdentityProvider(provider *api.ClientCertIdentityProvider, identityProvider api.IdentityProvider, fieldPath *field.Path) ValidationResults {
+	validationResults := ValidationResults{}
+
+	if len(provider.ClientCA) == 0 {
+		validationResults.AddErrors(field.Required(fieldPath.Child("provider", "clientCA"), ""))
+	} else {
+		validationResults.AddErrors(ValidateFile(provider.ClientCA, fieldPath.Child("provider", "clientCA"))...)
+	}
+
+	if len(provider.UsernameAttribute) > 0 && !validClientCertUsernameAttributes.Has(provider.UsernameAttribute) {
+		validationResults.AddErrors(field.NotSupported(fieldPath.Child("provider", "usernameAttribute"), provider.UsernameAttribute, validClientCertUsernameAttributes.List()))
+	}
+	for i, attribute := range provider.GroupAttributes {
+		if !validClientCertGroupAttributes.Has(attribute) {
+			validationResults.AddErrors(field.NotSupported(fieldPath.Child("provider", "groupAttributes").Index(i), attribute, validClientCertGroupAttributes.List()))
+		}
+	}
+
+	// Certificates are verified transparently during the TLS handshake, there is no login or challenge flow to direct clients through
+	if identityProvider.UseAsLogin {
+		validationResults.AddErrors(field.Invalid(fieldPath.Child("login"), identityProvider.UseAsLogin, "client certificate providers cannot be used for browser based login"))
+	}
+	if identityProvider.UseAsChallenger {
+		validationResults.AddErrors(field.Invalid(fieldPath.Child("challenge"), identityProvider.UseAsChallenger, "client certificate providers cannot issue challenges"))
+	}
+
+	return validationResults
+}
+
 func ValidateOAuthIdentityProvider(clientID string, clientSecret api.StringSource, fieldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
 
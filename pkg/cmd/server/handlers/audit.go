@@ -0,0 +1,260 @@
+package handlers
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/golang/glog"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+	apifilters "k8s.io/kubernetes/pkg/apiserver/filters"
+
+	authenticationapi "github.com/openshift/origin/pkg/auth/api"
+	authorizationapi "github.com/openshift/origin/pkg/authorization/api"
+)
+
+// auditRecord is the structured form of a single enriched audit entry. It is
+// serialized as one JSON object per line so that external log pipelines (SIEM,
+// fluentd, etc.) can consume the stream without stateful parsing.
+type auditRecord struct {
+	// Timestamp is the time the request passed through the audit filter, in RFC3339 format.
+	Timestamp string `json:"timestamp"`
+	// Method is the HTTP verb of the request.
+	Method string `json:"method"`
+	// URI is the request URI.
+	URI string `json:"uri"`
+	// SourceIP is the remote address of the request.
+	SourceIP string `json:"sourceIP,omitempty"`
+	// User is the name of the authenticated user.
+	User string `json:"user"`
+	// UID is the UID of the authenticated user, if any.
+	UID string `json:"uid,omitempty"`
+	// Groups are the groups of the authenticated user.
+	Groups []string `json:"groups,omitempty"`
+	// IdentityProviders are the names of the identity providers that produced the
+	// identities bound to the authenticated user, if known.
+	IdentityProviders []string `json:"identityProviders,omitempty"`
+	// Scopes are the scopes of the OAuth token used for the request, if any.
+	Scopes []string `json:"scopes,omitempty"`
+	// ImpersonatedUser is the user requested via the Impersonate-User header, if any.
+	ImpersonatedUser string `json:"impersonatedUser,omitempty"`
+	// ImpersonatedGroups are the groups requested via Impersonate-Group headers, if any.
+	ImpersonatedGroups []string `json:"impersonatedGroups,omitempty"`
+	// ImpersonatedScopes are the scopes requested via Impersonate-User-Scope headers, if any.
+	ImpersonatedScopes []string `json:"impersonatedScopes,omitempty"`
+}
+
+// AuditEnrichmentFilter decorates the audit log with OpenShift-specific identity
+// information: the identity provider that authenticated the user, the scopes of the
+// OAuth token used, and the requested impersonation chain. It must be placed before
+// the impersonation filter so that it observes the originally authenticated user.
+func AuditEnrichmentFilter(handler http.Handler, contextMapper kapi.RequestContextMapper, writer io.Writer) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		defer handler.ServeHTTP(w, req)
+
+		ctx, exists := contextMapper.Get(req)
+		if !exists {
+			return
+		}
+		user, ok := kapi.UserFrom(ctx)
+		if !ok {
+			return
+		}
+
+		record := auditRecord{
+			Timestamp: time.Now().UTC().Format(time.RFC3339),
+			Method:    req.Method,
+			URI:       req.RequestURI,
+			SourceIP:  req.RemoteAddr,
+			User:      user.GetName(),
+			UID:       user.GetUID(),
+			Groups:    user.GetGroups(),
+
+			ImpersonatedUser:   req.Header.Get(authenticationapi.ImpersonateUserHeader),
+			ImpersonatedGroups: req.Header[authenticationapi.ImpersonateGroupHeader],
+			ImpersonatedScopes: req.Header[authenticationapi.ImpersonateUserScopeHeader],
+		}
+		if extra := user.GetExtra(); extra != nil {
+			record.IdentityProviders = extra[authenticationapi.IdentityProviderKey]
+			record.Scopes = extra[authorizationapi.ScopesKey]
+		}
+
+		line, err := json.Marshal(record)
+		if err != nil {
+			glog.Errorf("Unable to serialize audit record: %v", err)
+			return
+		}
+		if _, err := writer.Write(append(line, '\n')); err != nil {
+			glog.Errorf("Unable to write audit record: %v", err)
+		}
+	})
+}
+
+// AuditLevel describes how much of a request is recorded in the audit log.
+type AuditLevel string
+
+const (
+	// AuditLevelNone suppresses audit logging entirely for the namespace.
+	AuditLevelNone AuditLevel = "None"
+	// AuditLevelMetadata records request metadata (user, verb, resource); this is
+	// the default and matches the historical behavior of the audit log.
+	AuditLevelMetadata AuditLevel = "Metadata"
+	// AuditLevelRequestResponse additionally records the request and response bodies.
+	AuditLevelRequestResponse AuditLevel = "RequestResponse"
+
+	// maxAuditBodyBytes caps how much of a request or response body is kept in a
+	// single audit record so that large objects cannot bloat the log.
+	maxAuditBodyBytes = 64 * 1024
+)
+
+// ValidAuditLevel returns true if level names one of the supported audit levels.
+func ValidAuditLevel(level AuditLevel) bool {
+	switch level {
+	case AuditLevelNone, AuditLevelMetadata, AuditLevelRequestResponse:
+		return true
+	}
+	return false
+}
+
+// NamespaceAuditLevelFilter dispatches each request to the handler registered for the
+// audit level of the request's namespace. Requests that are not scoped to a namespace,
+// or whose namespace does not select a valid level, use AuditLevelMetadata.
+func NamespaceAuditLevelFilter(handlers map[AuditLevel]http.Handler, levelFor func(namespace string) AuditLevel, attributeGetter apifilters.RequestAttributeGetter) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		level := AuditLevelMetadata
+		if attribs, err := attributeGetter.GetAttribs(req); err == nil {
+			if namespace := attribs.GetNamespace(); len(namespace) > 0 {
+				if namespaceLevel := levelFor(namespace); ValidAuditLevel(namespaceLevel) {
+					level = namespaceLevel
+				}
+			}
+		}
+		handler, ok := handlers[level]
+		if !ok {
+			handler = handlers[AuditLevelMetadata]
+		}
+		handler.ServeHTTP(w, req)
+	})
+}
+
+// auditBodyRecord is the structured form of a request/response body audit entry,
+// serialized as one JSON object per line like auditRecord.
+type auditBodyRecord struct {
+	// Timestamp is the time the response completed, in RFC3339 format.
+	Timestamp string `json:"timestamp"`
+	// Method is the HTTP verb of the request.
+	Method string `json:"method"`
+	// URI is the request URI.
+	URI string `json:"uri"`
+	// User is the name of the authenticated user.
+	User string `json:"user,omitempty"`
+	// Status is the HTTP status code of the response.
+	Status int `json:"status"`
+	// RequestBody is the request body, truncated to maxAuditBodyBytes.
+	RequestBody string `json:"requestBody,omitempty"`
+	// ResponseBody is the response body, truncated to maxAuditBodyBytes.
+	ResponseBody string `json:"responseBody,omitempty"`
+	// Truncated is true if either body was truncated.
+	Truncated bool `json:"truncated,omitempty"`
+}
+
+// RequestResponseAuditFilter records the request and response bodies of every request
+// in addition to whatever the wrapped handler logs. It is used for namespaces whose
+// audit level is RequestResponse.
+func RequestResponseAuditFilter(handler http.Handler, contextMapper kapi.RequestContextMapper, writer io.Writer) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		record := auditBodyRecord{
+			Method: req.Method,
+			URI:    req.RequestURI,
+		}
+		if ctx, exists := contextMapper.Get(req); exists {
+			if user, ok := kapi.UserFrom(ctx); ok {
+				record.User = user.GetName()
+			}
+		}
+
+		if req.Body != nil {
+			body, err := ioutil.ReadAll(req.Body)
+			req.Body.Close()
+			if err != nil {
+				http.Error(w, "Unable to read request body", http.StatusInternalServerError)
+				return
+			}
+			req.Body = ioutil.NopCloser(bytes.NewReader(body))
+			if len(body) > maxAuditBodyBytes {
+				body = body[:maxAuditBodyBytes]
+				record.Truncated = true
+			}
+			record.RequestBody = string(body)
+		}
+
+		capture := &bodyCaptureResponseWriter{ResponseWriter: w}
+		if _, fancy := w.(http.CloseNotifier); fancy {
+			handler.ServeHTTP(&fancyBodyCaptureDelegator{capture}, req)
+		} else {
+			handler.ServeHTTP(capture, req)
+		}
+
+		record.Timestamp = time.Now().UTC().Format(time.RFC3339)
+		record.Status = capture.status
+		record.ResponseBody = capture.body.String()
+		record.Truncated = record.Truncated || capture.truncated
+
+		line, err := json.Marshal(record)
+		if err != nil {
+			glog.Errorf("Unable to serialize audit record: %v", err)
+			return
+		}
+		if _, err := writer.Write(append(line, '\n')); err != nil {
+			glog.Errorf("Unable to write audit record: %v", err)
+		}
+	})
+}
+
+// bodyCaptureResponseWriter tees the response body into a bounded buffer while
+// passing it through to the real ResponseWriter.
+type bodyCaptureResponseWriter struct {
+	http.ResponseWriter
+	status    int
+	body      bytes.Buffer
+	truncated bool
+}
+
+func (w *bodyCaptureResponseWriter) WriteHeader(code int) {
+	w.status = code
+	w.ResponseWriter.WriteHeader(code)
+}
+
+func (w *bodyCaptureResponseWriter) Write(b []byte) (int, error) {
+	if remaining := maxAuditBodyBytes - w.body.Len(); remaining >= len(b) {
+		w.body.Write(b)
+	} else {
+		w.body.Write(b[:remaining])
+		w.truncated = true
+	}
+	return w.ResponseWriter.Write(b)
+}
+
+// fancyBodyCaptureDelegator implements http.CloseNotifier, http.Flusher and
+// http.Hijacker so that watches and exec continue to work when bodies are captured.
+type fancyBodyCaptureDelegator struct {
+	*bodyCaptureResponseWriter
+}
+
+func (f *fancyBodyCaptureDelegator) CloseNotify() <-chan bool {
+	return f.ResponseWriter.(http.CloseNotifier).CloseNotify()
+}
+
+func (f *fancyBodyCaptureDelegator) Flush() {
+	f.ResponseWriter.(http.Flusher).Flush()
+}
+
+func (f *fancyBodyCaptureDelegator) Hijack() (net.Conn, *bufio.ReadWriter, error) {
+	return f.ResponseWriter.(http.Hijacker).Hijack()
+}
@@ -0,0 +1,253 @@
+package handlers
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang/glog"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+	utilrand "k8s.io/kubernetes/pkg/util/rand"
+)
+
+const (
+	// maxRecordedSessionBytes caps how much data is kept per direction for a single
+	// session so that a long-running or high-volume session cannot exhaust memory.
+	maxRecordedSessionBytes = 10 * 1024 * 1024
+
+	// sessionWebhookTimeout bounds how long a transcript delivery may take.
+	sessionWebhookTimeout = 30 * time.Second
+)
+
+// RecordedSession is the transcript of a single interactive exec or attach session.
+// Input and Output hold the raw bytes of the upgraded connection in each direction;
+// because the connection is multiplexed they are framed by the streaming protocol
+// (SPDY) rather than being a clean terminal capture.
+type RecordedSession struct {
+	// User is the name of the authenticated user that opened the session.
+	User string `json:"user"`
+	// UID is the UID of the authenticated user, if any.
+	UID string `json:"uid,omitempty"`
+	// Groups are the groups of the authenticated user.
+	Groups []string `json:"groups,omitempty"`
+	// Method is the HTTP verb of the request that opened the session.
+	Method string `json:"method"`
+	// URI is the request URI, which identifies the pod and container.
+	URI string `json:"uri"`
+	// SourceIP is the remote address of the request.
+	SourceIP string `json:"sourceIP,omitempty"`
+	// StartedAt is the time the connection was upgraded, in RFC3339 format.
+	StartedAt string `json:"startedAt"`
+	// CompletedAt is the time the connection was closed, in RFC3339 format.
+	CompletedAt string `json:"completedAt"`
+	// Input holds the bytes sent by the client, up to maxRecordedSessionBytes.
+	Input []byte `json:"input,omitempty"`
+	// Output holds the bytes sent to the client, up to maxRecordedSessionBytes.
+	Output []byte `json:"output,omitempty"`
+	// Truncated is true if either direction exceeded the recording limit.
+	Truncated bool `json:"truncated,omitempty"`
+}
+
+// SessionSink receives completed session transcripts.
+type SessionSink interface {
+	Record(session *RecordedSession) error
+}
+
+// fileSessionSink writes one transcript file per session into a directory.
+type fileSessionSink struct {
+	dir string
+}
+
+// NewFileSessionSink returns a sink that writes one JSON transcript file per
+// session into the specified directory.
+func NewFileSessionSink(dir string) SessionSink {
+	return &fileSessionSink{dir: dir}
+}
+
+func (s *fileSessionSink) Record(session *RecordedSession) error {
+	data, err := json.Marshal(session)
+	if err != nil {
+		return err
+	}
+	name := fmt.Sprintf("session-%s-%s.json", time.Now().UTC().Format("20060102-150405"), utilrand.String(5))
+	return ioutil.WriteFile(filepath.Join(s.dir, name), data, 0600)
+}
+
+// webhookSessionSink posts each transcript as a JSON document to an endpoint.
+type webhookSessionSink struct {
+	url    string
+	client *http.Client
+}
+
+// NewWebhookSessionSink returns a sink that posts each transcript as a JSON
+// document to the specified URL.
+func NewWebhookSessionSink(url string) SessionSink {
+	return &webhookSessionSink{
+		url:    url,
+		client: &http.Client{Timeout: sessionWebhookTimeout},
+	}
+}
+
+func (s *webhookSessionSink) Record(session *RecordedSession) error {
+	data, err := json.Marshal(session)
+	if err != nil {
+		return err
+	}
+	resp, err := s.client.Post(s.url, "application/json", bytes.NewReader(data))
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		return fmt.Errorf("session recording webhook returned status %q", resp.Status)
+	}
+	return nil
+}
+
+// SessionRecordingFilter tees the input and output of interactive exec and attach
+// sessions to the provided sinks, together with the identity of the authenticated
+// user. It must be placed after the authentication filter in the handler chain so
+// that the user is known, and it only records requests that upgrade the connection.
+func SessionRecordingFilter(handler http.Handler, contextMapper kapi.RequestContextMapper, sinks []SessionSink) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if len(sinks) == 0 || !isRecordableSessionRequest(req) {
+			handler.ServeHTTP(w, req)
+			return
+		}
+		hijacker, ok := w.(http.Hijacker)
+		if !ok {
+			handler.ServeHTTP(w, req)
+			return
+		}
+
+		session := &RecordedSession{
+			Method:   req.Method,
+			URI:      req.RequestURI,
+			SourceIP: req.RemoteAddr,
+		}
+		if ctx, exists := contextMapper.Get(req); exists {
+			if user, ok := kapi.UserFrom(ctx); ok {
+				session.User = user.GetName()
+				session.UID = user.GetUID()
+				session.Groups = user.GetGroups()
+			}
+		}
+
+		handler.ServeHTTP(&sessionRecordingResponseWriter{ResponseWriter: w, hijacker: hijacker, session: session, sinks: sinks}, req)
+	})
+}
+
+// isRecordableSessionRequest returns true for requests that open an interactive
+// session against a pod and ask for a connection upgrade.
+func isRecordableSessionRequest(req *http.Request) bool {
+	if len(req.Header.Get("Upgrade")) == 0 {
+		return false
+	}
+	return strings.HasSuffix(req.URL.Path, "/exec") || strings.HasSuffix(req.URL.Path, "/attach")
+}
+
+// sessionRecordingResponseWriter intercepts the connection hijack performed by the
+// exec/attach proxy and substitutes a connection that tees both directions.
+type sessionRecordingResponseWriter struct {
+	http.ResponseWriter
+	hijacker http.Hijacker
+	session  *RecordedSession
+	sinks    []SessionSink
+}
+
+func (w *sessionRecordingResponseWriter) Hijack() (net.Conn, *bufio.ReadWriter, error) {
+	conn, rw, err := w.hijacker.Hijack()
+	if err != nil {
+		return conn, rw, err
+	}
+	w.session.StartedAt = time.Now().UTC().Format(time.RFC3339)
+	return &recordingConn{Conn: conn, session: w.session, sinks: w.sinks}, rw, nil
+}
+
+// CloseNotify is part of http.CloseNotifier and is required by the proxy handler.
+func (w *sessionRecordingResponseWriter) CloseNotify() <-chan bool {
+	return w.ResponseWriter.(http.CloseNotifier).CloseNotify()
+}
+
+// Flush is part of http.Flusher.
+func (w *sessionRecordingResponseWriter) Flush() {
+	if flusher, ok := w.ResponseWriter.(http.Flusher); ok {
+		flusher.Flush()
+	}
+}
+
+// recordingConn tees everything read from and written to the hijacked client
+// connection. Reads carry session input (client to pod), writes carry session
+// output (pod to client).
+type recordingConn struct {
+	net.Conn
+
+	session *RecordedSession
+	sinks   []SessionSink
+
+	lock     sync.Mutex
+	input    bytes.Buffer
+	output   bytes.Buffer
+	recorded bool
+}
+
+func (c *recordingConn) Read(b []byte) (int, error) {
+	n, err := c.Conn.Read(b)
+	if n > 0 {
+		c.record(&c.input, b[:n])
+	}
+	return n, err
+}
+
+func (c *recordingConn) Write(b []byte) (int, error) {
+	n, err := c.Conn.Write(b)
+	if n > 0 {
+		c.record(&c.output, b[:n])
+	}
+	return n, err
+}
+
+func (c *recordingConn) record(buffer *bytes.Buffer, data []byte) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if remaining := maxRecordedSessionBytes - buffer.Len(); remaining < len(data) {
+		data = data[:remaining]
+		c.session.Truncated = true
+	}
+	buffer.Write(data)
+}
+
+func (c *recordingConn) Close() error {
+	err := c.Conn.Close()
+
+	c.lock.Lock()
+	if c.recorded {
+		c.lock.Unlock()
+		return err
+	}
+	c.recorded = true
+	c.session.CompletedAt = time.Now().UTC().Format(time.RFC3339)
+	c.session.Input = c.input.Bytes()
+	c.session.Output = c.output.Bytes()
+	c.lock.Unlock()
+
+	// deliver outside the request handling path so that a slow sink cannot delay
+	// connection teardown
+	go func() {
+		for _, sink := range c.sinks {
+			if recordErr := sink.Record(c.session); recordErr != nil {
+				glog.Errorf("Unable to record session for user %q: %v", c.session.User, recordErr)
+			}
+		}
+	}()
+	return err
+}
@@ -9,6 +9,7 @@ import (
 	"net/http"
 	"net/url"
 	"path"
+	"time"
 
 	"github.com/RangelReale/osin"
 	"github.com/RangelReale/osincli"
@@ -24,6 +25,7 @@ import (
 	"k8s.io/kubernetes/pkg/util/sets"
 	"k8s.io/kubernetes/plugin/pkg/auth/authenticator/request/union"
 
+	authapi "github.com/openshift/origin/pkg/auth/api"
 	"github.com/openshift/origin/pkg/auth/authenticator/challenger/passwordchallenger"
 	"github.com/openshift/origin/pkg/auth/authenticator/challenger/placeholderchallenger"
 	"github.com/openshift/origin/pkg/auth/authenticator/password/allowanypassword"
@@ -32,6 +34,7 @@ import (
 	"github.com/openshift/origin/pkg/auth/authenticator/password/htpasswd"
 	"github.com/openshift/origin/pkg/auth/authenticator/password/keystonepassword"
 	"github.com/openshift/origin/pkg/auth/authenticator/password/ldappassword"
+	"github.com/openshift/origin/pkg/auth/authenticator/password/lockout"
 	"github.com/openshift/origin/pkg/auth/authenticator/redirector"
 	"github.com/openshift/origin/pkg/auth/authenticator/request/basicauthrequest"
 	"github.com/openshift/origin/pkg/auth/authenticator/request/headerrequest"
@@ -45,10 +48,12 @@ import (
 	"github.com/openshift/origin/pkg/auth/oauth/handlers"
 	"github.com/openshift/origin/pkg/auth/oauth/registry"
 	"github.com/openshift/origin/pkg/auth/server/csrf"
+	"github.com/openshift/origin/pkg/auth/server/deviceauth"
 	"github.com/openshift/origin/pkg/auth/server/errorpage"
 	"github.com/openshift/origin/pkg/auth/server/grant"
 	"github.com/openshift/origin/pkg/auth/server/login"
 	"github.com/openshift/origin/pkg/auth/server/selectprovider"
+	"github.com/openshift/origin/pkg/auth/server/clientregistration"
 	"github.com/openshift/origin/pkg/auth/server/tokenrequest"
 	"github.com/openshift/origin/pkg/auth/userregistry/identitymapper"
 	configapi "github.com/openshift/origin/pkg/cmd/server/api"
@@ -181,6 +186,14 @@ func (c *AuthConfig) WithOAuth(handler http.Handler) (http.Handler, error) {
 	tokenRequestEndpoints := tokenrequest.NewEndpoints(c.Options.MasterPublicURL, osOAuthClient)
 	tokenRequestEndpoints.Install(mux, OpenShiftOAuthAPIPrefix)
 
+	// RFC 7591 dynamic client registration
+	clientRegistrationEndpoints := clientregistration.NewEndpoints(clientRegistry)
+	clientRegistrationEndpoints.Install(mux, OpenShiftOAuthAPIPrefix)
+
+	// RFC 8628 device authorization grant
+	deviceAuthEndpoints := deviceauth.NewEndpoints(c.Options.MasterPublicURL, authRequestHandler, authHandler, c.getCSRF(), combinedOAuthClientGetter, accessTokenRegistry, int64(c.Options.TokenConfig.AccessTokenMaxAgeSeconds))
+	deviceAuthEndpoints.Install(mux, OpenShiftOAuthAPIPrefix)
+
 	// glog.Infof("oauth server configured as: %#v", server)
 	// glog.Infof("auth handler: %#v", authHandler)
 	// glog.Infof("auth request handler: %#v", authRequestHandler)
@@ -404,7 +417,7 @@ func (c *AuthConfig) getAuthenticationHandler(mux cmdutil.Mux, errorHandler hand
 	}
 
 	for _, identityProvider := range c.Options.IdentityProviders {
-		identityMapper, err := identitymapper.NewIdentityUserMapper(c.IdentityRegistry, c.UserRegistry, identitymapper.MappingMethodType(identityProvider.MappingMethod))
+		identityMapper, err := c.getIdentityMapper(identityProvider)
 		if err != nil {
 			return nil, err
 		}
@@ -597,8 +610,30 @@ func (c *AuthConfig) getOAuthProvider(identityProvider configapi.IdentityProvide
 
 }
 
+// getIdentityMapper returns the identity mapper for the given provider, wrapped to
+// refresh virtual group membership from GroupMappings at login time when the group
+// registries are configured.
+func (c *AuthConfig) getIdentityMapper(identityProvider configapi.IdentityProvider) (authapi.UserIdentityMapper, error) {
+	var userNameMapping *identitymapper.UserNameMapping
+	if mapping := identityProvider.UserNameMapping; mapping != nil {
+		userNameMapping = &identitymapper.UserNameMapping{
+			Template:  mapping.Template,
+			Lowercase: mapping.Lowercase,
+			Prefix:    mapping.Prefix,
+		}
+	}
+	identityMapper, err := identitymapper.NewIdentityUserMapper(c.IdentityRegistry, c.UserRegistry, identitymapper.MappingMethodType(identityProvider.MappingMethod), userNameMapping)
+	if err != nil {
+		return nil, err
+	}
+	if c.GroupMappingRegistry != nil && c.GroupRegistry != nil {
+		identityMapper = identitymapper.NewGroupMappingMapper(identityMapper, c.GroupMappingRegistry, c.GroupRegistry)
+	}
+	return identityMapper, nil
+}
+
 func (c *AuthConfig) getPasswordAuthenticator(identityProvider configapi.IdentityProvider) (authenticator.Password, error) {
-	identityMapper, err := identitymapper.NewIdentityUserMapper(c.IdentityRegistry, c.UserRegistry, identitymapper.MappingMethodType(identityProvider.MappingMethod))
+	identityMapper, err := c.getIdentityMapper(identityProvider)
 	if err != nil {
 		return nil, err
 	}
@@ -644,7 +679,7 @@ func (c *AuthConfig) getPasswordAuthenticator(identityProvider configapi.Identit
 		if htpasswordAuth, err := htpasswd.New(identityProvider.Name, htpasswdFile, identityMapper); err != nil {
 			return nil, fmt.Errorf("Error loading htpasswd file %s: %v", htpasswdFile, err)
 		} else {
-			return htpasswordAuth, nil
+			return c.withLoginLockout(htpasswordAuth), nil
 		}
 
 	case (*configapi.BasicAuthPasswordIdentityProvider):
@@ -656,7 +691,7 @@ func (c *AuthConfig) getPasswordAuthenticator(identityProvider configapi.Identit
 		if err != nil {
 			return nil, fmt.Errorf("Error building BasicAuthPasswordIdentityProvider client: %v", err)
 		}
-		return basicauthpassword.New(identityProvider.Name, connectionInfo.URL, transport, identityMapper), nil
+		return c.withLoginLockout(basicauthpassword.New(identityProvider.Name, connectionInfo.URL, transport, identityMapper)), nil
 
 	case (*configapi.KeystonePasswordIdentityProvider):
 		connectionInfo := provider.RemoteConnectionInfo
@@ -676,6 +711,16 @@ func (c *AuthConfig) getPasswordAuthenticator(identityProvider configapi.Identit
 
 }
 
+// withLoginLockout wraps a password authenticator with per-user failed-login throttling
+// when a login lockout is configured.
+func (c *AuthConfig) withLoginLockout(passwordAuthenticator authenticator.Password) authenticator.Password {
+	lockoutConfig := c.Options.LoginLockout
+	if lockoutConfig == nil {
+		return passwordAuthenticator
+	}
+	return lockout.New(passwordAuthenticator, lockoutConfig.MaxFailures, time.Duration(lockoutConfig.LockDurationSeconds)*time.Second)
+}
+
 func (c *AuthConfig) getAuthenticationRequestHandler() (authenticator.Request, error) {
 	var authRequestHandlers []authenticator.Request
 
@@ -684,7 +729,7 @@ func (c *AuthConfig) getAuthenticationRequestHandler() (authenticator.Request, e
 	}
 
 	for _, identityProvider := range c.Options.IdentityProviders {
-		identityMapper, err := identitymapper.NewIdentityUserMapper(c.IdentityRegistry, c.UserRegistry, identitymapper.MappingMethodType(identityProvider.MappingMethod))
+		identityMapper, err := c.getIdentityMapper(identityProvider)
 		if err != nil {
 			return nil, err
 		}
@@ -694,7 +739,11 @@ func (c *AuthConfig) getAuthenticationRequestHandler() (authenticator.Request, e
 			if err != nil {
 				return nil, err
 			}
-			authRequestHandlers = append(authRequestHandlers, basicauthrequest.NewBasicAuthAuthentication(identityProvider.Name, passwordAuthenticator, true))
+			basicAuthRequestHandler := basicauthrequest.NewBasicAuthAuthentication(identityProvider.Name, passwordAuthenticator, true)
+			if lockoutConfig := c.Options.LoginLockout; lockoutConfig != nil {
+				basicAuthRequestHandler = lockout.NewRequest(basicAuthRequestHandler, lockoutConfig.MaxFailures, time.Duration(lockoutConfig.LockDurationSeconds)*time.Second)
+			}
+			authRequestHandlers = append(authRequestHandlers, basicAuthRequestHandler)
 
 		} else if identityProvider.UseAsChallenger && configapi.IsOAuthIdentityProvider(identityProvider) {
 			oauthProvider, err := c.getOAuthProvider(identityProvider)
@@ -737,6 +786,24 @@ func (c *AuthConfig) getAuthenticationRequestHandler() (authenticator.Request, e
 				}
 				authRequestHandlers = append(authRequestHandlers, authRequestHandler)
 
+			case (*configapi.ClientCertIdentityProvider):
+				caData, err := ioutil.ReadFile(provider.ClientCA)
+				if err != nil {
+					return nil, fmt.Errorf("Error reading %s: %v", provider.ClientCA, err)
+				}
+				opts := x509request.DefaultVerifyOptions()
+				opts.Roots = x509.NewCertPool()
+				if ok := opts.Roots.AppendCertsFromPEM(caData); !ok {
+					return nil, fmt.Errorf("Error loading certs from %s: %v", provider.ClientCA, err)
+				}
+
+				userConversion := x509request.NewSubjectMappingUserConversion(identityProvider.Name, x509request.SubjectMappingConfig{
+					UsernameAttribute: provider.UsernameAttribute,
+					GroupAttributes:   provider.GroupAttributes,
+					GroupPrefix:       provider.GroupPrefix,
+				}, identityMapper)
+				authRequestHandlers = append(authRequestHandlers, x509request.New(opts, userConversion))
+
 			}
 		}
 	}
@@ -14,6 +14,10 @@ import (
 	osclient "github.com/openshift/origin/pkg/client"
 	configapi "github.com/openshift/origin/pkg/cmd/server/api"
 	"github.com/openshift/origin/pkg/cmd/server/api/latest"
+	groupregistry "github.com/openshift/origin/pkg/user/registry/group"
+	groupetcd "github.com/openshift/origin/pkg/user/registry/group/etcd"
+	groupmappingregistry "github.com/openshift/origin/pkg/user/registry/groupmapping"
+	groupmappingetcd "github.com/openshift/origin/pkg/user/registry/groupmapping/etcd"
 	identityregistry "github.com/openshift/origin/pkg/user/registry/identity"
 	identityetcd "github.com/openshift/origin/pkg/user/registry/identity/etcd"
 	userregistry "github.com/openshift/origin/pkg/user/registry/user"
@@ -44,6 +48,11 @@ type AuthConfig struct {
 	UserRegistry     userregistry.Registry
 	IdentityRegistry identityregistry.Registry
 
+	// GroupRegistry and GroupMappingRegistry are used to refresh virtual group
+	// membership from identity attributes at login time.
+	GroupRegistry        groupregistry.Registry
+	GroupMappingRegistry groupmappingregistry.Registry
+
 	SessionAuth *session.Authenticator
 
 	HandlerWrapper handlerWrapper
@@ -83,6 +92,18 @@ func BuildAuthConfig(masterConfig *MasterConfig) (*AuthConfig, error) {
 	}
 	identityRegistry := identityregistry.NewRegistry(identityStorage)
 
+	groupStorage, err := groupetcd.NewREST(masterConfig.RESTOptionsGetter)
+	if err != nil {
+		return nil, err
+	}
+	groupRegistry := groupregistry.NewRegistry(groupStorage)
+
+	groupMappingStorage, err := groupmappingetcd.NewREST(masterConfig.RESTOptionsGetter)
+	if err != nil {
+		return nil, err
+	}
+	groupMappingRegistry := groupmappingregistry.NewRegistry(groupMappingStorage)
+
 	ret := &AuthConfig{
 		Options: *options.OAuthConfig,
 
@@ -96,6 +117,9 @@ func BuildAuthConfig(masterConfig *MasterConfig) (*AuthConfig, error) {
 		IdentityRegistry: identityRegistry,
 		UserRegistry:     userRegistry,
 
+		GroupRegistry:        groupRegistry,
+		GroupMappingRegistry: groupMappingRegistry,
+
 		SessionAuth: sessionAuth,
 
 		HandlerWrapper: sessionHandlerWrapper,
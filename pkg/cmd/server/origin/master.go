@@ -37,7 +37,9 @@ import (
 	kubeletclient "k8s.io/kubernetes/pkg/kubelet/client"
 	"k8s.io/kubernetes/pkg/master"
 	"k8s.io/kubernetes/pkg/runtime"
+	certutil "k8s.io/kubernetes/pkg/util/cert"
 	"k8s.io/kubernetes/pkg/util/flowcontrol"
+	knet "k8s.io/kubernetes/pkg/util/net"
 	"k8s.io/kubernetes/pkg/util/sets"
 	utilwait "k8s.io/kubernetes/pkg/util/wait"
 
@@ -68,6 +70,7 @@ import (
 	deployconfiggenerator "github.com/openshift/origin/pkg/deploy/registry/generator"
 	deployconfiginstantiate "github.com/openshift/origin/pkg/deploy/registry/instantiate"
 	deployrollback "github.com/openshift/origin/pkg/deploy/registry/rollback"
+	deploywebhook "github.com/openshift/origin/pkg/deploy/registry/webhook"
 	"github.com/openshift/origin/pkg/dockerregistry"
 	imageapiv1 "github.com/openshift/origin/pkg/image/api/v1"
 	"github.com/openshift/origin/pkg/image/importer"
@@ -81,22 +84,27 @@ import (
 	"github.com/openshift/origin/pkg/image/registry/imagestreamimage"
 	"github.com/openshift/origin/pkg/image/registry/imagestreamimport"
 	"github.com/openshift/origin/pkg/image/registry/imagestreammapping"
+	"github.com/openshift/origin/pkg/image/registry/imagestreampulltoken"
 	"github.com/openshift/origin/pkg/image/registry/imagestreamtag"
+	registrywebhooketcd "github.com/openshift/origin/pkg/image/registry/registrywebhook/etcd"
 	oauthapi "github.com/openshift/origin/pkg/oauth/api"
 	oauthapiv1 "github.com/openshift/origin/pkg/oauth/api/v1"
 	"github.com/openshift/origin/pkg/oauth/discovery"
+	"github.com/openshift/origin/pkg/oauth/registry/oauthaccesstoken"
 	accesstokenetcd "github.com/openshift/origin/pkg/oauth/registry/oauthaccesstoken/etcd"
 	authorizetokenetcd "github.com/openshift/origin/pkg/oauth/registry/oauthauthorizetoken/etcd"
 	clientregistry "github.com/openshift/origin/pkg/oauth/registry/oauthclient"
 	clientetcd "github.com/openshift/origin/pkg/oauth/registry/oauthclient/etcd"
 	clientauthetcd "github.com/openshift/origin/pkg/oauth/registry/oauthclientauthorization/etcd"
 	openservicebrokerserver "github.com/openshift/origin/pkg/openservicebroker/server"
+	projectapi "github.com/openshift/origin/pkg/project/api"
 	projectapiv1 "github.com/openshift/origin/pkg/project/api/v1"
 	projectproxy "github.com/openshift/origin/pkg/project/registry/project/proxy"
 	projectrequeststorage "github.com/openshift/origin/pkg/project/registry/projectrequest/delegated"
 	routeapiv1 "github.com/openshift/origin/pkg/route/api/v1"
 	routeallocationcontroller "github.com/openshift/origin/pkg/route/controller/allocation"
 	routeetcd "github.com/openshift/origin/pkg/route/registry/route/etcd"
+	sdnapi "github.com/openshift/origin/pkg/sdn/api"
 	networkapiv1 "github.com/openshift/origin/pkg/sdn/api/v1"
 	clusternetworketcd "github.com/openshift/origin/pkg/sdn/registry/clusternetwork/etcd"
 	egressnetworkpolicyetcd "github.com/openshift/origin/pkg/sdn/registry/egressnetworkpolicy/etcd"
@@ -112,11 +120,13 @@ import (
 	templateservicebroker "github.com/openshift/origin/pkg/template/servicebroker"
 	userapiv1 "github.com/openshift/origin/pkg/user/api/v1"
 	groupetcd "github.com/openshift/origin/pkg/user/registry/group/etcd"
+	groupmappingetcd "github.com/openshift/origin/pkg/user/registry/groupmapping/etcd"
 	identityregistry "github.com/openshift/origin/pkg/user/registry/identity"
 	identityetcd "github.com/openshift/origin/pkg/user/registry/identity/etcd"
 	userregistry "github.com/openshift/origin/pkg/user/registry/user"
 	useretcd "github.com/openshift/origin/pkg/user/registry/user/etcd"
 	"github.com/openshift/origin/pkg/user/registry/useridentitymapping"
+	userpreferenceetcd "github.com/openshift/origin/pkg/user/registry/userpreference/etcd"
 	"github.com/openshift/origin/pkg/version"
 
 	"github.com/openshift/origin/pkg/build/registry/buildclone"
@@ -129,6 +139,7 @@ import (
 	"github.com/openshift/origin/pkg/api"
 	"github.com/openshift/origin/pkg/api/v1"
 	authorizationapi "github.com/openshift/origin/pkg/authorization/api"
+	"github.com/openshift/origin/pkg/authorization/registry/bulksubjectaccessreview"
 	clusterpolicyregistry "github.com/openshift/origin/pkg/authorization/registry/clusterpolicy"
 	clusterpolicyetcd "github.com/openshift/origin/pkg/authorization/registry/clusterpolicy/etcd"
 	clusterpolicybindingregistry "github.com/openshift/origin/pkg/authorization/registry/clusterpolicybinding"
@@ -302,7 +313,31 @@ func (c *MasterConfig) buildHandlerChain(assetConfig *AssetConfig) (func(http.Ha
 				// backwards compatible writer to regular log
 				writer = cmdutil.NewGLogWriterV(0)
 			}
+			unauditedHandler := handler
 			handler = kapiserverfilters.WithAudit(handler, attributeGetter, writer)
+			// enrich the audit stream with OpenShift identity information (identity
+			// provider, token scopes, and the requested impersonation chain)
+			handler = serverhandlers.AuditEnrichmentFilter(handler, contextMapper, writer)
+			// honor the per-project audit level annotation so that sensitive
+			// namespaces can record full request/response bodies while others can
+			// opt out of auditing entirely
+			handler = serverhandlers.NamespaceAuditLevelFilter(map[serverhandlers.AuditLevel]http.Handler{
+				serverhandlers.AuditLevelNone:            unauditedHandler,
+				serverhandlers.AuditLevelMetadata:        handler,
+				serverhandlers.AuditLevelRequestResponse: serverhandlers.RequestResponseAuditFilter(handler, contextMapper, writer),
+			}, c.auditLevelForNamespace, attributeGetter)
+		}
+		// session recording must also come inside the authentication filter so the
+		// transcript can be attributed to the authenticated user
+		if recording := c.Options.SessionRecordingConfig; recording != nil && recording.Enabled {
+			sinks := []serverhandlers.SessionSink{}
+			if len(recording.RecordingDirPath) > 0 {
+				sinks = append(sinks, serverhandlers.NewFileSessionSink(recording.RecordingDirPath))
+			}
+			if len(recording.WebhookURL) > 0 {
+				sinks = append(sinks, serverhandlers.NewWebhookSessionSink(recording.WebhookURL))
+			}
+			handler = serverhandlers.SessionRecordingFilter(handler, contextMapper, sinks)
 		}
 		handler = serverhandlers.AuthenticationHandlerFilter(handler, c.Authenticator, contextMapper)
 		handler = namespacingFilter(handler, contextMapper)
@@ -345,6 +380,19 @@ func (c *MasterConfig) buildHandlerChain(assetConfig *AssetConfig) (func(http.Ha
 	}, messages, nil
 }
 
+// auditLevelForNamespace returns the audit level selected by the namespace's project
+// annotation, or AuditLevelMetadata if the namespace does not select a valid level.
+func (c *MasterConfig) auditLevelForNamespace(namespace string) serverhandlers.AuditLevel {
+	ns, err := c.ProjectCache.GetNamespace(namespace)
+	if err != nil {
+		return serverhandlers.AuditLevelMetadata
+	}
+	if level := serverhandlers.AuditLevel(ns.Annotations[projectapi.ProjectAuditLevel]); serverhandlers.ValidAuditLevel(level) {
+		return level
+	}
+	return serverhandlers.AuditLevelMetadata
+}
+
 func (c *MasterConfig) RunHealth() error {
 	apiContainer := genericmux.NewAPIContainer(http.NewServeMux(), kapi.Codecs)
 
@@ -640,6 +688,10 @@ func (c *MasterConfig) GetRestStorage() map[unversioned.GroupVersion]map[string]
 	if err != nil {
 		glog.Fatalf("Unable to configure a default transport for importing: %v", err)
 	}
+	registryImportTransports, err := importTransportsForRegistries(c.Options.ImagePolicyConfig.ImportProxies)
+	if err != nil {
+		glog.Fatalf("Unable to configure per-registry transports for importing: %v", err)
+	}
 
 	buildStorage, buildDetailsStorage, err := buildetcd.NewREST(c.RESTOptionsGetter)
 	checkStorageErr(err)
@@ -663,6 +715,10 @@ func (c *MasterConfig) GetRestStorage() map[unversioned.GroupVersion]map[string]
 	checkStorageErr(err)
 	deployConfigRegistry := deployconfigregistry.NewRegistry(deployConfigStorage)
 
+	// We use the deployapiv1 schemegroup to encode the DeploymentConfig that gets
+	// returned, mirroring the build config webhooks.
+	deployConfigWebHooks := deploywebhook.NewWebHookREST(deployConfigRegistry, dcInstantiateStorage, deployapiv1.SchemeGroupVersion)
+
 	routeAllocator := c.RouteAllocator()
 
 	routeStorage, routeStatusStorage, err := routeetcd.NewREST(c.RESTOptionsGetter, routeAllocator)
@@ -686,6 +742,10 @@ func (c *MasterConfig) GetRestStorage() map[unversioned.GroupVersion]map[string]
 	userIdentityMappingStorage := useridentitymapping.NewREST(userRegistry, identityRegistry)
 	groupStorage, err := groupetcd.NewREST(c.RESTOptionsGetter)
 	checkStorageErr(err)
+	groupMappingStorage, err := groupmappingetcd.NewREST(c.RESTOptionsGetter)
+	checkStorageErr(err)
+	userPreferenceStorage, err := userpreferenceetcd.NewREST(c.RESTOptionsGetter)
+	checkStorageErr(err)
 
 	policyStorage, err := policyetcd.NewREST(c.RESTOptionsGetter)
 	checkStorageErr(err)
@@ -712,6 +772,7 @@ func (c *MasterConfig) GetRestStorage() map[unversioned.GroupVersion]map[string]
 	subjectAccessReviewStorage := subjectaccessreview.NewREST(c.Authorizer)
 	subjectAccessReviewRegistry := subjectaccessreview.NewRegistry(subjectAccessReviewStorage)
 	localSubjectAccessReviewStorage := localsubjectaccessreview.NewREST(subjectAccessReviewRegistry)
+	bulkSubjectAccessReviewStorage := bulksubjectaccessreview.NewREST(subjectAccessReviewRegistry)
 	resourceAccessReviewStorage := resourceaccessreview.NewREST(c.Authorizer, c.SubjectLocator)
 	resourceAccessReviewRegistry := resourceaccessreview.NewRegistry(resourceAccessReviewStorage)
 	localResourceAccessReviewStorage := localresourceaccessreview.NewREST(resourceAccessReviewRegistry)
@@ -729,6 +790,8 @@ func (c *MasterConfig) GetRestStorage() map[unversioned.GroupVersion]map[string]
 	checkStorageErr(err)
 	imageStreamRegistry := imagestream.NewRegistry(imageStreamStorage, imageStreamStatusStorage, internalImageStreamStorage)
 	imageStreamMappingStorage := imagestreammapping.NewREST(imageRegistry, imageStreamRegistry, c.RegistryNameFn)
+	registryWebhookStorage, err := registrywebhooketcd.NewREST(c.RESTOptionsGetter)
+	checkStorageErr(err)
 	imageStreamTagStorage := imagestreamtag.NewREST(imageRegistry, imageStreamRegistry)
 	imageStreamTagRegistry := imagestreamtag.NewRegistry(imageStreamTagStorage)
 	importerCache, err := imageimporter.NewImageStreamLayerCache(imageimporter.DefaultImageStreamLayerCacheSize)
@@ -739,7 +802,7 @@ func (c *MasterConfig) GetRestStorage() map[unversioned.GroupVersion]map[string]
 	importerDockerClientFn := func() dockerregistry.Client {
 		return dockerregistry.NewClient(20*time.Second, false)
 	}
-	imageStreamImportStorage := imagestreamimport.NewREST(importerFn, imageStreamRegistry, internalImageStreamStorage, imageStorage, c.ImageStreamImportSecretClient(), importTransport, insecureImportTransport, importerDockerClientFn, c.Options.ImagePolicyConfig.AllowedRegistriesForImport, c.RegistryNameFn, c.ImageStreamImportSARClient().SubjectAccessReviews())
+	imageStreamImportStorage := imagestreamimport.NewREST(importerFn, imageStreamRegistry, internalImageStreamStorage, imageStorage, c.ImageStreamImportSecretClient(), importTransport, insecureImportTransport, registryImportTransports, importerDockerClientFn, c.Options.ImagePolicyConfig.AllowedRegistriesForImport, c.RegistryNameFn, c.ImageStreamImportSARClient().SubjectAccessReviews())
 	imageStreamImageStorage := imagestreamimage.NewREST(imageRegistry, imageStreamRegistry)
 	imageStreamImageRegistry := imagestreamimage.NewRegistry(imageStreamImageStorage)
 
@@ -781,7 +844,9 @@ func (c *MasterConfig) GetRestStorage() map[unversioned.GroupVersion]map[string]
 		glog.Errorf("Error parsing project request template value: %v", err)
 		// we can continue on, the storage that gets created will be valid, it simply won't work properly.  There's no reason to kill the master
 	}
-	projectRequestStorage := projectrequeststorage.NewREST(c.Options.ProjectConfig.ProjectRequestMessage, namespace, templateName, c.PrivilegedLoopbackOpenShiftClient, c.PrivilegedLoopbackKubernetesClientset, c.Informers.PolicyBindings().Lister())
+	// when the networkpolicy plugin is in use, new projects are isolated by preset NetworkPolicies
+	isolateNetwork := sdnapi.IsOpenShiftNetworkPolicyPlugin(c.Options.NetworkConfig.NetworkPluginName)
+	projectRequestStorage := projectrequeststorage.NewREST(c.Options.ProjectConfig.ProjectRequestMessage, namespace, templateName, c.Options.ProjectConfig.ProjectRequestApprovalWebhookURL, isolateNetwork, c.PrivilegedLoopbackOpenShiftClient, c.PrivilegedLoopbackKubernetesClientset, c.Informers.PolicyBindings().Lister())
 
 	bcClient := c.BuildConfigWebHookClient()
 	buildConfigWebHooks := buildconfigregistry.NewWebHookREST(
@@ -816,6 +881,7 @@ func (c *MasterConfig) GetRestStorage() map[unversioned.GroupVersion]map[string]
 	checkStorageErr(err)
 	accessTokenStorage, err := accesstokenetcd.NewREST(c.RESTOptionsGetter, combinedOAuthClientGetter)
 	checkStorageErr(err)
+	imageStreamPullTokenStorage := imagestreampulltoken.NewREST(imageStreamRegistry, oauthaccesstoken.NewRegistry(accessTokenStorage), userRegistry, OpenShiftCLIClientID)
 	clientAuthorizationStorage, err := clientauthetcd.NewREST(c.RESTOptionsGetter, combinedOAuthClientGetter)
 	checkStorageErr(err)
 
@@ -852,8 +918,10 @@ func (c *MasterConfig) GetRestStorage() map[unversioned.GroupVersion]map[string]
 	storage[userapiv1.SchemeGroupVersion] = map[string]rest.Storage{
 		"users":                userStorage,
 		"groups":               groupStorage,
+		"groupMappings":        groupMappingStorage,
 		"identities":           identityStorage,
 		"userIdentityMappings": userIdentityMappingStorage,
+		"userPreferences":      userPreferenceStorage,
 	}
 
 	storage[oauthapiv1.SchemeGroupVersion] = map[string]rest.Storage{
@@ -866,6 +934,7 @@ func (c *MasterConfig) GetRestStorage() map[unversioned.GroupVersion]map[string]
 	storage[authzapiv1.SchemeGroupVersion] = map[string]rest.Storage{
 		"resourceAccessReviews":      resourceAccessReviewStorage,
 		"subjectAccessReviews":       subjectAccessReviewStorage,
+		"bulkSubjectAccessReviews":   bulkSubjectAccessReviewStorage,
 		"localSubjectAccessReviews":  localSubjectAccessReviewStorage,
 		"localResourceAccessReviews": localResourceAccessReviewStorage,
 		"selfSubjectRulesReviews":    selfSubjectRulesReviewStorage,
@@ -902,6 +971,7 @@ func (c *MasterConfig) GetRestStorage() map[unversioned.GroupVersion]map[string]
 		"deploymentConfigs/rollback":    deployConfigRollbackStorage,
 		"deploymentConfigs/log":         deploylogregistry.NewREST(configClient, kclient, c.DeploymentLogClient(), nodeConnectionInfoGetter),
 		"deploymentConfigs/instantiate": dcInstantiateStorage,
+		"deploymentConfigs/webhooks":    deployConfigWebHooks,
 	}
 
 	storage[templateapiv1.SchemeGroupVersion] = map[string]rest.Storage{
@@ -910,15 +980,17 @@ func (c *MasterConfig) GetRestStorage() map[unversioned.GroupVersion]map[string]
 	}
 
 	storage[imageapiv1.SchemeGroupVersion] = map[string]rest.Storage{
-		"images":               imageStorage,
-		"imagesignatures":      imageSignatureStorage,
-		"imageStreams/secrets": imageStreamSecretsStorage,
-		"imageStreams":         imageStreamStorage,
-		"imageStreams/status":  imageStreamStatusStorage,
-		"imageStreamImports":   imageStreamImportStorage,
-		"imageStreamImages":    imageStreamImageStorage,
-		"imageStreamMappings":  imageStreamMappingStorage,
-		"imageStreamTags":      imageStreamTagStorage,
+		"images":                imageStorage,
+		"imagesignatures":       imageSignatureStorage,
+		"imageStreams/secrets":  imageStreamSecretsStorage,
+		"imageStreams":          imageStreamStorage,
+		"imageStreams/status":   imageStreamStatusStorage,
+		"imageStreamImports":    imageStreamImportStorage,
+		"imageStreamImages":     imageStreamImageStorage,
+		"imageStreamPullTokens": imageStreamPullTokenStorage,
+		"imageStreamMappings":   imageStreamMappingStorage,
+		"imageStreamTags":       imageStreamTagStorage,
+		"registryWebhooks":      registryWebhookStorage,
 	}
 
 	storage[routeapiv1.SchemeGroupVersion] = map[string]rest.Storage{
@@ -959,6 +1031,34 @@ func checkStorageErr(err error) {
 	}
 }
 
+// importTransportsForRegistries builds one transport per registry that has proxy or
+// certificate authority settings configured for image import.
+func importTransportsForRegistries(proxies []configapi.RegistryProxyConfig) (map[string]http.RoundTripper, error) {
+	if len(proxies) == 0 {
+		return nil, nil
+	}
+	transports := map[string]http.RoundTripper{}
+	for _, proxy := range proxies {
+		transport := knet.SetTransportDefaults(&http.Transport{})
+		if len(proxy.ProxyURL) > 0 {
+			proxyURL, err := url.Parse(proxy.ProxyURL)
+			if err != nil {
+				return nil, fmt.Errorf("invalid proxy URL %q for registry %q: %v", proxy.ProxyURL, proxy.DomainName, err)
+			}
+			transport.Proxy = http.ProxyURL(proxyURL)
+		}
+		if len(proxy.CA) > 0 {
+			pool, err := certutil.NewPool(proxy.CA)
+			if err != nil {
+				return nil, fmt.Errorf("error reading CA bundle %q for registry %q: %v", proxy.CA, proxy.DomainName, err)
+			}
+			transport.TLSClientConfig = &tls.Config{RootCAs: pool}
+		}
+		transports[proxy.DomainName] = transport
+	}
+	return transports, nil
+}
+
 // initAPIVersionRoute initializes the osapi endpoint to behave similar to the upstream api endpoint
 func initAPIVersionRoute(apiContainer *genericmux.APIContainer, prefix string, versions ...string) {
 	versionHandler := apiserver.APIVersionHandler(kapi.Codecs, func(req *restful.Request) *unversioned.APIVersions {
@@ -43,6 +43,7 @@ import (
 	"github.com/openshift/origin/pkg/auth/authenticator/request/bearertoken"
 	"github.com/openshift/origin/pkg/auth/authenticator/request/paramtoken"
 	"github.com/openshift/origin/pkg/auth/authenticator/request/x509request"
+	"github.com/openshift/origin/pkg/auth/authenticator/token/sascopes"
 	authnregistry "github.com/openshift/origin/pkg/auth/oauth/registry"
 	"github.com/openshift/origin/pkg/auth/userregistry/identitymapper"
 	authorizationapi "github.com/openshift/origin/pkg/authorization/api"
@@ -57,6 +58,7 @@ import (
 	policybindingregistry "github.com/openshift/origin/pkg/authorization/registry/policybinding"
 	policybindingetcd "github.com/openshift/origin/pkg/authorization/registry/policybinding/etcd"
 	"github.com/openshift/origin/pkg/authorization/rulevalidation"
+	buildshard "github.com/openshift/origin/pkg/build/controller/shard"
 	osclient "github.com/openshift/origin/pkg/client"
 	oadmission "github.com/openshift/origin/pkg/cmd/server/admission"
 	configapi "github.com/openshift/origin/pkg/cmd/server/api"
@@ -128,6 +130,10 @@ type MasterConfig struct {
 	ControllerPlug      plug.Plug
 	ControllerPlugStart func()
 
+	// BuildControllerSharder decides which namespaces the build controllers on this
+	// master are responsible for when the build controller workload is sharded.
+	BuildControllerSharder buildshard.Sharder
+
 	// ImageFor is a function that returns the appropriate image to use for a named component
 	ImageFor func(component string) string
 	// RegistryNameFn retrieves the name of the integrated registry, or false if no such registry
@@ -293,6 +299,8 @@ func BuildMasterConfig(options configapi.MasterConfig) (*MasterConfig, error) {
 		ControllerPlug:      plug,
 		ControllerPlugStart: plugStart,
 
+		BuildControllerSharder: newBuildControllerSharder(options, client),
+
 		ImageFor:       imageTemplate.ExpandOrDie,
 		RegistryNameFn: imageapi.DefaultRegistryFunc(defaultRegistryFunc),
 
@@ -335,12 +343,16 @@ var (
 	openshiftAdmissionControlPlugins = []string{
 		"ProjectRequestLimit",
 		"OriginNamespaceLifecycle",
+		"openshift.io/ProjectArchive",
 		"openshift.io/RestrictSubjectBindings",
+		"openshift.io/RouteWildcardPolicy",
 		"PodNodeConstraints",
 		"openshift.io/JenkinsBootstrapper",
 		"openshift.io/BuildConfigSecretInjector",
 		"BuildByStrategy",
+		"openshift.io/ImageTagPromotion",
 		imageadmission.PluginName,
+		"openshift.io/ImageSignaturePolicy",
 		"OwnerReferencesPermissionEnforcement",
 		quotaadmission.PluginName,
 	}
@@ -348,9 +360,12 @@ var (
 	// KubeAdmissionPlugins gives the in-order default admission chain for kube resources.
 	KubeAdmissionPlugins = []string{
 		lifecycle.PluginName,
+		"openshift.io/ProjectArchive",
 		"RunOnceDuration",
 		"PodNodeConstraints",
 		"OriginPodNodeEnvironment",
+		"openshift.io/ProjectTolerations",
+		"openshift.io/PodBandwidth",
 		"PodNodeSelector",
 		overrideapi.PluginName,
 		serviceadmit.ExternalIPPluginName,
@@ -380,15 +395,21 @@ var (
 		lifecycle.PluginName,
 		"ProjectRequestLimit",
 		"OriginNamespaceLifecycle",
+		"openshift.io/ProjectArchive",
 		"openshift.io/RestrictSubjectBindings",
+		"openshift.io/RouteWildcardPolicy",
 		"PodNodeConstraints",
 		"openshift.io/JenkinsBootstrapper",
 		"openshift.io/BuildConfigSecretInjector",
 		"BuildByStrategy",
+		"openshift.io/ImageTagPromotion",
 		imageadmission.PluginName,
+		"openshift.io/ImageSignaturePolicy",
 		"RunOnceDuration",
 		"PodNodeConstraints",
 		"OriginPodNodeEnvironment",
+		"openshift.io/ProjectTolerations",
+		"openshift.io/PodBandwidth",
 		"PodNodeSelector",
 		overrideapi.PluginName,
 		serviceadmit.ExternalIPPluginName,
@@ -590,6 +611,27 @@ func newControllerPlug(options configapi.MasterConfig, client etcdclient.Client)
 	}
 }
 
+func newBuildControllerSharder(options configapi.MasterConfig, client etcdclient.Client) buildshard.Sharder {
+	if options.BuildControllerShards <= 1 {
+		return buildshard.All()
+	}
+	ttl := options.ControllerLeaseTTL
+	if ttl <= 0 {
+		ttl = 30
+	}
+	id := fmt.Sprintf("master-%s", kutilrand.String(8))
+	leased := buildshard.NewLeased(
+		client,
+		path.Join(options.EtcdStorageConfig.OpenShiftStoragePrefix, "leases/build-controller-shards"),
+		id,
+		options.BuildControllerShards,
+		uint64(ttl),
+	)
+	glog.V(2).Infof("Competing for %d build controller shards as %s, renewing every %d seconds", options.BuildControllerShards, id, ttl)
+	leased.Run()
+	return leased
+}
+
 func newServiceAccountTokenGetter(options configapi.MasterConfig) (serviceaccount.ServiceAccountTokenGetter, error) {
 	if options.KubernetesMasterConfig == nil {
 		// When we're running against an external Kubernetes, use the external kubernetes client to validate service account tokens
@@ -633,7 +675,10 @@ func newAuthenticator(config configapi.MasterConfig, restOptionsGetter restoptio
 			publicKeys = append(publicKeys, readPublicKeys...)
 		}
 		serviceAccountTokenAuthenticator := serviceaccount.JWTTokenAuthenticator(publicKeys, true, tokenGetter)
-		tokenAuthenticators = append(tokenAuthenticators, bearertoken.New(serviceAccountTokenAuthenticator, true))
+		// apply scope restrictions declared on the service account, so tokens for
+		// annotated service accounts cannot escalate beyond the granted scopes
+		scopedTokenAuthenticator := sascopes.NewAuthenticator(serviceAccountTokenAuthenticator, tokenGetter)
+		tokenAuthenticators = append(tokenAuthenticators, bearertoken.New(scopedTokenAuthenticator, true))
 	}
 
 	// OAuth token
@@ -1,6 +1,7 @@
 package origin
 
 import (
+	"fmt"
 	"io/ioutil"
 	"net"
 	"path"
@@ -14,8 +15,10 @@ import (
 	cmapp "k8s.io/kubernetes/cmd/kube-controller-manager/app/options"
 	"k8s.io/kubernetes/pkg/admission"
 	kapi "k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/api/resource"
 	"k8s.io/kubernetes/pkg/api/unversioned"
 	kclientset "k8s.io/kubernetes/pkg/client/clientset_generated/internalclientset"
+	"k8s.io/kubernetes/pkg/client/restclient"
 	"k8s.io/kubernetes/pkg/controller"
 	kresourcequota "k8s.io/kubernetes/pkg/controller/resourcequota"
 	sacontroller "k8s.io/kubernetes/pkg/controller/serviceaccount"
@@ -24,12 +27,14 @@ import (
 	"k8s.io/kubernetes/pkg/serviceaccount"
 	"k8s.io/kubernetes/pkg/util/cert"
 	"k8s.io/kubernetes/pkg/util/flowcontrol"
+	"k8s.io/kubernetes/pkg/util/sets"
 	utilwait "k8s.io/kubernetes/pkg/util/wait"
 	serviceaccountadmission "k8s.io/kubernetes/plugin/pkg/admission/serviceaccount"
 
 	builddefaults "github.com/openshift/origin/pkg/build/admission/defaults"
 	buildoverrides "github.com/openshift/origin/pkg/build/admission/overrides"
 	buildclient "github.com/openshift/origin/pkg/build/client"
+	buildcontroller "github.com/openshift/origin/pkg/build/controller"
 	buildpodcontroller "github.com/openshift/origin/pkg/build/controller/buildpod"
 	buildcontrollerfactory "github.com/openshift/origin/pkg/build/controller/factory"
 	buildstrategy "github.com/openshift/origin/pkg/build/controller/strategy"
@@ -42,15 +47,20 @@ import (
 	cmdutil "github.com/openshift/origin/pkg/cmd/util"
 	"github.com/openshift/origin/pkg/cmd/util/clientcmd"
 	"github.com/openshift/origin/pkg/controller/shared"
+	deployapi "github.com/openshift/origin/pkg/deploy/api"
 	deploycontroller "github.com/openshift/origin/pkg/deploy/controller/deployment"
 	deployconfigcontroller "github.com/openshift/origin/pkg/deploy/controller/deploymentconfig"
 	triggercontroller "github.com/openshift/origin/pkg/deploy/controller/generictrigger"
 	"github.com/openshift/origin/pkg/dns"
+	eventscontroller "github.com/openshift/origin/pkg/events/controller"
 	imagecontroller "github.com/openshift/origin/pkg/image/controller"
+	imagetagfollowcontroller "github.com/openshift/origin/pkg/image/controller/tagfollow"
+	"github.com/openshift/origin/pkg/image/importer"
 	projectcontroller "github.com/openshift/origin/pkg/project/controller"
 	quota "github.com/openshift/origin/pkg/quota"
 	quotacontroller "github.com/openshift/origin/pkg/quota/controller"
 	"github.com/openshift/origin/pkg/quota/controller/clusterquotareconciliation"
+	routecertificatecontroller "github.com/openshift/origin/pkg/route/controller/certificate"
 	sdnplugin "github.com/openshift/origin/pkg/sdn/plugin"
 	securitycontroller "github.com/openshift/origin/pkg/security/controller"
 	"github.com/openshift/origin/pkg/security/mcs"
@@ -91,6 +101,30 @@ func (c *MasterConfig) RunOriginNamespaceController() {
 	controller.Run()
 }
 
+// RunProjectArchiveController starts the controller that scales content of archived projects
+// down to zero and restores it when they are unarchived
+func (c *MasterConfig) RunProjectArchiveController() {
+	osclient, kclient := c.OriginNamespaceControllerClients()
+	factory := projectcontroller.ArchiveControllerFactory{
+		Client:     osclient,
+		KubeClient: kclient,
+	}
+	controller := factory.Create()
+	controller.Run()
+}
+
+// RunEventRetentionController starts the controller that aggregates duplicate events and
+// enforces per-namespace event count budgets
+func (c *MasterConfig) RunEventRetentionController() {
+	factory := eventscontroller.RetentionControllerFactory{
+		KubeClient:     c.PrivilegedLoopbackKubernetesClientset,
+		Budget:         eventscontroller.DefaultBudget,
+		SampleInterval: eventscontroller.DefaultSampleInterval,
+	}
+	controller := factory.Create()
+	controller.Run()
+}
+
 // RunServiceAccountsController starts the service account controller
 func (c *MasterConfig) RunServiceAccountsController() {
 	if len(c.Options.ServiceAccountConfig.ManagedNames) == 0 {
@@ -132,14 +166,20 @@ func (c *MasterConfig) RunServiceAccountTokensController(cm *cmapp.CMServer) {
 			glog.Fatalf("Error parsing master ca file for Service Account Token Manager: %s: %v", c.Options.ServiceAccountConfig.MasterCA, err)
 		}
 	}
+	servingServingCAFile := ""
+	if c.Options.ControllerConfig.ServiceServingCert.ExternalSigner != nil {
+		servingServingCAFile = c.Options.ControllerConfig.ServiceServingCert.ExternalSigner.CABundleFile
+	} else if c.Options.ControllerConfig.ServiceServingCert.Signer != nil {
+		servingServingCAFile = c.Options.ControllerConfig.ServiceServingCert.Signer.CertFile
+	}
 	servingServingCABundle := []byte{}
-	if c.Options.ControllerConfig.ServiceServingCert.Signer != nil && len(c.Options.ControllerConfig.ServiceServingCert.Signer.CertFile) > 0 {
-		servingServingCA, err := ioutil.ReadFile(c.Options.ControllerConfig.ServiceServingCert.Signer.CertFile)
+	if len(servingServingCAFile) > 0 {
+		servingServingCA, err := ioutil.ReadFile(servingServingCAFile)
 		if err != nil {
-			glog.Fatalf("Error reading ca file for Service Serving Certificate Signer: %s: %v", c.Options.ControllerConfig.ServiceServingCert.Signer.CertFile, err)
+			glog.Fatalf("Error reading ca file for Service Serving Certificate Signer: %s: %v", servingServingCAFile, err)
 		}
 		if _, err := crypto.CertsFromPEM(servingServingCA); err != nil {
-			glog.Fatalf("Error parsing ca file for Service Serving Certificate Signer: %s: %v", c.Options.ControllerConfig.ServiceServingCert.Signer.CertFile, err)
+			glog.Fatalf("Error parsing ca file for Service Serving Certificate Signer: %s: %v", servingServingCAFile, err)
 		}
 
 		// if we have a rootCA bundle add that too.  The rootCA will be used when hitting the default master service, since those are signed
@@ -257,6 +297,7 @@ func (c *MasterConfig) RunBuildController(informers shared.InformerFactory) erro
 	}
 
 	osclient, kclient := c.BuildControllerClients()
+	buildDefaults.Client = kclient
 	factory := buildcontrollerfactory.BuildControllerFactory{
 		KubeClient:   kclient,
 		OSClient:     osclient,
@@ -279,6 +320,7 @@ func (c *MasterConfig) RunBuildController(informers shared.InformerFactory) erro
 		},
 		BuildDefaults:  buildDefaults,
 		BuildOverrides: buildOverrides,
+		Sharder:        c.BuildControllerSharder,
 	}
 
 	controller := factory.Create()
@@ -310,6 +352,40 @@ func (c *MasterConfig) RunBuildImageChangeTriggerController() {
 	}()
 }
 
+// RunExternalImageChangeTriggerController starts polling external registries for digest
+// changes of images referenced directly by build config ImageChange triggers. It is a
+// no-op when no registries are configured for polling.
+func (c *MasterConfig) RunExternalImageChangeTriggerController() {
+	registries := c.Options.ImagePolicyConfig.ExternalImageChangeTriggerRegistries
+	if len(registries) == 0 {
+		return
+	}
+
+	importTransport, err := restclient.TransportFor(&restclient.Config{})
+	if err != nil {
+		glog.Fatalf("Unable to configure a default transport for importing: %v", err)
+	}
+	insecureImportTransport, err := restclient.TransportFor(&restclient.Config{Insecure: true})
+	if err != nil {
+		glog.Fatalf("Unable to configure a default transport for importing: %v", err)
+	}
+	registryImportTransports, err := importTransportsForRegistries(c.Options.ImagePolicyConfig.ImportProxies)
+	if err != nil {
+		glog.Fatalf("Unable to configure per-registry transports for importing: %v", err)
+	}
+	retriever := importer.NewContext(importTransport, insecureImportTransport).WithRegistryTransports(registryImportTransports).WithCredentials(importer.NoCredentials)
+
+	bcClient, _ := c.BuildImageChangeTriggerControllerClients()
+	controller := &buildcontroller.ExternalImageChangeController{
+		BuildConfigIndex:        &oscache.StoreToBuildConfigListerImpl{Indexer: c.Informers.BuildConfigs().Indexer()},
+		BuildConfigInstantiator: buildclient.NewOSClientBuildConfigInstantiatorClient(bcClient),
+		DigestResolver:          &importer.RegistryDigestResolver{Retriever: retriever},
+		Registries:              sets.NewString(registries...),
+		Interval:                time.Duration(c.Options.ImagePolicyConfig.ExternalImageChangeTriggerIntervalSeconds) * time.Second,
+	}
+	go controller.Run(utilwait.NeverStop)
+}
+
 // RunBuildConfigChangeController starts the build config change trigger controller process.
 func (c *MasterConfig) RunBuildConfigChangeController() {
 	bcClient, kClient := c.BuildConfigChangeControllerClients()
@@ -318,6 +394,7 @@ func (c *MasterConfig) RunBuildConfigChangeController() {
 		Client:                  bcClient,
 		KubeClient:              kClient,
 		BuildConfigInstantiator: bcInstantiator,
+		Sharder:                 c.BuildControllerSharder,
 	}
 	factory.Create().Run()
 }
@@ -340,6 +417,11 @@ func (c *MasterConfig) RunDeploymentController() {
 		path.Join(serviceaccountadmission.DefaultAPITokenMountPath, kapi.ServiceAccountTokenKey),
 	)
 
+	defaultPodTemplate, err := deployerPodTemplateFromConfig(c.Options.ControllerConfig.DeployerPodTemplate)
+	if err != nil {
+		glog.Fatalf("Unable to initialize deployment controller: %v", err)
+	}
+
 	controller := deploycontroller.NewDeploymentController(
 		rcInformer,
 		podInformer,
@@ -347,11 +429,52 @@ func (c *MasterConfig) RunDeploymentController() {
 		bootstrappolicy.DeployerServiceAccountName,
 		c.ImageFor("deployer"),
 		env,
+		defaultPodTemplate,
 		c.ExternalVersionCodec,
 	)
 	go controller.Run(5, utilwait.NeverStop)
 }
 
+// deployerPodTemplateFromConfig converts the cluster default deployer pod template from
+// the master configuration into its API form.
+func deployerPodTemplateFromConfig(config *configapi.DeployerPodTemplateConfig) (*deployapi.DeployerPodTemplateSpec, error) {
+	if config == nil {
+		return nil, nil
+	}
+
+	template := &deployapi.DeployerPodTemplateSpec{
+		NodeSelector:       config.NodeSelector,
+		ServiceAccountName: config.ServiceAccountName,
+	}
+	for _, toleration := range config.Tolerations {
+		template.Tolerations = append(template.Tolerations, kapi.Toleration{
+			Key:      toleration.Key,
+			Operator: kapi.TolerationOperator(toleration.Operator),
+			Value:    toleration.Value,
+			Effect:   kapi.TaintEffect(toleration.Effect),
+		})
+	}
+	if len(config.Requests) > 0 || len(config.Limits) > 0 {
+		requirements := &kapi.ResourceRequirements{Requests: kapi.ResourceList{}, Limits: kapi.ResourceList{}}
+		for name, value := range config.Requests {
+			quantity, err := resource.ParseQuantity(value)
+			if err != nil {
+				return nil, fmt.Errorf("invalid deployer pod resource request %s=%s: %v", name, value, err)
+			}
+			requirements.Requests[kapi.ResourceName(name)] = quantity
+		}
+		for name, value := range config.Limits {
+			quantity, err := resource.ParseQuantity(value)
+			if err != nil {
+				return nil, fmt.Errorf("invalid deployer pod resource limit %s=%s: %v", name, value, err)
+			}
+			requirements.Limits[kapi.ResourceName(name)] = quantity
+		}
+		template.Resources = requirements
+	}
+	return template, nil
+}
+
 // RunDeploymentConfigController starts the deployment config controller process.
 func (c *MasterConfig) RunDeploymentConfigController() {
 	dcInfomer := c.Informers.DeploymentConfigs().Informer()
@@ -382,22 +505,53 @@ func (c *MasterConfig) RunSDNController() {
 	}
 }
 
-func (c *MasterConfig) RunServiceServingCertController(client *kclientset.Clientset) {
-	if c.Options.ControllerConfig.ServiceServingCert.Signer == nil {
-		return
+// servingCertSigner builds the cluster serving cert signer from the controller config, or
+// returns nil if no signer is configured.
+func servingCertSigner(config configapi.ServiceServingCert) servingcertcontroller.Signer {
+	if config.Signer == nil && config.ExternalSigner == nil {
+		return nil
 	}
-	ca, err := crypto.GetCA(c.Options.ControllerConfig.ServiceServingCert.Signer.CertFile, c.Options.ControllerConfig.ServiceServingCert.Signer.KeyFile, "")
+
+	if config.ExternalSigner != nil {
+		signer, err := servingcertcontroller.NewRemoteSigner(config.ExternalSigner.SignURL, config.ExternalSigner.CABundleFile)
+		if err != nil {
+			glog.Fatalf("serving cert signer failed: %v", err)
+		}
+		return signer
+	}
+
+	ca, err := crypto.GetCA(config.Signer.CertFile, config.Signer.KeyFile, "")
 	if err != nil {
-		glog.Fatalf("service serving cert controller failed: %v", err)
+		glog.Fatalf("serving cert signer failed: %v", err)
 	}
+	return servingcertcontroller.NewCASigner(ca)
+}
 
-	servingCertController := servingcertcontroller.NewServiceServingCertController(client.Core(), client.Core(), ca, "cluster.local", 2*time.Minute)
+func (c *MasterConfig) RunServiceServingCertController(client *kclientset.Clientset) {
+	signer := servingCertSigner(c.Options.ControllerConfig.ServiceServingCert)
+	if signer == nil {
+		return
+	}
+
+	servingCertController := servingcertcontroller.NewServiceServingCertController(client.Core(), client.Core(), client.Core(), signer, "cluster.local", 2*time.Minute)
 	go servingCertController.Run(1, make(chan struct{}))
 
-	servingCertUpdateController := servingcertcontroller.NewServiceServingCertUpdateController(client.Core(), client.Core(), ca, "cluster.local", 20*time.Minute)
+	servingCertUpdateController := servingcertcontroller.NewServiceServingCertUpdateController(client.Core(), client.Core(), signer, "cluster.local", 20*time.Minute)
 	go servingCertUpdateController.Run(5, make(chan struct{}))
 }
 
+// RunRouteCertificateController starts the controller that provisions serving certificates
+// for routes that request one by annotation, reusing the service serving cert signer.
+func (c *MasterConfig) RunRouteCertificateController() {
+	signer := servingCertSigner(c.Options.ControllerConfig.ServiceServingCert)
+	if signer == nil {
+		return
+	}
+
+	routeCertController := routecertificatecontroller.NewRouteCertificateController(c.PrivilegedLoopbackOpenShiftClient, signer, 2*time.Minute)
+	go routeCertController.Run(1, make(chan struct{}))
+}
+
 // RunImageImportController starts the image import trigger controller process.
 func (c *MasterConfig) RunImageImportController() {
 	osclient := c.ImageImportControllerClient()
@@ -427,6 +581,18 @@ func (c *MasterConfig) RunImageImportController() {
 	}
 }
 
+// RunImageTagFollowController starts the controller that keeps cross image
+// stream tag aliases up to date as the tags they follow move.
+func (c *MasterConfig) RunImageTagFollowController() {
+	osclient := c.ImageImportControllerClient()
+
+	factory := imagetagfollowcontroller.TagFollowControllerFactory{
+		Client:         osclient,
+		ResyncInterval: 10 * time.Minute,
+	}
+	factory.Create().Run()
+}
+
 // RunSecurityAllocationController starts the security allocation controller process.
 func (c *MasterConfig) RunSecurityAllocationController() {
 	alloc := c.Options.ProjectConfig.SecurityAllocator
@@ -17,15 +17,21 @@ import (
 	_ "github.com/openshift/origin/pkg/build/admission/strategyrestrictions"
 	_ "github.com/openshift/origin/pkg/image/admission"
 	_ "github.com/openshift/origin/pkg/image/admission/imagepolicy"
+	_ "github.com/openshift/origin/pkg/image/admission/imagesignature"
+	_ "github.com/openshift/origin/pkg/image/admission/tagpromotion"
 	_ "github.com/openshift/origin/pkg/ingress/admission"
+	_ "github.com/openshift/origin/pkg/project/admission/archive"
 	_ "github.com/openshift/origin/pkg/project/admission/lifecycle"
 	_ "github.com/openshift/origin/pkg/project/admission/nodeenv"
 	_ "github.com/openshift/origin/pkg/project/admission/requestlimit"
+	_ "github.com/openshift/origin/pkg/project/admission/tolerations"
 	_ "github.com/openshift/origin/pkg/quota/admission/clusterresourceoverride"
 	_ "github.com/openshift/origin/pkg/quota/admission/clusterresourcequota"
 	_ "github.com/openshift/origin/pkg/quota/admission/resourcequota"
 	_ "github.com/openshift/origin/pkg/quota/admission/runonceduration"
+	_ "github.com/openshift/origin/pkg/route/admission/wildcardpolicy"
 	_ "github.com/openshift/origin/pkg/scheduler/admission/podnodeconstraints"
+	_ "github.com/openshift/origin/pkg/sdn/admission/podbandwidth"
 	_ "github.com/openshift/origin/pkg/security/admission"
 	_ "k8s.io/kubernetes/plugin/pkg/admission/admit"
 	_ "k8s.io/kubernetes/plugin/pkg/admission/alwayspullimages"
@@ -53,6 +59,8 @@ import (
 var (
 	defaultOnPlugins = sets.NewString(
 		"OriginNamespaceLifecycle",
+		"openshift.io/ProjectArchive",
+		"openshift.io/RouteWildcardPolicy",
 		"openshift.io/JenkinsBootstrapper",
 		"openshift.io/BuildConfigSecretInjector",
 		"BuildByStrategy",
@@ -60,6 +68,8 @@ var (
 		imageadmission.PluginName,
 		lifecycle.PluginName,
 		"OriginPodNodeEnvironment",
+		"openshift.io/ProjectTolerations",
+		"openshift.io/PodBandwidth",
 		"PodNodeSelector",
 		serviceadmit.ExternalIPPluginName,
 		serviceadmit.RestrictedEndpointsPluginName,
@@ -83,6 +93,8 @@ var (
 		"PodNodeConstraints",
 		overrideapi.PluginName,
 		imagepolicy.PluginName,
+		"openshift.io/ImageSignaturePolicy",
+		"openshift.io/ImageTagPromotion",
 		"AlwaysPullImages",
 		"ImagePolicyWebhook",
 		"openshift.io/RestrictSubjectBindings",
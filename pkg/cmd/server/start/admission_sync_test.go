@@ -31,7 +31,10 @@ var admissionPluginsNotUsedByKube = sets.NewString(
 	"openshift.io/BuildConfigSecretInjector", // from origin, only needed for managing builds, not kubernetes resources
 	"OriginNamespaceLifecycle",               // from origin, only needed for rejecting openshift resources, so not needed by kube
 	"ProjectRequestLimit",                    // from origin, used for limiting project requests by user (online use case)
+	"openshift.io/ImageSignaturePolicy",      // from origin, needs the origin client and project cache wiring that only the origin chain provides
+	"openshift.io/ImageTagPromotion",         // from origin, only needed for managing image stream tags, not kubernetes resources
 	"openshift.io/RestrictSubjectBindings",   // from origin, used for enforcing rolebindingrestrictions, not kubernetes resources
+	"openshift.io/RouteWildcardPolicy",       // from origin, only needed for validating route wildcard policy, not kubernetes resources
 	"RunOnceDuration",                        // from origin, used for overriding the ActiveDeadlineSeconds for run-once pods
 
 	"NamespaceExists",  // superseded by NamespaceLifecycle
@@ -729,12 +729,16 @@ func startControllers(oc *origin.MasterConfig, kc *kubernetes.MasterConfig) erro
 		oc.RunBuildPodController()
 		oc.RunBuildConfigChangeController()
 		oc.RunBuildImageChangeTriggerController()
+		oc.RunExternalImageChangeTriggerController()
 	}
 	oc.RunDeploymentController()
 	oc.RunDeploymentConfigController()
 	oc.RunDeploymentTriggerController()
 	oc.RunImageImportController()
+	oc.RunImageTagFollowController()
 	oc.RunOriginNamespaceController()
+	oc.RunProjectArchiveController()
+	oc.RunEventRetentionController()
 	oc.RunSDNController()
 
 	// initializes quota docs used by admission
@@ -747,6 +751,7 @@ func startControllers(oc *origin.MasterConfig, kc *kubernetes.MasterConfig) erro
 		glog.Fatalf("Could not get client: %v", err)
 	}
 	oc.RunServiceServingCertController(serviceServingCertClient)
+	oc.RunRouteCertificateController()
 	oc.RunUnidlingController()
 
 	_, _, ingressIPClient, err := oc.GetServiceAccountClients(bootstrappolicy.InfraServiceIngressIPControllerServiceAccountName)
@@ -29,6 +29,7 @@ import (
 	deployapi "github.com/openshift/origin/pkg/deploy/api"
 	deployutil "github.com/openshift/origin/pkg/deploy/util"
 	imageapi "github.com/openshift/origin/pkg/image/api"
+	routeapi "github.com/openshift/origin/pkg/route/api"
 )
 
 // New creates a default Factory for commands that should share identical server
@@ -261,6 +262,49 @@ func (f *Factory) PodForResource(resource string, timeout time.Duration) (string
 			return "", err
 		}
 		return pod.Name, nil
+	case api.Resource("services"):
+		kc, err := f.ClientSet()
+		if err != nil {
+			return "", err
+		}
+		svc, err := kc.Core().Services(namespace).Get(name)
+		if err != nil {
+			return "", err
+		}
+		if len(svc.Spec.Selector) == 0 {
+			return "", fmt.Errorf("service %s does not select any pods", name)
+		}
+		selector := labels.SelectorFromSet(svc.Spec.Selector)
+		pod, _, err := kcmdutil.GetFirstPod(kc, namespace, selector, timeout, sortBy)
+		if err != nil {
+			return "", err
+		}
+		return pod.Name, nil
+	case routeapi.Resource("routes"), routeapi.LegacyResource("routes"):
+		oc, kc, err := f.Clients()
+		if err != nil {
+			return "", err
+		}
+		route, err := oc.Routes(namespace).Get(name)
+		if err != nil {
+			return "", err
+		}
+		if route.Spec.To.Kind != "Service" || len(route.Spec.To.Name) == 0 {
+			return "", fmt.Errorf("route %s does not point to a service", name)
+		}
+		svc, err := kc.Core().Services(namespace).Get(route.Spec.To.Name)
+		if err != nil {
+			return "", err
+		}
+		if len(svc.Spec.Selector) == 0 {
+			return "", fmt.Errorf("service %s does not select any pods", svc.Name)
+		}
+		selector := labels.SelectorFromSet(svc.Spec.Selector)
+		pod, _, err := kcmdutil.GetFirstPod(kc.Core(), namespace, selector, timeout, sortBy)
+		if err != nil {
+			return "", err
+		}
+		return pod.Name, nil
 	case deployapi.Resource("deploymentconfigs"), deployapi.LegacyResource("deploymentconfigs"):
 		oc, kc, err := f.Clients()
 		if err != nil {
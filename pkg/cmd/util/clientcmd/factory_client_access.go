@@ -19,6 +19,7 @@ import (
 	kapi "k8s.io/kubernetes/pkg/api"
 	"k8s.io/kubernetes/pkg/api/meta"
 	"k8s.io/kubernetes/pkg/api/unversioned"
+	"k8s.io/kubernetes/pkg/auth/user"
 	kclientset "k8s.io/kubernetes/pkg/client/clientset_generated/internalclientset"
 	"k8s.io/kubernetes/pkg/client/restclient"
 	"k8s.io/kubernetes/pkg/client/typed/discovery"
@@ -30,13 +31,17 @@ import (
 	"k8s.io/kubernetes/pkg/kubectl/resource"
 	"k8s.io/kubernetes/pkg/runtime"
 	"k8s.io/kubernetes/pkg/util/homedir"
+	"k8s.io/kubernetes/pkg/util/validation/field"
 
+	authclient "github.com/openshift/origin/pkg/auth/client"
+	authorizationapi "github.com/openshift/origin/pkg/authorization/api"
 	"github.com/openshift/origin/pkg/client"
 	"github.com/openshift/origin/pkg/cmd/cli/config"
 	"github.com/openshift/origin/pkg/cmd/cli/describe"
 	deployapi "github.com/openshift/origin/pkg/deploy/api"
 	deploycmd "github.com/openshift/origin/pkg/deploy/cmd"
 	imageutil "github.com/openshift/origin/pkg/image/util"
+	oauthvalidation "github.com/openshift/origin/pkg/oauth/api/validation"
 	routegen "github.com/openshift/origin/pkg/route/generator"
 )
 
@@ -114,9 +119,12 @@ func DefaultClientConfig(flags *pflag.FlagSet) kclientcmd.ClientConfig {
 	cobra.MarkFlagFilename(flags, overrideFlags.AuthOverrideFlags.ClientKey.LongName)
 	cobra.MarkFlagFilename(flags, overrideFlags.ClusterOverrideFlags.CertificateAuthority.LongName)
 
+	impersonateScopes := &[]string{}
+	flags.StringArrayVar(impersonateScopes, "as-scope", nil, "Scope to limit the impersonated user to. May be repeated to specify multiple scopes. Requires --as.")
+
 	clientConfig := kclientcmd.NewNonInteractiveDeferredLoadingClientConfig(loadingRules, overrides)
 
-	return clientConfig
+	return scopeImpersonatingClientConfig{nested: clientConfig, scopes: impersonateScopes}
 }
 
 func (f *ring0Factory) Clients() (*client.Client, kclientset.Interface, error) {
@@ -486,6 +494,56 @@ func (c defaultingClientConfig) ClientConfig() (*restclient.Config, error) {
 To view or setup config directly use the 'config' command.`)}
 }
 
+// scopeImpersonatingClientConfig restricts an impersonated user to the scopes given via
+// --as-scope. The scopes are sent along with the impersonation headers and evaluated by
+// the server's scope authorizer, so the resulting access is the intersection of the
+// impersonated user's permissions and the scopes.
+type scopeImpersonatingClientConfig struct {
+	nested kclientcmd.ClientConfig
+	scopes *[]string
+}
+
+// RawConfig calls the nested method
+func (c scopeImpersonatingClientConfig) RawConfig() (kclientcmdapi.Config, error) {
+	return c.nested.RawConfig()
+}
+
+// Namespace calls the nested method
+func (c scopeImpersonatingClientConfig) Namespace() (string, bool, error) {
+	return c.nested.Namespace()
+}
+
+// ConfigAccess calls the nested method
+func (c scopeImpersonatingClientConfig) ConfigAccess() kclientcmd.ConfigAccess {
+	return c.nested.ConfigAccess()
+}
+
+// ClientConfig returns the nested client config, limited to the requested impersonation
+// scopes if any were given.
+func (c scopeImpersonatingClientConfig) ClientConfig() (*restclient.Config, error) {
+	cfg, err := c.nested.ClientConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	scopes := *c.scopes
+	if len(scopes) == 0 {
+		return cfg, nil
+	}
+	if len(cfg.Impersonate) == 0 {
+		return nil, fmt.Errorf("--as-scope requires a user to impersonate, specify one with --as")
+	}
+	if errs := oauthvalidation.ValidateScopes(scopes, field.NewPath("as-scope")); len(errs) > 0 {
+		return nil, errs.ToAggregate()
+	}
+
+	impersonatingConfig := authclient.NewImpersonatingConfig(&user.DefaultInfo{
+		Name:  cfg.Impersonate,
+		Extra: map[string][]string{authorizationapi.ScopesKey: scopes},
+	}, *cfg)
+	return &impersonatingConfig, nil
+}
+
 // computeDiscoverCacheDir takes the parentDir and the host and comes up with a "usually non-colliding" name.
 func computeDiscoverCacheDir(parentDir, host string) string {
 	// strip the optional scheme from host if its there:
@@ -0,0 +1,121 @@
+package clientcmd
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+
+	"k8s.io/kubernetes/pkg/client/restclient"
+	kclientcmd "k8s.io/kubernetes/pkg/client/unversioned/clientcmd"
+	kclientcmdapi "k8s.io/kubernetes/pkg/client/unversioned/clientcmd/api"
+
+	authenticationapi "github.com/openshift/origin/pkg/auth/api"
+)
+
+type fakeNestedClientConfig struct {
+	config restclient.Config
+}
+
+func (c fakeNestedClientConfig) RawConfig() (kclientcmdapi.Config, error) {
+	return kclientcmdapi.Config{}, nil
+}
+
+func (c fakeNestedClientConfig) ClientConfig() (*restclient.Config, error) {
+	config := c.config
+	return &config, nil
+}
+
+func (c fakeNestedClientConfig) Namespace() (string, bool, error) {
+	return "default", false, nil
+}
+
+func (c fakeNestedClientConfig) ConfigAccess() kclientcmd.ConfigAccess {
+	return nil
+}
+
+type headerRecordingRoundTripper struct {
+	req *http.Request
+}
+
+func (rt *headerRecordingRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	rt.req = req
+	return &http.Response{StatusCode: http.StatusOK}, nil
+}
+
+func TestScopeImpersonatingClientConfig(t *testing.T) {
+	testCases := []struct {
+		name        string
+		impersonate string
+		scopes      []string
+
+		expectedErr    string
+		expectedScopes []string
+	}{
+		{
+			name:        "no scopes leaves the config untouched",
+			impersonate: "bob",
+		},
+		{
+			name:        "scopes without a user to impersonate",
+			scopes:      []string{"role:view:myproject"},
+			expectedErr: "--as-scope requires a user to impersonate",
+		},
+		{
+			name:        "invalid scope",
+			impersonate: "bob",
+			scopes:      []string{"role:view"},
+			expectedErr: "role:view",
+		},
+		{
+			name:           "valid scopes are sent with the impersonation headers",
+			impersonate:    "bob",
+			scopes:         []string{"role:view:myproject", "user:info"},
+			expectedScopes: []string{"role:view:myproject", "user:info"},
+		},
+	}
+
+	for _, tc := range testCases {
+		scopes := tc.scopes
+		clientConfig := scopeImpersonatingClientConfig{
+			nested: fakeNestedClientConfig{config: restclient.Config{Impersonate: tc.impersonate}},
+			scopes: &scopes,
+		}
+
+		config, err := clientConfig.ClientConfig()
+		if len(tc.expectedErr) > 0 {
+			if err == nil || !strings.Contains(err.Error(), tc.expectedErr) {
+				t.Errorf("%s: expected error containing %q, got %v", tc.name, tc.expectedErr, err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", tc.name, err)
+			continue
+		}
+
+		if len(tc.scopes) == 0 {
+			if config.WrapTransport != nil {
+				t.Errorf("%s: expected the config to be left untouched without scopes", tc.name)
+			}
+			continue
+		}
+
+		recorder := &headerRecordingRoundTripper{}
+		req, err := http.NewRequest("GET", "https://localhost:8443/api", nil)
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", tc.name, err)
+		}
+		if _, err := config.WrapTransport(recorder).RoundTrip(req); err != nil {
+			t.Errorf("%s: unexpected error: %v", tc.name, err)
+			continue
+		}
+
+		if user := recorder.req.Header.Get(authenticationapi.ImpersonateUserHeader); user != tc.impersonate {
+			t.Errorf("%s: expected impersonated user %q, got %q", tc.name, tc.impersonate, user)
+		}
+		sentScopes := recorder.req.Header[authenticationapi.ImpersonateUserScopeHeader]
+		if strings.Join(sentScopes, " ") != strings.Join(tc.expectedScopes, " ") {
+			t.Errorf("%s: expected scopes %v, got %v", tc.name, tc.expectedScopes, sentScopes)
+		}
+	}
+}
@@ -0,0 +1,113 @@
+package tokencmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/golang/glog"
+
+	"k8s.io/kubernetes/pkg/client/restclient"
+)
+
+const (
+	// deviceCodeGrantType is the RFC 8628 device code grant type.
+	deviceCodeGrantType = "urn:ietf:params:oauth:grant-type:device_code"
+
+	deviceCodePath  = "/oauth/device/code"
+	deviceTokenPath = "/oauth/device/token"
+)
+
+// deviceCodeResponse is the RFC 8628 device authorization response.
+type deviceCodeResponse struct {
+	DeviceCode              string `json:"device_code"`
+	UserCode                string `json:"user_code"`
+	VerificationURI         string `json:"verification_uri"`
+	VerificationURIComplete string `json:"verification_uri_complete"`
+	ExpiresIn               int    `json:"expires_in"`
+	Interval                int    `json:"interval"`
+}
+
+// deviceTokenResponse is the token response, or the polling error, returned by the
+// device token endpoint.
+type deviceTokenResponse struct {
+	AccessToken      string `json:"access_token"`
+	Error            string `json:"error"`
+	ErrorDescription string `json:"error_description"`
+}
+
+// RequestDeviceToken requests a token using the RFC 8628 device authorization
+// grant. It prints the verification URL and user code to out, then polls the
+// server until the user completes verification on another device.
+func RequestDeviceToken(clientCfg *restclient.Config, out io.Writer) (string, error) {
+	rt, err := restclient.TransportFor(clientCfg)
+	if err != nil {
+		return "", err
+	}
+
+	codeResponse := &deviceCodeResponse{}
+	data := url.Values{"client_id": {"openshift-challenging-client"}}
+	if err := postForm(rt, clientCfg.Host+deviceCodePath, data, codeResponse); err != nil {
+		return "", fmt.Errorf("unable to request a device code: %v", err)
+	}
+
+	fmt.Fprintf(out, "To sign in, open %s on another device and enter the code %s\n\n", codeResponse.VerificationURI, codeResponse.UserCode)
+
+	interval := codeResponse.Interval
+	if interval < 5 {
+		interval = 5
+	}
+	deadline := time.Now().Add(time.Duration(codeResponse.ExpiresIn) * time.Second)
+
+	data = url.Values{
+		"grant_type":  {deviceCodeGrantType},
+		"client_id":   {"openshift-challenging-client"},
+		"device_code": {codeResponse.DeviceCode},
+	}
+	for time.Now().Before(deadline) {
+		time.Sleep(time.Duration(interval) * time.Second)
+
+		tokenResponse := &deviceTokenResponse{}
+		if err := postForm(rt, clientCfg.Host+deviceTokenPath, data, tokenResponse); err != nil {
+			return "", fmt.Errorf("unable to poll for a device token: %v", err)
+		}
+
+		switch tokenResponse.Error {
+		case "":
+			return tokenResponse.AccessToken, nil
+		case "authorization_pending":
+			glog.V(4).Infof("Authorization pending, polling again in %d seconds", interval)
+		case "slow_down":
+			interval += 5
+			glog.V(4).Infof("Server requested slower polling, backing off to %d seconds", interval)
+		case "access_denied":
+			return "", fmt.Errorf("the request was denied")
+		case "expired_token":
+			return "", fmt.Errorf("the device code expired before verification completed")
+		default:
+			return "", fmt.Errorf("error requesting token: %s %s", tokenResponse.Error, tokenResponse.ErrorDescription)
+		}
+	}
+	return "", fmt.Errorf("timed out waiting for verification to complete")
+}
+
+// postForm posts the form values to the given URL and decodes the JSON response
+// into into. Error status codes are not fatal here: the device token endpoint
+// reports polling state via error responses with JSON bodies.
+func postForm(rt http.RoundTripper, requestURL string, data url.Values, into interface{}) error {
+	req, err := http.NewRequest("POST", requestURL, strings.NewReader(data.Encode()))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	resp, err := rt.RoundTrip(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	return json.NewDecoder(resp.Body).Decode(into)
+}
@@ -7,5 +7,6 @@ func DeploymentConfigToSelectableFields(deploymentConfig *DeploymentConfig) fiel
 	return fields.Set{
 		"metadata.name":      deploymentConfig.Name,
 		"metadata.namespace": deploymentConfig.Namespace,
+		"spec.strategy.type": string(deploymentConfig.Spec.Strategy.Type),
 	}
 }
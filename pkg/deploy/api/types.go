@@ -77,12 +77,22 @@ const (
 	// DeploymentReplicasAnnotation is for internal use only and is for
 	// detecting external modifications to deployment replica counts.
 	DeploymentReplicasAnnotation = "openshift.io/deployment.replicas"
+	// RouterStatusURLAnnotation is an annotation on a deployment config that points the
+	// rolling strategy at a router status endpoint which is queried for route backend
+	// health before old pods are scaled down.
+	RouterStatusURLAnnotation = "deploy.openshift.io/router-status-url"
+	// RouteHealthChecksAnnotation is an annotation on a deployment config holding a comma
+	// delimited list of route names in the config's namespace whose backends must be
+	// healthy through the router before a rolling deployment proceeds.
+	RouteHealthChecksAnnotation = "deploy.openshift.io/route-health-checks"
 	// PostHookPodSuffix is the suffix added to all pre hook pods
 	PreHookPodSuffix = "hook-pre"
 	// PostHookPodSuffix is the suffix added to all mid hook pods
 	MidHookPodSuffix = "hook-mid"
 	// PostHookPodSuffix is the suffix added to all post hook pods
 	PostHookPodSuffix = "hook-post"
+	// ValidateHookPodSuffix is the suffix added to all validate hook pods
+	ValidateHookPodSuffix = "hook-validate"
 )
 
 // These constants represent values used in deployment annotations.
@@ -188,6 +198,8 @@ type DeploymentStrategy struct {
 	RecreateParams *RecreateDeploymentStrategyParams
 	// RollingParams are the input to the Rolling deployment strategy.
 	RollingParams *RollingDeploymentStrategyParams
+	// BlueGreenParams are the input to the BlueGreen deployment strategy.
+	BlueGreenParams *BlueGreenDeploymentStrategyParams
 
 	// Resources contains resource requirements to execute the deployment and any hooks.
 	Resources kapi.ResourceRequirements
@@ -199,6 +211,25 @@ type DeploymentStrategy struct {
 	// ActiveDeadlineSeconds is the duration in seconds that the deployer pods for this deployment
 	// config may be active on a node before the system actively tries to terminate them.
 	ActiveDeadlineSeconds *int64
+
+	// DeployerPodTemplate overrides select fields of the deployer pod and any lifecycle
+	// pre/post hook pods. Fields left unset keep their usual defaults.
+	DeployerPodTemplate *DeployerPodTemplateSpec
+}
+
+// DeployerPodTemplateSpec overrides select fields of the pods that execute a deployment
+// and its lifecycle hooks.
+type DeployerPodTemplateSpec struct {
+	// Resources overrides the resource requirements of the deployer and hook pods.
+	Resources *kapi.ResourceRequirements
+	// NodeSelector replaces the node selector the deployer pod inherits from the
+	// deployment's pod template.
+	NodeSelector map[string]string
+	// Tolerations are applied to the deployer and hook pods through the
+	// scheduler.alpha.kubernetes.io/tolerations annotation.
+	Tolerations []kapi.Toleration
+	// ServiceAccountName overrides the service account the deployer pod runs as.
+	ServiceAccountName string
 }
 
 // DeploymentStrategyType refers to a specific DeploymentStrategy implementation.
@@ -211,6 +242,9 @@ const (
 	DeploymentStrategyTypeCustom DeploymentStrategyType = "Custom"
 	// DeploymentStrategyTypeRolling uses the Kubernetes RollingUpdater.
 	DeploymentStrategyTypeRolling DeploymentStrategyType = "Rolling"
+	// DeploymentStrategyTypeBlueGreen deploys the new version under a parallel service
+	// and switches route backends to it once validation succeeds.
+	DeploymentStrategyTypeBlueGreen DeploymentStrategyType = "BlueGreen"
 )
 
 // CustomDeploymentStrategyParams are the input to the Custom deployment strategy.
@@ -287,6 +321,33 @@ type RollingDeploymentStrategyParams struct {
 	Post *LifecycleHook
 }
 
+// BlueGreenDeploymentStrategyParams are the input to the BlueGreen deployment
+// strategy. The strategy scales the new deployment up under a parallel service
+// while the route still points at the old deployment, runs the Validate hook
+// against it, then atomically switches the route backends to the new service. The
+// old deployment is kept running so traffic can be switched back for a fast
+// rollback.
+type BlueGreenDeploymentStrategyParams struct {
+	// TimeoutSeconds is the time to wait for updates before giving up. If the
+	// value is nil, a default will be used.
+	TimeoutSeconds *int64
+	// Route is the name of the route whose backends are switched to the new
+	// deployment's service once validation succeeds. The route must exist in the
+	// namespace of the deployment config.
+	Route string
+	// Pre is a lifecycle hook which is executed before the new deployment is
+	// scaled up. All LifecycleHookFailurePolicy values are supported.
+	Pre *LifecycleHook
+	// Validate is a lifecycle hook which is executed after the new deployment is
+	// fully scaled up under the parallel service but before the route is switched.
+	// A failing hook with the Abort policy leaves the route pointing at the old
+	// deployment. All LifecycleHookFailurePolicy values are supported.
+	Validate *LifecycleHook
+	// Post is a lifecycle hook which is executed after the route has been
+	// switched. All LifecycleHookFailurePolicy values are supported.
+	Post *LifecycleHook
+}
+
 // LifecycleHook defines a specific deployment lifecycle action. Only one type of action may be specified at any time.
 type LifecycleHook struct {
 	// FailurePolicy specifies what action to take if the hook fails.
@@ -343,6 +404,10 @@ type DeploymentTriggerPolicy struct {
 	Type DeploymentTriggerType
 	// ImageChangeParams represents the parameters for the ImageChange trigger.
 	ImageChangeParams *DeploymentTriggerImageChangeParams
+	// ConfigSourceParams represents the parameters for the ConfigSourceChange trigger.
+	ConfigSourceParams *DeploymentTriggerConfigSourceParams
+	// WebHookParams represents the parameters for the WebHook trigger.
+	WebHookParams *DeploymentTriggerWebHookParams
 }
 
 // DeploymentTriggerType refers to a specific DeploymentTriggerPolicy implementation.
@@ -357,6 +422,12 @@ const (
 	// DeploymentTriggerOnConfigChange will create new deployments in response to changes to
 	// the ControllerTemplate of a DeploymentConfig.
 	DeploymentTriggerOnConfigChange DeploymentTriggerType = "ConfigChange"
+	// DeploymentTriggerOnConfigSourceChange will create new deployments in response to changes
+	// to the content of ConfigMaps or Secrets referenced by the DeploymentConfig.
+	DeploymentTriggerOnConfigSourceChange DeploymentTriggerType = "ConfigSourceChange"
+	// DeploymentTriggerOnWebHook will create new deployments in response to secret-authenticated
+	// requests to the deployment config's webhooks subresource.
+	DeploymentTriggerOnWebHook DeploymentTriggerType = "WebHook"
 )
 
 // DeploymentTriggerImageChangeParams represents the parameters to the ImageChange trigger.
@@ -372,6 +443,43 @@ type DeploymentTriggerImageChangeParams struct {
 	From kapi.ObjectReference
 	// LastTriggeredImage is the last image to be triggered.
 	LastTriggeredImage string
+	// ApprovalRequired means that an observed image update is recorded in the
+	// deployment config status as a pending image and is only rolled out once
+	// it has been approved.
+	ApprovalRequired bool
+}
+
+// DeploymentTriggerConfigSourceParams represents the parameters to the ConfigSourceChange trigger.
+type DeploymentTriggerConfigSourceParams struct {
+	// Automatic means that detection of a change to a watched ConfigMap or Secret should
+	// result in a new deployment.
+	Automatic bool
+	// ConfigMaps are the names of ConfigMaps in the deployment config's namespace whose
+	// content should be watched for changes.
+	ConfigMaps []string
+	// Secrets are the names of Secrets in the deployment config's namespace whose content
+	// should be watched for changes.
+	Secrets []string
+	// LastTriggeredHashes records, per watched object, the content hash observed when the
+	// trigger last fired, keyed by "configmap/<name>" or "secret/<name>". This field is
+	// maintained by the system.
+	LastTriggeredHashes map[string]string
+}
+
+// DeploymentTriggerWebHookParams represents the parameters to the WebHook trigger.
+type DeploymentTriggerWebHookParams struct {
+	// Secret used to validate requests.
+	Secret string
+	// AllowEnv determines whether the webhook can set environment variables on the
+	// deployment config's containers.
+	AllowEnv bool
+}
+
+// DeploymentWebHookEvent is the payload expected for a deployment config webhook post
+type DeploymentWebHookEvent struct {
+	// Env contains environment variables you want to set on the deployment config's
+	// containers before the new deployment is started. ValueFrom is not supported.
+	Env []kapi.EnvVar
 }
 
 // DeploymentConfigStatus represents the current deployment state.
@@ -397,6 +505,22 @@ type DeploymentConfigStatus struct {
 	Conditions []DeploymentCondition
 	// Total number of ready pods targeted by this deployment.
 	ReadyReplicas int32
+	// PendingCauses are trigger causes that were observed while the deployment config
+	// was paused. They are cleared when the next deployment is instantiated after the
+	// config is resumed.
+	PendingCauses []DeploymentCause
+	// PendingImages records image updates observed for approval-required image
+	// change triggers that have not yet been approved for rollout.
+	PendingImages []PendingImage
+}
+
+// PendingImage is an image update observed for an approval-required image change
+// trigger that is waiting for approval before it is rolled out.
+type PendingImage struct {
+	// From is the image stream tag the update was observed on.
+	From kapi.ObjectReference
+	// Image is the resolved image reference awaiting approval.
+	Image string
 }
 
 // DeploymentDetails captures information about the causes of a deployment.
@@ -501,6 +625,9 @@ type DeploymentRequest struct {
 	// Force will try to force a new deployment to run. If the deployment config is paused,
 	// then setting this to true will return an Invalid error.
 	Force bool
+	// Approve applies the pending images recorded for approval-required image
+	// change triggers when the triggers are processed.
+	Approve bool
 }
 
 // DeploymentLog represents the logs for a deployment
@@ -83,6 +83,8 @@ type DeploymentStrategy struct {
 	RecreateParams *RecreateDeploymentStrategyParams `json:"recreateParams,omitempty" protobuf:"bytes,3,opt,name=recreateParams"`
 	// RollingParams are the input to the Rolling deployment strategy.
 	RollingParams *RollingDeploymentStrategyParams `json:"rollingParams,omitempty" protobuf:"bytes,4,opt,name=rollingParams"`
+	// BlueGreenParams are the input to the BlueGreen deployment strategy.
+	BlueGreenParams *BlueGreenDeploymentStrategyParams `json:"blueGreenParams,omitempty" protobuf:"bytes,9,opt,name=blueGreenParams"`
 
 	// Resources contains resource requirements to execute the deployment and any hooks.
 	Resources kapi.ResourceRequirements `json:"resources,omitempty" protobuf:"bytes,5,opt,name=resources"`
@@ -94,6 +96,25 @@ type DeploymentStrategy struct {
 	// ActiveDeadlineSeconds is the duration in seconds that the deployer pods for this deployment
 	// config may be active on a node before the system actively tries to terminate them.
 	ActiveDeadlineSeconds *int64 `json:"activeDeadlineSeconds,omitempty" protobuf:"varint,8,opt,name=activeDeadlineSeconds"`
+
+	// DeployerPodTemplate overrides select fields of the deployer pod and any lifecycle
+	// pre/post hook pods. Fields left unset keep their usual defaults.
+	DeployerPodTemplate *DeployerPodTemplateSpec `json:"deployerPodTemplate,omitempty" protobuf:"bytes,10,opt,name=deployerPodTemplate"`
+}
+
+// DeployerPodTemplateSpec overrides select fields of the pods that execute a deployment
+// and its lifecycle hooks.
+type DeployerPodTemplateSpec struct {
+	// Resources overrides the resource requirements of the deployer and hook pods.
+	Resources *kapi.ResourceRequirements `json:"resources,omitempty" protobuf:"bytes,1,opt,name=resources"`
+	// NodeSelector replaces the node selector the deployer pod inherits from the
+	// deployment's pod template.
+	NodeSelector map[string]string `json:"nodeSelector,omitempty" protobuf:"bytes,2,rep,name=nodeSelector"`
+	// Tolerations are applied to the deployer and hook pods through the
+	// scheduler.alpha.kubernetes.io/tolerations annotation.
+	Tolerations []kapi.Toleration `json:"tolerations,omitempty" protobuf:"bytes,3,rep,name=tolerations"`
+	// ServiceAccountName overrides the service account the deployer pod runs as.
+	ServiceAccountName string `json:"serviceAccountName,omitempty" protobuf:"bytes,4,opt,name=serviceAccountName"`
 }
 
 // DeploymentStrategyType refers to a specific DeploymentStrategy implementation.
@@ -106,6 +127,9 @@ const (
 	DeploymentStrategyTypeCustom DeploymentStrategyType = "Custom"
 	// DeploymentStrategyTypeRolling uses the Kubernetes RollingUpdater.
 	DeploymentStrategyTypeRolling DeploymentStrategyType = "Rolling"
+	// DeploymentStrategyTypeBlueGreen deploys the new version under a parallel service
+	// and switches route backends to it once validation succeeds.
+	DeploymentStrategyTypeBlueGreen DeploymentStrategyType = "BlueGreen"
 )
 
 // CustomDeploymentStrategyParams are the input to the Custom deployment strategy.
@@ -182,6 +206,33 @@ type RollingDeploymentStrategyParams struct {
 	Post *LifecycleHook `json:"post,omitempty" protobuf:"bytes,8,opt,name=post"`
 }
 
+// BlueGreenDeploymentStrategyParams are the input to the BlueGreen deployment
+// strategy. The strategy scales the new deployment up under a parallel service
+// while the route still points at the old deployment, runs the Validate hook
+// against it, then atomically switches the route backends to the new service. The
+// old deployment is kept running so traffic can be switched back for a fast
+// rollback.
+type BlueGreenDeploymentStrategyParams struct {
+	// TimeoutSeconds is the time to wait for updates before giving up. If the
+	// value is nil, a default will be used.
+	TimeoutSeconds *int64 `json:"timeoutSeconds,omitempty" protobuf:"varint,1,opt,name=timeoutSeconds"`
+	// Route is the name of the route whose backends are switched to the new
+	// deployment's service once validation succeeds. The route must exist in the
+	// namespace of the deployment config.
+	Route string `json:"route" protobuf:"bytes,2,opt,name=route"`
+	// Pre is a lifecycle hook which is executed before the new deployment is
+	// scaled up. All LifecycleHookFailurePolicy values are supported.
+	Pre *LifecycleHook `json:"pre,omitempty" protobuf:"bytes,3,opt,name=pre"`
+	// Validate is a lifecycle hook which is executed after the new deployment is
+	// fully scaled up under the parallel service but before the route is switched.
+	// A failing hook with the Abort policy leaves the route pointing at the old
+	// deployment. All LifecycleHookFailurePolicy values are supported.
+	Validate *LifecycleHook `json:"validate,omitempty" protobuf:"bytes,4,opt,name=validate"`
+	// Post is a lifecycle hook which is executed after the route has been
+	// switched. All LifecycleHookFailurePolicy values are supported.
+	Post *LifecycleHook `json:"post,omitempty" protobuf:"bytes,5,opt,name=post"`
+}
+
 // LifecycleHook defines a specific deployment lifecycle action. Only one type of action may be specified at any time.
 type LifecycleHook struct {
 	// FailurePolicy specifies what action to take if the hook fails.
@@ -247,6 +298,10 @@ type DeploymentTriggerPolicy struct {
 	Type DeploymentTriggerType `json:"type,omitempty" protobuf:"bytes,1,opt,name=type,casttype=DeploymentTriggerType"`
 	// ImageChangeParams represents the parameters for the ImageChange trigger.
 	ImageChangeParams *DeploymentTriggerImageChangeParams `json:"imageChangeParams,omitempty" protobuf:"bytes,2,opt,name=imageChangeParams"`
+	// ConfigSourceParams represents the parameters for the ConfigSourceChange trigger.
+	ConfigSourceParams *DeploymentTriggerConfigSourceParams `json:"configSourceParams,omitempty" protobuf:"bytes,3,opt,name=configSourceParams"`
+	// WebHookParams represents the parameters for the WebHook trigger.
+	WebHookParams *DeploymentTriggerWebHookParams `json:"webHookParams,omitempty" protobuf:"bytes,4,opt,name=webHookParams"`
 }
 
 // DeploymentTriggerType refers to a specific DeploymentTriggerPolicy implementation.
@@ -259,6 +314,12 @@ const (
 	// DeploymentTriggerOnConfigChange will create new deployments in response to changes to
 	// the ControllerTemplate of a DeploymentConfig.
 	DeploymentTriggerOnConfigChange DeploymentTriggerType = "ConfigChange"
+	// DeploymentTriggerOnConfigSourceChange will create new deployments in response to changes
+	// to the content of ConfigMaps or Secrets referenced by the DeploymentConfig.
+	DeploymentTriggerOnConfigSourceChange DeploymentTriggerType = "ConfigSourceChange"
+	// DeploymentTriggerOnWebHook will create new deployments in response to secret-authenticated
+	// requests to the deployment config's webhooks subresource.
+	DeploymentTriggerOnWebHook DeploymentTriggerType = "WebHook"
 )
 
 // DeploymentTriggerImageChangeParams represents the parameters to the ImageChange trigger.
@@ -274,6 +335,43 @@ type DeploymentTriggerImageChangeParams struct {
 	From kapi.ObjectReference `json:"from" protobuf:"bytes,3,opt,name=from"`
 	// LastTriggeredImage is the last image to be triggered.
 	LastTriggeredImage string `json:"lastTriggeredImage,omitempty" protobuf:"bytes,4,opt,name=lastTriggeredImage"`
+	// ApprovalRequired means that an observed image update is recorded in the
+	// deployment config status as a pending image and is only rolled out once
+	// it has been approved.
+	ApprovalRequired bool `json:"approvalRequired,omitempty" protobuf:"varint,5,opt,name=approvalRequired"`
+}
+
+// DeploymentTriggerConfigSourceParams represents the parameters to the ConfigSourceChange trigger.
+type DeploymentTriggerConfigSourceParams struct {
+	// Automatic means that detection of a change to a watched ConfigMap or Secret should
+	// result in a new deployment.
+	Automatic bool `json:"automatic,omitempty" protobuf:"varint,1,opt,name=automatic"`
+	// ConfigMaps are the names of ConfigMaps in the deployment config's namespace whose
+	// content should be watched for changes.
+	ConfigMaps []string `json:"configMaps,omitempty" protobuf:"bytes,2,rep,name=configMaps"`
+	// Secrets are the names of Secrets in the deployment config's namespace whose content
+	// should be watched for changes.
+	Secrets []string `json:"secrets,omitempty" protobuf:"bytes,3,rep,name=secrets"`
+	// LastTriggeredHashes records, per watched object, the content hash observed when the
+	// trigger last fired, keyed by "configmap/<name>" or "secret/<name>". This field is
+	// maintained by the system.
+	LastTriggeredHashes map[string]string `json:"lastTriggeredHashes,omitempty" protobuf:"bytes,4,rep,name=lastTriggeredHashes"`
+}
+
+// DeploymentTriggerWebHookParams represents the parameters to the WebHook trigger.
+type DeploymentTriggerWebHookParams struct {
+	// Secret used to validate requests.
+	Secret string `json:"secret,omitempty" protobuf:"bytes,1,opt,name=secret"`
+	// AllowEnv determines whether the webhook can set environment variables on the
+	// deployment config's containers.
+	AllowEnv bool `json:"allowEnv,omitempty" protobuf:"varint,2,opt,name=allowEnv"`
+}
+
+// DeploymentWebHookEvent is the payload expected for a deployment config webhook post
+type DeploymentWebHookEvent struct {
+	// Env contains environment variables you want to set on the deployment config's
+	// containers before the new deployment is started. ValueFrom is not supported.
+	Env []kapi.EnvVar `json:"env,omitempty" protobuf:"bytes,1,rep,name=env"`
 }
 
 // DeploymentConfigStatus represents the current deployment state.
@@ -299,6 +397,22 @@ type DeploymentConfigStatus struct {
 	Conditions []DeploymentCondition `json:"conditions,omitempty" patchStrategy:"merge" patchMergeKey:"type" protobuf:"bytes,8,rep,name=conditions"`
 	// Total number of ready pods targeted by this deployment.
 	ReadyReplicas int32 `json:"readyReplicas,omitempty" protobuf:"varint,9,opt,name=readyReplicas"`
+	// PendingCauses are trigger causes that were observed while the deployment config
+	// was paused. They are cleared when the next deployment is instantiated after the
+	// config is resumed.
+	PendingCauses []DeploymentCause `json:"pendingCauses,omitempty" protobuf:"bytes,10,rep,name=pendingCauses"`
+	// PendingImages records image updates observed for approval-required image
+	// change triggers that have not yet been approved for rollout.
+	PendingImages []PendingImage `json:"pendingImages,omitempty" protobuf:"bytes,11,rep,name=pendingImages"`
+}
+
+// PendingImage is an image update observed for an approval-required image change
+// trigger that is waiting for approval before it is rolled out.
+type PendingImage struct {
+	// From is the image stream tag the update was observed on.
+	From kapi.ObjectReference `json:"from" protobuf:"bytes,1,opt,name=from"`
+	// Image is the resolved image reference awaiting approval.
+	Image string `json:"image" protobuf:"bytes,2,opt,name=image"`
 }
 
 // DeploymentDetails captures information about the causes of a deployment.
@@ -405,6 +519,9 @@ type DeploymentRequest struct {
 	// Force will try to force a new deployment to run. If the deployment config is paused,
 	// then setting this to true will return an Invalid error.
 	Force bool `json:"force" protobuf:"varint,3,opt,name=force"`
+	// Approve applies the pending images recorded for approval-required image
+	// change triggers when the triggers are processed.
+	Approve bool `json:"approve,omitempty" protobuf:"varint,4,opt,name=approve"`
 }
 
 // DeploymentLog represents the logs for a deployment
@@ -22,8 +22,12 @@ func init() {
 // Public to allow building arbitrary schemes.
 func RegisterConversions(scheme *runtime.Scheme) error {
 	return scheme.AddGeneratedConversionFuncs(
+		Convert_v1_BlueGreenDeploymentStrategyParams_To_api_BlueGreenDeploymentStrategyParams,
+		Convert_api_BlueGreenDeploymentStrategyParams_To_v1_BlueGreenDeploymentStrategyParams,
 		Convert_v1_CustomDeploymentStrategyParams_To_api_CustomDeploymentStrategyParams,
 		Convert_api_CustomDeploymentStrategyParams_To_v1_CustomDeploymentStrategyParams,
+		Convert_v1_DeployerPodTemplateSpec_To_api_DeployerPodTemplateSpec,
+		Convert_api_DeployerPodTemplateSpec_To_v1_DeployerPodTemplateSpec,
 		Convert_v1_DeploymentCause_To_api_DeploymentCause,
 		Convert_api_DeploymentCause_To_v1_DeploymentCause,
 		Convert_v1_DeploymentCauseImageTrigger_To_api_DeploymentCauseImageTrigger,
@@ -60,6 +64,8 @@ func RegisterConversions(scheme *runtime.Scheme) error {
 		Convert_api_ExecNewPodHook_To_v1_ExecNewPodHook,
 		Convert_v1_LifecycleHook_To_api_LifecycleHook,
 		Convert_api_LifecycleHook_To_v1_LifecycleHook,
+		Convert_v1_PendingImage_To_api_PendingImage,
+		Convert_api_PendingImage_To_v1_PendingImage,
 		Convert_v1_RecreateDeploymentStrategyParams_To_api_RecreateDeploymentStrategyParams,
 		Convert_api_RecreateDeploymentStrategyParams_To_v1_RecreateDeploymentStrategyParams,
 		Convert_v1_RollingDeploymentStrategyParams_To_api_RollingDeploymentStrategyParams,
@@ -69,6 +75,80 @@ func RegisterConversions(scheme *runtime.Scheme) error {
 	)
 }
 
+func autoConvert_v1_BlueGreenDeploymentStrategyParams_To_api_BlueGreenDeploymentStrategyParams(in *BlueGreenDeploymentStrategyParams, out *api.BlueGreenDeploymentStrategyParams, s conversion.Scope) error {
+	out.TimeoutSeconds = (*int64)(unsafe.Pointer(in.TimeoutSeconds))
+	out.Route = in.Route
+	if in.Pre != nil {
+		in, out := &in.Pre, &out.Pre
+		*out = new(api.LifecycleHook)
+		if err := Convert_v1_LifecycleHook_To_api_LifecycleHook(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.Pre = nil
+	}
+	if in.Validate != nil {
+		in, out := &in.Validate, &out.Validate
+		*out = new(api.LifecycleHook)
+		if err := Convert_v1_LifecycleHook_To_api_LifecycleHook(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.Validate = nil
+	}
+	if in.Post != nil {
+		in, out := &in.Post, &out.Post
+		*out = new(api.LifecycleHook)
+		if err := Convert_v1_LifecycleHook_To_api_LifecycleHook(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.Post = nil
+	}
+	return nil
+}
+
+func Convert_v1_BlueGreenDeploymentStrategyParams_To_api_BlueGreenDeploymentStrategyParams(in *BlueGreenDeploymentStrategyParams, out *api.BlueGreenDeploymentStrategyParams, s conversion.Scope) error {
+	return autoConvert_v1_BlueGreenDeploymentStrategyParams_To_api_BlueGreenDeploymentStrategyParams(in, out, s)
+}
+
+func autoConvert_api_BlueGreenDeploymentStrategyParams_To_v1_BlueGreenDeploymentStrategyParams(in *api.BlueGreenDeploymentStrategyParams, out *BlueGreenDeploymentStrategyParams, s conversion.Scope) error {
+	out.TimeoutSeconds = (*int64)(unsafe.Pointer(in.TimeoutSeconds))
+	out.Route = in.Route
+	if in.Pre != nil {
+		in, out := &in.Pre, &out.Pre
+		*out = new(LifecycleHook)
+		if err := Convert_api_LifecycleHook_To_v1_LifecycleHook(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.Pre = nil
+	}
+	if in.Validate != nil {
+		in, out := &in.Validate, &out.Validate
+		*out = new(LifecycleHook)
+		if err := Convert_api_LifecycleHook_To_v1_LifecycleHook(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.Validate = nil
+	}
+	if in.Post != nil {
+		in, out := &in.Post, &out.Post
+		*out = new(LifecycleHook)
+		if err := Convert_api_LifecycleHook_To_v1_LifecycleHook(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.Post = nil
+	}
+	return nil
+}
+
+func Convert_api_BlueGreenDeploymentStrategyParams_To_v1_BlueGreenDeploymentStrategyParams(in *api.BlueGreenDeploymentStrategyParams, out *BlueGreenDeploymentStrategyParams, s conversion.Scope) error {
+	return autoConvert_api_BlueGreenDeploymentStrategyParams_To_v1_BlueGreenDeploymentStrategyParams(in, out, s)
+}
+
 func autoConvert_v1_CustomDeploymentStrategyParams_To_api_CustomDeploymentStrategyParams(in *CustomDeploymentStrategyParams, out *api.CustomDeploymentStrategyParams, s conversion.Scope) error {
 	out.Image = in.Image
 	if in.Environment != nil {
@@ -111,6 +191,66 @@ func Convert_api_CustomDeploymentStrategyParams_To_v1_CustomDeploymentStrategyPa
 	return autoConvert_api_CustomDeploymentStrategyParams_To_v1_CustomDeploymentStrategyParams(in, out, s)
 }
 
+func autoConvert_v1_DeployerPodTemplateSpec_To_api_DeployerPodTemplateSpec(in *DeployerPodTemplateSpec, out *api.DeployerPodTemplateSpec, s conversion.Scope) error {
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = new(pkg_api.ResourceRequirements)
+		if err := api_v1.Convert_v1_ResourceRequirements_To_api_ResourceRequirements(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.Resources = nil
+	}
+	out.NodeSelector = *(*map[string]string)(unsafe.Pointer(&in.NodeSelector))
+	if in.Tolerations != nil {
+		in, out := &in.Tolerations, &out.Tolerations
+		*out = make([]pkg_api.Toleration, len(*in))
+		for i := range *in {
+			if err := api_v1.Convert_v1_Toleration_To_api_Toleration(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Tolerations = nil
+	}
+	out.ServiceAccountName = in.ServiceAccountName
+	return nil
+}
+
+func Convert_v1_DeployerPodTemplateSpec_To_api_DeployerPodTemplateSpec(in *DeployerPodTemplateSpec, out *api.DeployerPodTemplateSpec, s conversion.Scope) error {
+	return autoConvert_v1_DeployerPodTemplateSpec_To_api_DeployerPodTemplateSpec(in, out, s)
+}
+
+func autoConvert_api_DeployerPodTemplateSpec_To_v1_DeployerPodTemplateSpec(in *api.DeployerPodTemplateSpec, out *DeployerPodTemplateSpec, s conversion.Scope) error {
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = new(api_v1.ResourceRequirements)
+		if err := api_v1.Convert_api_ResourceRequirements_To_v1_ResourceRequirements(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.Resources = nil
+	}
+	out.NodeSelector = *(*map[string]string)(unsafe.Pointer(&in.NodeSelector))
+	if in.Tolerations != nil {
+		in, out := &in.Tolerations, &out.Tolerations
+		*out = make([]api_v1.Toleration, len(*in))
+		for i := range *in {
+			if err := api_v1.Convert_api_Toleration_To_v1_Toleration(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Tolerations = nil
+	}
+	out.ServiceAccountName = in.ServiceAccountName
+	return nil
+}
+
+func Convert_api_DeployerPodTemplateSpec_To_v1_DeployerPodTemplateSpec(in *api.DeployerPodTemplateSpec, out *DeployerPodTemplateSpec, s conversion.Scope) error {
+	return autoConvert_api_DeployerPodTemplateSpec_To_v1_DeployerPodTemplateSpec(in, out, s)
+}
+
 func autoConvert_v1_DeploymentCause_To_api_DeploymentCause(in *DeploymentCause, out *api.DeploymentCause, s conversion.Scope) error {
 	out.Type = api.DeploymentTriggerType(in.Type)
 	if in.ImageTrigger != nil {
@@ -421,6 +561,28 @@ func autoConvert_v1_DeploymentConfigStatus_To_api_DeploymentConfigStatus(in *Dep
 	}
 	out.Conditions = *(*[]api.DeploymentCondition)(unsafe.Pointer(&in.Conditions))
 	out.ReadyReplicas = in.ReadyReplicas
+	if in.PendingCauses != nil {
+		in, out := &in.PendingCauses, &out.PendingCauses
+		*out = make([]api.DeploymentCause, len(*in))
+		for i := range *in {
+			if err := Convert_v1_DeploymentCause_To_api_DeploymentCause(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.PendingCauses = nil
+	}
+	if in.PendingImages != nil {
+		in, out := &in.PendingImages, &out.PendingImages
+		*out = make([]api.PendingImage, len(*in))
+		for i := range *in {
+			if err := Convert_v1_PendingImage_To_api_PendingImage(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.PendingImages = nil
+	}
 	return nil
 }
 
@@ -446,6 +608,28 @@ func autoConvert_api_DeploymentConfigStatus_To_v1_DeploymentConfigStatus(in *api
 	}
 	out.Conditions = *(*[]DeploymentCondition)(unsafe.Pointer(&in.Conditions))
 	out.ReadyReplicas = in.ReadyReplicas
+	if in.PendingCauses != nil {
+		in, out := &in.PendingCauses, &out.PendingCauses
+		*out = make([]DeploymentCause, len(*in))
+		for i := range *in {
+			if err := Convert_api_DeploymentCause_To_v1_DeploymentCause(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.PendingCauses = nil
+	}
+	if in.PendingImages != nil {
+		in, out := &in.PendingImages, &out.PendingImages
+		*out = make([]PendingImage, len(*in))
+		for i := range *in {
+			if err := Convert_api_PendingImage_To_v1_PendingImage(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.PendingImages = nil
+	}
 	return nil
 }
 
@@ -549,6 +733,7 @@ func autoConvert_v1_DeploymentRequest_To_api_DeploymentRequest(in *DeploymentReq
 	out.Name = in.Name
 	out.Latest = in.Latest
 	out.Force = in.Force
+	out.Approve = in.Approve
 	return nil
 }
 
@@ -560,6 +745,7 @@ func autoConvert_api_DeploymentRequest_To_v1_DeploymentRequest(in *api.Deploymen
 	out.Name = in.Name
 	out.Latest = in.Latest
 	out.Force = in.Force
+	out.Approve = in.Approve
 	return nil
 }
 
@@ -596,12 +782,30 @@ func autoConvert_v1_DeploymentStrategy_To_api_DeploymentStrategy(in *DeploymentS
 	} else {
 		out.RollingParams = nil
 	}
+	if in.BlueGreenParams != nil {
+		in, out := &in.BlueGreenParams, &out.BlueGreenParams
+		*out = new(api.BlueGreenDeploymentStrategyParams)
+		if err := Convert_v1_BlueGreenDeploymentStrategyParams_To_api_BlueGreenDeploymentStrategyParams(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.BlueGreenParams = nil
+	}
 	if err := api_v1.Convert_v1_ResourceRequirements_To_api_ResourceRequirements(&in.Resources, &out.Resources, s); err != nil {
 		return err
 	}
 	out.Labels = *(*map[string]string)(unsafe.Pointer(&in.Labels))
 	out.Annotations = *(*map[string]string)(unsafe.Pointer(&in.Annotations))
 	out.ActiveDeadlineSeconds = (*int64)(unsafe.Pointer(in.ActiveDeadlineSeconds))
+	if in.DeployerPodTemplate != nil {
+		in, out := &in.DeployerPodTemplate, &out.DeployerPodTemplate
+		*out = new(api.DeployerPodTemplateSpec)
+		if err := Convert_v1_DeployerPodTemplateSpec_To_api_DeployerPodTemplateSpec(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.DeployerPodTemplate = nil
+	}
 	return nil
 }
 
@@ -638,12 +842,30 @@ func autoConvert_api_DeploymentStrategy_To_v1_DeploymentStrategy(in *api.Deploym
 	} else {
 		out.RollingParams = nil
 	}
+	if in.BlueGreenParams != nil {
+		in, out := &in.BlueGreenParams, &out.BlueGreenParams
+		*out = new(BlueGreenDeploymentStrategyParams)
+		if err := Convert_api_BlueGreenDeploymentStrategyParams_To_v1_BlueGreenDeploymentStrategyParams(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.BlueGreenParams = nil
+	}
 	if err := api_v1.Convert_api_ResourceRequirements_To_v1_ResourceRequirements(&in.Resources, &out.Resources, s); err != nil {
 		return err
 	}
 	out.Labels = *(*map[string]string)(unsafe.Pointer(&in.Labels))
 	out.Annotations = *(*map[string]string)(unsafe.Pointer(&in.Annotations))
 	out.ActiveDeadlineSeconds = (*int64)(unsafe.Pointer(in.ActiveDeadlineSeconds))
+	if in.DeployerPodTemplate != nil {
+		in, out := &in.DeployerPodTemplate, &out.DeployerPodTemplate
+		*out = new(DeployerPodTemplateSpec)
+		if err := Convert_api_DeployerPodTemplateSpec_To_v1_DeployerPodTemplateSpec(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.DeployerPodTemplate = nil
+	}
 	return nil
 }
 
@@ -809,6 +1031,30 @@ func Convert_api_LifecycleHook_To_v1_LifecycleHook(in *api.LifecycleHook, out *L
 	return autoConvert_api_LifecycleHook_To_v1_LifecycleHook(in, out, s)
 }
 
+func autoConvert_v1_PendingImage_To_api_PendingImage(in *PendingImage, out *api.PendingImage, s conversion.Scope) error {
+	if err := api_v1.Convert_v1_ObjectReference_To_api_ObjectReference(&in.From, &out.From, s); err != nil {
+		return err
+	}
+	out.Image = in.Image
+	return nil
+}
+
+func Convert_v1_PendingImage_To_api_PendingImage(in *PendingImage, out *api.PendingImage, s conversion.Scope) error {
+	return autoConvert_v1_PendingImage_To_api_PendingImage(in, out, s)
+}
+
+func autoConvert_api_PendingImage_To_v1_PendingImage(in *api.PendingImage, out *PendingImage, s conversion.Scope) error {
+	if err := api_v1.Convert_api_ObjectReference_To_v1_ObjectReference(&in.From, &out.From, s); err != nil {
+		return err
+	}
+	out.Image = in.Image
+	return nil
+}
+
+func Convert_api_PendingImage_To_v1_PendingImage(in *api.PendingImage, out *PendingImage, s conversion.Scope) error {
+	return autoConvert_api_PendingImage_To_v1_PendingImage(in, out, s)
+}
+
 func autoConvert_v1_RecreateDeploymentStrategyParams_To_api_RecreateDeploymentStrategyParams(in *RecreateDeploymentStrategyParams, out *api.RecreateDeploymentStrategyParams, s conversion.Scope) error {
 	out.TimeoutSeconds = (*int64)(unsafe.Pointer(in.TimeoutSeconds))
 	if in.Pre != nil {
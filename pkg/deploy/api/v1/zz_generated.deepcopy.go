@@ -21,7 +21,9 @@ func init() {
 // to allow building arbitrary schemes.
 func RegisterDeepCopies(scheme *runtime.Scheme) error {
 	return scheme.AddGeneratedDeepCopyFuncs(
+		conversion.GeneratedDeepCopyFunc{Fn: DeepCopy_v1_BlueGreenDeploymentStrategyParams, InType: reflect.TypeOf(&BlueGreenDeploymentStrategyParams{})},
 		conversion.GeneratedDeepCopyFunc{Fn: DeepCopy_v1_CustomDeploymentStrategyParams, InType: reflect.TypeOf(&CustomDeploymentStrategyParams{})},
+		conversion.GeneratedDeepCopyFunc{Fn: DeepCopy_v1_DeployerPodTemplateSpec, InType: reflect.TypeOf(&DeployerPodTemplateSpec{})},
 		conversion.GeneratedDeepCopyFunc{Fn: DeepCopy_v1_DeploymentCause, InType: reflect.TypeOf(&DeploymentCause{})},
 		conversion.GeneratedDeepCopyFunc{Fn: DeepCopy_v1_DeploymentCauseImageTrigger, InType: reflect.TypeOf(&DeploymentCauseImageTrigger{})},
 		conversion.GeneratedDeepCopyFunc{Fn: DeepCopy_v1_DeploymentCondition, InType: reflect.TypeOf(&DeploymentCondition{})},
@@ -40,12 +42,56 @@ func RegisterDeepCopies(scheme *runtime.Scheme) error {
 		conversion.GeneratedDeepCopyFunc{Fn: DeepCopy_v1_DeploymentTriggerPolicy, InType: reflect.TypeOf(&DeploymentTriggerPolicy{})},
 		conversion.GeneratedDeepCopyFunc{Fn: DeepCopy_v1_ExecNewPodHook, InType: reflect.TypeOf(&ExecNewPodHook{})},
 		conversion.GeneratedDeepCopyFunc{Fn: DeepCopy_v1_LifecycleHook, InType: reflect.TypeOf(&LifecycleHook{})},
+		conversion.GeneratedDeepCopyFunc{Fn: DeepCopy_v1_PendingImage, InType: reflect.TypeOf(&PendingImage{})},
 		conversion.GeneratedDeepCopyFunc{Fn: DeepCopy_v1_RecreateDeploymentStrategyParams, InType: reflect.TypeOf(&RecreateDeploymentStrategyParams{})},
 		conversion.GeneratedDeepCopyFunc{Fn: DeepCopy_v1_RollingDeploymentStrategyParams, InType: reflect.TypeOf(&RollingDeploymentStrategyParams{})},
 		conversion.GeneratedDeepCopyFunc{Fn: DeepCopy_v1_TagImageHook, InType: reflect.TypeOf(&TagImageHook{})},
 	)
 }
 
+func DeepCopy_v1_BlueGreenDeploymentStrategyParams(in interface{}, out interface{}, c *conversion.Cloner) error {
+	{
+		in := in.(*BlueGreenDeploymentStrategyParams)
+		out := out.(*BlueGreenDeploymentStrategyParams)
+		if in.TimeoutSeconds != nil {
+			in, out := &in.TimeoutSeconds, &out.TimeoutSeconds
+			*out = new(int64)
+			**out = **in
+		} else {
+			out.TimeoutSeconds = nil
+		}
+		out.Route = in.Route
+		if in.Pre != nil {
+			in, out := &in.Pre, &out.Pre
+			*out = new(LifecycleHook)
+			if err := DeepCopy_v1_LifecycleHook(*in, *out, c); err != nil {
+				return err
+			}
+		} else {
+			out.Pre = nil
+		}
+		if in.Validate != nil {
+			in, out := &in.Validate, &out.Validate
+			*out = new(LifecycleHook)
+			if err := DeepCopy_v1_LifecycleHook(*in, *out, c); err != nil {
+				return err
+			}
+		} else {
+			out.Validate = nil
+		}
+		if in.Post != nil {
+			in, out := &in.Post, &out.Post
+			*out = new(LifecycleHook)
+			if err := DeepCopy_v1_LifecycleHook(*in, *out, c); err != nil {
+				return err
+			}
+		} else {
+			out.Post = nil
+		}
+		return nil
+	}
+}
+
 func DeepCopy_v1_CustomDeploymentStrategyParams(in interface{}, out interface{}, c *conversion.Cloner) error {
 	{
 		in := in.(*CustomDeploymentStrategyParams)
@@ -73,6 +119,44 @@ func DeepCopy_v1_CustomDeploymentStrategyParams(in interface{}, out interface{},
 	}
 }
 
+func DeepCopy_v1_DeployerPodTemplateSpec(in interface{}, out interface{}, c *conversion.Cloner) error {
+	{
+		in := in.(*DeployerPodTemplateSpec)
+		out := out.(*DeployerPodTemplateSpec)
+		if in.Resources != nil {
+			in, out := &in.Resources, &out.Resources
+			*out = new(api_v1.ResourceRequirements)
+			if err := api_v1.DeepCopy_v1_ResourceRequirements(*in, *out, c); err != nil {
+				return err
+			}
+		} else {
+			out.Resources = nil
+		}
+		if in.NodeSelector != nil {
+			in, out := &in.NodeSelector, &out.NodeSelector
+			*out = make(map[string]string)
+			for key, val := range *in {
+				(*out)[key] = val
+			}
+		} else {
+			out.NodeSelector = nil
+		}
+		if in.Tolerations != nil {
+			in, out := &in.Tolerations, &out.Tolerations
+			*out = make([]api_v1.Toleration, len(*in))
+			for i := range *in {
+				if err := api_v1.DeepCopy_v1_Toleration(&(*in)[i], &(*out)[i], c); err != nil {
+					return err
+				}
+			}
+		} else {
+			out.Tolerations = nil
+		}
+		out.ServiceAccountName = in.ServiceAccountName
+		return nil
+	}
+}
+
 func DeepCopy_v1_DeploymentCause(in interface{}, out interface{}, c *conversion.Cloner) error {
 	{
 		in := in.(*DeploymentCause)
@@ -267,6 +351,26 @@ func DeepCopy_v1_DeploymentConfigStatus(in interface{}, out interface{}, c *conv
 			out.Conditions = nil
 		}
 		out.ReadyReplicas = in.ReadyReplicas
+		if in.PendingCauses != nil {
+			in, out := &in.PendingCauses, &out.PendingCauses
+			*out = make([]DeploymentCause, len(*in))
+			for i := range *in {
+				if err := DeepCopy_v1_DeploymentCause(&(*in)[i], &(*out)[i], c); err != nil {
+					return err
+				}
+			}
+		} else {
+			out.PendingCauses = nil
+		}
+		if in.PendingImages != nil {
+			in, out := &in.PendingImages, &out.PendingImages
+			*out = make([]PendingImage, len(*in))
+			for i := range *in {
+				(*out)[i] = (*in)[i]
+			}
+		} else {
+			out.PendingImages = nil
+		}
 		return nil
 	}
 }
@@ -357,6 +461,7 @@ func DeepCopy_v1_DeploymentRequest(in interface{}, out interface{}, c *conversio
 		out.Name = in.Name
 		out.Latest = in.Latest
 		out.Force = in.Force
+		out.Approve = in.Approve
 		return nil
 	}
 }
@@ -393,6 +498,15 @@ func DeepCopy_v1_DeploymentStrategy(in interface{}, out interface{}, c *conversi
 		} else {
 			out.RollingParams = nil
 		}
+		if in.BlueGreenParams != nil {
+			in, out := &in.BlueGreenParams, &out.BlueGreenParams
+			*out = new(BlueGreenDeploymentStrategyParams)
+			if err := DeepCopy_v1_BlueGreenDeploymentStrategyParams(*in, *out, c); err != nil {
+				return err
+			}
+		} else {
+			out.BlueGreenParams = nil
+		}
 		if err := api_v1.DeepCopy_v1_ResourceRequirements(&in.Resources, &out.Resources, c); err != nil {
 			return err
 		}
@@ -421,6 +535,15 @@ func DeepCopy_v1_DeploymentStrategy(in interface{}, out interface{}, c *conversi
 		} else {
 			out.ActiveDeadlineSeconds = nil
 		}
+		if in.DeployerPodTemplate != nil {
+			in, out := &in.DeployerPodTemplate, &out.DeployerPodTemplate
+			*out = new(DeployerPodTemplateSpec)
+			if err := DeepCopy_v1_DeployerPodTemplateSpec(*in, *out, c); err != nil {
+				return err
+			}
+		} else {
+			out.DeployerPodTemplate = nil
+		}
 		return nil
 	}
 }
@@ -522,6 +645,16 @@ func DeepCopy_v1_LifecycleHook(in interface{}, out interface{}, c *conversion.Cl
 	}
 }
 
+func DeepCopy_v1_PendingImage(in interface{}, out interface{}, c *conversion.Cloner) error {
+	{
+		in := in.(*PendingImage)
+		out := out.(*PendingImage)
+		out.From = in.From
+		out.Image = in.Image
+		return nil
+	}
+}
+
 func DeepCopy_v1_RecreateDeploymentStrategyParams(in interface{}, out interface{}, c *conversion.Cloner) error {
 	{
 		in := in.(*RecreateDeploymentStrategyParams)
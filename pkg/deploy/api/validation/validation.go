@@ -223,6 +223,12 @@ func validateDeploymentStrategy(strategy *deployapi.DeploymentStrategy, pod *kap
 		} else {
 			errs = append(errs, validateRollingParams(strategy.RollingParams, pod, fldPath.Child("rollingParams"))...)
 		}
+	case deployapi.DeploymentStrategyTypeBlueGreen:
+		if strategy.BlueGreenParams == nil {
+			errs = append(errs, field.Required(fldPath.Child("blueGreenParams"), ""))
+		} else {
+			errs = append(errs, validateBlueGreenParams(strategy.BlueGreenParams, pod, fldPath.Child("blueGreenParams"))...)
+		}
 	case deployapi.DeploymentStrategyTypeCustom:
 		if strategy.CustomParams == nil {
 			errs = append(errs, field.Required(fldPath.Child("customParams"), ""))
@@ -239,6 +245,10 @@ func validateDeploymentStrategy(strategy *deployapi.DeploymentStrategy, pod *kap
 		errs = append(errs, field.Invalid(fldPath.Child("type"), strategy.Type, "unsupported strategy type, use \"Custom\" instead and specify your own strategy"))
 	}
 
+	if strategy.DeployerPodTemplate != nil {
+		errs = append(errs, validateDeployerPodTemplate(strategy.DeployerPodTemplate, fldPath.Child("deployerPodTemplate"))...)
+	}
+
 	if strategy.Labels != nil {
 		errs = append(errs, unversionedvalidation.ValidateLabels(strategy.Labels, fldPath.Child("labels"))...)
 	}
@@ -264,6 +274,24 @@ func validateDeploymentStrategy(strategy *deployapi.DeploymentStrategy, pod *kap
 	return errs
 }
 
+func validateDeployerPodTemplate(template *deployapi.DeployerPodTemplateSpec, fldPath *field.Path) field.ErrorList {
+	errs := field.ErrorList{}
+
+	if template.Resources != nil {
+		errs = append(errs, validation.ValidateResourceRequirements(template.Resources, fldPath.Child("resources"))...)
+	}
+	if template.NodeSelector != nil {
+		errs = append(errs, unversionedvalidation.ValidateLabels(template.NodeSelector, fldPath.Child("nodeSelector"))...)
+	}
+	if len(template.ServiceAccountName) > 0 {
+		for _, msg := range validation.ValidateServiceAccountName(template.ServiceAccountName, false) {
+			errs = append(errs, field.Invalid(fldPath.Child("serviceAccountName"), template.ServiceAccountName, msg))
+		}
+	}
+
+	return errs
+}
+
 func validateCustomParams(params *deployapi.CustomDeploymentStrategyParams, fldPath *field.Path) field.ErrorList {
 	errs := field.ErrorList{}
 
@@ -292,6 +320,30 @@ func validateRecreateParams(params *deployapi.RecreateDeploymentStrategyParams,
 	return errs
 }
 
+func validateBlueGreenParams(params *deployapi.BlueGreenDeploymentStrategyParams, pod *kapi.PodSpec, fldPath *field.Path) field.ErrorList {
+	errs := field.ErrorList{}
+
+	if params.TimeoutSeconds != nil && *params.TimeoutSeconds < 1 {
+		errs = append(errs, field.Invalid(fldPath.Child("timeoutSeconds"), *params.TimeoutSeconds, "must be >0"))
+	}
+
+	if len(params.Route) == 0 {
+		errs = append(errs, field.Required(fldPath.Child("route"), ""))
+	}
+
+	if params.Pre != nil {
+		errs = append(errs, validateLifecycleHook(params.Pre, pod, fldPath.Child("pre"))...)
+	}
+	if params.Validate != nil {
+		errs = append(errs, validateLifecycleHook(params.Validate, pod, fldPath.Child("validate"))...)
+	}
+	if params.Post != nil {
+		errs = append(errs, validateLifecycleHook(params.Post, pod, fldPath.Child("post"))...)
+	}
+
+	return errs
+}
+
 func validateLifecycleHook(hook *deployapi.LifecycleHook, pod *kapi.PodSpec, fldPath *field.Path) field.ErrorList {
 	errs := field.ErrorList{}
 
@@ -428,6 +480,42 @@ func validateTrigger(trigger *deployapi.DeploymentTriggerPolicy, fldPath *field.
 		}
 	}
 
+	if trigger.Type == deployapi.DeploymentTriggerOnConfigSourceChange {
+		if trigger.ConfigSourceParams == nil {
+			errs = append(errs, field.Required(fldPath.Child("configSourceParams"), ""))
+		} else {
+			errs = append(errs, validateConfigSourceParams(trigger.ConfigSourceParams, fldPath.Child("configSourceParams"))...)
+		}
+	}
+
+	if trigger.Type == deployapi.DeploymentTriggerOnWebHook {
+		if trigger.WebHookParams == nil {
+			errs = append(errs, field.Required(fldPath.Child("webHookParams"), ""))
+		} else if len(trigger.WebHookParams.Secret) == 0 {
+			errs = append(errs, field.Required(fldPath.Child("webHookParams", "secret"), ""))
+		}
+	}
+
+	return errs
+}
+
+func validateConfigSourceParams(params *deployapi.DeploymentTriggerConfigSourceParams, fldPath *field.Path) field.ErrorList {
+	errs := field.ErrorList{}
+
+	if len(params.ConfigMaps) == 0 && len(params.Secrets) == 0 {
+		errs = append(errs, field.Required(fldPath, "at least one configMap or secret must be specified"))
+	}
+	for i, name := range params.ConfigMaps {
+		if reasons := kvalidation.IsDNS1123Subdomain(name); len(reasons) != 0 {
+			errs = append(errs, field.Invalid(fldPath.Child("configMaps").Index(i), name, strings.Join(reasons, ", ")))
+		}
+	}
+	for i, name := range params.Secrets {
+		if reasons := kvalidation.IsDNS1123Subdomain(name); len(reasons) != 0 {
+			errs = append(errs, field.Invalid(fldPath.Child("secrets").Index(i), name, strings.Join(reasons, ", ")))
+		}
+	}
+
 	return errs
 }
 
@@ -453,6 +541,10 @@ func validateImageChangeParams(params *deployapi.DeploymentTriggerImageChangePar
 		errs = append(errs, field.Required(fldPath.Child("containerNames"), ""))
 	}
 
+	if params.ApprovalRequired && !params.Automatic {
+		errs = append(errs, field.Invalid(fldPath.Child("approvalRequired"), params.ApprovalRequired, "only automatic triggers can require approval"))
+	}
+
 	return errs
 }
 
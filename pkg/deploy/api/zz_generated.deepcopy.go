@@ -21,7 +21,9 @@ func init() {
 // to allow building arbitrary schemes.
 func RegisterDeepCopies(scheme *runtime.Scheme) error {
 	return scheme.AddGeneratedDeepCopyFuncs(
+		conversion.GeneratedDeepCopyFunc{Fn: DeepCopy_api_BlueGreenDeploymentStrategyParams, InType: reflect.TypeOf(&BlueGreenDeploymentStrategyParams{})},
 		conversion.GeneratedDeepCopyFunc{Fn: DeepCopy_api_CustomDeploymentStrategyParams, InType: reflect.TypeOf(&CustomDeploymentStrategyParams{})},
+		conversion.GeneratedDeepCopyFunc{Fn: DeepCopy_api_DeployerPodTemplateSpec, InType: reflect.TypeOf(&DeployerPodTemplateSpec{})},
 		conversion.GeneratedDeepCopyFunc{Fn: DeepCopy_api_DeploymentCause, InType: reflect.TypeOf(&DeploymentCause{})},
 		conversion.GeneratedDeepCopyFunc{Fn: DeepCopy_api_DeploymentCauseImageTrigger, InType: reflect.TypeOf(&DeploymentCauseImageTrigger{})},
 		conversion.GeneratedDeepCopyFunc{Fn: DeepCopy_api_DeploymentCondition, InType: reflect.TypeOf(&DeploymentCondition{})},
@@ -40,6 +42,7 @@ func RegisterDeepCopies(scheme *runtime.Scheme) error {
 		conversion.GeneratedDeepCopyFunc{Fn: DeepCopy_api_DeploymentTriggerPolicy, InType: reflect.TypeOf(&DeploymentTriggerPolicy{})},
 		conversion.GeneratedDeepCopyFunc{Fn: DeepCopy_api_ExecNewPodHook, InType: reflect.TypeOf(&ExecNewPodHook{})},
 		conversion.GeneratedDeepCopyFunc{Fn: DeepCopy_api_LifecycleHook, InType: reflect.TypeOf(&LifecycleHook{})},
+		conversion.GeneratedDeepCopyFunc{Fn: DeepCopy_api_PendingImage, InType: reflect.TypeOf(&PendingImage{})},
 		conversion.GeneratedDeepCopyFunc{Fn: DeepCopy_api_RecreateDeploymentStrategyParams, InType: reflect.TypeOf(&RecreateDeploymentStrategyParams{})},
 		conversion.GeneratedDeepCopyFunc{Fn: DeepCopy_api_RollingDeploymentStrategyParams, InType: reflect.TypeOf(&RollingDeploymentStrategyParams{})},
 		conversion.GeneratedDeepCopyFunc{Fn: DeepCopy_api_TagImageHook, InType: reflect.TypeOf(&TagImageHook{})},
@@ -47,6 +50,49 @@ func RegisterDeepCopies(scheme *runtime.Scheme) error {
 	)
 }
 
+func DeepCopy_api_BlueGreenDeploymentStrategyParams(in interface{}, out interface{}, c *conversion.Cloner) error {
+	{
+		in := in.(*BlueGreenDeploymentStrategyParams)
+		out := out.(*BlueGreenDeploymentStrategyParams)
+		if in.TimeoutSeconds != nil {
+			in, out := &in.TimeoutSeconds, &out.TimeoutSeconds
+			*out = new(int64)
+			**out = **in
+		} else {
+			out.TimeoutSeconds = nil
+		}
+		out.Route = in.Route
+		if in.Pre != nil {
+			in, out := &in.Pre, &out.Pre
+			*out = new(LifecycleHook)
+			if err := DeepCopy_api_LifecycleHook(*in, *out, c); err != nil {
+				return err
+			}
+		} else {
+			out.Pre = nil
+		}
+		if in.Validate != nil {
+			in, out := &in.Validate, &out.Validate
+			*out = new(LifecycleHook)
+			if err := DeepCopy_api_LifecycleHook(*in, *out, c); err != nil {
+				return err
+			}
+		} else {
+			out.Validate = nil
+		}
+		if in.Post != nil {
+			in, out := &in.Post, &out.Post
+			*out = new(LifecycleHook)
+			if err := DeepCopy_api_LifecycleHook(*in, *out, c); err != nil {
+				return err
+			}
+		} else {
+			out.Post = nil
+		}
+		return nil
+	}
+}
+
 func DeepCopy_api_CustomDeploymentStrategyParams(in interface{}, out interface{}, c *conversion.Cloner) error {
 	{
 		in := in.(*CustomDeploymentStrategyParams)
@@ -74,6 +120,44 @@ func DeepCopy_api_CustomDeploymentStrategyParams(in interface{}, out interface{}
 	}
 }
 
+func DeepCopy_api_DeployerPodTemplateSpec(in interface{}, out interface{}, c *conversion.Cloner) error {
+	{
+		in := in.(*DeployerPodTemplateSpec)
+		out := out.(*DeployerPodTemplateSpec)
+		if in.Resources != nil {
+			in, out := &in.Resources, &out.Resources
+			*out = new(pkg_api.ResourceRequirements)
+			if err := pkg_api.DeepCopy_api_ResourceRequirements(*in, *out, c); err != nil {
+				return err
+			}
+		} else {
+			out.Resources = nil
+		}
+		if in.NodeSelector != nil {
+			in, out := &in.NodeSelector, &out.NodeSelector
+			*out = make(map[string]string)
+			for key, val := range *in {
+				(*out)[key] = val
+			}
+		} else {
+			out.NodeSelector = nil
+		}
+		if in.Tolerations != nil {
+			in, out := &in.Tolerations, &out.Tolerations
+			*out = make([]pkg_api.Toleration, len(*in))
+			for i := range *in {
+				if err := pkg_api.DeepCopy_api_Toleration(&(*in)[i], &(*out)[i], c); err != nil {
+					return err
+				}
+			}
+		} else {
+			out.Tolerations = nil
+		}
+		out.ServiceAccountName = in.ServiceAccountName
+		return nil
+	}
+}
+
 func DeepCopy_api_DeploymentCause(in interface{}, out interface{}, c *conversion.Cloner) error {
 	{
 		in := in.(*DeploymentCause)
@@ -268,6 +352,26 @@ func DeepCopy_api_DeploymentConfigStatus(in interface{}, out interface{}, c *con
 			out.Conditions = nil
 		}
 		out.ReadyReplicas = in.ReadyReplicas
+		if in.PendingCauses != nil {
+			in, out := &in.PendingCauses, &out.PendingCauses
+			*out = make([]DeploymentCause, len(*in))
+			for i := range *in {
+				if err := DeepCopy_api_DeploymentCause(&(*in)[i], &(*out)[i], c); err != nil {
+					return err
+				}
+			}
+		} else {
+			out.PendingCauses = nil
+		}
+		if in.PendingImages != nil {
+			in, out := &in.PendingImages, &out.PendingImages
+			*out = make([]PendingImage, len(*in))
+			for i := range *in {
+				(*out)[i] = (*in)[i]
+			}
+		} else {
+			out.PendingImages = nil
+		}
 		return nil
 	}
 }
@@ -358,6 +462,7 @@ func DeepCopy_api_DeploymentRequest(in interface{}, out interface{}, c *conversi
 		out.Name = in.Name
 		out.Latest = in.Latest
 		out.Force = in.Force
+		out.Approve = in.Approve
 		return nil
 	}
 }
@@ -394,6 +499,15 @@ func DeepCopy_api_DeploymentStrategy(in interface{}, out interface{}, c *convers
 		} else {
 			out.RollingParams = nil
 		}
+		if in.BlueGreenParams != nil {
+			in, out := &in.BlueGreenParams, &out.BlueGreenParams
+			*out = new(BlueGreenDeploymentStrategyParams)
+			if err := DeepCopy_api_BlueGreenDeploymentStrategyParams(*in, *out, c); err != nil {
+				return err
+			}
+		} else {
+			out.BlueGreenParams = nil
+		}
 		if err := pkg_api.DeepCopy_api_ResourceRequirements(&in.Resources, &out.Resources, c); err != nil {
 			return err
 		}
@@ -422,6 +536,15 @@ func DeepCopy_api_DeploymentStrategy(in interface{}, out interface{}, c *convers
 		} else {
 			out.ActiveDeadlineSeconds = nil
 		}
+		if in.DeployerPodTemplate != nil {
+			in, out := &in.DeployerPodTemplate, &out.DeployerPodTemplate
+			*out = new(DeployerPodTemplateSpec)
+			if err := DeepCopy_api_DeployerPodTemplateSpec(*in, *out, c); err != nil {
+				return err
+			}
+		} else {
+			out.DeployerPodTemplate = nil
+		}
 		return nil
 	}
 }
@@ -523,6 +646,16 @@ func DeepCopy_api_LifecycleHook(in interface{}, out interface{}, c *conversion.C
 	}
 }
 
+func DeepCopy_api_PendingImage(in interface{}, out interface{}, c *conversion.Cloner) error {
+	{
+		in := in.(*PendingImage)
+		out := out.(*PendingImage)
+		out.From = in.From
+		out.Image = in.Image
+		return nil
+	}
+}
+
 func DeepCopy_api_RecreateDeploymentStrategyParams(in interface{}, out interface{}, c *conversion.Cloner) error {
 	{
 		in := in.(*RecreateDeploymentStrategyParams)
@@ -10,6 +10,7 @@ import (
 	kclientset "k8s.io/kubernetes/pkg/client/clientset_generated/internalclientset"
 	kcoreclient "k8s.io/kubernetes/pkg/client/clientset_generated/internalclientset/typed/core/internalversion"
 	"k8s.io/kubernetes/pkg/kubectl"
+	"k8s.io/kubernetes/pkg/util/sets"
 
 	"github.com/openshift/origin/pkg/client"
 	deployapi "github.com/openshift/origin/pkg/deploy/api"
@@ -88,6 +89,168 @@ func (h *DeploymentConfigHistoryViewer) ViewHistory(namespace, name string, revi
 	})
 }
 
+// ViewHistoryDiff returns a structured diff of the pod templates of two revisions of a
+// deployment config, covering images, environment variables, and compute resources.
+func (h *DeploymentConfigHistoryViewer) ViewHistoryDiff(namespace, name string, baseRevision, diffRevision int64) (string, error) {
+	opts := kapi.ListOptions{LabelSelector: deployutil.ConfigSelector(name)}
+	deploymentList, err := h.rn.ReplicationControllers(namespace).List(opts)
+	if err != nil {
+		return "", err
+	}
+
+	var base, diff *kapi.PodTemplateSpec
+	for i := range deploymentList.Items {
+		rc := &deploymentList.Items[i]
+		switch deployutil.DeploymentVersionFor(rc) {
+		case baseRevision:
+			base = rc.Spec.Template
+		case diffRevision:
+			diff = rc.Spec.Template
+		}
+	}
+	if base == nil {
+		return "", fmt.Errorf("unable to find revision %d", baseRevision)
+	}
+	if diff == nil {
+		return "", fmt.Errorf("unable to find revision %d", diffRevision)
+	}
+
+	changes := podTemplateChanges(base, diff)
+	if len(changes) == 0 {
+		return fmt.Sprintf("No differences between revisions %d and %d.\n", baseRevision, diffRevision), nil
+	}
+
+	return tabbedString(func(out *tabwriter.Writer) error {
+		fmt.Fprintf(out, "CONTAINER\tFIELD\tREVISION %d\tREVISION %d\n", baseRevision, diffRevision)
+		for _, change := range changes {
+			fmt.Fprintf(out, "%s\t%s\t%s\t%s\n", change.container, change.field, change.base, change.diff)
+		}
+		return nil
+	})
+}
+
+type podTemplateChange struct {
+	container string
+	field     string
+	base      string
+	diff      string
+}
+
+const absentValue = "<none>"
+
+// podTemplateChanges computes per-container differences in image, environment,
+// and compute resources between two pod templates.
+func podTemplateChanges(base, diff *kapi.PodTemplateSpec) []podTemplateChange {
+	baseContainers := map[string]*kapi.Container{}
+	for i := range base.Spec.Containers {
+		baseContainers[base.Spec.Containers[i].Name] = &base.Spec.Containers[i]
+	}
+	diffContainers := map[string]*kapi.Container{}
+	names := []string{}
+	for i := range diff.Spec.Containers {
+		container := &diff.Spec.Containers[i]
+		diffContainers[container.Name] = container
+		names = append(names, container.Name)
+	}
+	for name := range baseContainers {
+		if _, ok := diffContainers[name]; !ok {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	changes := []podTemplateChange{}
+	for _, name := range names {
+		baseContainer, inBase := baseContainers[name]
+		diffContainer, inDiff := diffContainers[name]
+		switch {
+		case !inBase:
+			changes = append(changes, podTemplateChange{name, "container", absentValue, "added"})
+			continue
+		case !inDiff:
+			changes = append(changes, podTemplateChange{name, "container", "present", "removed"})
+			continue
+		}
+
+		if baseContainer.Image != diffContainer.Image {
+			changes = append(changes, podTemplateChange{name, "image", baseContainer.Image, diffContainer.Image})
+		}
+		changes = append(changes, envChanges(name, baseContainer.Env, diffContainer.Env)...)
+		changes = append(changes, resourceChanges(name, "requests", baseContainer.Resources.Requests, diffContainer.Resources.Requests)...)
+		changes = append(changes, resourceChanges(name, "limits", baseContainer.Resources.Limits, diffContainer.Resources.Limits)...)
+	}
+	return changes
+}
+
+func envChanges(container string, base, diff []kapi.EnvVar) []podTemplateChange {
+	baseEnv := envValues(base)
+	diffEnv := envValues(diff)
+	names := sets.NewString()
+	for name := range baseEnv {
+		names.Insert(name)
+	}
+	for name := range diffEnv {
+		names.Insert(name)
+	}
+
+	changes := []podTemplateChange{}
+	for _, name := range names.List() {
+		baseValue, inBase := baseEnv[name]
+		diffValue, inDiff := diffEnv[name]
+		if inBase && inDiff && baseValue == diffValue {
+			continue
+		}
+		if !inBase {
+			baseValue = absentValue
+		}
+		if !inDiff {
+			diffValue = absentValue
+		}
+		changes = append(changes, podTemplateChange{container, "env " + name, baseValue, diffValue})
+	}
+	return changes
+}
+
+func envValues(env []kapi.EnvVar) map[string]string {
+	values := map[string]string{}
+	for _, variable := range env {
+		value := variable.Value
+		if variable.ValueFrom != nil {
+			value = "<set from source>"
+		}
+		values[variable.Name] = value
+	}
+	return values
+}
+
+func resourceChanges(container, kind string, base, diff kapi.ResourceList) []podTemplateChange {
+	names := sets.NewString()
+	for name := range base {
+		names.Insert(string(name))
+	}
+	for name := range diff {
+		names.Insert(string(name))
+	}
+
+	changes := []podTemplateChange{}
+	for _, name := range names.List() {
+		baseQuantity, inBase := base[kapi.ResourceName(name)]
+		diffQuantity, inDiff := diff[kapi.ResourceName(name)]
+		if inBase && inDiff && baseQuantity.Cmp(diffQuantity) == 0 {
+			continue
+		}
+		baseValue, diffValue := absentValue, absentValue
+		if inBase {
+			baseValue = baseQuantity.String()
+		}
+		if inDiff {
+			diffValue = diffQuantity.String()
+		}
+		changes = append(changes, podTemplateChange{container, fmt.Sprintf("%s.%s", kind, name), baseValue, diffValue})
+	}
+	return changes
+}
+
 // TODO: Re-use from an utility package
 func tabbedString(f func(*tabwriter.Writer) error) (string, error) {
 	out := new(tabwriter.Writer)
@@ -0,0 +1,60 @@
+package cmd
+
+import (
+	"reflect"
+	"testing"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/api/resource"
+)
+
+func TestPodTemplateChanges(t *testing.T) {
+	base := &kapi.PodTemplateSpec{
+		Spec: kapi.PodSpec{
+			Containers: []kapi.Container{
+				{
+					Name:  "app",
+					Image: "repo/app:v1",
+					Env:   []kapi.EnvVar{{Name: "MODE", Value: "dev"}, {Name: "UNCHANGED", Value: "same"}},
+					Resources: kapi.ResourceRequirements{
+						Requests: kapi.ResourceList{kapi.ResourceCPU: resource.MustParse("100m")},
+					},
+				},
+				{Name: "sidecar", Image: "repo/sidecar:v1"},
+			},
+		},
+	}
+	diff := &kapi.PodTemplateSpec{
+		Spec: kapi.PodSpec{
+			Containers: []kapi.Container{
+				{
+					Name:  "app",
+					Image: "repo/app:v2",
+					Env:   []kapi.EnvVar{{Name: "MODE", Value: "prod"}, {Name: "UNCHANGED", Value: "same"}, {Name: "EXTRA", Value: "yes"}},
+					Resources: kapi.ResourceRequirements{
+						Requests: kapi.ResourceList{kapi.ResourceCPU: resource.MustParse("200m")},
+						Limits:   kapi.ResourceList{kapi.ResourceMemory: resource.MustParse("512Mi")},
+					},
+				},
+			},
+		},
+	}
+
+	expected := []podTemplateChange{
+		{"app", "image", "repo/app:v1", "repo/app:v2"},
+		{"app", "env EXTRA", "<none>", "yes"},
+		{"app", "env MODE", "dev", "prod"},
+		{"app", "requests.cpu", "100m", "200m"},
+		{"app", "limits.memory", "<none>", "512Mi"},
+		{"sidecar", "container", "present", "removed"},
+	}
+
+	changes := podTemplateChanges(base, diff)
+	if !reflect.DeepEqual(changes, expected) {
+		t.Errorf("unexpected changes:\n got %v\nwant %v", changes, expected)
+	}
+
+	if changes := podTemplateChanges(base, base); len(changes) != 0 {
+		t.Errorf("expected no changes for identical templates, got %v", changes)
+	}
+}
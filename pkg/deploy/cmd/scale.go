@@ -1,9 +1,10 @@
 package cmd
 
 import (
+	"fmt"
 	"time"
 
-	kapi "k8s.io/kubernetes/pkg/api"
+	kerrors "k8s.io/kubernetes/pkg/api/errors"
 	kclientset "k8s.io/kubernetes/pkg/client/clientset_generated/internalclientset"
 	kcoreclient "k8s.io/kubernetes/pkg/client/clientset_generated/internalclientset/typed/core/internalversion"
 	"k8s.io/kubernetes/pkg/kubectl"
@@ -44,15 +45,11 @@ func (scaler *DeploymentConfigScaler) Scale(namespace, name string, newSize uint
 	}
 	// TODO: convert to a watch and use resource version from the ScaleCondition - kubernetes/kubernetes#31051
 	if waitForReplicas != nil {
-		dc, err := scaler.dcClient.DeploymentConfigs(namespace).Get(name)
-		if err != nil {
-			return err
-		}
-		rc, err := scaler.rcClient.ReplicationControllers(namespace).Get(util.LatestDeploymentNameForConfig(dc))
-		if err != nil {
-			return err
+		err := wait.Poll(waitForReplicas.Interval, waitForReplicas.Timeout, deploymentHasReadyReplicas(scaler.clientInterface, scaler.dcClient, namespace, name, int32(newSize)))
+		if err == wait.ErrWaitTimeout {
+			return fmt.Errorf("timed out waiting for %q to reach %d ready replicas; check the latest deployment for errors", name, newSize)
 		}
-		return wait.Poll(waitForReplicas.Interval, waitForReplicas.Timeout, controllerHasSpecifiedReplicas(scaler.clientInterface, rc, dc.Spec.Replicas))
+		return err
 	}
 	return nil
 }
@@ -72,28 +69,33 @@ func (scaler *DeploymentConfigScaler) ScaleSimple(namespace, name string, precon
 	return updated.ResourceVersion, nil
 }
 
-// controllerHasSpecifiedReplicas returns a condition that will be true if and
-// only if the specified replica count for a controller's ReplicaSelector
-// equals the Replicas count.
+// deploymentHasReadyReplicas returns a condition that will be true if and only
+// if the deployment that is currently active for the config has the specified
+// number of ready replicas.
 //
-// This is a slightly modified version of
-// unversioned.ControllerHasDesiredReplicas. This  is necessary because when
-// scaling an RC via a DC, the RC spec replica count is not immediately
-// updated to match the owning DC.
-func controllerHasSpecifiedReplicas(c kclientset.Interface, controller *kapi.ReplicationController, specifiedReplicas int32) wait.ConditionFunc {
-	// If we're given a controller where the status lags the spec, it either means that the controller is stale,
-	// or that the rc manager hasn't noticed the update yet. Polling status.Replicas is not safe in the latter case.
-	desiredGeneration := controller.Generation
-
+// The active deployment is resolved again on every poll, because an ongoing
+// rollout or replication controller adoption can replace the deployment the
+// scale operation started against. Polling the replication controller status
+// rather than its spec is necessary because when scaling an RC via a DC, the
+// RC spec replica count is not immediately updated to match the owning DC.
+func deploymentHasReadyReplicas(c kclientset.Interface, oc client.DeploymentConfigsNamespacer, namespace, name string, specifiedReplicas int32) wait.ConditionFunc {
 	return func() (bool, error) {
-		ctrl, err := c.Core().ReplicationControllers(controller.Namespace).Get(controller.Name)
+		dc, err := oc.DeploymentConfigs(namespace).Get(name)
+		if err != nil {
+			return false, err
+		}
+		if dc.Status.LatestVersion == 0 {
+			// No deployment has been created yet; only the template was scaled.
+			return true, nil
+		}
+		ctrl, err := c.Core().ReplicationControllers(namespace).Get(util.LatestDeploymentNameForConfig(dc))
 		if err != nil {
+			if kerrors.IsNotFound(err) {
+				// The latest deployment has not been created yet, keep waiting.
+				return false, nil
+			}
 			return false, err
 		}
-		// There's a chance a concurrent update modifies the Spec.Replicas causing this check to pass,
-		// or, after this check has passed, a modification causes the rc manager to create more pods.
-		// This will not be an issue once we've implemented graceful delete for rcs, but till then
-		// concurrent stop operations on the same rc might have unintended side effects.
-		return ctrl.Status.ObservedGeneration >= desiredGeneration && ctrl.Status.Replicas == specifiedReplicas, nil
+		return ctrl.Status.Replicas == specifiedReplicas && ctrl.Status.ReadyReplicas == specifiedReplicas, nil
 	}
 }
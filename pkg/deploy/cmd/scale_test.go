@@ -65,6 +65,7 @@ func TestScale(t *testing.T) {
 			config.Spec.Replicas = scale.Spec.Replicas
 			deployment.Spec.Replicas = scale.Spec.Replicas
 			deployment.Status.Replicas = deployment.Spec.Replicas
+			deployment.Status.ReadyReplicas = deployment.Spec.Replicas
 			return true, scale, nil
 		})
 		kc.AddReactor("get", "replicationcontrollers", func(action core.Action) (handled bool, ret runtime.Object, err error) {
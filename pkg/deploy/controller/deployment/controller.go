@@ -80,6 +80,9 @@ type DeploymentController struct {
 	// environment is a set of environment variables which should be injected into all
 	// deployer pod containers.
 	environment []kapi.EnvVar
+	// defaultPodTemplate holds cluster-wide defaults for select fields of deployer pods.
+	// Values set on an individual deployment config take precedence.
+	defaultPodTemplate *deployapi.DeployerPodTemplateSpec
 	// codec is used for deserializing deploymentconfigs from replication controller
 	// annotations.
 	codec runtime.Codec
@@ -363,6 +366,13 @@ func (c *DeploymentController) makeDeployerPod(deployment *kapi.ReplicationContr
 	util.MergeInto(pod.Labels, deploymentConfig.Spec.Strategy.Labels, 0)
 	util.MergeInto(pod.Annotations, deploymentConfig.Spec.Strategy.Annotations, 0)
 
+	// Apply any deployer pod overrides, with the deployment config winning over the
+	// cluster defaults field by field.
+	podTemplate := deployutil.MergeDeployerPodTemplates(deploymentConfig.Spec.Strategy.DeployerPodTemplate, c.defaultPodTemplate)
+	if err := deployutil.ApplyDeployerPodTemplate(pod, podTemplate); err != nil {
+		return nil, err
+	}
+
 	pod.Spec.Containers[0].ImagePullPolicy = kapi.PullIfNotPresent
 
 	return pod, nil
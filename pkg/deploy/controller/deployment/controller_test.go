@@ -32,7 +32,7 @@ func okDeploymentController(client kclientset.Interface, deployment *kapi.Replic
 	rcInformer := cache.NewSharedIndexInformer(&cache.ListWatch{}, &kapi.ReplicationController{}, 2*time.Minute, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc})
 	podInformer := cache.NewSharedIndexInformer(&cache.ListWatch{}, &kapi.Pod{}, 2*time.Minute, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc})
 
-	c := NewDeploymentController(rcInformer, podInformer, client, "sa:test", "openshift/origin-deployer", env, codec)
+	c := NewDeploymentController(rcInformer, podInformer, client, "sa:test", "openshift/origin-deployer", env, nil, codec)
 
 	// deployer pod
 	if deployment != nil {
@@ -192,8 +192,6 @@ func TestHandle_createPodOk(t *testing.T) {
 // TestHandle_createPodFail ensures that an API failure while creating a
 // deployer pod results in a nonfatal error.
 func TestHandle_createPodFail(t *testing.T) {
-	var updatedDeployment *kapi.ReplicationController
-
 	client := &fake.Clientset{}
 	client.AddReactor("create", "pods", func(action core.Action) (handled bool, ret runtime.Object, err error) {
 		name := action.(core.CreateAction).GetObject().(*kapi.Pod).Name
@@ -201,7 +199,6 @@ func TestHandle_createPodFail(t *testing.T) {
 	})
 	client.AddReactor("update", "replicationcontrollers", func(action core.Action) (handled bool, ret runtime.Object, err error) {
 		rc := action.(core.UpdateAction).GetObject().(*kapi.ReplicationController)
-		updatedDeployment = rc
 		return true, rc, nil
 	})
 
@@ -1027,3 +1024,40 @@ func TestDeployerCustomLabelsAndAnnotations(t *testing.T) {
 		expectMapContains(t, podTemplate.Annotations, test.annotations, "annotations")
 	}
 }
+
+func TestDeployerPodTemplateOverrides(t *testing.T) {
+	config := deploytest.OkDeploymentConfig(1)
+	config.Spec.Strategy.DeployerPodTemplate = &deployapi.DeployerPodTemplateSpec{
+		NodeSelector: map[string]string{"region": "primary"},
+		Tolerations:  []kapi.Toleration{{Key: "dedicated", Operator: kapi.TolerationOpEqual, Value: "deploy", Effect: kapi.TaintEffectNoSchedule}},
+	}
+	deployment, _ := deployutil.MakeDeployment(config, codec)
+
+	client := &fake.Clientset{}
+	client.AddReactor("create", "pods", func(action core.Action) (handled bool, ret runtime.Object, err error) {
+		return true, deployerPod(deployment, "", true), nil
+	})
+
+	controller := okDeploymentController(client, nil, nil, true, kapi.PodUnknown)
+	// the deployment config's node selector wins over the cluster default, while the
+	// service account falls through to the default
+	controller.defaultPodTemplate = &deployapi.DeployerPodTemplateSpec{
+		NodeSelector:       map[string]string{"region": "infra"},
+		ServiceAccountName: "privileged-deployer",
+	}
+
+	pod, err := controller.makeDeployerPod(deployment)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if e, a := "primary", pod.Spec.NodeSelector["region"]; e != a {
+		t.Errorf("expected node selector region=%s, got %s", e, a)
+	}
+	if e, a := "privileged-deployer", pod.Spec.ServiceAccountName; e != a {
+		t.Errorf("expected service account %s, got %s", e, a)
+	}
+	if len(pod.Annotations[kapi.TolerationsAnnotationKey]) == 0 {
+		t.Errorf("expected tolerations annotation on the deployer pod")
+	}
+}
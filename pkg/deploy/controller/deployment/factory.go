@@ -15,6 +15,7 @@ import (
 	"k8s.io/kubernetes/pkg/util/wait"
 	"k8s.io/kubernetes/pkg/util/workqueue"
 
+	deployapi "github.com/openshift/origin/pkg/deploy/api"
 	deployutil "github.com/openshift/origin/pkg/deploy/util"
 )
 
@@ -26,7 +27,7 @@ const (
 )
 
 // NewDeploymentController creates a new DeploymentController.
-func NewDeploymentController(rcInformer, podInformer cache.SharedIndexInformer, kc kclientset.Interface, sa, image string, env []kapi.EnvVar, codec runtime.Codec) *DeploymentController {
+func NewDeploymentController(rcInformer, podInformer cache.SharedIndexInformer, kc kclientset.Interface, sa, image string, env []kapi.EnvVar, defaultPodTemplate *deployapi.DeployerPodTemplateSpec, codec runtime.Codec) *DeploymentController {
 	eventBroadcaster := record.NewBroadcaster()
 	eventBroadcaster.StartRecordingToSink(&kcoreclient.EventSinkImpl{Interface: kc.Core().Events("")})
 	recorder := eventBroadcaster.NewRecorder(kapi.EventSource{Component: "deployments-controller"})
@@ -37,11 +38,12 @@ func NewDeploymentController(rcInformer, podInformer cache.SharedIndexInformer,
 
 		queue: workqueue.NewRateLimitingQueue(workqueue.DefaultControllerRateLimiter()),
 
-		serviceAccount: sa,
-		deployerImage:  image,
-		environment:    env,
-		recorder:       recorder,
-		codec:          codec,
+		serviceAccount:     sa,
+		deployerImage:      image,
+		environment:        env,
+		defaultPodTemplate: defaultPodTemplate,
+		recorder:           recorder,
+		codec:              codec,
 	}
 
 	c.rcStore.Indexer = rcInformer.GetIndexer()
@@ -40,9 +40,11 @@ type DeploymentTriggerController struct {
 	codec runtime.Codec
 }
 
-// Handle processes deployment triggers for a deployment config.
+// Handle processes deployment triggers for a deployment config. Paused
+// deployment configs are still instantiated so that the instantiate endpoint
+// can queue the observed trigger causes in their status.
 func (c *DeploymentTriggerController) Handle(config *deployapi.DeploymentConfig) error {
-	if len(config.Spec.Triggers) == 0 || config.Spec.Paused {
+	if len(config.Spec.Triggers) == 0 {
 		return nil
 	}
 
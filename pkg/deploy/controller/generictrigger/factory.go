@@ -90,8 +90,9 @@ func (c *DeploymentTriggerController) waitForSyncedStore(ready chan<- struct{},
 func (c *DeploymentTriggerController) addDeploymentConfig(obj interface{}) {
 	dc := obj.(*deployapi.DeploymentConfig)
 
-	// No need to enqueue deployment configs that have no triggers or are paused.
-	if len(dc.Spec.Triggers) == 0 || dc.Spec.Paused {
+	// No need to enqueue deployment configs that have no triggers. Paused configs
+	// are still enqueued so their trigger causes can be queued in status.
+	if len(dc.Spec.Triggers) == 0 {
 		return
 	}
 	// We don't want to compete with the main deployment config controller. Let's process this
@@ -111,8 +112,9 @@ func (c *DeploymentTriggerController) updateDeploymentConfig(old, cur interface{
 	if newDc.ResourceVersion == oldDc.ResourceVersion {
 		return
 	}
-	// No need to enqueue deployment configs that have no triggers or are paused.
-	if len(newDc.Spec.Triggers) == 0 || newDc.Spec.Paused {
+	// No need to enqueue deployment configs that have no triggers. Paused configs
+	// are still enqueued so their trigger causes can be queued in status.
+	if len(newDc.Spec.Triggers) == 0 {
 		return
 	}
 	// We don't want to compete with the main deployment config controller. Let's process this
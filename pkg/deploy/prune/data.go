@@ -62,6 +62,14 @@ func NewFilterBeforePredicate(d time.Duration) FilterPredicate {
 	}
 }
 
+// NewFilterWithoutAnnotationPredicate is a function that returns true if the deployment does not carry the specified annotation key
+func NewFilterWithoutAnnotationPredicate(key string) FilterPredicate {
+	return func(item *kapi.ReplicationController) bool {
+		_, found := item.Annotations[key]
+		return !found
+	}
+}
+
 // FilterDeploymentsPredicate is a function that returns true if the replication controller is associated with a DeploymentConfig
 func FilterDeploymentsPredicate(item *kapi.ReplicationController) bool {
 	return len(deployutil.DeploymentConfigNameFor(item)) > 0
@@ -82,6 +82,25 @@ func TestFilterBeforePredicate(t *testing.T) {
 	}
 }
 
+func TestFilterWithoutAnnotationPredicate(t *testing.T) {
+	annotated := mockDeployment("a", "annotated", nil)
+	annotated.Annotations["keep"] = ""
+	items := []*kapi.ReplicationController{
+		annotated,
+		mockDeployment("a", "plain", nil),
+	}
+	filter := &andFilter{
+		filterPredicates: []FilterPredicate{NewFilterWithoutAnnotationPredicate("keep")},
+	}
+	result := filter.Filter(items)
+	if len(result) != 1 {
+		t.Errorf("Unexpected number of results")
+	}
+	if expected, actual := "plain", result[0].Name; expected != actual {
+		t.Errorf("expected %v, actual %v", expected, actual)
+	}
+}
+
 func TestEmptyDataSet(t *testing.T) {
 	deployments := []*kapi.ReplicationController{}
 	deploymentConfigs := []*deployapi.DeploymentConfig{}
@@ -41,6 +41,8 @@ type PrunerOptions struct {
 	KeepComplete int
 	// KeepFailed is per DeploymentConfig how many of the most recent failed deployments should be preserved.
 	KeepFailed int
+	// KeepAnnotated if set excludes deployments carrying the annotation key from the candidate prune set.
+	KeepAnnotated string
 	// DeploymentConfigs is the entire list of deploymentconfigs across all namespaces in the cluster.
 	DeploymentConfigs []*deployapi.DeploymentConfig
 	// Deployments is the entire list of deployments across all namespaces in the cluster.
@@ -53,12 +55,16 @@ func NewPruner(options PrunerOptions) Pruner {
 	glog.V(1).Infof("Creating deployment pruner with keepYoungerThan=%v, orphans=%v, keepComplete=%v, keepFailed=%v",
 		options.KeepYoungerThan, options.Orphans, options.KeepComplete, options.KeepFailed)
 
+	filterPredicates := []FilterPredicate{
+		FilterDeploymentsPredicate,
+		FilterZeroReplicaSize,
+		NewFilterBeforePredicate(options.KeepYoungerThan),
+	}
+	if len(options.KeepAnnotated) > 0 {
+		filterPredicates = append(filterPredicates, NewFilterWithoutAnnotationPredicate(options.KeepAnnotated))
+	}
 	filter := &andFilter{
-		filterPredicates: []FilterPredicate{
-			FilterDeploymentsPredicate,
-			FilterZeroReplicaSize,
-			NewFilterBeforePredicate(options.KeepYoungerThan),
-		},
+		filterPredicates: filterPredicates,
 	}
 	deployments := filter.Filter(options.Deployments)
 	dataSet := NewDataSet(options.DeploymentConfigs, deployments)
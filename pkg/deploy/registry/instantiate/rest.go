@@ -58,14 +58,23 @@ func (r *REST) Create(ctx kapi.Context, obj runtime.Object) (runtime.Object, err
 	config := configObj.(*deployapi.DeploymentConfig)
 	old := config
 
+	// Paused deployment configs cannot be instantiated, but we still record the
+	// causes that would have triggered a new deployment so they are visible in
+	// status and can be acted on once the config is resumed.
+	if config.Spec.Paused && !req.Force {
+		return r.recordPendingCauses(ctx, config)
+	}
+
 	if errs := validation.ValidateRequestForDeploymentConfig(req, config); len(errs) > 0 {
 		return nil, errors.NewInvalid(deployapi.Kind("DeploymentRequest"), req.Name, errs)
 	}
 
+	previousPendingImages := config.Status.PendingImages
+
 	// We need to process the deployment config before we can determine if it is possible to trigger
 	// a deployment.
 	if req.Latest {
-		if err := processTriggers(config, r.isn, req.Force); err != nil {
+		if err := processTriggers(config, r.isn, req.Force, req.Approve); err != nil {
 			return nil, err
 		}
 	}
@@ -74,8 +83,15 @@ func (r *REST) Create(ctx kapi.Context, obj runtime.Object) (runtime.Object, err
 	if err != nil {
 		return nil, err
 	}
-	// If we cannot trigger then there is nothing to do here.
+	// If we cannot trigger then there is nothing to do here, but any newly
+	// observed pending images still need to be persisted so they can be
+	// approved later.
 	if !canTrigger {
+		if !kapi.Semantic.DeepEqual(previousPendingImages, config.Status.PendingImages) {
+			if _, _, err := r.store.Update(ctx, config.Name, rest.DefaultUpdatedObjectInfo(config, kapi.Scheme)); err != nil {
+				return nil, err
+			}
+		}
 		return &unversioned.Status{
 			Message: fmt.Sprintf("deployment config %q cannot be instantiated", config.Name),
 			Code:    int32(204),
@@ -94,6 +110,8 @@ func (r *REST) Create(ctx kapi.Context, obj runtime.Object) (runtime.Object, err
 		config.Status.Details.Message = "manual change"
 	}
 	config.Status.LatestVersion++
+	// Any causes that were queued while the config was paused are now obsolete.
+	config.Status.PendingCauses = nil
 
 	userInfo, _ := kapi.UserFrom(ctx)
 	attrs := admission.NewAttributesRecord(config, old, deployapi.Kind("DeploymentConfig").WithVersion(""), config.Namespace, config.Name, deployapi.Resource("DeploymentConfig").WithVersion(""), "", admission.Update, userInfo)
@@ -105,10 +123,59 @@ func (r *REST) Create(ctx kapi.Context, obj runtime.Object) (runtime.Object, err
 	return updated, err
 }
 
+// recordPendingCauses computes the causes that would trigger a new deployment
+// for a paused config and queues them in its status. Queued causes are cleared
+// the next time the config is successfully instantiated.
+func (r *REST) recordPendingCauses(ctx kapi.Context, config *deployapi.DeploymentConfig) (runtime.Object, error) {
+	canTrigger, causes, err := canTrigger(config, r.rn, r.decoder, false)
+	if err != nil {
+		return nil, err
+	}
+	if !canTrigger {
+		return &unversioned.Status{
+			Message: fmt.Sprintf("deployment config %q cannot be instantiated", config.Name),
+			Code:    int32(204),
+		}, nil
+	}
+
+	pending := mergePendingCauses(config.Status.PendingCauses, causes)
+	if len(pending) != len(config.Status.PendingCauses) {
+		config.Status.PendingCauses = pending
+		if _, _, err := r.store.Update(ctx, config.Name, rest.DefaultUpdatedObjectInfo(config, kapi.Scheme)); err != nil {
+			return nil, err
+		}
+	}
+	glog.V(4).Infof("Deployment config %q is paused - queued causes %#v", config.Name, causes)
+
+	return &unversioned.Status{
+		Message: fmt.Sprintf("deployment config %q is paused - new deployment queued", config.Name),
+		Code:    int32(204),
+	}, nil
+}
+
+// mergePendingCauses returns pending extended with any cause from causes that
+// is not already queued.
+func mergePendingCauses(pending, causes []deployapi.DeploymentCause) []deployapi.DeploymentCause {
+	merged := pending
+	for _, cause := range causes {
+		found := false
+		for _, existing := range pending {
+			if kapi.Semantic.DeepEqual(existing, cause) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			merged = append(merged, cause)
+		}
+	}
+	return merged
+}
+
 // processTriggers will go over all deployment triggers that require processing and update
 // the deployment config accordingly. This contains the work that the image change controller
 // had been doing up to the point we got the /instantiate endpoint.
-func processTriggers(config *deployapi.DeploymentConfig, isn client.ImageStreamsNamespacer, force bool) error {
+func processTriggers(config *deployapi.DeploymentConfig, isn client.ImageStreamsNamespacer, force bool, approve bool) error {
 	errs := []error{}
 
 	// Process any image change triggers.
@@ -143,9 +210,18 @@ func processTriggers(config *deployapi.DeploymentConfig, isn client.ImageStreams
 
 		// Ensure a change occurred
 		if len(latestReference) == 0 || latestReference == params.LastTriggeredImage {
+			clearPendingImage(config, params.From)
 			continue
 		}
 
+		// Approval-required triggers only roll out once approved. Record the
+		// observed image in status so it can be approved.
+		if params.ApprovalRequired && !approve {
+			setPendingImage(config, params.From, latestReference)
+			continue
+		}
+		clearPendingImage(config, params.From)
+
 		// Update containers
 		names := sets.NewString(params.ContainerNames...)
 		for i := range config.Spec.Template.Spec.Containers {
@@ -170,6 +246,36 @@ func processTriggers(config *deployapi.DeploymentConfig, isn client.ImageStreams
 	return nil
 }
 
+// setPendingImage records an observed image for an approval-required trigger in
+// the config status, replacing any pending image previously recorded for the
+// same image stream tag.
+func setPendingImage(config *deployapi.DeploymentConfig, from kapi.ObjectReference, image string) {
+	pending := []deployapi.PendingImage{}
+	for _, existing := range config.Status.PendingImages {
+		if existing.From.Name == from.Name && existing.From.Namespace == from.Namespace {
+			continue
+		}
+		pending = append(pending, existing)
+	}
+	config.Status.PendingImages = append(pending, deployapi.PendingImage{From: from, Image: image})
+}
+
+// clearPendingImage removes any pending image recorded for the given image
+// stream tag from the config status.
+func clearPendingImage(config *deployapi.DeploymentConfig, from kapi.ObjectReference) {
+	pending := []deployapi.PendingImage{}
+	for _, existing := range config.Status.PendingImages {
+		if existing.From.Name == from.Name && existing.From.Namespace == from.Namespace {
+			continue
+		}
+		pending = append(pending, existing)
+	}
+	if len(pending) == 0 {
+		pending = nil
+	}
+	config.Status.PendingImages = pending
+}
+
 // canTrigger determines if we can trigger a new deployment for config based on the various deployment triggers.
 func canTrigger(
 	config *deployapi.DeploymentConfig,
@@ -68,7 +68,7 @@ func TestProcess_changeForNonAutomaticTag(t *testing.T) {
 		image := config.Spec.Template.Spec.Containers[0].Image
 
 		// Force equals to false; we shouldn't update the config anyway
-		err := processTriggers(config, fake, test.force)
+		err := processTriggers(config, fake, test.force, false)
 		if err == nil && test.expectedErr {
 			t.Errorf("%s: expected an error", test.name)
 			continue
@@ -102,14 +102,14 @@ func TestProcess_changeForUnregisteredTag(t *testing.T) {
 	image := config.Spec.Template.Spec.Containers[0].Image
 
 	// verify no-op; should be the same for force=true and force=false
-	if err := processTriggers(config, fake, false); err != nil {
+	if err := processTriggers(config, fake, false, false); err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
 	if image != config.Spec.Template.Spec.Containers[0].Image {
 		t.Fatalf("unexpected image update: %#v", config.Spec.Template.Spec.Containers[0].Image)
 	}
 
-	if err := processTriggers(config, fake, true); err != nil {
+	if err := processTriggers(config, fake, true, false); err != nil {
 		t.Fatalf("unexpected error when forced: %v", err)
 	}
 	if image != config.Spec.Template.Spec.Containers[0].Image {
@@ -117,6 +117,50 @@ func TestProcess_changeForUnregisteredTag(t *testing.T) {
 	}
 }
 
+// TestProcess_approvalRequired ensures that an image update behind a trigger
+// that requires approval is recorded as pending rather than applied, and that
+// approving applies it and clears the pending record.
+func TestProcess_approvalRequired(t *testing.T) {
+	config := deploytest.OkDeploymentConfig(1)
+	config.Namespace = kapi.NamespaceDefault
+	config.Spec.Triggers[0].ImageChangeParams.ApprovalRequired = true
+	// The image has been resolved at least once before.
+	config.Spec.Triggers[0].ImageChangeParams.LastTriggeredImage = deploytest.DockerImageReference
+
+	stream := deploytest.OkStreamForConfig(config)
+	config.Spec.Triggers[0].ImageChangeParams.LastTriggeredImage = "someotherresolveddockerimagereference"
+
+	fake := &testclient.Fake{}
+	fake.AddReactor("get", "imagestreams", func(action core.Action) (handled bool, ret runtime.Object, err error) {
+		return true, stream, nil
+	})
+
+	image := config.Spec.Template.Spec.Containers[0].Image
+
+	if err := processTriggers(config, fake, false, false); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if config.Spec.Template.Spec.Containers[0].Image != image {
+		t.Fatalf("unexpected image update without approval: %s", config.Spec.Template.Spec.Containers[0].Image)
+	}
+	if len(config.Status.PendingImages) != 1 {
+		t.Fatalf("expected one pending image, got %#v", config.Status.PendingImages)
+	}
+	if expected := deploytest.DockerImageReference; config.Status.PendingImages[0].Image != expected {
+		t.Fatalf("expected pending image %s, got %s", expected, config.Status.PendingImages[0].Image)
+	}
+
+	if err := processTriggers(config, fake, false, true); err != nil {
+		t.Fatalf("unexpected error on approval: %v", err)
+	}
+	if expected := deploytest.DockerImageReference; config.Spec.Template.Spec.Containers[0].Image != expected {
+		t.Fatalf("expected image update to %s on approval, got %s", expected, config.Spec.Template.Spec.Containers[0].Image)
+	}
+	if len(config.Status.PendingImages) != 0 {
+		t.Fatalf("expected pending images to be cleared, got %#v", config.Status.PendingImages)
+	}
+}
+
 // TestProcess_matchScenarios comprehensively tests trigger definitions against
 // image stream updates to ensure that the image change triggers match (or don't
 // match) properly.
@@ -229,7 +273,7 @@ func TestProcess_matchScenarios(t *testing.T) {
 
 		image := config.Spec.Template.Spec.Containers[0].Image
 
-		err := processTriggers(config, fake, false)
+		err := processTriggers(config, fake, false, false)
 		if err != nil {
 			t.Errorf("unexpected error: %v", err)
 			continue
@@ -691,3 +735,69 @@ func TestCanTrigger(t *testing.T) {
 		}
 	}
 }
+
+// TestMergePendingCauses ensures that queued causes are deduplicated.
+func TestMergePendingCauses(t *testing.T) {
+	configCause := deployapi.DeploymentCause{Type: deployapi.DeploymentTriggerOnConfigChange}
+	imageCause := deployapi.DeploymentCause{
+		Type: deployapi.DeploymentTriggerOnImageChange,
+		ImageTrigger: &deployapi.DeploymentCauseImageTrigger{
+			From: kapi.ObjectReference{
+				Name:      "test:v1",
+				Namespace: kapi.NamespaceDefault,
+				Kind:      "ImageStreamTag",
+			},
+		},
+	}
+	otherImageCause := deployapi.DeploymentCause{
+		Type: deployapi.DeploymentTriggerOnImageChange,
+		ImageTrigger: &deployapi.DeploymentCauseImageTrigger{
+			From: kapi.ObjectReference{
+				Name:      "test:v2",
+				Namespace: kapi.NamespaceDefault,
+				Kind:      "ImageStreamTag",
+			},
+		},
+	}
+
+	tests := []struct {
+		name string
+
+		pending []deployapi.DeploymentCause
+		causes  []deployapi.DeploymentCause
+
+		expected []deployapi.DeploymentCause
+	}{
+		{
+			name: "no pending causes",
+
+			pending: nil,
+			causes:  []deployapi.DeploymentCause{configCause},
+
+			expected: []deployapi.DeploymentCause{configCause},
+		},
+		{
+			name: "duplicate cause is dropped",
+
+			pending: []deployapi.DeploymentCause{imageCause},
+			causes:  []deployapi.DeploymentCause{imageCause},
+
+			expected: []deployapi.DeploymentCause{imageCause},
+		},
+		{
+			name: "new cause is appended",
+
+			pending: []deployapi.DeploymentCause{imageCause},
+			causes:  []deployapi.DeploymentCause{otherImageCause, configCause},
+
+			expected: []deployapi.DeploymentCause{imageCause, otherImageCause, configCause},
+		},
+	}
+
+	for _, test := range tests {
+		got := mergePendingCauses(test.pending, test.causes)
+		if !kapi.Semantic.DeepEqual(test.expected, got) {
+			t.Errorf("%s: expected causes:\n%#v\ngot:\n%#v", test.name, test.expected, got)
+		}
+	}
+}
@@ -0,0 +1,202 @@
+package webhook
+
+import (
+	"crypto/hmac"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"mime"
+	"net/http"
+	"strings"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/api/errors"
+	krest "k8s.io/kubernetes/pkg/api/rest"
+	"k8s.io/kubernetes/pkg/api/unversioned"
+	"k8s.io/kubernetes/pkg/runtime"
+	utilruntime "k8s.io/kubernetes/pkg/util/runtime"
+	"k8s.io/kubernetes/pkg/util/yaml"
+
+	"github.com/openshift/origin/pkg/build/webhook"
+	deployapi "github.com/openshift/origin/pkg/deploy/api"
+	"github.com/openshift/origin/pkg/deploy/registry/deployconfig"
+	"github.com/openshift/origin/pkg/util/rest"
+)
+
+// NewWebHookREST returns the webhook handler wrapped in a rest.WebHook object.
+func NewWebHookREST(registry deployconfig.Registry, instantiator krest.Creater, groupVersion unversioned.GroupVersion) *rest.WebHook {
+	hook := &WebHook{
+		groupVersion: groupVersion,
+		registry:     registry,
+		instantiator: instantiator,
+	}
+	return rest.NewWebHook(hook, false)
+}
+
+// WebHook starts a new deployment for a deployment config in response to requests
+// authenticated by a per-trigger secret, so external CD systems can trigger
+// deployments without cluster credentials.
+type WebHook struct {
+	groupVersion unversioned.GroupVersion
+	registry     deployconfig.Registry
+	instantiator krest.Creater
+}
+
+// ServeHTTP implements rest.HookHandler
+func (w *WebHook) ServeHTTP(writer http.ResponseWriter, req *http.Request, ctx kapi.Context, name, subpath string) error {
+	parts := strings.Split(subpath, "/")
+	if len(parts) != 2 {
+		return errors.NewBadRequest(fmt.Sprintf("unexpected hook subpath %s", subpath))
+	}
+	secret, hookType := parts[0], parts[1]
+
+	if hookType != "generic" {
+		return errors.NewNotFound(deployapi.LegacyResource("deploymentconfighook"), hookType)
+	}
+
+	config, err := w.registry.GetDeploymentConfig(ctx, name)
+	if err != nil {
+		// clients should not be able to find information about deployment configs in
+		// the system unless the config exists and the secret matches
+		return errors.NewUnauthorized(fmt.Sprintf("the webhook %q for %q did not accept your secret", hookType, name))
+	}
+
+	trigger, err := webHookTrigger(config, secret)
+	if err != nil {
+		return errors.NewUnauthorized(fmt.Sprintf("the webhook %q for %q did not accept your secret", hookType, name))
+	}
+
+	envvars, warning, err := extractEnv(trigger, req)
+	if err == webhook.MethodNotSupported {
+		return errors.NewMethodNotSupported(deployapi.Resource("deploymentconfighook"), req.Method)
+	}
+	if err != nil {
+		return err
+	}
+
+	if len(envvars) > 0 {
+		for i := range config.Spec.Template.Spec.Containers {
+			container := &config.Spec.Template.Spec.Containers[i]
+			container.Env = updateEnv(container.Env, envvars)
+		}
+		if err := w.registry.UpdateDeploymentConfig(ctx, config); err != nil {
+			return errors.NewInternalError(fmt.Errorf("could not update environment of %q: %v", name, err))
+		}
+	}
+
+	request := &deployapi.DeploymentRequest{
+		Name:   name,
+		Latest: true,
+	}
+	obj, err := w.instantiator.Create(ctx, request)
+	if err != nil {
+		return errors.NewInternalError(fmt.Errorf("could not start a new deployment: %v", err))
+	}
+
+	// Send back the updated config so that the client can alert the user.
+	if encoded, err := runtime.Encode(kapi.Codecs.LegacyCodec(w.groupVersion), obj); err != nil {
+		utilruntime.HandleError(err)
+	} else {
+		writer.Write(encoded)
+	}
+
+	return warning
+}
+
+// webHookTrigger validates the provided secret against all WebHook triggers of the
+// deployment config and if it is valid, returns the matching trigger parameters.
+func webHookTrigger(config *deployapi.DeploymentConfig, secret string) (*deployapi.DeploymentTriggerWebHookParams, error) {
+	found := false
+	for _, trigger := range config.Spec.Triggers {
+		if trigger.Type != deployapi.DeploymentTriggerOnWebHook || trigger.WebHookParams == nil {
+			continue
+		}
+		found = true
+		if !hmac.Equal([]byte(trigger.WebHookParams.Secret), []byte(secret)) {
+			continue
+		}
+		return trigger.WebHookParams, nil
+	}
+	if !found {
+		return nil, webhook.ErrHookNotEnabled
+	}
+	return nil, webhook.ErrSecretMismatch
+}
+
+// extractEnv reads the optional environment variable payload of a webhook request.
+// Payloads the handler cannot make sense of are ignored with a warning so senders
+// that always post a body still trigger a deployment.
+func extractEnv(trigger *deployapi.DeploymentTriggerWebHookParams, req *http.Request) ([]kapi.EnvVar, error, error) {
+	if req.Method != "POST" {
+		return nil, nil, webhook.MethodNotSupported
+	}
+
+	contentType := req.Header.Get("Content-Type")
+	if len(contentType) != 0 {
+		var err error
+		contentType, _, err = mime.ParseMediaType(contentType)
+		if err != nil {
+			return nil, nil, errors.NewBadRequest(fmt.Sprintf("error parsing Content-Type: %s", err))
+		}
+	}
+
+	if req.Body == nil {
+		return nil, nil, nil
+	}
+
+	if contentType != "application/json" && contentType != "application/yaml" {
+		return nil, webhook.NewWarning("invalid Content-Type on payload, ignoring payload and continuing with deployment"), nil
+	}
+
+	body, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		return nil, nil, errors.NewBadRequest(err.Error())
+	}
+
+	if len(body) == 0 {
+		return nil, nil, nil
+	}
+
+	if contentType == "application/yaml" {
+		body, err = yaml.ToJSON(body)
+		if err != nil {
+			return nil, webhook.NewWarning(fmt.Sprintf("error converting payload to json: %v, ignoring payload and continuing with deployment", err)), nil
+		}
+	}
+	data := deployapi.DeploymentWebHookEvent{}
+	if err := json.Unmarshal(body, &data); err != nil {
+		return nil, webhook.NewWarning(fmt.Sprintf("error unmarshalling payload: %v, ignoring payload and continuing with deployment", err)), nil
+	}
+	if len(data.Env) > 0 && !trigger.AllowEnv {
+		return nil, webhook.NewWarning("ignoring environment variables in payload, the webhook trigger does not allow them"), nil
+	}
+	return data.Env, nil, nil
+}
+
+// updateEnv merges the webhook provided environment variables into the existing
+// environment of a container, overriding values with the same name.
+func updateEnv(existing []kapi.EnvVar, env []kapi.EnvVar) []kapi.EnvVar {
+	out := []kapi.EnvVar{}
+	for _, e := range existing {
+		if newer, ok := findEnv(env, e.Name); ok {
+			out = append(out, newer)
+			continue
+		}
+		out = append(out, e)
+	}
+	for _, e := range env {
+		if _, ok := findEnv(out, e.Name); !ok {
+			out = append(out, e)
+		}
+	}
+	return out
+}
+
+func findEnv(env []kapi.EnvVar, name string) (kapi.EnvVar, bool) {
+	for _, e := range env {
+		if e.Name == name {
+			return e, true
+		}
+	}
+	return kapi.EnvVar{}, false
+}
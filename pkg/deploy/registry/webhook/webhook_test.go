@@ -0,0 +1,193 @@
+package webhook
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+	kerrors "k8s.io/kubernetes/pkg/api/errors"
+	"k8s.io/kubernetes/pkg/runtime"
+	"k8s.io/kubernetes/pkg/watch"
+
+	_ "github.com/openshift/origin/pkg/api/install"
+	deployapi "github.com/openshift/origin/pkg/deploy/api"
+	deployapiv1 "github.com/openshift/origin/pkg/deploy/api/v1"
+)
+
+type fakeRegistry struct {
+	config  *deployapi.DeploymentConfig
+	updated *deployapi.DeploymentConfig
+	err     error
+}
+
+func (r *fakeRegistry) ListDeploymentConfigs(ctx kapi.Context, options *kapi.ListOptions) (*deployapi.DeploymentConfigList, error) {
+	return nil, r.err
+}
+
+func (r *fakeRegistry) WatchDeploymentConfigs(ctx kapi.Context, options *kapi.ListOptions) (watch.Interface, error) {
+	return nil, r.err
+}
+
+func (r *fakeRegistry) GetDeploymentConfig(ctx kapi.Context, name string) (*deployapi.DeploymentConfig, error) {
+	return r.config, r.err
+}
+
+func (r *fakeRegistry) CreateDeploymentConfig(ctx kapi.Context, config *deployapi.DeploymentConfig) error {
+	return r.err
+}
+
+func (r *fakeRegistry) UpdateDeploymentConfig(ctx kapi.Context, config *deployapi.DeploymentConfig) error {
+	r.updated = config
+	return r.err
+}
+
+func (r *fakeRegistry) DeleteDeploymentConfig(ctx kapi.Context, name string) error {
+	return r.err
+}
+
+type fakeInstantiator struct {
+	request *deployapi.DeploymentRequest
+	err     error
+}
+
+func (i *fakeInstantiator) New() runtime.Object {
+	return &deployapi.DeploymentRequest{}
+}
+
+func (i *fakeInstantiator) Create(ctx kapi.Context, obj runtime.Object) (runtime.Object, error) {
+	i.request = obj.(*deployapi.DeploymentRequest)
+	return &deployapi.DeploymentConfig{ObjectMeta: kapi.ObjectMeta{Name: i.request.Name}}, i.err
+}
+
+func webHookConfig(allowEnv bool) *deployapi.DeploymentConfig {
+	return &deployapi.DeploymentConfig{
+		ObjectMeta: kapi.ObjectMeta{Name: "config", Namespace: kapi.NamespaceDefault},
+		Spec: deployapi.DeploymentConfigSpec{
+			Triggers: []deployapi.DeploymentTriggerPolicy{
+				{
+					Type: deployapi.DeploymentTriggerOnWebHook,
+					WebHookParams: &deployapi.DeploymentTriggerWebHookParams{
+						Secret:   "secret101",
+						AllowEnv: allowEnv,
+					},
+				},
+			},
+			Template: &kapi.PodTemplateSpec{
+				Spec: kapi.PodSpec{
+					Containers: []kapi.Container{
+						{
+							Name: "container1",
+							Env:  []kapi.EnvVar{{Name: "VAR1", Value: "original"}},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func newTestWebHook(registry *fakeRegistry, instantiator *fakeInstantiator) *WebHook {
+	return &WebHook{
+		groupVersion: deployapiv1.SchemeGroupVersion,
+		registry:     registry,
+		instantiator: instantiator,
+	}
+}
+
+func TestServeHTTPWrongSecret(t *testing.T) {
+	registry := &fakeRegistry{config: webHookConfig(false)}
+	instantiator := &fakeInstantiator{}
+	hook := newTestWebHook(registry, instantiator)
+
+	req, _ := http.NewRequest("POST", "http://example.com", nil)
+	err := hook.ServeHTTP(httptest.NewRecorder(), req, kapi.NewDefaultContext(), "config", "wrongsecret/generic")
+	if !kerrors.IsUnauthorized(err) {
+		t.Fatalf("expected an unauthorized error, got %v", err)
+	}
+	if instantiator.request != nil {
+		t.Fatalf("unexpected instantiate request: %#v", instantiator.request)
+	}
+}
+
+func TestServeHTTPUnknownHookType(t *testing.T) {
+	registry := &fakeRegistry{config: webHookConfig(false)}
+	hook := newTestWebHook(registry, &fakeInstantiator{})
+
+	req, _ := http.NewRequest("POST", "http://example.com", nil)
+	err := hook.ServeHTTP(httptest.NewRecorder(), req, kapi.NewDefaultContext(), "config", "secret101/github")
+	if !kerrors.IsNotFound(err) {
+		t.Fatalf("expected a not found error, got %v", err)
+	}
+}
+
+func TestServeHTTPTriggersDeployment(t *testing.T) {
+	registry := &fakeRegistry{config: webHookConfig(false)}
+	instantiator := &fakeInstantiator{}
+	hook := newTestWebHook(registry, instantiator)
+
+	req, _ := http.NewRequest("POST", "http://example.com", nil)
+	if err := hook.ServeHTTP(httptest.NewRecorder(), req, kapi.NewDefaultContext(), "config", "secret101/generic"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if instantiator.request == nil || !instantiator.request.Latest {
+		t.Fatalf("expected a latest deployment request, got %#v", instantiator.request)
+	}
+	if registry.updated != nil {
+		t.Fatalf("unexpected update of the deployment config: %#v", registry.updated)
+	}
+}
+
+func TestServeHTTPEnvPayload(t *testing.T) {
+	registry := &fakeRegistry{config: webHookConfig(true)}
+	instantiator := &fakeInstantiator{}
+	hook := newTestWebHook(registry, instantiator)
+
+	payload := `{"env":[{"name":"VAR1","value":"updated"},{"name":"VAR2","value":"new"}]}`
+	req, _ := http.NewRequest("POST", "http://example.com", strings.NewReader(payload))
+	req.Header.Set("Content-Type", "application/json")
+	if err := hook.ServeHTTP(httptest.NewRecorder(), req, kapi.NewDefaultContext(), "config", "secret101/generic"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if registry.updated == nil {
+		t.Fatalf("expected the deployment config to be updated")
+	}
+	expected := []kapi.EnvVar{{Name: "VAR1", Value: "updated"}, {Name: "VAR2", Value: "new"}}
+	if env := registry.updated.Spec.Template.Spec.Containers[0].Env; !reflect.DeepEqual(env, expected) {
+		t.Fatalf("expected environment %#v, got %#v", expected, env)
+	}
+	if instantiator.request == nil || !instantiator.request.Latest {
+		t.Fatalf("expected a latest deployment request, got %#v", instantiator.request)
+	}
+}
+
+func TestServeHTTPEnvNotAllowed(t *testing.T) {
+	registry := &fakeRegistry{config: webHookConfig(false)}
+	instantiator := &fakeInstantiator{}
+	hook := newTestWebHook(registry, instantiator)
+
+	payload := `{"env":[{"name":"VAR2","value":"new"}]}`
+	req, _ := http.NewRequest("POST", "http://example.com", strings.NewReader(payload))
+	req.Header.Set("Content-Type", "application/json")
+	err := hook.ServeHTTP(httptest.NewRecorder(), req, kapi.NewDefaultContext(), "config", "secret101/generic")
+	if err == nil || !strings.Contains(err.Error(), "does not allow") {
+		t.Fatalf("expected a warning about disallowed environment variables, got %v", err)
+	}
+	if registry.updated != nil {
+		t.Fatalf("unexpected update of the deployment config: %#v", registry.updated)
+	}
+	if instantiator.request == nil {
+		t.Fatalf("expected the deployment to be triggered despite the ignored payload")
+	}
+}
+
+func TestUpdateEnv(t *testing.T) {
+	existing := []kapi.EnvVar{{Name: "VAR1", Value: "a"}, {Name: "VAR2", Value: "b"}}
+	env := []kapi.EnvVar{{Name: "VAR2", Value: "updated"}, {Name: "VAR3", Value: "c"}}
+	expected := []kapi.EnvVar{{Name: "VAR1", Value: "a"}, {Name: "VAR2", Value: "updated"}, {Name: "VAR3", Value: "c"}}
+	if out := updateEnv(existing, env); !reflect.DeepEqual(out, expected) {
+		t.Errorf("expected %#v, got %#v", expected, out)
+	}
+}
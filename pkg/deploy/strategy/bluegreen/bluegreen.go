@@ -0,0 +1,282 @@
+package bluegreen
+
+import (
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"strings"
+	"time"
+
+	"k8s.io/client-go/pkg/api/errors"
+	kapi "k8s.io/kubernetes/pkg/api"
+	kclientset "k8s.io/kubernetes/pkg/client/clientset_generated/internalclientset"
+	kcoreclient "k8s.io/kubernetes/pkg/client/clientset_generated/internalclientset/typed/core/internalversion"
+	"k8s.io/kubernetes/pkg/client/record"
+	"k8s.io/kubernetes/pkg/kubectl"
+	"k8s.io/kubernetes/pkg/runtime"
+	"k8s.io/kubernetes/pkg/util/wait"
+
+	"github.com/openshift/origin/pkg/client"
+	deployapi "github.com/openshift/origin/pkg/deploy/api"
+	strat "github.com/openshift/origin/pkg/deploy/strategy"
+	stratsupport "github.com/openshift/origin/pkg/deploy/strategy/support"
+	stratutil "github.com/openshift/origin/pkg/deploy/strategy/util"
+	deployutil "github.com/openshift/origin/pkg/deploy/util"
+	routeapi "github.com/openshift/origin/pkg/route/api"
+)
+
+// BlueGreenDeploymentStrategy deploys the new deployment under a service of its
+// own while the route named in the strategy params still sends traffic to the old
+// deployment. Once the new deployment is fully scaled up and the validate hook has
+// succeeded, the route backends are switched to the new service in a single
+// update. The old deployment and its service are left running so the route can be
+// switched back for a fast rollback.
+type BlueGreenDeploymentStrategy struct {
+	// out and errOut control where output is sent during the strategy
+	out, errOut io.Writer
+	// until is a condition that, if reached, will cause the strategy to exit early
+	until string
+	// rcClient is a client to access replication controllers
+	rcClient kcoreclient.ReplicationControllersGetter
+	// svcClient is a client to access services
+	svcClient kcoreclient.ServicesGetter
+	// eventClient is a client to access events
+	eventClient kcoreclient.EventsGetter
+	// routeClient is a client to access routes
+	routeClient client.RoutesNamespacer
+	// getUpdateAcceptor returns an UpdateAcceptor to verify the new deployment.
+	getUpdateAcceptor func(time.Duration, int32) strat.UpdateAcceptor
+	// scaler is used to scale replication controllers.
+	scaler kubectl.Scaler
+	// decoder is used to decode DeploymentConfigs contained in deployments.
+	decoder runtime.Decoder
+	// hookExecutor can execute a lifecycle hook.
+	hookExecutor stratsupport.HookExecutor
+	// retryPeriod is how often to try updating the replica count.
+	retryPeriod time.Duration
+	// events records the events
+	events record.EventSink
+}
+
+const (
+	// acceptorInterval is how often the UpdateAcceptor should check for
+	// readiness.
+	acceptorInterval = 1 * time.Second
+)
+
+// NewBlueGreenDeploymentStrategy makes a BlueGreenDeploymentStrategy backed by
+// a real HookExecutor and client.
+func NewBlueGreenDeploymentStrategy(client kclientset.Interface, oclient client.Interface, events record.EventSink, decoder runtime.Decoder, out, errOut io.Writer, until string) *BlueGreenDeploymentStrategy {
+	if out == nil {
+		out = ioutil.Discard
+	}
+	if errOut == nil {
+		errOut = ioutil.Discard
+	}
+	scaler, _ := kubectl.ScalerFor(kapi.Kind("ReplicationController"), client)
+	return &BlueGreenDeploymentStrategy{
+		out:         out,
+		errOut:      errOut,
+		events:      events,
+		until:       until,
+		rcClient:    client.Core(),
+		svcClient:   client.Core(),
+		eventClient: client.Core(),
+		routeClient: oclient,
+		getUpdateAcceptor: func(timeout time.Duration, minReadySeconds int32) strat.UpdateAcceptor {
+			return stratsupport.NewAcceptAvailablePods(out, client.Core(), timeout, acceptorInterval, minReadySeconds)
+		},
+		scaler:       scaler,
+		decoder:      decoder,
+		hookExecutor: stratsupport.NewHookExecutor(client.Core(), oclient, client.Core(), os.Stdout, decoder),
+		retryPeriod:  1 * time.Second,
+	}
+}
+
+// Deploy scales up the new deployment under a parallel service, validates it, and
+// switches the route backends to it. The old deployment is not scaled down.
+func (s *BlueGreenDeploymentStrategy) Deploy(from *kapi.ReplicationController, to *kapi.ReplicationController, desiredReplicas int) error {
+	config, err := deployutil.DecodeDeploymentConfig(to, s.decoder)
+	if err != nil {
+		return fmt.Errorf("couldn't decode config from deployment %s: %v", to.Name, err)
+	}
+	params := config.Spec.Strategy.BlueGreenParams
+	if params == nil {
+		return fmt.Errorf("missing blue-green params for deployment %s", to.Name)
+	}
+
+	retryTimeout := time.Duration(deployapi.DefaultRecreateTimeoutSeconds) * time.Second
+	if params.TimeoutSeconds != nil {
+		retryTimeout = time.Duration(*params.TimeoutSeconds) * time.Second
+	}
+	retryParams := kubectl.NewRetryParams(s.retryPeriod, retryTimeout)
+	waitParams := kubectl.NewRetryParams(s.retryPeriod, retryTimeout)
+
+	route, err := s.routeClient.Routes(to.Namespace).Get(params.Route)
+	if err != nil {
+		return fmt.Errorf("couldn't get route %s/%s: %v", to.Namespace, params.Route, err)
+	}
+
+	// Execute any pre-hook.
+	if params.Pre != nil {
+		if err := s.hookExecutor.Execute(params.Pre, to, deployapi.PreHookPodSuffix, "pre"); err != nil {
+			return fmt.Errorf("pre hook failed: %s", err)
+		}
+	}
+	if s.until == "pre" {
+		return strat.NewConditionReachedErr("pre hook succeeded")
+	}
+
+	// Record all warnings
+	defer stratutil.RecordConfigWarnings(s.eventClient, from, s.decoder, s.out)
+	defer stratutil.RecordConfigWarnings(s.eventClient, to, s.decoder, s.out)
+
+	// Expose the new deployment under a service of its own, leaving the route
+	// pointing at the old deployment.
+	service, err := s.ensureService(route, to)
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(s.out, "--> Created service %s for deployment %s\n", service.Name, to.Name)
+
+	// Scale up the new deployment and wait for it to be accepted.
+	if desiredReplicas > 0 {
+		fmt.Fprintf(s.out, "--> Scaling %s to %d\n", to.Name, desiredReplicas)
+		updatedTo, err := s.scaleAndWait(to, desiredReplicas, retryParams, waitParams)
+		if err != nil {
+			return fmt.Errorf("couldn't scale %s to %d: %v", to.Name, desiredReplicas, err)
+		}
+		if err := s.getUpdateAcceptor(retryTimeout, config.Spec.MinReadySeconds).Accept(updatedTo); err != nil {
+			return fmt.Errorf("update acceptor rejected %s: %v", to.Name, err)
+		}
+		to = updatedTo
+	}
+
+	// Run the validate hook against the new deployment while traffic still goes
+	// to the old deployment.
+	if params.Validate != nil {
+		if err := s.hookExecutor.Execute(params.Validate, to, deployapi.ValidateHookPodSuffix, "validate"); err != nil {
+			return fmt.Errorf("validate hook failed, route %s still points at the previous deployment: %s", route.Name, err)
+		}
+	}
+	if s.until == "validate" {
+		return strat.NewConditionReachedErr("validate hook succeeded")
+	}
+
+	// Switch the route backends to the new service in a single update. The old
+	// backend is kept as an alternate backend with zero weight so switching back
+	// only requires swapping the weights.
+	if err := s.switchRoute(route, service.Name); err != nil {
+		return fmt.Errorf("couldn't switch route %s to service %s: %v", route.Name, service.Name, err)
+	}
+	fmt.Fprintf(s.out, "--> Switched route %s to service %s\n", route.Name, service.Name)
+
+	// Execute any post-hook.
+	if params.Post != nil {
+		if err := s.hookExecutor.Execute(params.Post, to, deployapi.PostHookPodSuffix, "post"); err != nil {
+			return fmt.Errorf("post hook failed: %s", err)
+		}
+	}
+
+	return nil
+}
+
+// ensureService creates (or reuses) a service named after the new deployment that
+// selects only its pods, copying the ports of the service the route currently
+// points to.
+func (s *BlueGreenDeploymentStrategy) ensureService(route *routeapi.Route, to *kapi.ReplicationController) (*kapi.Service, error) {
+	if existing, err := s.svcClient.Services(to.Namespace).Get(to.Name); err == nil {
+		return existing, nil
+	} else if !errors.IsNotFound(err) {
+		return nil, fmt.Errorf("couldn't get service %s/%s: %v", to.Namespace, to.Name, err)
+	}
+
+	currentService, err := s.svcClient.Services(to.Namespace).Get(route.Spec.To.Name)
+	if err != nil {
+		return nil, fmt.Errorf("couldn't get service %s/%s targeted by route %s: %v", to.Namespace, route.Spec.To.Name, route.Name, err)
+	}
+
+	ports := []kapi.ServicePort{}
+	for _, port := range currentService.Spec.Ports {
+		ports = append(ports, kapi.ServicePort{
+			Name:       port.Name,
+			Protocol:   port.Protocol,
+			Port:       port.Port,
+			TargetPort: port.TargetPort,
+		})
+	}
+
+	service := &kapi.Service{
+		ObjectMeta: kapi.ObjectMeta{
+			Namespace: to.Namespace,
+			Name:      to.Name,
+			Labels:    to.Labels,
+		},
+		Spec: kapi.ServiceSpec{
+			Selector: to.Spec.Selector,
+			Ports:    ports,
+		},
+	}
+	created, err := s.svcClient.Services(to.Namespace).Create(service)
+	if err != nil {
+		return nil, fmt.Errorf("couldn't create service %s/%s: %v", to.Namespace, to.Name, err)
+	}
+	return created, nil
+}
+
+// switchRoute points the route at the new service and demotes the current backend
+// to an alternate backend with zero weight for fast rollback.
+func (s *BlueGreenDeploymentStrategy) switchRoute(route *routeapi.Route, serviceName string) error {
+	if route.Spec.To.Name == serviceName {
+		return nil
+	}
+
+	weight := int32(100)
+	zero := int32(0)
+	oldBackend := route.Spec.To
+	oldBackend.Weight = &zero
+
+	backends := []routeapi.RouteTargetReference{oldBackend}
+	for _, alternate := range route.Spec.AlternateBackends {
+		// drop any previous alternate referencing the new service
+		if alternate.Name == serviceName {
+			continue
+		}
+		backends = append(backends, alternate)
+	}
+
+	route.Spec.To = routeapi.RouteTargetReference{Kind: "Service", Name: serviceName, Weight: &weight}
+	route.Spec.AlternateBackends = backends
+	_, err := s.routeClient.Routes(route.Namespace).Update(route)
+	return err
+}
+
+func (s *BlueGreenDeploymentStrategy) scaleAndWait(deployment *kapi.ReplicationController, replicas int, retry *kubectl.RetryParams, retryParams *kubectl.RetryParams) (*kapi.ReplicationController, error) {
+	if int32(replicas) == deployment.Spec.Replicas && int32(replicas) == deployment.Status.Replicas {
+		return deployment, nil
+	}
+	var scaleErr error
+	err := wait.PollImmediate(1*time.Second, 30*time.Second, func() (bool, error) {
+		scaleErr = s.scaler.Scale(deployment.Namespace, deployment.Name, uint(replicas), &kubectl.ScalePrecondition{Size: -1, ResourceVersion: ""}, retry, retryParams)
+		if scaleErr == nil {
+			return true, nil
+		}
+		// This error is returned when the lifecycle admission plugin cache is not fully
+		// synchronized. In that case the scaling should be retried.
+		//
+		// FIXME: The error returned from admission should not be forbidden but come-back-later error.
+		if errors.IsForbidden(scaleErr) && strings.Contains(scaleErr.Error(), "not yet ready to handle request") {
+			return false, nil
+		}
+		return false, scaleErr
+	})
+	if err == wait.ErrWaitTimeout {
+		return nil, fmt.Errorf("%v: %v", err, scaleErr)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return s.rcClient.ReplicationControllers(deployment.Namespace).Get(deployment.Name)
+}
@@ -6,6 +6,7 @@ import (
 	"io"
 	"io/ioutil"
 	"os"
+	"strings"
 	"time"
 
 	kapi "k8s.io/kubernetes/pkg/api"
@@ -127,6 +128,20 @@ func (s *RollingDeploymentStrategy) Deploy(from *kapi.ReplicationController, to
 	params := config.Spec.Strategy.RollingParams
 	updateAcceptor := s.getUpdateAcceptor(time.Duration(*params.TimeoutSeconds)*time.Second, config.Spec.MinReadySeconds)
 
+	// Optionally gate acceptance on route backend health through the router, catching
+	// pods that pass readiness but are unreachable through their route.
+	if url := config.Annotations[deployapi.RouterStatusURLAnnotation]; len(url) > 0 {
+		routes := []string{}
+		for _, name := range strings.Split(config.Annotations[deployapi.RouteHealthChecksAnnotation], ",") {
+			if name = strings.TrimSpace(name); len(name) > 0 {
+				routes = append(routes, name)
+			}
+		}
+		if len(routes) > 0 {
+			updateAcceptor = stratsupport.NewAcceptRouteHealthy(s.out, updateAcceptor, url, routes, time.Duration(*params.TimeoutSeconds)*time.Second, acceptorInterval)
+		}
+	}
+
 	// If there's no prior deployment, delegate to another strategy since the
 	// rolling updater only supports transitioning between two deployments.
 	//
@@ -429,6 +429,10 @@ func makeHookPod(hook *deployapi.LifecycleHook, rc *kapi.ReplicationController,
 	util.MergeInto(pod.Labels, strategy.Labels, 0)
 	util.MergeInto(pod.Annotations, strategy.Annotations, 0)
 
+	if err := deployutil.ApplyDeployerPodTemplate(pod, strategy.DeployerPodTemplate); err != nil {
+		return nil, err
+	}
+
 	return pod, nil
 }
 
@@ -0,0 +1,117 @@
+package support
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/golang/glog"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/util/sets"
+	"k8s.io/kubernetes/pkg/util/wait"
+
+	strat "github.com/openshift/origin/pkg/deploy/strategy"
+)
+
+// backendStatus mirrors the per-route health reported by the router's status endpoint.
+type backendStatus struct {
+	Namespace string `json:"namespace"`
+	Name      string `json:"name"`
+	Active    bool   `json:"active"`
+	Endpoints int    `json:"endpoints"`
+}
+
+// NewAcceptRouteHealthy makes a new acceptRouteHealthy which delegates to the provided
+// acceptor and then verifies the named routes are healthy through the router before
+// accepting a replication controller.
+func NewAcceptRouteHealthy(
+	out io.Writer,
+	delegate strat.UpdateAcceptor,
+	statusURL string,
+	routes []string,
+	timeout time.Duration,
+	interval time.Duration,
+) *acceptRouteHealthy {
+	return &acceptRouteHealthy{
+		out:      out,
+		delegate: delegate,
+		routes:   routes,
+		timeout:  timeout,
+		interval: interval,
+		getBackendStatuses: func() ([]backendStatus, error) {
+			resp, err := http.Get(statusURL)
+			if err != nil {
+				return nil, err
+			}
+			defer resp.Body.Close()
+			if resp.StatusCode != http.StatusOK {
+				return nil, fmt.Errorf("router status endpoint returned %s", resp.Status)
+			}
+			statuses := []backendStatus{}
+			if err := json.NewDecoder(resp.Body).Decode(&statuses); err != nil {
+				return nil, err
+			}
+			return statuses, nil
+		},
+	}
+}
+
+// acceptRouteHealthy is an UpdateAcceptor which, after its delegate accepts a
+// replication controller, refuses to accept until the router reports active backends
+// for every named route. This catches pods that pass their readiness checks but are
+// unreachable through the route.
+type acceptRouteHealthy struct {
+	out io.Writer
+	// delegate is consulted before route health is considered.
+	delegate strat.UpdateAcceptor
+	// routes are the names of the routes in the deployment's namespace that must be
+	// healthy.
+	routes []string
+	// timeout is how long to wait for the routes to become healthy.
+	timeout time.Duration
+	// interval is how often to query the router.
+	interval time.Duration
+	// getBackendStatuses queries the router's status endpoint.
+	getBackendStatuses func() ([]backendStatus, error)
+}
+
+// Accept accepts a replication controller once the delegate accepts it and every
+// configured route reports active backends through the router.
+func (c *acceptRouteHealthy) Accept(rc *kapi.ReplicationController) error {
+	if c.delegate != nil {
+		if err := c.delegate.Accept(rc); err != nil {
+			return err
+		}
+	}
+	if len(c.routes) == 0 {
+		return nil
+	}
+
+	fmt.Fprintf(c.out, "--> Waiting up to %s for routes %v to report healthy backends\n", c.timeout, c.routes)
+	unhealthy := sets.NewString()
+	err := wait.Poll(c.interval, c.timeout, func() (bool, error) {
+		statuses, err := c.getBackendStatuses()
+		if err != nil {
+			glog.V(4).Infof("Unable to query router status: %v", err)
+			return false, nil
+		}
+		healthy := sets.NewString()
+		for _, status := range statuses {
+			if status.Namespace == rc.Namespace && status.Active {
+				healthy.Insert(status.Name)
+			}
+		}
+		unhealthy = sets.NewString(c.routes...).Difference(healthy)
+		return unhealthy.Len() == 0, nil
+	})
+	if err != nil {
+		if err == wait.ErrWaitTimeout {
+			return fmt.Errorf("routes %v did not report healthy backends within %.f seconds", unhealthy.List(), c.timeout.Seconds())
+		}
+		return fmt.Errorf("route health check failed for rc %q: %v", rc.Name, err)
+	}
+	return nil
+}
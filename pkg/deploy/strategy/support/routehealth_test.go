@@ -0,0 +1,104 @@
+package support
+
+import (
+	"fmt"
+	"io/ioutil"
+	"strings"
+	"testing"
+	"time"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+)
+
+func TestAcceptRouteHealthy(t *testing.T) {
+	rc := &kapi.ReplicationController{
+		ObjectMeta: kapi.ObjectMeta{Namespace: "test", Name: "deployment-1"},
+	}
+
+	tests := []struct {
+		name       string
+		routes     []string
+		statuses   []backendStatus
+		statusErr  error
+		acceptable bool
+	}{
+		{
+			name:       "no routes configured",
+			routes:     []string{},
+			acceptable: true,
+		},
+		{
+			name:   "all routes healthy",
+			routes: []string{"frontend"},
+			statuses: []backendStatus{
+				{Namespace: "test", Name: "frontend", Active: true, Endpoints: 2},
+			},
+			acceptable: true,
+		},
+		{
+			name:   "route without active backends",
+			routes: []string{"frontend"},
+			statuses: []backendStatus{
+				{Namespace: "test", Name: "frontend", Active: false, Endpoints: 0},
+			},
+			acceptable: false,
+		},
+		{
+			name:   "healthy route in another namespace",
+			routes: []string{"frontend"},
+			statuses: []backendStatus{
+				{Namespace: "other", Name: "frontend", Active: true, Endpoints: 2},
+			},
+			acceptable: false,
+		},
+		{
+			name:       "router status unavailable",
+			routes:     []string{"frontend"},
+			statusErr:  fmt.Errorf("connection refused"),
+			acceptable: false,
+		},
+	}
+
+	for _, test := range tests {
+		acceptor := &acceptRouteHealthy{
+			out:      ioutil.Discard,
+			routes:   test.routes,
+			timeout:  50 * time.Millisecond,
+			interval: 10 * time.Millisecond,
+			getBackendStatuses: func() ([]backendStatus, error) {
+				return test.statuses, test.statusErr
+			},
+		}
+		err := acceptor.Accept(rc)
+		if test.acceptable && err != nil {
+			t.Errorf("%s: unexpected error: %v", test.name, err)
+		}
+		if !test.acceptable && err == nil {
+			t.Errorf("%s: expected an error", test.name)
+		}
+	}
+}
+
+func TestAcceptRouteHealthyDelegateRejection(t *testing.T) {
+	acceptor := &acceptRouteHealthy{
+		out:      ioutil.Discard,
+		delegate: &rejectingAcceptor{},
+		routes:   []string{"frontend"},
+		timeout:  50 * time.Millisecond,
+		interval: 10 * time.Millisecond,
+		getBackendStatuses: func() ([]backendStatus, error) {
+			t.Errorf("router should not be queried when the delegate rejects")
+			return nil, nil
+		},
+	}
+	err := acceptor.Accept(&kapi.ReplicationController{})
+	if err == nil || !strings.Contains(err.Error(), "rejected") {
+		t.Errorf("expected the delegate's rejection, got %v", err)
+	}
+}
+
+type rejectingAcceptor struct{}
+
+func (a *rejectingAcceptor) Accept(rc *kapi.ReplicationController) error {
+	return fmt.Errorf("rejected")
+}
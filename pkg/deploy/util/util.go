@@ -1,6 +1,9 @@
 package util
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"sort"
@@ -641,3 +644,99 @@ func (s ByMostRecent) Swap(i, j int) { s[i], s[j] = s[j], s[i] }
 func (s ByMostRecent) Less(i, j int) bool {
 	return !s[i].CreationTimestamp.Before(s[j].CreationTimestamp)
 }
+
+// ConfigSourceHash returns a stable hash of the content of a ConfigMap-style data map
+// combined with a Secret-style binary data map. It is used by the ConfigSourceChange
+// trigger to detect content changes independent of resourceVersion churn.
+func ConfigSourceHash(data map[string]string, binaryData map[string][]byte) string {
+	keys := []string{}
+	for k := range data {
+		keys = append(keys, k)
+	}
+	for k := range binaryData {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	hash := sha256.New()
+	for _, k := range keys {
+		hash.Write([]byte(k))
+		hash.Write([]byte{0})
+		if v, ok := data[k]; ok {
+			hash.Write([]byte(v))
+		} else {
+			hash.Write(binaryData[k])
+		}
+		hash.Write([]byte{0})
+	}
+	return hex.EncodeToString(hash.Sum(nil))
+}
+
+// ConfigSourceTriggers returns the ConfigSourceChange trigger policies of the provided
+// deployment config.
+func ConfigSourceTriggers(config *deployapi.DeploymentConfig) []deployapi.DeploymentTriggerPolicy {
+	triggers := []deployapi.DeploymentTriggerPolicy{}
+	for _, trigger := range config.Spec.Triggers {
+		if trigger.Type == deployapi.DeploymentTriggerOnConfigSourceChange && trigger.ConfigSourceParams != nil {
+			triggers = append(triggers, trigger)
+		}
+	}
+	return triggers
+}
+
+// MergeDeployerPodTemplates merges the given deployer pod template overrides field by
+// field, with earlier templates taking precedence. Returns nil when every template is nil.
+func MergeDeployerPodTemplates(templates ...*deployapi.DeployerPodTemplateSpec) *deployapi.DeployerPodTemplateSpec {
+	var merged *deployapi.DeployerPodTemplateSpec
+	for _, template := range templates {
+		if template == nil {
+			continue
+		}
+		if merged == nil {
+			merged = &deployapi.DeployerPodTemplateSpec{}
+		}
+		if merged.Resources == nil {
+			merged.Resources = template.Resources
+		}
+		if merged.NodeSelector == nil {
+			merged.NodeSelector = template.NodeSelector
+		}
+		if merged.Tolerations == nil {
+			merged.Tolerations = template.Tolerations
+		}
+		if len(merged.ServiceAccountName) == 0 {
+			merged.ServiceAccountName = template.ServiceAccountName
+		}
+	}
+	return merged
+}
+
+// ApplyDeployerPodTemplate applies the template's overrides to a deployer or lifecycle
+// hook pod. A nil template leaves the pod unchanged.
+func ApplyDeployerPodTemplate(pod *api.Pod, template *deployapi.DeployerPodTemplateSpec) error {
+	if template == nil {
+		return nil
+	}
+	if template.Resources != nil {
+		for i := range pod.Spec.Containers {
+			pod.Spec.Containers[i].Resources = *template.Resources
+		}
+	}
+	if len(template.NodeSelector) > 0 {
+		pod.Spec.NodeSelector = template.NodeSelector
+	}
+	if len(template.ServiceAccountName) > 0 {
+		pod.Spec.ServiceAccountName = template.ServiceAccountName
+	}
+	if len(template.Tolerations) > 0 {
+		data, err := json.Marshal(template.Tolerations)
+		if err != nil {
+			return err
+		}
+		if pod.Annotations == nil {
+			pod.Annotations = map[string]string{}
+		}
+		pod.Annotations[api.TolerationsAnnotationKey] = string(data)
+	}
+	return nil
+}
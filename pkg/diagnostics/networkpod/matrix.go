@@ -0,0 +1,270 @@
+package network
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+	kclientset "k8s.io/kubernetes/pkg/client/clientset_generated/internalclientset"
+	kcontainer "k8s.io/kubernetes/pkg/kubelet/container"
+	kexec "k8s.io/kubernetes/pkg/util/exec"
+
+	osclient "github.com/openshift/origin/pkg/client"
+	"github.com/openshift/origin/pkg/diagnostics/networkpod/util"
+	"github.com/openshift/origin/pkg/diagnostics/types"
+)
+
+const (
+	CheckConnectivityMatrixName = "CheckConnectivityMatrix"
+
+	// Suspected causes reported next to failing paths in the matrix.
+	suspectMTU      = "pod-to-pod traffic to other nodes fails while same node traffic works; suspect MTU mismatch or blocked VXLAN traffic (UDP port 4789) between nodes"
+	suspectOVS      = "pod-to-pod traffic fails on the same node; suspect missing or stale OVS flows on this node"
+	suspectIPTables = "pod-to-service traffic fails while pod-to-pod traffic works; suspect iptables rules not programmed by kube-proxy"
+	suspectMaster   = "pod-to-master traffic fails; suspect firewall between nodes and master or master not listening on the reported endpoint"
+	suspectDNS      = "DNS resolution fails; suspect dnsmasq/SkyDNS misconfiguration or DNS traffic blocked between node and DNS servers"
+)
+
+// matrixEntry is one probed path in the connectivity matrix.
+type matrixEntry struct {
+	source  string
+	target  string
+	path    string
+	success bool
+	detail  string
+}
+
+// CheckConnectivityMatrix is a Diagnostic that probes pod-to-pod, pod-to-service,
+// pod-to-master and DNS paths from the local diagnostic pod and reports a matrix
+// of failures along with the suspected cause for each failing class of path.
+type CheckConnectivityMatrix struct {
+	KubeClient kclientset.Interface
+	OSClient   *osclient.Client
+
+	res types.DiagnosticResult
+}
+
+// Name is part of the Diagnostic interface and just returns name.
+func (d CheckConnectivityMatrix) Name() string {
+	return CheckConnectivityMatrixName
+}
+
+// Description is part of the Diagnostic interface and just returns the diagnostic description.
+func (d CheckConnectivityMatrix) Description() string {
+	return "Probe pod-to-pod, pod-to-service, pod-to-master and DNS paths from every node and report a matrix of failures with suspected causes (MTU, OVS flows, iptables)."
+}
+
+// CanRun is part of the Diagnostic interface; it determines if the conditions are right to run this diagnostic.
+func (d CheckConnectivityMatrix) CanRun() (bool, error) {
+	if d.KubeClient == nil {
+		return false, errors.New("must have kube client")
+	} else if d.OSClient == nil {
+		return false, errors.New("must have openshift client")
+	}
+	return true, nil
+}
+
+// Check is part of the Diagnostic interface; it runs the actual diagnostic logic
+func (d CheckConnectivityMatrix) Check() types.DiagnosticResult {
+	d.res = types.NewDiagnosticResult(CheckConnectivityMatrixName)
+
+	localPods, nonlocalPods, err := util.GetLocalAndNonLocalDiagnosticPods(d.KubeClient)
+	if err != nil {
+		d.res.Error("DNetMatrix1001", err, fmt.Sprintf("Getting local and nonlocal pods failed. Error: %s", err))
+		return d.res
+	}
+	if len(localPods) == 0 {
+		d.res.Error("DNetMatrix1002", nil, "No local diagnostic pod found, unable to probe any path from this node.")
+		return d.res
+	}
+	localPod := &localPods[0]
+
+	entries := []matrixEntry{}
+	entries = append(entries, d.probePodPaths(localPod, localPods, nonlocalPods)...)
+	entries = append(entries, d.probeServicePath(localPod))
+	entries = append(entries, d.probeMasterPath(localPod))
+	entries = append(entries, d.probeDNSPath(localPod))
+
+	d.reportMatrix(entries)
+	return d.res
+}
+
+// probePodPaths probes pod-to-pod connectivity to one diagnostic pod on the same
+// node (when present) and to the diagnostic pod on every other node.
+func (d CheckConnectivityMatrix) probePodPaths(localPod *kapi.Pod, localPods, nonlocalPods []kapi.Pod) []matrixEntry {
+	entries := []matrixEntry{}
+	for i := range localPods {
+		pod := &localPods[i]
+		if pod.UID == localPod.UID {
+			continue
+		}
+		entries = append(entries, d.probePing(localPod, pod, "pod-to-pod (same node)"))
+		break
+	}
+	for i := range nonlocalPods {
+		pod := &nonlocalPods[i]
+		entries = append(entries, d.probePing(localPod, pod, "pod-to-pod (different node)"))
+	}
+	return entries
+}
+
+func (d CheckConnectivityMatrix) probePing(fromPod, toPod *kapi.Pod, path string) matrixEntry {
+	entry := matrixEntry{
+		source: fmt.Sprintf("%s (node %s)", util.PrintPod(fromPod), fromPod.Spec.NodeName),
+		target: fmt.Sprintf("%s (node %s)", util.PrintPod(toPod), toPod.Spec.NodeName),
+		path:   path,
+	}
+	out, err := d.runInPodNetns(fromPod, "ping", "-c1", "-W2", toPod.Status.PodIP)
+	entry.success = err == nil
+	if err != nil {
+		entry.detail = fmt.Sprintf("%s, Out: %s", err, out)
+	}
+	return entry
+}
+
+// probeServicePath probes the well known kubernetes service cluster IP over TCP,
+// exercising the iptables rules programmed by kube-proxy.
+func (d CheckConnectivityMatrix) probeServicePath(localPod *kapi.Pod) matrixEntry {
+	entry := matrixEntry{
+		source: fmt.Sprintf("%s (node %s)", util.PrintPod(localPod), localPod.Spec.NodeName),
+		target: "service default/kubernetes",
+		path:   "pod-to-service",
+	}
+	service, err := d.KubeClient.Core().Services(kapi.NamespaceDefault).Get("kubernetes")
+	if err != nil {
+		entry.detail = fmt.Sprintf("unable to get default/kubernetes service: %s", err)
+		return entry
+	}
+	if len(service.Spec.Ports) == 0 {
+		entry.detail = "default/kubernetes service has no ports"
+		return entry
+	}
+	out, err := d.probeTCP(localPod, service.Spec.ClusterIP, service.Spec.Ports[0].Port)
+	entry.success = err == nil
+	if err != nil {
+		entry.detail = fmt.Sprintf("%s, Out: %s", err, out)
+	}
+	return entry
+}
+
+// probeMasterPath probes the master API endpoint directly, bypassing the service
+// cluster IP, to distinguish master reachability from iptables problems.
+func (d CheckConnectivityMatrix) probeMasterPath(localPod *kapi.Pod) matrixEntry {
+	entry := matrixEntry{
+		source: fmt.Sprintf("%s (node %s)", util.PrintPod(localPod), localPod.Spec.NodeName),
+		target: "master API endpoints",
+		path:   "pod-to-master",
+	}
+	endpoints, err := d.KubeClient.Core().Endpoints(kapi.NamespaceDefault).Get("kubernetes")
+	if err != nil {
+		entry.detail = fmt.Sprintf("unable to get default/kubernetes endpoints: %s", err)
+		return entry
+	}
+	for _, subset := range endpoints.Subsets {
+		if len(subset.Ports) == 0 {
+			continue
+		}
+		for _, address := range subset.Addresses {
+			out, err := d.probeTCP(localPod, address.IP, subset.Ports[0].Port)
+			if err != nil {
+				entry.detail = fmt.Sprintf("endpoint %s: %s, Out: %s", address.IP, err, out)
+				return entry
+			}
+		}
+	}
+	entry.success = true
+	return entry
+}
+
+// probeDNSPath resolves the well known kubernetes service name through the DNS
+// configuration visible inside the pod network namespace.
+func (d CheckConnectivityMatrix) probeDNSPath(localPod *kapi.Pod) matrixEntry {
+	entry := matrixEntry{
+		source: fmt.Sprintf("%s (node %s)", util.PrintPod(localPod), localPod.Spec.NodeName),
+		target: "kubernetes.default.svc.cluster.local",
+		path:   "dns",
+	}
+	out, err := d.runInPodNetns(localPod, "getent", "hosts", "kubernetes.default.svc.cluster.local")
+	entry.success = err == nil
+	if err != nil {
+		entry.detail = fmt.Sprintf("%s, Out: %s", err, out)
+	}
+	return entry
+}
+
+func (d CheckConnectivityMatrix) probeTCP(fromPod *kapi.Pod, ip string, port int32) (string, error) {
+	return d.runInPodNetns(fromPod, "timeout", "2", "bash", "-c", fmt.Sprintf("echo -n > /dev/tcp/%s/%d", ip, port))
+}
+
+// runInPodNetns runs the given command inside the network namespace of the pod.
+func (d CheckConnectivityMatrix) runInPodNetns(pod *kapi.Pod, cmd ...string) (string, error) {
+	if len(pod.Status.ContainerStatuses) == 0 {
+		return "", fmt.Errorf("ContainerID not found for pod %q", util.PrintPod(pod))
+	}
+
+	kexecer := kexec.New()
+	containerID := kcontainer.ParseContainerID(pod.Status.ContainerStatuses[0].ContainerID).ID
+	pid, err := kexecer.Command("docker", "inspect", "-f", "{{.State.Pid}}", containerID).CombinedOutput()
+	if err != nil {
+		return "", fmt.Errorf("fetching pid for pod %q, container %q failed: %s", util.PrintPod(pod), containerID, err)
+	}
+
+	args := append([]string{"-n", "-t", strings.Trim(fmt.Sprintf("%s", pid), "\n"), "--"}, cmd...)
+	out, err := kexecer.Command("nsenter", args...).CombinedOutput()
+	return string(out), err
+}
+
+// reportMatrix prints every probed path and reports failures grouped by path with
+// the suspected cause for that class of failure.
+func (d CheckConnectivityMatrix) reportMatrix(entries []matrixEntry) {
+	failuresByPath := map[string][]matrixEntry{}
+	successByPath := map[string]bool{}
+	for _, entry := range entries {
+		status := "ok"
+		if !entry.success {
+			status = "FAILED"
+			failuresByPath[entry.path] = append(failuresByPath[entry.path], entry)
+		} else {
+			successByPath[entry.path] = true
+		}
+		d.res.Debug("DNetMatrix2001", fmt.Sprintf("%-30s %s -> %s: %s", entry.path, entry.source, entry.target, status))
+	}
+
+	if len(failuresByPath) == 0 {
+		d.res.Info("DNetMatrix2002", "All probed paths in the connectivity matrix succeeded.")
+		return
+	}
+
+	for path, failures := range failuresByPath {
+		suspect := ""
+		switch path {
+		case "pod-to-pod (same node)":
+			suspect = suspectOVS
+		case "pod-to-pod (different node)":
+			if successByPath["pod-to-pod (same node)"] {
+				suspect = suspectMTU
+			} else {
+				suspect = suspectOVS
+			}
+		case "pod-to-service":
+			if successByPath["pod-to-pod (different node)"] || successByPath["pod-to-pod (same node)"] {
+				suspect = suspectIPTables
+			}
+		case "pod-to-master":
+			suspect = suspectMaster
+		case "dns":
+			suspect = suspectDNS
+		}
+
+		details := []string{}
+		for _, entry := range failures {
+			details = append(details, fmt.Sprintf("%s -> %s: %s", entry.source, entry.target, entry.detail))
+		}
+		msg := fmt.Sprintf("%d %q path(s) failed:\n%s", len(failures), path, strings.Join(details, "\n"))
+		if len(suspect) > 0 {
+			msg = fmt.Sprintf("%s\nSuspected cause: %s", msg, suspect)
+		}
+		d.res.Error("DNetMatrix2003", nil, msg)
+	}
+}
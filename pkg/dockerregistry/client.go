@@ -52,6 +52,29 @@ type Connection interface {
 	// ImageManifest will return the raw image manifest and digest by namespace,
 	// name, and tag.
 	ImageManifest(namespace, name, tag string) (string, []byte, error)
+	// RepositoryUsage will return the per-repository blob count and storage
+	// bytes reported by the registry's usage extension. Only the integrated
+	// registry's V2 API supports this endpoint.
+	RepositoryUsage(namespace, name string) (*RepositoryUsage, error)
+}
+
+// RepositoryUsage describes the blobs referenced by a repository and the storage
+// they consume, both deduplicated across images and totalled per reference.
+type RepositoryUsage struct {
+	// Version specifies the schema version
+	Version int `json:"schemaVersion"`
+	// Name is the repository name in "namespace/name" format
+	Name string `json:"name"`
+	// ImageCount is the number of images referenced by the repository
+	ImageCount int64 `json:"imageCount"`
+	// BlobCount is the number of distinct blobs referenced by the repository
+	BlobCount int64 `json:"blobCount"`
+	// TotalBlobCount is the number of blob references across all images
+	TotalBlobCount int64 `json:"totalBlobCount"`
+	// StorageBytes is the deduplicated size of all referenced blobs
+	StorageBytes int64 `json:"storageBytes"`
+	// TotalStorageBytes is the size of all blob references across all images
+	TotalStorageBytes int64 `json:"totalStorageBytes"`
 }
 
 // client implements the Client interface
@@ -218,6 +241,23 @@ func (c *connection) ImageTags(namespace, name string) (map[string]string, error
 	return repo.getTags(c)
 }
 
+// RepositoryUsage returns the blob count and storage bytes of the named Docker image repository
+func (c *connection) RepositoryUsage(namespace, name string) (*RepositoryUsage, error) {
+	if len(namespace) == 0 && imageapi.IsRegistryDockerHub(c.url.Host) {
+		namespace = imageapi.DockerDefaultNamespace
+	}
+	if len(name) == 0 {
+		return nil, fmt.Errorf("image name must be specified")
+	}
+
+	repo, err := c.getCachedRepository(fmt.Sprintf("%s/%s", namespace, name))
+	if err != nil {
+		return nil, err
+	}
+
+	return repo.getUsage(c)
+}
+
 // ImageByID returns the specified image within the named Docker image repository
 func (c *connection) ImageByID(namespace, name, imageID string) (*Image, error) {
 	if len(namespace) == 0 && imageapi.IsRegistryDockerHub(c.url.Host) {
@@ -481,6 +521,7 @@ type repository interface {
 	getTags(c *connection) (map[string]string, error)
 	getTaggedImage(c *connection, tag, userTag string) (*Image, []byte, error)
 	getImage(c *connection, image, userTag string) (*Image, []byte, error)
+	getUsage(c *connection) (*RepositoryUsage, error)
 }
 
 // v2repository exposes methods for accessing a named Docker V2 repository on a server.
@@ -556,6 +597,56 @@ func (repo *v2repository) getTags(c *connection) (map[string]string, error) {
 	return legacyTags, nil
 }
 
+func (repo *v2repository) getUsage(c *connection) (*RepositoryUsage, error) {
+	endpoint := repo.endpoint
+	endpoint.Path = path.Join(endpoint.Path, fmt.Sprintf("/extensions/v2/%s/usage", repo.name))
+	req, err := http.NewRequest("GET", endpoint.String(), nil)
+	if err != nil {
+		return nil, fmt.Errorf("error creating request: %v", err)
+	}
+
+	if len(repo.token) > 0 {
+		req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", repo.token))
+	}
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, convertConnectionError(c.url.String(), fmt.Errorf("error getting usage of repository %s: %v", repo.name, err))
+	}
+	defer resp.Body.Close()
+
+	switch code := resp.StatusCode; {
+	case code == http.StatusUnauthorized:
+		if len(repo.token) != 0 {
+			if repo.retries > 0 {
+				repo.retries--
+				time.Sleep(time.Second / 2)
+				return repo.getUsage(c)
+			}
+			delete(c.cached, repo.name)
+			return nil, errRepositoryNotFound{repo.name}
+		}
+		token, err := c.authenticateV2(resp.Header.Get("WWW-Authenticate"))
+		if err != nil {
+			return nil, fmt.Errorf("error getting usage of repository %s: %v", repo.name, err)
+		}
+		repo.retries = 2
+		repo.token = token
+		return repo.getUsage(c)
+
+	case code == http.StatusNotFound:
+		return nil, errRepositoryNotFound{repo.name}
+	case code >= 300 || resp.StatusCode < 200:
+		// token might have expired - evict repo from cache so we can get a new one on retry
+		delete(c.cached, repo.name)
+		return nil, fmt.Errorf("error retrieving usage: server returned %d", resp.StatusCode)
+	}
+	usage := &RepositoryUsage{}
+	if err := json.NewDecoder(resp.Body).Decode(usage); err != nil {
+		return nil, fmt.Errorf("error decoding repository %s usage: %v", repo.name, err)
+	}
+	return usage, nil
+}
+
 func (repo *v2repository) getTaggedImage(c *connection, tag, userTag string) (*Image, []byte, error) {
 	endpoint := repo.endpoint
 	endpoint.Path = path.Join(endpoint.Path, fmt.Sprintf("/v2/%s/manifests/%s", repo.name, tag))
@@ -751,6 +842,10 @@ func (repo *v1repository) getTags(c *connection) (map[string]string, error) {
 	return tags, nil
 }
 
+func (repo *v1repository) getUsage(c *connection) (*RepositoryUsage, error) {
+	return nil, fmt.Errorf("usage is not supported by V1 registries")
+}
+
 func (repo *v1repository) getTaggedImage(c *connection, tag, userTag string) (*Image, []byte, error) {
 	endpoint := repo.endpoint
 	endpoint.Path = path.Join(endpoint.Path, fmt.Sprintf("/v1/repositories/%s/tags/%s", repo.name, tag))
@@ -200,6 +200,12 @@ func (m *manifestService) Put(ctx context.Context, manifest distribution.Manifes
 		}
 	}
 
+	go m.repo.notifyWebhooks(ctx, registryWebhookEvent{
+		Event:  imageapi.RegistryWebhookEventPush,
+		Digest: dgst.String(),
+		Tag:    ism.Tag,
+	})
+
 	return dgst, nil
 }
 
@@ -208,7 +214,14 @@ func (m *manifestService) Put(ctx context.Context, manifest distribution.Manifes
 // the content related to the manifest in the registry's storage (signatures).
 func (m *manifestService) Delete(ctx context.Context, dgst digest.Digest) error {
 	context.GetLogger(ctx).Debugf("(*manifestService).Delete")
-	return m.manifests.Delete(withRepository(ctx, m.repo), dgst)
+	err := m.manifests.Delete(withRepository(ctx, m.repo), dgst)
+	if err == nil {
+		go m.repo.notifyWebhooks(ctx, registryWebhookEvent{
+			Event:  imageapi.RegistryWebhookEventDelete,
+			Digest: dgst.String(),
+		})
+	}
+	return err
 }
 
 // manifestInflight tracks currently downloading manifests
@@ -0,0 +1,308 @@
+package server
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"path"
+	"time"
+
+	"github.com/docker/distribution/context"
+	"github.com/docker/distribution/registry/api/errcode"
+	"github.com/docker/distribution/registry/api/v2"
+	"github.com/docker/distribution/registry/handlers"
+	storagedriver "github.com/docker/distribution/registry/storage/driver"
+
+	kerrors "k8s.io/kubernetes/pkg/api/errors"
+
+	"github.com/openshift/origin/pkg/client"
+	imageapi "github.com/openshift/origin/pkg/image/api"
+
+	gorillahandlers "github.com/gorilla/handlers"
+)
+
+const (
+	// RepositoryTrashRetentionEnvVar is an environment variable specifying how long soft-deleted
+	// repositories are kept in the trash before their blobs and manifests are removed from the
+	// storage backend. It takes a valid time duration string (e.g. "168h"). If empty or zero,
+	// soft-deletion is disabled and deleted image streams leave their storage in place until a
+	// hard prune removes it.
+	RepositoryTrashRetentionEnvVar = "REGISTRY_MIDDLEWARE_REPOSITORY_OPENSHIFT_TRASHRETENTION"
+
+	// repositoriesRootPath is the path in the storage backend under which repository data lives.
+	repositoriesRootPath = "/docker/registry/v2/repositories"
+	// trashRootPath is the path in the storage backend under which soft-deleted repositories are
+	// kept until the retention window passes.
+	trashRootPath = "/docker/registry/v2/trash"
+	// trashMarkerFile is written into a trashed repository and records the deletion time in
+	// RFC3339 format.
+	trashMarkerFile = "_trash_deleted_at"
+
+	// trashSweepInterval is how often the sweeper looks for repositories to trash or purge.
+	trashSweepInterval = 1 * time.Hour
+
+	restoredRepositorySchemaVersion = 1
+)
+
+var (
+	// errRepositoryNotInTrash is returned when a restore is requested for a repository that has
+	// no trash entry.
+	errRepositoryNotInTrash = fmt.Errorf("repository not found in trash")
+	// errRepositoryExists is returned when a restore would overwrite live repository storage.
+	errRepositoryExists = fmt.Errorf("repository already exists in storage")
+)
+
+// trashRepository moves the storage of the named repository into the trash and records the
+// deletion time. Any previous trash entry for the same name is replaced.
+func trashRepository(ctx context.Context, driver storagedriver.StorageDriver, name string) error {
+	repoPath := path.Join(repositoriesRootPath, name)
+	trashPath := path.Join(trashRootPath, name)
+
+	if _, err := driver.Stat(ctx, repoPath); err != nil {
+		return err
+	}
+	if err := driver.Delete(ctx, trashPath); err != nil {
+		if _, ok := err.(storagedriver.PathNotFoundError); !ok {
+			return err
+		}
+	}
+	if err := driver.Move(ctx, repoPath, trashPath); err != nil {
+		return err
+	}
+	marker := []byte(time.Now().UTC().Format(time.RFC3339))
+	return driver.PutContent(ctx, path.Join(trashPath, trashMarkerFile), marker)
+}
+
+// restoreRepository moves a trashed repository back into the live repository tree and returns
+// the tags it carries mapped to their manifest digests. errRepositoryNotInTrash is returned if
+// there is no trash entry for the name, errRepositoryExists if live storage for the name is
+// still (or again) present.
+func restoreRepository(ctx context.Context, driver storagedriver.StorageDriver, name string) (map[string]string, error) {
+	repoPath := path.Join(repositoriesRootPath, name)
+	trashPath := path.Join(trashRootPath, name)
+
+	if _, err := driver.Stat(ctx, trashPath); err != nil {
+		if _, ok := err.(storagedriver.PathNotFoundError); ok {
+			return nil, errRepositoryNotInTrash
+		}
+		return nil, err
+	}
+	if _, err := driver.Stat(ctx, repoPath); err == nil {
+		return nil, errRepositoryExists
+	} else if _, ok := err.(storagedriver.PathNotFoundError); !ok {
+		return nil, err
+	}
+
+	if err := driver.Delete(ctx, path.Join(trashPath, trashMarkerFile)); err != nil {
+		if _, ok := err.(storagedriver.PathNotFoundError); !ok {
+			return nil, err
+		}
+	}
+	if err := driver.Move(ctx, trashPath, repoPath); err != nil {
+		return nil, err
+	}
+
+	tags := map[string]string{}
+	tagDirs, err := driver.List(ctx, path.Join(repoPath, "_manifests", "tags"))
+	if err != nil {
+		if _, ok := err.(storagedriver.PathNotFoundError); ok {
+			return tags, nil
+		}
+		return nil, err
+	}
+	for _, tagDir := range tagDirs {
+		link, err := driver.GetContent(ctx, path.Join(tagDir, "current", "link"))
+		if err != nil {
+			context.GetLogger(ctx).Warnf("trash: unable to read tag link %s: %v", tagDir, err)
+			continue
+		}
+		tags[path.Base(tagDir)] = string(link)
+	}
+	return tags, nil
+}
+
+// trashSweeper periodically moves repositories whose image stream no longer exists into the
+// trash and purges trash entries older than the retention window.
+type trashSweeper struct {
+	driver    storagedriver.StorageDriver
+	osClient  client.Interface
+	retention time.Duration
+}
+
+func newTrashSweeper(driver storagedriver.StorageDriver, osClient client.Interface, retention time.Duration) *trashSweeper {
+	return &trashSweeper{
+		driver:    driver,
+		osClient:  osClient,
+		retention: retention,
+	}
+}
+
+func (s *trashSweeper) run(ctx context.Context) {
+	for {
+		s.sweep(ctx)
+		time.Sleep(trashSweepInterval)
+	}
+}
+
+func (s *trashSweeper) sweep(ctx context.Context) {
+	if err := s.sweepRepositories(ctx); err != nil {
+		context.GetLogger(ctx).Errorf("trash: error sweeping repositories: %v", err)
+	}
+	if err := s.sweepTrash(ctx); err != nil {
+		context.GetLogger(ctx).Errorf("trash: error sweeping trash: %v", err)
+	}
+}
+
+// sweepRepositories trashes every repository whose image stream has been deleted. A repository
+// is only trashed when the API server definitely reports the image stream as absent; any other
+// error leaves the storage untouched.
+func (s *trashSweeper) sweepRepositories(ctx context.Context) error {
+	for _, name := range s.listRepositories(ctx, repositoriesRootPath) {
+		namespace, repo := path.Split(name)
+		namespace = path.Clean(namespace)
+		_, err := s.osClient.ImageStreams(namespace).Get(repo)
+		switch {
+		case err == nil:
+		case kerrors.IsNotFound(err):
+			context.GetLogger(ctx).Infof("trash: image stream %s is gone, moving repository to trash", name)
+			if err := trashRepository(ctx, s.driver, name); err != nil {
+				context.GetLogger(ctx).Errorf("trash: unable to trash repository %s: %v", name, err)
+			}
+		default:
+			context.GetLogger(ctx).Warnf("trash: unable to verify image stream %s: %v", name, err)
+		}
+	}
+	return nil
+}
+
+// sweepTrash removes trash entries whose retention window has passed.
+func (s *trashSweeper) sweepTrash(ctx context.Context) error {
+	for _, name := range s.listRepositories(ctx, trashRootPath) {
+		trashPath := path.Join(trashRootPath, name)
+		marker, err := s.driver.GetContent(ctx, path.Join(trashPath, trashMarkerFile))
+		if err != nil {
+			context.GetLogger(ctx).Warnf("trash: unable to read deletion marker of %s: %v", name, err)
+			continue
+		}
+		deletedAt, err := time.Parse(time.RFC3339, string(marker))
+		if err != nil {
+			context.GetLogger(ctx).Warnf("trash: invalid deletion marker of %s: %v", name, err)
+			continue
+		}
+		if time.Since(deletedAt) < s.retention {
+			continue
+		}
+		context.GetLogger(ctx).Infof("trash: purging repository %s deleted at %s", name, deletedAt)
+		if err := s.driver.Delete(ctx, trashPath); err != nil {
+			context.GetLogger(ctx).Errorf("trash: unable to purge repository %s: %v", name, err)
+		}
+	}
+	return nil
+}
+
+// listRepositories returns the <namespace>/<name> repositories found under root. Repositories
+// pushed with a single path component are not managed by OpenShift and are skipped.
+func (s *trashSweeper) listRepositories(ctx context.Context, root string) []string {
+	names := []string{}
+	namespaces, err := s.driver.List(ctx, root)
+	if err != nil {
+		if _, ok := err.(storagedriver.PathNotFoundError); !ok {
+			context.GetLogger(ctx).Warnf("trash: unable to list %s: %v", root, err)
+		}
+		return names
+	}
+	for _, namespacePath := range namespaces {
+		repos, err := s.driver.List(ctx, namespacePath)
+		if err != nil {
+			context.GetLogger(ctx).Warnf("trash: unable to list %s: %v", namespacePath, err)
+			continue
+		}
+		for _, repoPath := range repos {
+			names = append(names, path.Join(path.Base(namespacePath), path.Base(repoPath)))
+		}
+	}
+	return names
+}
+
+// StartTrashSweeper starts a background sweeper that soft-deletes the storage of repositories
+// whose image stream has been removed and purges it after retention. A zero retention disables
+// the sweeper.
+func StartTrashSweeper(ctx context.Context, registryClient RegistryClient, retention time.Duration) error {
+	if retention <= 0 {
+		return nil
+	}
+	if dockerStorageDriver == nil {
+		return fmt.Errorf("the OpenShift storage driver middleware is required for repository soft-deletion")
+	}
+	osClient, _, err := registryClient.Clients()
+	if err != nil {
+		return err
+	}
+	go newTrashSweeper(dockerStorageDriver, osClient, retention).run(ctx)
+	return nil
+}
+
+// restoredRepository describes a repository that was restored from the trash.
+type restoredRepository struct {
+	// Version specifies the schema version
+	Version int `json:"schemaVersion"`
+	// Name is the repository name in "namespace/name" format
+	Name string `json:"name"`
+	// Tags maps the restored tags to their manifest digests
+	Tags map[string]string `json:"tags"`
+}
+
+// RepositoryRestoreDispatcher takes the request context and builds the appropriate handler for
+// restoring a soft-deleted repository from the trash.
+func RepositoryRestoreDispatcher(ctx *handlers.Context, r *http.Request) http.Handler {
+	restoreHandler := &restoreHandler{
+		Context: ctx,
+		Name:    context.GetStringValue(ctx, "vars.name"),
+	}
+
+	return gorillahandlers.MethodHandler{
+		"PUT": http.HandlerFunc(restoreHandler.Restore),
+	}
+}
+
+// restoreHandler handles requests to restore a repository from the trash.
+type restoreHandler struct {
+	*handlers.Context
+
+	Name string
+}
+
+// Restore moves the repository out of the trash and reports the tags it carries.
+func (rh *restoreHandler) Restore(w http.ResponseWriter, req *http.Request) {
+	defer req.Body.Close()
+	context.GetLogger(rh).Debugf("(*restoreHandler).Restore")
+
+	ref, err := imageapi.ParseDockerImageReference(rh.Name)
+	if err != nil || len(ref.Namespace) == 0 || len(ref.Name) == 0 {
+		rh.Errors = append(rh.Errors, v2.ErrorCodeNameInvalid.WithDetail("the repository name must be of the form <project>/<name>"))
+		return
+	}
+
+	tags, err := restoreRepository(rh.Context, dockerStorageDriver, ref.RepositoryName())
+	switch err {
+	case nil:
+	case errRepositoryNotInTrash:
+		w.WriteHeader(http.StatusNotFound)
+		return
+	case errRepositoryExists:
+		rh.Errors = append(rh.Errors, errcode.ErrorCodeDenied.WithDetail(fmt.Sprintf("repository %q already exists in storage, refusing to overwrite it", ref.RepositoryName())))
+		return
+	default:
+		rh.Errors = append(rh.Errors, errcode.ErrorCodeUnknown.WithDetail(fmt.Sprintf("error restoring repository %q: %v", ref.RepositoryName(), err)))
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	if err := json.NewEncoder(w).Encode(&restoredRepository{
+		Version: restoredRepositorySchemaVersion,
+		Name:    ref.RepositoryName(),
+		Tags:    tags,
+	}); err != nil {
+		context.GetLogger(rh).Errorf("error sending restore response: %v", err)
+	}
+}
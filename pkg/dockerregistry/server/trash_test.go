@@ -0,0 +1,121 @@
+package server
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/docker/distribution/context"
+	storagedriver "github.com/docker/distribution/registry/storage/driver"
+	"github.com/docker/distribution/registry/storage/driver/inmemory"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+
+	"github.com/openshift/origin/pkg/client/testclient"
+	imagetest "github.com/openshift/origin/pkg/image/admission/testutil"
+	imageapi "github.com/openshift/origin/pkg/image/api"
+)
+
+const testTrashDigest = "sha256:958608f8ecc1dc62c93b6c610f3a834dae4220c9642e6e8b4e0f2b3ad7cbd238"
+
+// populateTestRepository creates the minimal storage layout of a repository with a single tag
+// pointing at testTrashDigest.
+func populateTestRepository(t *testing.T, ctx context.Context, driver storagedriver.StorageDriver, name, tag string) {
+	repoPath := fmt.Sprintf("%s/%s", repositoriesRootPath, name)
+	for path, content := range map[string]string{
+		fmt.Sprintf("%s/_manifests/tags/%s/current/link", repoPath, tag): testTrashDigest,
+		fmt.Sprintf("%s/_layers/sha256/deadbeef/link", repoPath):         testTrashDigest,
+	} {
+		if err := driver.PutContent(ctx, path, []byte(content)); err != nil {
+			t.Fatalf("unexpected error populating %s: %v", path, err)
+		}
+	}
+}
+
+func pathExists(ctx context.Context, driver storagedriver.StorageDriver, path string) bool {
+	_, err := driver.Stat(ctx, path)
+	return err == nil
+}
+
+func TestTrashAndRestoreRepository(t *testing.T) {
+	ctx := context.Background()
+	driver := inmemory.New()
+	populateTestRepository(t, ctx, driver, "nm/is", "latest")
+
+	if err := trashRepository(ctx, driver, "nm/is"); err != nil {
+		t.Fatalf("unexpected error trashing repository: %v", err)
+	}
+	if pathExists(ctx, driver, repositoriesRootPath+"/nm/is") {
+		t.Errorf("expected live repository storage to be moved to the trash")
+	}
+	if !pathExists(ctx, driver, trashRootPath+"/nm/is/"+trashMarkerFile) {
+		t.Errorf("expected a deletion marker in the trash entry")
+	}
+	if err := trashRepository(ctx, driver, "nm/is"); err == nil {
+		t.Errorf("expected an error trashing an already trashed repository")
+	}
+
+	tags, err := restoreRepository(ctx, driver, "nm/is")
+	if err != nil {
+		t.Fatalf("unexpected error restoring repository: %v", err)
+	}
+	if len(tags) != 1 || tags["latest"] != testTrashDigest {
+		t.Errorf("unexpected restored tags: %#v", tags)
+	}
+	if !pathExists(ctx, driver, repositoriesRootPath+"/nm/is/_layers/sha256/deadbeef/link") {
+		t.Errorf("expected the repository layout to be restored")
+	}
+	if pathExists(ctx, driver, repositoriesRootPath+"/nm/is/"+trashMarkerFile) {
+		t.Errorf("the deletion marker must not be restored with the repository")
+	}
+
+	if _, err := restoreRepository(ctx, driver, "nm/is"); err != errRepositoryNotInTrash {
+		t.Errorf("expected errRepositoryNotInTrash restoring twice, got %v", err)
+	}
+
+	if err := trashRepository(ctx, driver, "nm/is"); err != nil {
+		t.Fatalf("unexpected error trashing repository: %v", err)
+	}
+	populateTestRepository(t, ctx, driver, "nm/is", "latest")
+	if _, err := restoreRepository(ctx, driver, "nm/is"); err != errRepositoryExists {
+		t.Errorf("expected errRepositoryExists restoring over live storage, got %v", err)
+	}
+}
+
+func TestTrashSweep(t *testing.T) {
+	ctx := context.Background()
+	driver := inmemory.New()
+	populateTestRepository(t, ctx, driver, "keep/is", "latest")
+	populateTestRepository(t, ctx, driver, "gone/is", "latest")
+
+	client := &testclient.Fake{}
+	client.AddReactor("get", "imagestreams", imagetest.GetFakeImageStreamGetHandler(t, imageapi.ImageStream{
+		ObjectMeta: kapi.ObjectMeta{Namespace: "keep", Name: "is"},
+	}))
+
+	sweeper := newTrashSweeper(driver, client, time.Hour)
+	sweeper.sweep(ctx)
+
+	if !pathExists(ctx, driver, repositoriesRootPath+"/keep/is") {
+		t.Errorf("expected the repository with a live image stream to stay in place")
+	}
+	if !pathExists(ctx, driver, trashRootPath+"/gone/is/"+trashMarkerFile) {
+		t.Errorf("expected the repository without an image stream to be trashed")
+	}
+
+	// a fresh trash entry survives the sweep
+	sweeper.sweep(ctx)
+	if !pathExists(ctx, driver, trashRootPath+"/gone/is") {
+		t.Errorf("expected the trash entry to survive within the retention window")
+	}
+
+	// age the deletion marker past the retention window
+	expired := time.Now().UTC().Add(-2 * time.Hour).Format(time.RFC3339)
+	if err := driver.PutContent(ctx, trashRootPath+"/gone/is/"+trashMarkerFile, []byte(expired)); err != nil {
+		t.Fatalf("unexpected error aging the deletion marker: %v", err)
+	}
+	sweeper.sweep(ctx)
+	if pathExists(ctx, driver, trashRootPath+"/gone/is") {
+		t.Errorf("expected the expired trash entry to be purged")
+	}
+}
@@ -0,0 +1,164 @@
+package server
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	kapierrors "k8s.io/kubernetes/pkg/api/errors"
+	"k8s.io/kubernetes/pkg/util/sets"
+
+	ctxu "github.com/docker/distribution/context"
+
+	"github.com/docker/distribution/context"
+	"github.com/docker/distribution/reference"
+	"github.com/docker/distribution/registry/api/errcode"
+	"github.com/docker/distribution/registry/api/v2"
+	"github.com/docker/distribution/registry/auth"
+	"github.com/docker/distribution/registry/handlers"
+
+	imageapi "github.com/openshift/origin/pkg/image/api"
+
+	gorillahandlers "github.com/gorilla/handlers"
+)
+
+const usageSchemaVersion = 1
+
+// storageUsage describes the blobs referenced by a single repository and the
+// storage they consume. The deduplicated numbers count each blob once no
+// matter how many images reference it; the total numbers count a blob once per
+// referencing image.
+type storageUsage struct {
+	// Version specifies the schema version
+	Version int `json:"schemaVersion"`
+	// Name is the repository name in "namespace/name" format
+	Name string `json:"name"`
+	// ImageCount is the number of images referenced by the repository
+	ImageCount int64 `json:"imageCount"`
+	// BlobCount is the number of distinct blobs referenced by the repository
+	BlobCount int64 `json:"blobCount"`
+	// TotalBlobCount is the number of blob references across all images
+	TotalBlobCount int64 `json:"totalBlobCount"`
+	// StorageBytes is the deduplicated size of all referenced blobs
+	StorageBytes int64 `json:"storageBytes"`
+	// TotalStorageBytes is the size of all blob references across all images
+	TotalStorageBytes int64 `json:"totalStorageBytes"`
+}
+
+// RegisterStorageUsageHandler registers the storage usage extension to Docker
+// registry.
+func RegisterStorageUsageHandler(app *handlers.App) {
+	extensionsRouter := app.NewRoute().PathPrefix("/extensions/v2/").Subrouter()
+	getUsageAccess := func(r *http.Request) []auth.Access {
+		return []auth.Access{
+			{
+				Resource: auth.Resource{
+					Type: "repository",
+					Name: context.GetStringValue(context.WithVars(app, r), "vars.name"),
+				},
+				Action: "pull",
+			},
+		}
+	}
+	app.RegisterRoute(
+		extensionsRouter.Path("/{name:"+reference.NameRegexp.String()+"}/usage").Methods("GET"),
+		StorageUsageDispatcher,
+		handlers.NameRequired,
+		getUsageAccess,
+	)
+}
+
+type usageHandler struct {
+	ctx       *handlers.Context
+	reference imageapi.DockerImageReference
+}
+
+// StorageUsageDispatcher handles the GET requests for the storage usage endpoint.
+func StorageUsageDispatcher(ctx *handlers.Context, r *http.Request) http.Handler {
+	usageHandler := &usageHandler{ctx: ctx}
+	usageHandler.reference, _ = imageapi.ParseDockerImageReference(ctxu.GetStringValue(ctx, "vars.name"))
+
+	return gorillahandlers.MethodHandler{
+		"GET": http.HandlerFunc(usageHandler.Get),
+	}
+}
+
+func (h *usageHandler) Get(w http.ResponseWriter, req *http.Request) {
+	context.GetLogger(h.ctx).Debugf("(*usageHandler).Get")
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	if len(h.reference.Namespace) == 0 || len(h.reference.Name) == 0 {
+		h.handleError(h.ctx, v2.ErrorCodeNameInvalid.WithDetail("the repository name must be of the form <project>/<name>"), w)
+		return
+	}
+	client, ok := userClientFrom(h.ctx)
+	if !ok {
+		h.handleError(h.ctx, errcode.ErrorCodeUnknown.WithDetail("unable to get origin client"), w)
+		return
+	}
+
+	stream, err := client.ImageStreams(h.reference.Namespace).Get(h.reference.Name)
+	switch {
+	case err == nil:
+	case kapierrors.IsUnauthorized(err):
+		h.handleError(h.ctx, errcode.ErrorCodeUnauthorized.WithDetail(fmt.Sprintf("not authorized to get usage of repository %q: %v", h.reference.RepositoryName(), err)), w)
+		return
+	case kapierrors.IsNotFound(err):
+		w.WriteHeader(http.StatusNotFound)
+		return
+	default:
+		h.handleError(h.ctx, errcode.ErrorCodeUnknown.WithDetail(fmt.Sprintf("unable to get usage of repository %q: %v", h.reference.RepositoryName(), err)), w)
+		return
+	}
+
+	usage := storageUsage{
+		Version: usageSchemaVersion,
+		Name:    h.reference.RepositoryName(),
+	}
+	seenImages := sets.NewString()
+	seenBlobs := sets.NewString()
+	for _, history := range stream.Status.Tags {
+		for _, event := range history.Items {
+			if len(event.Image) == 0 || seenImages.Has(event.Image) {
+				continue
+			}
+			seenImages.Insert(event.Image)
+
+			isimage, err := client.ImageStreamImages(h.reference.Namespace).Get(h.reference.Name, event.Image)
+			if kapierrors.IsNotFound(err) {
+				// the image was pruned after being tagged
+				continue
+			}
+			if err != nil {
+				h.handleError(h.ctx, errcode.ErrorCodeUnknown.WithDetail(fmt.Sprintf("unable to get image %s of repository %q: %v", event.Image, h.reference.RepositoryName(), err)), w)
+				return
+			}
+
+			usage.ImageCount++
+			for _, layer := range isimage.Image.DockerImageLayers {
+				usage.TotalBlobCount++
+				usage.TotalStorageBytes += layer.LayerSize
+				if seenBlobs.Has(layer.Name) {
+					continue
+				}
+				seenBlobs.Insert(layer.Name)
+				usage.BlobCount++
+				usage.StorageBytes += layer.LayerSize
+			}
+		}
+	}
+
+	if data, err := json.Marshal(usage); err != nil {
+		h.handleError(h.ctx, errcode.ErrorCodeUnknown.WithDetail(fmt.Sprintf("failed to serialize repository usage: %v", err)), w)
+	} else {
+		w.Write(data)
+	}
+}
+
+func (h *usageHandler) handleError(ctx context.Context, err error, w http.ResponseWriter) {
+	context.GetLogger(ctx).Errorf("(*usageHandler): %v", err)
+	ctx, w = context.WithResponseWriter(ctx, w)
+	if serveErr := errcode.ServeJSON(w, err); serveErr != nil {
+		context.GetResponseLogger(ctx).Errorf("error sending error response: %v", serveErr)
+		return
+	}
+}
@@ -0,0 +1,125 @@
+package server
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/docker/distribution/context"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+
+	imageapi "github.com/openshift/origin/pkg/image/api"
+)
+
+const (
+	// registryWebhookGlobalNamespace is the namespace whose RegistryWebhooks receive events for
+	// every repository. It matches the namespace used for other shared resources.
+	registryWebhookGlobalNamespace = "openshift"
+
+	// registryWebhookTimeout bounds a single delivery attempt
+	registryWebhookTimeout = 10 * time.Second
+
+	// registryWebhookSignatureHeader carries the hex encoded HMAC-SHA256 signature of the payload
+	registryWebhookSignatureHeader = "X-Registry-Signature"
+
+	// registryWebhookEventHeader carries the event type of the payload
+	registryWebhookEventHeader = "X-Registry-Event"
+)
+
+// registryWebhookEvent is the payload POSTed to registry webhook endpoints.
+type registryWebhookEvent struct {
+	// Event is "push" or "delete"
+	Event string `json:"event"`
+	// Repository is the <namespace>/<name> of the repository the event occurred in
+	Repository string `json:"repository"`
+	// Digest is the digest of the manifest the event concerns
+	Digest string `json:"digest"`
+	// Tag is the tag the manifest was pushed to, if any
+	Tag string `json:"tag,omitempty"`
+	// Timestamp is the time the event was emitted
+	Timestamp time.Time `json:"timestamp"`
+}
+
+// notifyWebhooks delivers the given event to every matching RegistryWebhook defined in the
+// repository's namespace or in the global namespace. Webhook objects are read on each
+// delivery so changes take effect without a registry restart. Deliveries are best effort;
+// failures are logged and never fail the triggering registry operation.
+func (r *repository) notifyWebhooks(ctx context.Context, event registryWebhookEvent) {
+	event.Repository = fmt.Sprintf("%s/%s", r.namespace, r.name)
+	event.Timestamp = time.Now()
+
+	payload, err := json.Marshal(&event)
+	if err != nil {
+		context.GetLogger(ctx).Errorf("webhook: error marshaling %s event for %s: %v", event.Event, event.Repository, err)
+		return
+	}
+
+	for _, namespace := range []string{r.namespace, registryWebhookGlobalNamespace} {
+		webhooks, err := r.registryOSClient.RegistryWebhooks(namespace).List(kapi.ListOptions{})
+		if err != nil {
+			context.GetLogger(ctx).Errorf("webhook: error listing registry webhooks in namespace %s: %v", namespace, err)
+			continue
+		}
+		for i := range webhooks.Items {
+			webhook := &webhooks.Items[i]
+			if !webhookMatchesEvent(webhook, event.Event) {
+				continue
+			}
+			if err := deliverWebhookEvent(webhook, payload, event.Event); err != nil {
+				context.GetLogger(ctx).Errorf("webhook: error delivering %s event for %s to %s/%s: %v", event.Event, event.Repository, webhook.Namespace, webhook.Name, err)
+			}
+		}
+	}
+}
+
+// webhookMatchesEvent returns true if the webhook subscribes to the given event type. An
+// empty event list subscribes to all events.
+func webhookMatchesEvent(webhook *imageapi.RegistryWebhook, event string) bool {
+	if len(webhook.Spec.Events) == 0 {
+		return true
+	}
+	for _, e := range webhook.Spec.Events {
+		if e == event {
+			return true
+		}
+	}
+	return false
+}
+
+// deliverWebhookEvent POSTs the payload to the webhook endpoint, signing it with the
+// webhook secret when one is set.
+func deliverWebhookEvent(webhook *imageapi.RegistryWebhook, payload []byte, event string) error {
+	req, err := http.NewRequest("POST", webhook.Spec.URL, bytes.NewReader(payload))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(registryWebhookEventHeader, event)
+	if len(webhook.Spec.Secret) > 0 {
+		mac := hmac.New(sha256.New, []byte(webhook.Spec.Secret))
+		mac.Write(payload)
+		req.Header.Set(registryWebhookSignatureHeader, hex.EncodeToString(mac.Sum(nil)))
+	}
+
+	transport := secureTransport
+	if webhook.Spec.Insecure {
+		transport = insecureTransport
+	}
+	client := &http.Client{Transport: transport, Timeout: registryWebhookTimeout}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("endpoint returned status %s", resp.Status)
+	}
+	return nil
+}
@@ -0,0 +1,79 @@
+package server
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	imageapi "github.com/openshift/origin/pkg/image/api"
+)
+
+func TestWebhookMatchesEvent(t *testing.T) {
+	for _, tc := range []struct {
+		events   []string
+		event    string
+		expected bool
+	}{
+		{events: nil, event: "push", expected: true},
+		{events: []string{"push"}, event: "push", expected: true},
+		{events: []string{"push"}, event: "delete", expected: false},
+		{events: []string{"push", "delete"}, event: "delete", expected: true},
+	} {
+		webhook := &imageapi.RegistryWebhook{}
+		webhook.Spec.Events = tc.events
+		if got := webhookMatchesEvent(webhook, tc.event); got != tc.expected {
+			t.Errorf("events=%v event=%s: got %v, expected %v", tc.events, tc.event, got, tc.expected)
+		}
+	}
+}
+
+func TestDeliverWebhookEvent(t *testing.T) {
+	payload := []byte(`{"event":"push","repository":"ns/repo"}`)
+
+	var receivedEvent, receivedSignature, receivedBody string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		receivedBody = string(body)
+		receivedEvent = r.Header.Get(registryWebhookEventHeader)
+		receivedSignature = r.Header.Get(registryWebhookSignatureHeader)
+	}))
+	defer server.Close()
+
+	webhook := &imageapi.RegistryWebhook{}
+	webhook.Spec.URL = server.URL
+	webhook.Spec.Secret = "top-secret"
+
+	if err := deliverWebhookEvent(webhook, payload, "push"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if receivedBody != string(payload) {
+		t.Errorf("unexpected body: %s", receivedBody)
+	}
+	if receivedEvent != "push" {
+		t.Errorf("unexpected event header: %s", receivedEvent)
+	}
+	mac := hmac.New(sha256.New, []byte(webhook.Spec.Secret))
+	mac.Write(payload)
+	if expected := hex.EncodeToString(mac.Sum(nil)); receivedSignature != expected {
+		t.Errorf("got signature %s, expected %s", receivedSignature, expected)
+	}
+}
+
+func TestDeliverWebhookEventFailure(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "no thanks", http.StatusInternalServerError)
+	}))
+	defer server.Close()
+
+	webhook := &imageapi.RegistryWebhook{}
+	webhook.Spec.URL = server.URL
+
+	if err := deliverWebhookEvent(webhook, []byte("{}"), "push"); err == nil {
+		t.Fatal("expected an error for a non-2xx response")
+	}
+}
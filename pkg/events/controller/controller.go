@@ -0,0 +1,137 @@
+package controller
+
+import (
+	"sort"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/api/errors"
+	"k8s.io/kubernetes/pkg/client/clientset_generated/internalclientset"
+
+	"github.com/golang/glog"
+)
+
+// RetentionController aggregates duplicate events and enforces a per-namespace event
+// count budget so busy namespaces cannot flood etcd with events.  Use the
+// RetentionControllerFactory to create this controller.
+type RetentionController struct {
+	// KubeClient is a Kubernetes client.
+	KubeClient internalclientset.Interface
+	// Budget is the maximum number of events retained in a single namespace.
+	Budget int
+	// SampleInterval controls sampling of over-budget events: every SampleInterval-th
+	// event that would otherwise be deleted is retained so some history survives.
+	SampleInterval int
+}
+
+// aggregationKey identifies events that describe the same condition on the same object
+// and can be merged into a single event, even when their messages differ slightly.
+type aggregationKey struct {
+	uid    string
+	kind   string
+	name   string
+	reason string
+	Type   string
+}
+
+// Handle aggregates and prunes the events of a single namespace
+func (c *RetentionController) Handle(namespace *kapi.Namespace) (err error) {
+	// events of a terminating namespace are deleted along with the namespace
+	if namespace.Status.Phase == kapi.NamespaceTerminating {
+		return nil
+	}
+
+	events, err := c.KubeClient.Core().Events(namespace.Name).List(kapi.ListOptions{})
+	if err != nil {
+		return err
+	}
+
+	remaining, err := c.aggregateEvents(namespace.Name, events.Items)
+	if err != nil {
+		return err
+	}
+
+	return c.enforceBudget(namespace.Name, remaining)
+}
+
+// aggregateEvents merges events that share an involved object, reason and type into the
+// most recent event of each group, summing their counts, and returns the events that
+// remain after merging
+func (c *RetentionController) aggregateEvents(namespace string, events []kapi.Event) ([]kapi.Event, error) {
+	groups := map[aggregationKey][]kapi.Event{}
+	for i := range events {
+		event := events[i]
+		key := aggregationKey{
+			uid:    string(event.InvolvedObject.UID),
+			kind:   event.InvolvedObject.Kind,
+			name:   event.InvolvedObject.Name,
+			reason: event.Reason,
+			Type:   event.Type,
+		}
+		groups[key] = append(groups[key], event)
+	}
+
+	remaining := []kapi.Event{}
+	for _, group := range groups {
+		if len(group) == 1 {
+			remaining = append(remaining, group[0])
+			continue
+		}
+
+		sort.Sort(byLastTimestamp(group))
+		representative := group[len(group)-1]
+		representative.FirstTimestamp = group[0].FirstTimestamp
+		for _, duplicate := range group[:len(group)-1] {
+			representative.Count += duplicate.Count
+		}
+
+		updated, err := c.KubeClient.Core().Events(namespace).Update(&representative)
+		if err != nil {
+			if errors.IsNotFound(err) {
+				continue
+			}
+			return nil, err
+		}
+		for _, duplicate := range group[:len(group)-1] {
+			if err := c.KubeClient.Core().Events(namespace).Delete(duplicate.Name, nil); err != nil && !errors.IsNotFound(err) {
+				return nil, err
+			}
+		}
+		remaining = append(remaining, *updated)
+	}
+	return remaining, nil
+}
+
+// enforceBudget deletes the oldest events in the namespace until the budget is met,
+// retaining every SampleInterval-th deletion candidate as a sample
+func (c *RetentionController) enforceBudget(namespace string, events []kapi.Event) error {
+	if c.Budget <= 0 || len(events) <= c.Budget {
+		return nil
+	}
+
+	sort.Sort(byLastTimestamp(events))
+	overflow := events[:len(events)-c.Budget]
+	glog.V(4).Infof("namespace %s has %d events over its budget of %d", namespace, len(overflow), c.Budget)
+
+	for i := range overflow {
+		if c.SampleInterval > 1 && i%c.SampleInterval == 0 {
+			continue
+		}
+		if err := c.KubeClient.Core().Events(namespace).Delete(overflow[i].Name, nil); err != nil && !errors.IsNotFound(err) {
+			return err
+		}
+	}
+	return nil
+}
+
+// byLastTimestamp sorts events from oldest to newest, breaking ties by name so the
+// order is stable
+type byLastTimestamp []kapi.Event
+
+func (s byLastTimestamp) Len() int      { return len(s) }
+func (s byLastTimestamp) Swap(i, j int) { s[i], s[j] = s[j], s[i] }
+func (s byLastTimestamp) Less(i, j int) bool {
+	if s[i].LastTimestamp.Equal(s[j].LastTimestamp) {
+		return s[i].Name < s[j].Name
+	}
+	return s[i].LastTimestamp.Before(s[j].LastTimestamp)
+}
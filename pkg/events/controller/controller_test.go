@@ -0,0 +1,144 @@
+package controller
+
+import (
+	"testing"
+	"time"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/api/unversioned"
+	"k8s.io/kubernetes/pkg/client/clientset_generated/internalclientset/fake"
+	"k8s.io/kubernetes/pkg/types"
+)
+
+func testEvent(name, reason string, count int32, lastSeen time.Time) *kapi.Event {
+	return &kapi.Event{
+		ObjectMeta: kapi.ObjectMeta{Name: name, Namespace: "test"},
+		InvolvedObject: kapi.ObjectReference{
+			Kind:      "Pod",
+			Namespace: "test",
+			Name:      "mypod",
+			UID:       types.UID("pod-uid"),
+		},
+		Reason:         reason,
+		Type:           kapi.EventTypeNormal,
+		Count:          count,
+		FirstTimestamp: unversioned.NewTime(lastSeen.Add(-time.Minute)),
+		LastTimestamp:  unversioned.NewTime(lastSeen),
+	}
+}
+
+func activeNamespace() *kapi.Namespace {
+	return &kapi.Namespace{
+		ObjectMeta: kapi.ObjectMeta{Name: "test"},
+		Status:     kapi.NamespaceStatus{Phase: kapi.NamespaceActive},
+	}
+}
+
+func TestAggregateDuplicateEvents(t *testing.T) {
+	base := time.Date(2016, time.November, 10, 12, 0, 0, 0, time.UTC)
+	client := fake.NewSimpleClientset(
+		testEvent("pulled-1", "Pulled", 1, base),
+		testEvent("pulled-2", "Pulled", 2, base.Add(time.Minute)),
+		testEvent("pulled-3", "Pulled", 3, base.Add(2*time.Minute)),
+		testEvent("started-1", "Started", 1, base),
+	)
+	controller := &RetentionController{KubeClient: client, Budget: 10, SampleInterval: 1}
+
+	if err := controller.Handle(activeNamespace()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	events, err := client.Core().Events("test").List(kapi.ListOptions{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(events.Items) != 2 {
+		t.Fatalf("expected 2 events after aggregation, got %d", len(events.Items))
+	}
+	for _, event := range events.Items {
+		if event.Reason != "Pulled" {
+			continue
+		}
+		if event.Name != "pulled-3" {
+			t.Errorf("expected the most recent event to survive aggregation, got %s", event.Name)
+		}
+		if event.Count != 6 {
+			t.Errorf("expected aggregated count 6, got %d", event.Count)
+		}
+		if !event.FirstTimestamp.Equal(unversioned.NewTime(base.Add(-time.Minute))) {
+			t.Errorf("expected the earliest first timestamp to be preserved, got %v", event.FirstTimestamp)
+		}
+	}
+}
+
+func TestEnforceBudget(t *testing.T) {
+	base := time.Date(2016, time.November, 10, 12, 0, 0, 0, time.UTC)
+	client := fake.NewSimpleClientset(
+		testEvent("a", "ReasonA", 1, base),
+		testEvent("b", "ReasonB", 1, base.Add(time.Minute)),
+		testEvent("c", "ReasonC", 1, base.Add(2*time.Minute)),
+		testEvent("d", "ReasonD", 1, base.Add(3*time.Minute)),
+	)
+	controller := &RetentionController{KubeClient: client, Budget: 2, SampleInterval: 1}
+
+	if err := controller.Handle(activeNamespace()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	events, err := client.Core().Events("test").List(kapi.ListOptions{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(events.Items) != 2 {
+		t.Fatalf("expected 2 events after budget enforcement, got %d", len(events.Items))
+	}
+	for _, event := range events.Items {
+		if event.Name != "c" && event.Name != "d" {
+			t.Errorf("expected only the newest events to be retained, got %s", event.Name)
+		}
+	}
+}
+
+func TestEnforceBudgetWithSampling(t *testing.T) {
+	base := time.Date(2016, time.November, 10, 12, 0, 0, 0, time.UTC)
+	client := fake.NewSimpleClientset(
+		testEvent("a", "ReasonA", 1, base),
+		testEvent("b", "ReasonB", 1, base.Add(time.Minute)),
+		testEvent("c", "ReasonC", 1, base.Add(2*time.Minute)),
+		testEvent("d", "ReasonD", 1, base.Add(3*time.Minute)),
+		testEvent("e", "ReasonE", 1, base.Add(4*time.Minute)),
+	)
+	controller := &RetentionController{KubeClient: client, Budget: 1, SampleInterval: 2}
+
+	if err := controller.Handle(activeNamespace()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	events, err := client.Core().Events("test").List(kapi.ListOptions{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	// overflow is a, b, c, d: every second one (a, c) is retained as a sample
+	if len(events.Items) != 3 {
+		t.Fatalf("expected 3 events after sampled budget enforcement, got %d", len(events.Items))
+	}
+	for _, event := range events.Items {
+		if event.Name != "a" && event.Name != "c" && event.Name != "e" {
+			t.Errorf("unexpected event retained: %s", event.Name)
+		}
+	}
+}
+
+func TestTerminatingNamespaceIgnored(t *testing.T) {
+	client := &fake.Clientset{}
+	controller := &RetentionController{KubeClient: client, Budget: 1, SampleInterval: 1}
+
+	namespace := activeNamespace()
+	namespace.Status.Phase = kapi.NamespaceTerminating
+	if err := controller.Handle(namespace); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(client.Actions()) != 0 {
+		t.Errorf("expected no actions for a terminating namespace, got %#v", client.Actions())
+	}
+}
@@ -0,0 +1,85 @@
+package controller
+
+import (
+	"time"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/client/cache"
+	kclientset "k8s.io/kubernetes/pkg/client/clientset_generated/internalclientset"
+	"k8s.io/kubernetes/pkg/runtime"
+	"k8s.io/kubernetes/pkg/util/flowcontrol"
+	utilruntime "k8s.io/kubernetes/pkg/util/runtime"
+	"k8s.io/kubernetes/pkg/watch"
+
+	controller "github.com/openshift/origin/pkg/controller"
+)
+
+const (
+	// DefaultBudget is the per-namespace event count budget applied when no budget is
+	// configured.
+	DefaultBudget = 1000
+	// DefaultSampleInterval retains every tenth over-budget event.
+	DefaultSampleInterval = 10
+
+	// resyncPeriod controls how often each namespace is revisited for aggregation and
+	// budget enforcement.
+	resyncPeriod = 5 * time.Minute
+)
+
+type RetentionControllerFactory struct {
+	// KubeClient is a Kubernetes client.
+	KubeClient *kclientset.Clientset
+	// Budget is the maximum number of events retained in a single namespace.
+	Budget int
+	// SampleInterval controls sampling of over-budget events.
+	SampleInterval int
+}
+
+// Create creates a RetentionController.
+func (factory *RetentionControllerFactory) Create() controller.RunnableController {
+	namespaceLW := &cache.ListWatch{
+		ListFunc: func(options kapi.ListOptions) (runtime.Object, error) {
+			return factory.KubeClient.Core().Namespaces().List(options)
+		},
+		WatchFunc: func(options kapi.ListOptions) (watch.Interface, error) {
+			return factory.KubeClient.Core().Namespaces().Watch(options)
+		},
+	}
+	queue := cache.NewResyncableFIFO(cache.MetaNamespaceKeyFunc)
+	cache.NewReflector(namespaceLW, &kapi.Namespace{}, queue, resyncPeriod).Run()
+
+	budget := factory.Budget
+	if budget == 0 {
+		budget = DefaultBudget
+	}
+	sampleInterval := factory.SampleInterval
+	if sampleInterval == 0 {
+		sampleInterval = DefaultSampleInterval
+	}
+
+	retentionController := &RetentionController{
+		KubeClient:     factory.KubeClient,
+		Budget:         budget,
+		SampleInterval: sampleInterval,
+	}
+
+	return &controller.RetryController{
+		Queue: queue,
+		RetryManager: controller.NewQueueRetryManager(
+			queue,
+			cache.MetaNamespaceKeyFunc,
+			func(obj interface{}, err error, retries controller.Retry) bool {
+				utilruntime.HandleError(err)
+				if retries.Count > 0 {
+					return false
+				}
+				return true
+			},
+			flowcontrol.NewTokenBucketRateLimiter(1, 10),
+		),
+		Handle: func(obj interface{}) error {
+			namespace := obj.(*kapi.Namespace)
+			return retentionController.Handle(namespace)
+		},
+	}
+}
@@ -12,8 +12,22 @@ func templateScorer(template templateapi.Template, term string) (float32, bool)
 	return score, score < 0.3
 }
 
+// imageStreamAliases maps a detected platform term to alternate image stream names that
+// provide a builder for that platform.
+var imageStreamAliases = map[string][]string{
+	"golang": {"go"},
+	"jee":    {"java", "openjdk"},
+	"rust":   {"rust-nightly"},
+}
+
 func imageStreamScorer(imageStream imageapi.ImageStream, term string) (float32, bool) {
 	score := stringProximityScorer(imageStream.Name, term)
+	for _, alias := range imageStreamAliases[term] {
+		// a direct match on the term always beats a match on one of its aliases
+		if aliasScore := stringProximityScorer(imageStream.Name, alias) + 0.01; aliasScore < score {
+			score = aliasScore
+		}
+	}
 	return score, score < 0.3
 }
 
@@ -0,0 +1,43 @@
+package app
+
+import (
+	"testing"
+
+	imageapi "github.com/openshift/origin/pkg/image/api"
+)
+
+func TestImageStreamScorerAliases(t *testing.T) {
+	tests := []struct {
+		streamName string
+		term       string
+		scored     bool
+	}{
+		{streamName: "golang", term: "golang", scored: true},
+		{streamName: "go", term: "golang", scored: true},
+		{streamName: "java", term: "jee", scored: true},
+		{streamName: "openjdk", term: "jee", scored: true},
+		{streamName: "rust-nightly", term: "rust", scored: true},
+		{streamName: "ruby", term: "golang", scored: false},
+	}
+	for _, test := range tests {
+		stream := imageapi.ImageStream{}
+		stream.Name = test.streamName
+		score, scored := imageStreamScorer(stream, test.term)
+		if scored != test.scored {
+			t.Errorf("stream %q, term %q: expected scored=%v, got %v (score %v)", test.streamName, test.term, test.scored, scored, score)
+		}
+	}
+}
+
+func TestImageStreamScorerPrefersDirectMatch(t *testing.T) {
+	direct := imageapi.ImageStream{}
+	direct.Name = "golang"
+	alias := imageapi.ImageStream{}
+	alias.Name = "go"
+
+	directScore, _ := imageStreamScorer(direct, "golang")
+	aliasScore, _ := imageStreamScorer(alias, "golang")
+	if directScore >= aliasScore {
+		t.Errorf("expected a direct name match (%v) to score better than an alias match (%v)", directScore, aliasScore)
+	}
+}
@@ -57,6 +57,10 @@ func (g *FakeGit) SubmoduleUpdate(dir string, init, recurse bool) error {
 	return nil
 }
 
+func (g *FakeGit) LFSPull(dir string) error {
+	return nil
+}
+
 func (f *FakeGit) Fetch(source string) error {
 	return nil
 }
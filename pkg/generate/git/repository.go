@@ -32,6 +32,7 @@ type Repository interface {
 	Fetch(dir string) error
 	Checkout(dir string, ref string) error
 	SubmoduleUpdate(dir string, init, recursive bool) error
+	LFSPull(dir string) error
 	Archive(dir, ref, format string, w io.Writer) error
 	Init(dir string, bare bool) error
 	Add(dir string, spec string) error
@@ -293,6 +294,13 @@ func (r *repository) SubmoduleUpdate(location string, init, recursive bool) erro
 	return err
 }
 
+// LFSPull fetches Git LFS content referenced by the current checkout and
+// replaces the pointer files in the work tree with it
+func (r *repository) LFSPull(location string) error {
+	_, _, err := r.git(location, "lfs", "pull")
+	return err
+}
+
 // ShowFormat formats the ref with the given git show format string
 func (r *repository) ShowFormat(location, ref, format string) (string, error) {
 	out, _, err := r.git(location, "show", "--quiet", ref, fmt.Sprintf("--format=%s", format))
@@ -20,6 +20,7 @@ type Detectors []DetectorFunc
 var DefaultDetectors = Detectors{
 	DetectRuby,
 	DetectJava,
+	DetectGradle,
 	DetectNodeJS,
 	DetectPHP,
 	DetectPython,
@@ -28,6 +29,7 @@ var DefaultDetectors = Detectors{
 	DetectDotNet,
 	DetectLiteralDotNet,
 	DetectGolang,
+	DetectRust,
 }
 
 // DetectRuby detects Ruby source
@@ -40,6 +42,11 @@ func DetectJava(dir string) *Info {
 	return detect("jee", dir, "pom.xml")
 }
 
+// DetectGradle detects Gradle based Java source, including the Kotlin DSL
+func DetectGradle(dir string) *Info {
+	return detect("jee", dir, "build.gradle", "build.gradle.kts")
+}
+
 // DetectNodeJS detects NodeJS source
 func DetectNodeJS(dir string) *Info {
 	return detect("nodejs", dir, "app.json", "package.json")
@@ -77,7 +84,12 @@ func DetectLiteralDotNet(dir string) *Info {
 
 // DetectGolang detects Go source
 func DetectGolang(dir string) *Info {
-	return detect("golang", dir, "main.go", "Godeps")
+	return detect("golang", dir, "main.go", "Godeps", "go.mod")
+}
+
+// DetectRust detects Rust source
+func DetectRust(dir string) *Info {
+	return detect("rust", dir, "Cargo.toml")
 }
 
 // detect returns an Info object with the given platform if the source at dir contains any of the argument files
@@ -0,0 +1,186 @@
+package imagesignature
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/golang/glog"
+
+	"k8s.io/kubernetes/pkg/admission"
+	kapi "k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/api/errors"
+	clientset "k8s.io/kubernetes/pkg/client/clientset_generated/internalclientset"
+	"k8s.io/kubernetes/pkg/util/sets"
+
+	"github.com/openshift/origin/pkg/client"
+	oadmission "github.com/openshift/origin/pkg/cmd/server/admission"
+	configlatest "github.com/openshift/origin/pkg/cmd/server/api/latest"
+	signatureapi "github.com/openshift/origin/pkg/image/admission/imagesignature/api"
+	signatureapivalidation "github.com/openshift/origin/pkg/image/admission/imagesignature/api/validation"
+	imageapi "github.com/openshift/origin/pkg/image/api"
+	projectcache "github.com/openshift/origin/pkg/project/cache"
+)
+
+func init() {
+	admission.RegisterPlugin("openshift.io/ImageSignaturePolicy", func(client clientset.Interface, config io.Reader) (admission.Interface, error) {
+		pluginConfig, err := readConfig(config)
+		if err != nil {
+			return nil, err
+		}
+		if pluginConfig == nil {
+			glog.Infof("Admission plugin %q is not configured so it will be disabled.", "openshift.io/ImageSignaturePolicy")
+			return nil, nil
+		}
+		return NewImageSignaturePolicy(pluginConfig)
+	})
+}
+
+func readConfig(reader io.Reader) (*signatureapi.ImageSignaturePolicyConfig, error) {
+	obj, err := configlatest.ReadYAML(reader)
+	if err != nil {
+		return nil, err
+	}
+	if obj == nil {
+		return nil, nil
+	}
+	config, ok := obj.(*signatureapi.ImageSignaturePolicyConfig)
+	if !ok {
+		return nil, fmt.Errorf("unexpected config object: %#v", obj)
+	}
+	errs := signatureapivalidation.ValidateImageSignaturePolicyConfig(config)
+	if len(errs) > 0 {
+		return nil, errs.ToAggregate()
+	}
+	return config, nil
+}
+
+type imageSignaturePolicy struct {
+	*admission.Handler
+	client             client.Interface
+	cache              *projectcache.ProjectCache
+	trustedKeyIDs      sets.String
+	excludedNamespaces sets.String
+}
+
+// ensure that the required Openshift admission interfaces are implemented
+var _ = oadmission.WantsOpenshiftClient(&imageSignaturePolicy{})
+var _ = oadmission.WantsProjectCache(&imageSignaturePolicy{})
+
+// NewImageSignaturePolicy returns an admission plugin that rejects pods referencing
+// images that do not carry a trusted signature.
+func NewImageSignaturePolicy(config *signatureapi.ImageSignaturePolicyConfig) (admission.Interface, error) {
+	return &imageSignaturePolicy{
+		Handler:            admission.NewHandler(admission.Create, admission.Update),
+		trustedKeyIDs:      sets.NewString(config.TrustedKeyIDs...),
+		excludedNamespaces: sets.NewString(config.ExcludedNamespaces...),
+	}, nil
+}
+
+// Admit rejects pods whose images cannot be resolved to a signed, trusted Image. Images
+// must be referenced by digest - a tag reference cannot be matched against an
+// ImageSignature and is therefore rejected as unverifiable.
+func (o *imageSignaturePolicy) Admit(a admission.Attributes) error {
+	if a.GetResource().GroupResource() != kapi.Resource("pods") {
+		return nil
+	}
+	pod, ok := a.GetObject().(*kapi.Pod)
+	if !ok {
+		return nil
+	}
+	if o.excludedNamespaces.Has(a.GetNamespace()) {
+		return nil
+	}
+	excluded, err := o.namespaceExcluded(a.GetNamespace())
+	if err != nil {
+		return err
+	}
+	if excluded {
+		return nil
+	}
+
+	for _, container := range append(pod.Spec.InitContainers, pod.Spec.Containers...) {
+		if err := o.admitImage(container.Image); err != nil {
+			return admission.NewForbidden(a, err)
+		}
+	}
+	return nil
+}
+
+// namespaceExcluded returns true when the namespace carries the signature policy
+// annotation set to ignore. The project cache is authoritative for namespace metadata
+// so no additional API round trip is needed on the pod creation path.
+func (o *imageSignaturePolicy) namespaceExcluded(namespace string) (bool, error) {
+	ns, err := o.cache.GetNamespace(namespace)
+	if err != nil {
+		return false, err
+	}
+	return ns.Annotations[signatureapi.SignaturePolicyAnnotation] == signatureapi.SignaturePolicyIgnore, nil
+}
+
+func (o *imageSignaturePolicy) admitImage(pullSpec string) error {
+	ref, err := imageapi.ParseDockerImageReference(pullSpec)
+	if err != nil {
+		return fmt.Errorf("invalid image reference %q: %v", pullSpec, err)
+	}
+	if len(ref.ID) == 0 {
+		return fmt.Errorf("image %q is not referenced by digest and its signatures cannot be verified", pullSpec)
+	}
+	image, err := o.client.Images().Get(ref.ID)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return fmt.Errorf("image %q has no signature data on this cluster", pullSpec)
+		}
+		return err
+	}
+	for i := range image.Signatures {
+		if o.signatureTrusted(&image.Signatures[i]) {
+			return nil
+		}
+	}
+	return fmt.Errorf("image %q does not have a trusted signature", pullSpec)
+}
+
+// signatureTrusted returns true when the signature was verified against the image it is
+// attached to and, if trusted keys are configured, was made by one of those keys.
+func (o *imageSignaturePolicy) signatureTrusted(signature *imageapi.ImageSignature) bool {
+	if !signatureConditionTrue(signature, imageapi.SignatureTrusted) {
+		return false
+	}
+	if !signatureConditionTrue(signature, imageapi.SignatureForImage) {
+		return false
+	}
+	if o.trustedKeyIDs.Len() == 0 {
+		return true
+	}
+	if signature.IssuedTo == nil {
+		return false
+	}
+	return o.trustedKeyIDs.Has(signature.IssuedTo.PublicKeyID)
+}
+
+func signatureConditionTrue(signature *imageapi.ImageSignature, t imageapi.SignatureConditionType) bool {
+	for _, condition := range signature.Conditions {
+		if condition.Type == t {
+			return condition.Status == kapi.ConditionTrue
+		}
+	}
+	return false
+}
+
+func (o *imageSignaturePolicy) SetOpenshiftClient(client client.Interface) {
+	o.client = client
+}
+
+func (o *imageSignaturePolicy) SetProjectCache(cache *projectcache.ProjectCache) {
+	o.cache = cache
+}
+
+func (o *imageSignaturePolicy) Validate() error {
+	if o.client == nil {
+		return fmt.Errorf("openshift.io/ImageSignaturePolicy plugin requires an Openshift client")
+	}
+	if o.cache == nil {
+		return fmt.Errorf("openshift.io/ImageSignaturePolicy plugin requires a project cache")
+	}
+	return nil
+}
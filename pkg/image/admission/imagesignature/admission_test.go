@@ -0,0 +1,163 @@
+package imagesignature
+
+import (
+	"bytes"
+	"testing"
+
+	"k8s.io/kubernetes/pkg/admission"
+	kapi "k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/client/cache"
+	"k8s.io/kubernetes/pkg/client/clientset_generated/internalclientset/fake"
+
+	"github.com/openshift/origin/pkg/client/testclient"
+	signatureapi "github.com/openshift/origin/pkg/image/admission/imagesignature/api"
+	imageapi "github.com/openshift/origin/pkg/image/api"
+	projectcache "github.com/openshift/origin/pkg/project/cache"
+
+	// install all APIs
+	_ "github.com/openshift/origin/pkg/api/install"
+)
+
+const testDigest = "sha256:0000000000000000000000000000000000000000000000000000000000000001"
+
+func TestReadConfig(t *testing.T) {
+	config, err := readConfig(bytes.NewBufferString(`apiVersion: v1
+kind: ImageSignaturePolicyConfig
+trustedKeyIDs:
+- "0x685ebe62bf278440"
+excludedNamespaces:
+- openshift-infra
+`))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(config.TrustedKeyIDs) != 1 || config.TrustedKeyIDs[0] != "0x685ebe62bf278440" {
+		t.Errorf("unexpected trusted keys: %#v", config.TrustedKeyIDs)
+	}
+	if len(config.ExcludedNamespaces) != 1 || config.ExcludedNamespaces[0] != "openshift-infra" {
+		t.Errorf("unexpected excluded namespaces: %#v", config.ExcludedNamespaces)
+	}
+}
+
+func TestAdmit(t *testing.T) {
+	tests := map[string]struct {
+		config        *signatureapi.ImageSignaturePolicyConfig
+		image         *imageapi.Image
+		pullSpec      string
+		namespace     string
+		nsAnnotations map[string]string
+		expectAllowed bool
+	}{
+		"signed image referenced by digest": {
+			config:        &signatureapi.ImageSignaturePolicyConfig{},
+			image:         signedImage("0x685ebe62bf278440"),
+			pullSpec:      "registry.example.com/test/app@" + testDigest,
+			namespace:     "test",
+			expectAllowed: true,
+		},
+		"signed by trusted key": {
+			config:        &signatureapi.ImageSignaturePolicyConfig{TrustedKeyIDs: []string{"0x685ebe62bf278440"}},
+			image:         signedImage("0x685ebe62bf278440"),
+			pullSpec:      "registry.example.com/test/app@" + testDigest,
+			namespace:     "test",
+			expectAllowed: true,
+		},
+		"signed by untrusted key": {
+			config:        &signatureapi.ImageSignaturePolicyConfig{TrustedKeyIDs: []string{"0xffffffffffffffff"}},
+			image:         signedImage("0x685ebe62bf278440"),
+			pullSpec:      "registry.example.com/test/app@" + testDigest,
+			namespace:     "test",
+			expectAllowed: false,
+		},
+		"unsigned image": {
+			config:        &signatureapi.ImageSignaturePolicyConfig{},
+			image:         unsignedImage(),
+			pullSpec:      "registry.example.com/test/app@" + testDigest,
+			namespace:     "test",
+			expectAllowed: false,
+		},
+		"tag reference cannot be verified": {
+			config:        &signatureapi.ImageSignaturePolicyConfig{},
+			image:         signedImage("0x685ebe62bf278440"),
+			pullSpec:      "registry.example.com/test/app:latest",
+			namespace:     "test",
+			expectAllowed: false,
+		},
+		"excluded namespace": {
+			config:        &signatureapi.ImageSignaturePolicyConfig{ExcludedNamespaces: []string{"test"}},
+			image:         unsignedImage(),
+			pullSpec:      "registry.example.com/test/app:latest",
+			namespace:     "test",
+			expectAllowed: true,
+		},
+		"namespace annotated ignore": {
+			config:        &signatureapi.ImageSignaturePolicyConfig{},
+			image:         unsignedImage(),
+			pullSpec:      "registry.example.com/test/app:latest",
+			namespace:     "test",
+			nsAnnotations: map[string]string{signatureapi.SignaturePolicyAnnotation: signatureapi.SignaturePolicyIgnore},
+			expectAllowed: true,
+		},
+	}
+
+	for name, tc := range tests {
+		plugin, err := NewImageSignaturePolicy(tc.config)
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", name, err)
+		}
+		policy := plugin.(*imageSignaturePolicy)
+		policy.SetOpenshiftClient(testclient.NewSimpleFake(tc.image))
+		policy.SetProjectCache(fakeProjectCache(tc.namespace, tc.nsAnnotations))
+
+		pod := &kapi.Pod{
+			ObjectMeta: kapi.ObjectMeta{Name: "test-pod", Namespace: tc.namespace},
+			Spec: kapi.PodSpec{
+				Containers: []kapi.Container{
+					{Name: "app", Image: tc.pullSpec},
+				},
+			},
+		}
+		attrs := admission.NewAttributesRecord(pod, nil, kapi.Kind("Pod").WithVersion("version"), tc.namespace, pod.Name, kapi.Resource("pods").WithVersion("version"), "", admission.Create, nil)
+		err = policy.Admit(attrs)
+		if tc.expectAllowed && err != nil {
+			t.Errorf("%s: unexpected error: %v", name, err)
+		}
+		if !tc.expectAllowed && err == nil {
+			t.Errorf("%s: expected an error, got none", name)
+		}
+	}
+}
+
+func signedImage(keyID string) *imageapi.Image {
+	image := unsignedImage()
+	image.Signatures = []imageapi.ImageSignature{
+		{
+			Type: imageapi.ImageSignatureTypeAtomicImageV1,
+			Conditions: []imageapi.SignatureCondition{
+				{Type: imageapi.SignatureTrusted, Status: kapi.ConditionTrue},
+				{Type: imageapi.SignatureForImage, Status: kapi.ConditionTrue},
+			},
+			IssuedTo: &imageapi.SignatureSubject{PublicKeyID: keyID},
+		},
+	}
+	return image
+}
+
+func unsignedImage() *imageapi.Image {
+	return &imageapi.Image{
+		ObjectMeta:           kapi.ObjectMeta{Name: testDigest},
+		DockerImageReference: "registry.example.com/test/app@" + testDigest,
+	}
+}
+
+func fakeProjectCache(namespace string, annotations map[string]string) *projectcache.ProjectCache {
+	kclientset := &fake.Clientset{}
+	pCache := projectcache.NewFake(kclientset.Core().Namespaces(), projectcache.NewCacheStore(cache.MetaNamespaceKeyFunc), "")
+	pCache.Store.Add(&kapi.Namespace{
+		ObjectMeta: kapi.ObjectMeta{
+			Name:        namespace,
+			Annotations: annotations,
+		},
+	})
+	return pCache
+}
@@ -0,0 +1,32 @@
+package api
+
+import (
+	"k8s.io/kubernetes/pkg/api/unversioned"
+)
+
+// ImageSignaturePolicyConfig is the configuration for the image signature policy plug-in.
+// When enabled, pods may only reference images by digest whose ImageSignature objects
+// carry a trusted, matching signature. Individual namespaces can be exempted either
+// through the configuration or with the SignaturePolicyAnnotation on the namespace.
+type ImageSignaturePolicyConfig struct {
+	unversioned.TypeMeta
+
+	// TrustedKeyIDs is a list of public key IDs (the PublicKeyID of a signature subject,
+	// e.g. "0x685ebe62bf278440") whose signatures are accepted. If empty, any signature
+	// with Trusted and ForImage conditions set to true is accepted.
+	TrustedKeyIDs []string
+
+	// ExcludedNamespaces is a list of namespaces that are not subject to signature
+	// verification.
+	ExcludedNamespaces []string
+}
+
+// SignaturePolicyAnnotation is an annotation that can be placed on a namespace to
+// exempt its pods from image signature verification. The only recognized value is
+// "ignore". Because namespace editors cannot usually set this annotation, it allows
+// cluster administrators to grant exceptions per project.
+const SignaturePolicyAnnotation = "images.openshift.io/signature-policy"
+
+// SignaturePolicyIgnore is the annotation value that disables signature verification
+// for a namespace.
+const SignaturePolicyIgnore = "ignore"
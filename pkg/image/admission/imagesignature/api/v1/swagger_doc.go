@@ -0,0 +1,16 @@
+package v1
+
+// This file contains methods that can be used by the go-restful package to generate Swagger
+// documentation for the object types found in 'types.go' This file is automatically generated
+// by hack/update-generated-swagger-descriptions.sh and should be run after a full build of OpenShift.
+// ==== DO NOT EDIT THIS FILE MANUALLY ====
+
+var map_ImageSignaturePolicyConfig = map[string]string{
+	"": "ImageSignaturePolicyConfig is the configuration for the image signature policy plug-in. When enabled, pods may only reference images by digest whose ImageSignature objects carry a trusted, matching signature. Individual namespaces can be exempted either through the configuration or with the SignaturePolicyAnnotation on the namespace.",
+	"trustedKeyIDs":      "TrustedKeyIDs is a list of public key IDs (the PublicKeyID of a signature subject, e.g. \"0x685ebe62bf278440\") whose signatures are accepted. If empty, any signature with Trusted and ForImage conditions set to true is accepted.",
+	"excludedNamespaces": "ExcludedNamespaces is a list of namespaces that are not subject to signature verification.",
+}
+
+func (ImageSignaturePolicyConfig) SwaggerDoc() map[string]string {
+	return map_ImageSignaturePolicyConfig
+}
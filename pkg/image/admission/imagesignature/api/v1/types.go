@@ -0,0 +1,22 @@
+package v1
+
+import (
+	"k8s.io/kubernetes/pkg/api/unversioned"
+)
+
+// ImageSignaturePolicyConfig is the configuration for the image signature policy plug-in.
+// When enabled, pods may only reference images by digest whose ImageSignature objects
+// carry a trusted, matching signature. Individual namespaces can be exempted either
+// through the configuration or with the SignaturePolicyAnnotation on the namespace.
+type ImageSignaturePolicyConfig struct {
+	unversioned.TypeMeta `json:",inline"`
+
+	// TrustedKeyIDs is a list of public key IDs (the PublicKeyID of a signature subject,
+	// e.g. "0x685ebe62bf278440") whose signatures are accepted. If empty, any signature
+	// with Trusted and ForImage conditions set to true is accepted.
+	TrustedKeyIDs []string `json:"trustedKeyIDs,omitempty" description:"public key IDs whose signatures are accepted"`
+
+	// ExcludedNamespaces is a list of namespaces that are not subject to signature
+	// verification.
+	ExcludedNamespaces []string `json:"excludedNamespaces,omitempty" description:"namespaces exempt from signature verification"`
+}
@@ -0,0 +1,28 @@
+package validation
+
+import (
+	"strings"
+
+	"k8s.io/kubernetes/pkg/util/validation/field"
+
+	"github.com/openshift/origin/pkg/image/admission/imagesignature/api"
+)
+
+func ValidateImageSignaturePolicyConfig(config *api.ImageSignaturePolicyConfig) field.ErrorList {
+	allErrs := field.ErrorList{}
+	for i, keyID := range config.TrustedKeyIDs {
+		if len(keyID) == 0 {
+			allErrs = append(allErrs, field.Invalid(field.NewPath("trustedKeyIDs").Index(i), keyID, "key ID cannot be empty"))
+			continue
+		}
+		if !strings.HasPrefix(keyID, "0x") {
+			allErrs = append(allErrs, field.Invalid(field.NewPath("trustedKeyIDs").Index(i), keyID, "key ID must be a hexadecimal public key ID prefixed with 0x"))
+		}
+	}
+	for i, ns := range config.ExcludedNamespaces {
+		if len(ns) == 0 {
+			allErrs = append(allErrs, field.Invalid(field.NewPath("excludedNamespaces").Index(i), ns, "namespace cannot be empty"))
+		}
+	}
+	return allErrs
+}
@@ -0,0 +1,201 @@
+package tagpromotion
+
+import (
+	"fmt"
+	"io"
+	"io/ioutil"
+	"path"
+	"reflect"
+
+	"github.com/ghodss/yaml"
+	"github.com/golang/glog"
+
+	"k8s.io/kubernetes/pkg/admission"
+	"k8s.io/kubernetes/pkg/auth/authorizer"
+	clientset "k8s.io/kubernetes/pkg/client/clientset_generated/internalclientset"
+
+	oadmission "github.com/openshift/origin/pkg/cmd/server/admission"
+	imageapi "github.com/openshift/origin/pkg/image/api"
+)
+
+const PluginName = "openshift.io/ImageTagPromotion"
+
+func init() {
+	admission.RegisterPlugin(PluginName, func(client clientset.Interface, config io.Reader) (admission.Interface, error) {
+		pluginConfig, err := readConfig(config)
+		if err != nil {
+			return nil, err
+		}
+		if pluginConfig == nil || len(pluginConfig.ProtectedTagPatterns) == 0 {
+			glog.Infof("Admission plugin %q is not configured so it will be disabled.", PluginName)
+			return nil, nil
+		}
+		return NewTagPromotion(pluginConfig.ProtectedTagPatterns), nil
+	})
+}
+
+// pluginConfig holds the patterns of image stream tags that require the promote
+// permission to change. Patterns use path.Match syntax, e.g. "prod" or "release-*".
+type pluginConfig struct {
+	ProtectedTagPatterns []string `json:"protectedTagPatterns"`
+}
+
+func readConfig(reader io.Reader) (*pluginConfig, error) {
+	if reader == nil || reflect.ValueOf(reader).IsNil() {
+		return nil, nil
+	}
+	data, err := ioutil.ReadAll(reader)
+	if err != nil {
+		return nil, err
+	}
+	if len(data) == 0 {
+		return nil, nil
+	}
+	config := &pluginConfig{}
+	if err := yaml.Unmarshal(data, config); err != nil {
+		return nil, err
+	}
+	for _, pattern := range config.ProtectedTagPatterns {
+		if _, err := path.Match(pattern, ""); err != nil {
+			return nil, fmt.Errorf("invalid protected tag pattern %q: %v", pattern, err)
+		}
+	}
+	return config, nil
+}
+
+// tagPromotion requires users changing protected tags on an image stream to have
+// the update permission on the imagestreamtags/promote subresource, so movement of
+// tags like :prod can be limited to a controlled promotion workflow.
+type tagPromotion struct {
+	*admission.Handler
+	patterns   []string
+	authorizer authorizer.Authorizer
+}
+
+var _ = oadmission.WantsAuthorizer(&tagPromotion{})
+
+// NewTagPromotion creates a new admission plugin that protects the tags matching
+// the provided patterns.
+func NewTagPromotion(patterns []string) admission.Interface {
+	return &tagPromotion{
+		Handler:  admission.NewHandler(admission.Create, admission.Update, admission.Delete),
+		patterns: patterns,
+	}
+}
+
+func (a *tagPromotion) Admit(attr admission.Attributes) error {
+	if len(attr.GetSubresource()) > 0 {
+		return nil
+	}
+
+	var changed []string
+	switch attr.GetResource().GroupResource() {
+	case imageapi.Resource("imagestreams"), imageapi.LegacyResource("imagestreams"):
+		stream, ok := attr.GetObject().(*imageapi.ImageStream)
+		if !ok {
+			return nil
+		}
+		var old *imageapi.ImageStream
+		if attr.GetOperation() == admission.Update {
+			old, _ = attr.GetOldObject().(*imageapi.ImageStream)
+		}
+		changed = a.changedProtectedTags(stream, old)
+
+	case imageapi.Resource("imagestreamtags"), imageapi.LegacyResource("imagestreamtags"):
+		_, tag, err := imageapi.ParseImageStreamTagName(attr.GetName())
+		if err != nil {
+			return nil
+		}
+		if a.protected(tag) {
+			changed = []string{tag}
+		}
+
+	case imageapi.Resource("imagestreammappings"), imageapi.LegacyResource("imagestreammappings"):
+		mapping, ok := attr.GetObject().(*imageapi.ImageStreamMapping)
+		if !ok {
+			return nil
+		}
+		tag := mapping.Tag
+		if len(tag) == 0 {
+			tag = imageapi.DefaultImageTag
+		}
+		if a.protected(tag) {
+			changed = []string{tag}
+		}
+
+	default:
+		return nil
+	}
+
+	if len(changed) == 0 {
+		return nil
+	}
+
+	allow, err := a.checkPromoteAccess(attr)
+	if err != nil {
+		return err
+	}
+	if !allow {
+		return admission.NewForbidden(attr, fmt.Errorf("changing the protected image stream tag(s) %v requires permission to update imagestreamtags/promote", changed))
+	}
+	return nil
+}
+
+// changedProtectedTags returns the protected tags that would be added or changed by
+// the provided image stream relative to its previous state.
+func (a *tagPromotion) changedProtectedTags(stream, old *imageapi.ImageStream) []string {
+	var changed []string
+	for tag, ref := range stream.Spec.Tags {
+		if !a.protected(tag) {
+			continue
+		}
+		if old != nil && reflect.DeepEqual(old.Spec.Tags[tag], ref) {
+			continue
+		}
+		changed = append(changed, tag)
+	}
+	for tag, events := range stream.Status.Tags {
+		if !a.protected(tag) {
+			continue
+		}
+		if old != nil && reflect.DeepEqual(old.Status.Tags[tag], events) {
+			continue
+		}
+		changed = append(changed, tag)
+	}
+	return changed
+}
+
+func (a *tagPromotion) protected(tag string) bool {
+	for _, pattern := range a.patterns {
+		if ok, _ := path.Match(pattern, tag); ok {
+			return true
+		}
+	}
+	return false
+}
+
+func (a *tagPromotion) checkPromoteAccess(attr admission.Attributes) (bool, error) {
+	authzAttr := authorizer.AttributesRecord{
+		User:            attr.GetUserInfo(),
+		Verb:            "update",
+		Namespace:       attr.GetNamespace(),
+		Resource:        "imagestreamtags",
+		Subresource:     "promote",
+		APIGroup:        attr.GetResource().Group,
+		ResourceRequest: true,
+	}
+	allow, _, err := a.authorizer.Authorize(authzAttr)
+	return allow, err
+}
+
+func (a *tagPromotion) SetAuthorizer(authorizer authorizer.Authorizer) {
+	a.authorizer = authorizer
+}
+
+func (a *tagPromotion) Validate() error {
+	if a.authorizer == nil {
+		return fmt.Errorf("%s needs an Openshift Authorizer", PluginName)
+	}
+	return nil
+}
@@ -10,6 +10,14 @@ func ImageToSelectableFields(image *Image) fields.Set {
 	}
 }
 
+// RegistryWebhookToSelectableFields returns a label set that represents the object.
+func RegistryWebhookToSelectableFields(webhook *RegistryWebhook) fields.Set {
+	return fields.Set{
+		"metadata.name":      webhook.Name,
+		"metadata.namespace": webhook.Namespace,
+	}
+}
+
 // ImageStreamToSelectableFields returns a label set that represents the object.
 func ImageStreamToSelectableFields(ir *ImageStream) fields.Set {
 	return fields.Set{
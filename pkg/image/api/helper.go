@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"net"
 	"net/url"
+	"path"
 	"regexp"
 	"sort"
 	"strings"
@@ -1110,3 +1111,50 @@ func (tagref TagReference) HasAnnotationTag(searchTag string) bool {
 	}
 	return false
 }
+
+// FilterRepositoriesForImport applies the include and exclude glob patterns of a
+// repository prefix import to the repository names found below the prefix, returning
+// the names to import. An empty include list matches every repository; exclusion
+// always wins over inclusion.
+func FilterRepositoriesForImport(spec *RepositoryPrefixImportSpec, names []string) []string {
+	matched := []string{}
+	for _, name := range names {
+		included := len(spec.IncludePatterns) == 0
+		for _, pattern := range spec.IncludePatterns {
+			if ok, _ := path.Match(pattern, name); ok {
+				included = true
+				break
+			}
+		}
+		if !included {
+			continue
+		}
+		excluded := false
+		for _, pattern := range spec.ExcludePatterns {
+			if ok, _ := path.Match(pattern, name); ok {
+				excluded = true
+				break
+			}
+		}
+		if !excluded {
+			matched = append(matched, name)
+		}
+	}
+	return matched
+}
+
+// imageVulnerabilitySeverityRank orders vulnerability severities from least (0) to most severe.
+var imageVulnerabilitySeverityRank = map[ImageVulnerabilitySeverity]int{
+	VulnerabilitySeverityUnknown:    0,
+	VulnerabilitySeverityNegligible: 1,
+	VulnerabilitySeverityLow:        2,
+	VulnerabilitySeverityMedium:     3,
+	VulnerabilitySeverityHigh:       4,
+	VulnerabilitySeverityCritical:   5,
+}
+
+// AtLeast returns true if the severity is at least as severe as min. Unrecognized
+// severities rank lowest.
+func (severity ImageVulnerabilitySeverity) AtLeast(min ImageVulnerabilitySeverity) bool {
+	return imageVulnerabilitySeverityRank[severity] >= imageVulnerabilitySeverityRank[min]
+}
@@ -1828,3 +1828,36 @@ func TestDockerImageReferenceForImage(t *testing.T) {
 		t.Errorf("expected failure for unknown image")
 	}
 }
+
+func TestFilterRepositoriesForImport(t *testing.T) {
+	names := []string{"mysql", "mariadb", "postgresql", "redis"}
+	tests := map[string]struct {
+		include  []string
+		exclude  []string
+		expected []string
+	}{
+		"no patterns matches everything": {
+			expected: []string{"mysql", "mariadb", "postgresql", "redis"},
+		},
+		"include pattern limits matches": {
+			include:  []string{"m*"},
+			expected: []string{"mysql", "mariadb"},
+		},
+		"exclude wins over include": {
+			include:  []string{"m*"},
+			exclude:  []string{"maria*"},
+			expected: []string{"mysql"},
+		},
+		"exclude without include": {
+			exclude:  []string{"redis"},
+			expected: []string{"mysql", "mariadb", "postgresql"},
+		},
+	}
+	for name, test := range tests {
+		spec := &RepositoryPrefixImportSpec{IncludePatterns: test.include, ExcludePatterns: test.exclude}
+		actual := FilterRepositoriesForImport(spec, names)
+		if !reflect.DeepEqual(actual, test.expected) {
+			t.Errorf("%s: expected %v, got %v", name, test.expected, actual)
+		}
+	}
+}
@@ -77,6 +77,9 @@ type Image struct {
 	DockerImageManifestMediaType string
 	// DockerImageConfig is a JSON blob that the runtime uses to set up the container. This is a part of manifest schema v2.
 	DockerImageConfig string
+	// VulnerabilityReport is the most recent vulnerability scan result reported for this image by an
+	// external scanner integration. May be unset if the image has never been scanned.
+	VulnerabilityReport *ImageVulnerabilityReport
 }
 
 // ImageLayer represents a single layer of the image. Some images may have multiple layers. Some may have none.
@@ -89,6 +92,35 @@ type ImageLayer struct {
 	MediaType string
 }
 
+// ImageVulnerabilitySeverity is the severity of a vulnerability found in an image.
+type ImageVulnerabilitySeverity string
+
+// These are the valid severities of an image vulnerability, ordered from least to most severe.
+const (
+	VulnerabilitySeverityUnknown    ImageVulnerabilitySeverity = "Unknown"
+	VulnerabilitySeverityNegligible ImageVulnerabilitySeverity = "Negligible"
+	VulnerabilitySeverityLow        ImageVulnerabilitySeverity = "Low"
+	VulnerabilitySeverityMedium     ImageVulnerabilitySeverity = "Medium"
+	VulnerabilitySeverityHigh       ImageVulnerabilitySeverity = "High"
+	VulnerabilitySeverityCritical   ImageVulnerabilitySeverity = "Critical"
+)
+
+// ImageVulnerabilityReport summarizes the result of a vulnerability scan of an image performed by an
+// external scanner integration. Based on this information it is possible to restrict runnable images
+// to those matching cluster-wide policy.
+type ImageVulnerabilityReport struct {
+	// ScannerName identifies the scanner that produced the report.
+	ScannerName string
+	// ScanTime is the time the scan was completed.
+	ScanTime unversioned.Time
+	// HighestSeverity is the severity of the most severe vulnerability found in the image.
+	HighestSeverity ImageVulnerabilitySeverity
+	// Counts holds the number of vulnerabilities found per severity.
+	Counts map[ImageVulnerabilitySeverity]int32
+	// ReportURL is an optional URL pointing at the scanner's full report for the image.
+	ReportURL string
+}
+
 const (
 	// The supported type of image signature.
 	ImageSignatureTypeAtomicImageV1 string = "AtomicImageV1"
@@ -245,6 +277,9 @@ type TagImportPolicy struct {
 	Insecure bool
 	// Scheduled indicates to the server that this tag should be periodically checked to ensure it is up to date, and imported
 	Scheduled bool
+	// Immutable is true if the tag may not be moved once it references an image. Pushes, imports, or
+	// tag updates that would change the image the tag points to are rejected until the flag is cleared.
+	Immutable bool
 }
 
 // TagReferencePolicyType describes how pull-specs for images in an image stream tag are generated when
@@ -418,6 +453,11 @@ type ImageStreamImportSpec struct {
 	// Repository is an optional import of an entire Docker image repository. A maximum limit on the
 	// number of tags imported this way is imposed by the server.
 	Repository *RepositoryImportSpec
+	// RepositoryPrefix is an optional bulk import of all repositories sharing a registry
+	// prefix. Each matched repository is created or updated as an individual image stream
+	// named after the last segment of the repository. A maximum limit on the number of
+	// repositories imported this way is imposed by the server.
+	RepositoryPrefix *RepositoryPrefixImportSpec
 	// Images are a list of individual images to import.
 	Images []ImageImportSpec
 }
@@ -428,6 +468,8 @@ type ImageStreamImportStatus struct {
 	Import *ImageStream
 	// Repository is set if spec.repository was set to the outcome of the import
 	Repository *RepositoryImportStatus
+	// RepositoryPrefix is set if spec.repositoryPrefix was set to the outcome of the import
+	RepositoryPrefix *RepositoryPrefixImportStatus
 	// Images is set with the result of importing spec.images
 	Images []ImageImportStatus
 }
@@ -442,6 +484,45 @@ type RepositoryImportSpec struct {
 	IncludeManifest bool
 }
 
+// RepositoryPrefixImportSpec indicates to import every Docker image repository that shares
+// a registry prefix (for example docker.io/library) into individual image streams.
+type RepositoryPrefixImportSpec struct {
+	// Prefix is the registry host and leading repository segments shared by all repositories
+	// to import, e.g. "docker.io/library". Only kind DockerImage is implied.
+	Prefix string
+	// IncludePatterns are shell glob patterns matched against the repository name below the
+	// prefix. If empty, all repositories below the prefix are candidates for import.
+	IncludePatterns []string
+	// ExcludePatterns are shell glob patterns matched against the repository name below the
+	// prefix. Repositories matching any pattern are skipped, even if included.
+	ExcludePatterns []string
+
+	ImportPolicy    TagImportPolicy
+	ReferencePolicy TagReferencePolicy
+	IncludeManifest bool
+}
+
+// RepositoryPrefixImportStatus describes the outcome of a bulk repository prefix import
+type RepositoryPrefixImportStatus struct {
+	// Status reflects whether any failure occurred listing the repositories below the prefix
+	Status unversioned.Status
+	// Streams are the per-repository results of the import, in the order the repositories
+	// were returned by the registry.
+	Streams []RepositoryStreamImportStatus
+	// AdditionalRepositories are repositories that matched the patterns but were not imported
+	// because a maximum limit of bulk imports was applied.
+	AdditionalRepositories []string
+}
+
+// RepositoryStreamImportStatus describes the outcome of importing a single repository
+// matched during a bulk repository prefix import
+type RepositoryStreamImportStatus struct {
+	// Name is the name of the image stream that was created or updated
+	Name string
+	// Repository is the outcome of importing the repository's tags
+	Repository RepositoryImportStatus
+}
+
 // RepositoryImportStatus describes the outcome of the repository import
 type RepositoryImportStatus struct {
 	// Status reflects whether any failure occurred during import
@@ -469,3 +550,70 @@ type ImageImportStatus struct {
 	Status unversioned.Status
 	Image  *Image
 }
+
+// ImageStreamPullToken mints a short-lived bearer token that grants pull-only access to a
+// single image stream. The returned token can be presented to the integrated registry by
+// external consumers that do not have a service account kubeconfig, and carries a scope
+// that limits it to reading the named image stream and its layers.
+type ImageStreamPullToken struct {
+	unversioned.TypeMeta
+	// ObjectMeta must identify the name of the image stream the token grants access to
+	kapi.ObjectMeta
+
+	// ExpiresInSeconds is the requested lifetime of the token. If unset the server default
+	// of one hour is applied, and requests beyond the server maximum are truncated.
+	ExpiresInSeconds *int64
+
+	// Token is the opaque bearer token. It is returned only from create and cannot be
+	// retrieved afterwards.
+	Token string
+	// Scope is the authorization scope encoded into the token
+	Scope string
+	// ExpirationTimestamp is the time after which the token is no longer accepted
+	ExpirationTimestamp unversioned.Time
+}
+
+// RegistryWebhook describes an external endpoint that is notified of events in the
+// integrated registry. Webhooks created in a namespace receive events for repositories in
+// that namespace only; webhooks created in the shared OpenShift namespace receive events
+// for every repository. The registry reads these objects on each delivery, so webhooks
+// take effect without a registry restart.
+type RegistryWebhook struct {
+	unversioned.TypeMeta
+	// Standard object's metadata.
+	kapi.ObjectMeta
+
+	// Spec describes the endpoint and the events it receives
+	Spec RegistryWebhookSpec
+}
+
+const (
+	// RegistryWebhookEventPush is delivered when a manifest is pushed to a repository
+	RegistryWebhookEventPush = "push"
+	// RegistryWebhookEventDelete is delivered when a manifest is deleted from a repository
+	RegistryWebhookEventDelete = "delete"
+)
+
+// RegistryWebhookSpec describes where and how registry events are delivered
+type RegistryWebhookSpec struct {
+	// URL is the endpoint events are POSTed to. Must be an http or https URL.
+	URL string
+	// Events is the list of event types to deliver. Valid values are "push" and "delete".
+	// An empty list means all events.
+	Events []string
+	// Secret, if set, is used to compute an HMAC-SHA256 signature of the delivered payload
+	// which is sent in the X-Registry-Signature header
+	Secret string
+	// Insecure, if true, skips TLS certificate verification of the endpoint
+	Insecure bool
+}
+
+// RegistryWebhookList is a collection of RegistryWebhooks
+type RegistryWebhookList struct {
+	unversioned.TypeMeta
+	// Standard object's metadata.
+	unversioned.ListMeta
+
+	// Items is a list of registry webhooks
+	Items []RegistryWebhook
+}
@@ -58,6 +58,9 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 		&ImageStreamTagList{},
 		&ImageStreamImage{},
 		&ImageStreamImport{},
+		&ImageStreamPullToken{},
+		&RegistryWebhook{},
+		&RegistryWebhookList{},
 	}
 	scheme.AddKnownTypes(SchemeGroupVersion,
 		append(types,
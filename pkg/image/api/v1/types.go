@@ -43,6 +43,9 @@ type Image struct {
 	DockerImageManifestMediaType string `json:"dockerImageManifestMediaType,omitempty" protobuf:"bytes,9,opt,name=dockerImageManifestMediaType"`
 	// DockerImageConfig is a JSON blob that the runtime uses to set up the container. This is a part of manifest schema v2.
 	DockerImageConfig string `json:"dockerImageConfig,omitempty" protobuf:"bytes,10,opt,name=dockerImageConfig"`
+	// VulnerabilityReport is the most recent vulnerability scan result reported for this image by an
+	// external scanner integration. May be unset if the image has never been scanned.
+	VulnerabilityReport *ImageVulnerabilityReport `json:"vulnerabilityReport,omitempty" protobuf:"bytes,11,opt,name=vulnerabilityReport"`
 }
 
 // ImageLayer represents a single layer of the image. Some images may have multiple layers. Some may have none.
@@ -55,6 +58,25 @@ type ImageLayer struct {
 	MediaType string `json:"mediaType" protobuf:"bytes,3,opt,name=mediaType"`
 }
 
+// ImageVulnerabilitySeverity is the severity of a vulnerability found in an image.
+type ImageVulnerabilitySeverity string
+
+// ImageVulnerabilityReport summarizes the result of a vulnerability scan of an image performed by an
+// external scanner integration. Based on this information it is possible to restrict runnable images
+// to those matching cluster-wide policy.
+type ImageVulnerabilityReport struct {
+	// ScannerName identifies the scanner that produced the report.
+	ScannerName string `json:"scannerName" protobuf:"bytes,1,opt,name=scannerName"`
+	// ScanTime is the time the scan was completed.
+	ScanTime unversioned.Time `json:"scanTime" protobuf:"bytes,2,opt,name=scanTime"`
+	// HighestSeverity is the severity of the most severe vulnerability found in the image.
+	HighestSeverity ImageVulnerabilitySeverity `json:"highestSeverity" protobuf:"bytes,3,opt,name=highestSeverity,casttype=ImageVulnerabilitySeverity"`
+	// Counts holds the number of vulnerabilities found per severity.
+	Counts map[ImageVulnerabilitySeverity]int32 `json:"counts,omitempty" protobuf:"bytes,4,rep,name=counts,castkey=ImageVulnerabilitySeverity"`
+	// ReportURL is an optional URL pointing at the scanner's full report for the image.
+	ReportURL string `json:"reportURL,omitempty" protobuf:"bytes,5,opt,name=reportURL"`
+}
+
 // ImageSignature holds a signature of an image. It allows to verify image identity and possibly other claims
 // as long as the signature is trusted. Based on this information it is possible to restrict runnable images
 // to those matching cluster-wide policy.
@@ -189,6 +211,9 @@ type TagImportPolicy struct {
 	Insecure bool `json:"insecure,omitempty" protobuf:"varint,1,opt,name=insecure"`
 	// Scheduled indicates to the server that this tag should be periodically checked to ensure it is up to date, and imported
 	Scheduled bool `json:"scheduled,omitempty" protobuf:"varint,2,opt,name=scheduled"`
+	// Immutable is true if the tag may not be moved once it references an image. Pushes, imports, or
+	// tag updates that would change the image the tag points to are rejected until the flag is cleared.
+	Immutable bool `json:"immutable,omitempty" protobuf:"varint,3,opt,name=immutable"`
 }
 
 // TagReferencePolicyType describes how pull-specs for images in an image stream tag are generated when
@@ -373,6 +398,11 @@ type ImageStreamImportSpec struct {
 	// Repository is an optional import of an entire Docker image repository. A maximum limit on the
 	// number of tags imported this way is imposed by the server.
 	Repository *RepositoryImportSpec `json:"repository,omitempty" protobuf:"bytes,2,opt,name=repository"`
+	// RepositoryPrefix is an optional bulk import of all repositories sharing a registry
+	// prefix. Each matched repository is created or updated as an individual image stream
+	// named after the last segment of the repository. A maximum limit on the number of
+	// repositories imported this way is imposed by the server.
+	RepositoryPrefix *RepositoryPrefixImportSpec `json:"repositoryPrefix,omitempty" protobuf:"bytes,4,opt,name=repositoryPrefix"`
 	// Images are a list of individual images to import.
 	Images []ImageImportSpec `json:"images,omitempty" protobuf:"bytes,3,rep,name=images"`
 }
@@ -383,6 +413,8 @@ type ImageStreamImportStatus struct {
 	Import *ImageStream `json:"import,omitempty" protobuf:"bytes,1,opt,name=import"`
 	// Repository is set if spec.repository was set to the outcome of the import
 	Repository *RepositoryImportStatus `json:"repository,omitempty" protobuf:"bytes,2,opt,name=repository"`
+	// RepositoryPrefix is set if spec.repositoryPrefix was set to the outcome of the import
+	RepositoryPrefix *RepositoryPrefixImportStatus `json:"repositoryPrefix,omitempty" protobuf:"bytes,4,opt,name=repositoryPrefix"`
 	// Images is set with the result of importing spec.images
 	Images []ImageImportStatus `json:"images,omitempty" protobuf:"bytes,3,rep,name=images"`
 }
@@ -400,6 +432,48 @@ type RepositoryImportSpec struct {
 	IncludeManifest bool `json:"includeManifest,omitempty" protobuf:"varint,3,opt,name=includeManifest"`
 }
 
+// RepositoryPrefixImportSpec describes a request to import every Docker image repository
+// that shares a registry prefix (for example docker.io/library) into individual image streams.
+type RepositoryPrefixImportSpec struct {
+	// Prefix is the registry host and leading repository segments shared by all repositories
+	// to import, e.g. "docker.io/library". Only kind DockerImage is implied.
+	Prefix string `json:"prefix" protobuf:"bytes,1,opt,name=prefix"`
+	// IncludePatterns are shell glob patterns matched against the repository name below the
+	// prefix. If empty, all repositories below the prefix are candidates for import.
+	IncludePatterns []string `json:"includePatterns,omitempty" protobuf:"bytes,2,rep,name=includePatterns"`
+	// ExcludePatterns are shell glob patterns matched against the repository name below the
+	// prefix. Repositories matching any pattern are skipped, even if included.
+	ExcludePatterns []string `json:"excludePatterns,omitempty" protobuf:"bytes,3,rep,name=excludePatterns"`
+
+	// ImportPolicy is the policy controlling how each image is imported
+	ImportPolicy TagImportPolicy `json:"importPolicy,omitempty" protobuf:"bytes,4,opt,name=importPolicy"`
+	// ReferencePolicy defines how other components should consume the images
+	ReferencePolicy TagReferencePolicy `json:"referencePolicy,omitempty" protobuf:"bytes,5,opt,name=referencePolicy"`
+	// IncludeManifest determines if the manifest for each image is returned in the response
+	IncludeManifest bool `json:"includeManifest,omitempty" protobuf:"varint,6,opt,name=includeManifest"`
+}
+
+// RepositoryPrefixImportStatus describes the result of a bulk repository prefix import
+type RepositoryPrefixImportStatus struct {
+	// Status reflects whether any failure occurred listing the repositories below the prefix
+	Status unversioned.Status `json:"status,omitempty" protobuf:"bytes,1,opt,name=status"`
+	// Streams are the per-repository results of the import, in the order the repositories
+	// were returned by the registry.
+	Streams []RepositoryStreamImportStatus `json:"streams,omitempty" protobuf:"bytes,2,rep,name=streams"`
+	// AdditionalRepositories are repositories that matched the patterns but were not imported
+	// because a maximum limit of bulk imports was applied.
+	AdditionalRepositories []string `json:"additionalRepositories,omitempty" protobuf:"bytes,3,rep,name=additionalRepositories"`
+}
+
+// RepositoryStreamImportStatus describes the outcome of importing a single repository
+// matched during a bulk repository prefix import
+type RepositoryStreamImportStatus struct {
+	// Name is the name of the image stream that was created or updated
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+	// Repository is the outcome of importing the repository's tags
+	Repository RepositoryImportStatus `json:"repository" protobuf:"bytes,2,opt,name=repository"`
+}
+
 // RepositoryImportStatus describes the result of an image repository import
 type RepositoryImportStatus struct {
 	// Status reflects whether any failure occurred during import
@@ -435,3 +509,63 @@ type ImageImportStatus struct {
 	// Tag is the tag this image was located under, if any
 	Tag string `json:"tag,omitempty" protobuf:"bytes,3,opt,name=tag"`
 }
+
+// ImageStreamPullToken mints a short-lived bearer token that grants pull-only access to a
+// single image stream. The returned token can be presented to the integrated registry by
+// external consumers that do not have a service account kubeconfig, and carries a scope
+// that limits it to reading the named image stream and its layers.
+type ImageStreamPullToken struct {
+	unversioned.TypeMeta `json:",inline"`
+	// Standard object's metadata. Name must identify the image stream the token grants access to.
+	kapi.ObjectMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+
+	// ExpiresInSeconds is the requested lifetime of the token. If unset the server default
+	// of one hour is applied, and requests beyond the server maximum are truncated.
+	ExpiresInSeconds *int64 `json:"expiresInSeconds,omitempty" protobuf:"varint,2,opt,name=expiresInSeconds"`
+
+	// Token is the opaque bearer token. It is returned only from create and cannot be
+	// retrieved afterwards.
+	Token string `json:"token,omitempty" protobuf:"bytes,3,opt,name=token"`
+	// Scope is the authorization scope encoded into the token
+	Scope string `json:"scope,omitempty" protobuf:"bytes,4,opt,name=scope"`
+	// ExpirationTimestamp is the time after which the token is no longer accepted
+	ExpirationTimestamp unversioned.Time `json:"expirationTimestamp,omitempty" protobuf:"bytes,5,opt,name=expirationTimestamp"`
+}
+
+// RegistryWebhook describes an external endpoint that is notified of events in the
+// integrated registry. Webhooks created in a namespace receive events for repositories in
+// that namespace only; webhooks created in the shared OpenShift namespace receive events
+// for every repository. The registry reads these objects on each delivery, so webhooks
+// take effect without a registry restart.
+type RegistryWebhook struct {
+	unversioned.TypeMeta `json:",inline"`
+	// Standard object's metadata.
+	kapi.ObjectMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+
+	// Spec describes the endpoint and the events it receives
+	Spec RegistryWebhookSpec `json:"spec" protobuf:"bytes,2,opt,name=spec"`
+}
+
+// RegistryWebhookSpec describes where and how registry events are delivered
+type RegistryWebhookSpec struct {
+	// URL is the endpoint events are POSTed to. Must be an http or https URL.
+	URL string `json:"url" protobuf:"bytes,1,opt,name=url"`
+	// Events is the list of event types to deliver. Valid values are "push" and "delete".
+	// An empty list means all events.
+	Events []string `json:"events,omitempty" protobuf:"bytes,2,rep,name=events"`
+	// Secret, if set, is used to compute an HMAC-SHA256 signature of the delivered payload
+	// which is sent in the X-Registry-Signature header
+	Secret string `json:"secret,omitempty" protobuf:"bytes,3,opt,name=secret"`
+	// Insecure, if true, skips TLS certificate verification of the endpoint
+	Insecure bool `json:"insecure,omitempty" protobuf:"varint,4,opt,name=insecure"`
+}
+
+// RegistryWebhookList is a collection of RegistryWebhooks
+type RegistryWebhookList struct {
+	unversioned.TypeMeta `json:",inline"`
+	// Standard object's metadata.
+	unversioned.ListMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+
+	// Items is a list of registry webhooks
+	Items []RegistryWebhook `json:"items" protobuf:"bytes,2,rep,name=items"`
+}
@@ -62,6 +62,12 @@ func RegisterConversions(scheme *runtime.Scheme) error {
 		Convert_api_RepositoryImportSpec_To_v1_RepositoryImportSpec,
 		Convert_v1_RepositoryImportStatus_To_api_RepositoryImportStatus,
 		Convert_api_RepositoryImportStatus_To_v1_RepositoryImportStatus,
+		Convert_v1_RepositoryPrefixImportSpec_To_api_RepositoryPrefixImportSpec,
+		Convert_api_RepositoryPrefixImportSpec_To_v1_RepositoryPrefixImportSpec,
+		Convert_v1_RepositoryPrefixImportStatus_To_api_RepositoryPrefixImportStatus,
+		Convert_api_RepositoryPrefixImportStatus_To_v1_RepositoryPrefixImportStatus,
+		Convert_v1_RepositoryStreamImportStatus_To_api_RepositoryStreamImportStatus,
+		Convert_api_RepositoryStreamImportStatus_To_v1_RepositoryStreamImportStatus,
 		Convert_v1_SignatureCondition_To_api_SignatureCondition,
 		Convert_api_SignatureCondition_To_v1_SignatureCondition,
 		Convert_v1_SignatureGenericEntity_To_api_SignatureGenericEntity,
@@ -466,6 +472,15 @@ func autoConvert_v1_ImageStreamImportSpec_To_api_ImageStreamImportSpec(in *Image
 	} else {
 		out.Repository = nil
 	}
+	if in.RepositoryPrefix != nil {
+		in, out := &in.RepositoryPrefix, &out.RepositoryPrefix
+		*out = new(api.RepositoryPrefixImportSpec)
+		if err := Convert_v1_RepositoryPrefixImportSpec_To_api_RepositoryPrefixImportSpec(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.RepositoryPrefix = nil
+	}
 	if in.Images != nil {
 		in, out := &in.Images, &out.Images
 		*out = make([]api.ImageImportSpec, len(*in))
@@ -495,6 +510,15 @@ func autoConvert_api_ImageStreamImportSpec_To_v1_ImageStreamImportSpec(in *api.I
 	} else {
 		out.Repository = nil
 	}
+	if in.RepositoryPrefix != nil {
+		in, out := &in.RepositoryPrefix, &out.RepositoryPrefix
+		*out = new(RepositoryPrefixImportSpec)
+		if err := Convert_api_RepositoryPrefixImportSpec_To_v1_RepositoryPrefixImportSpec(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.RepositoryPrefix = nil
+	}
 	if in.Images != nil {
 		in, out := &in.Images, &out.Images
 		*out = make([]ImageImportSpec, len(*in))
@@ -532,6 +556,15 @@ func autoConvert_v1_ImageStreamImportStatus_To_api_ImageStreamImportStatus(in *I
 	} else {
 		out.Repository = nil
 	}
+	if in.RepositoryPrefix != nil {
+		in, out := &in.RepositoryPrefix, &out.RepositoryPrefix
+		*out = new(api.RepositoryPrefixImportStatus)
+		if err := Convert_v1_RepositoryPrefixImportStatus_To_api_RepositoryPrefixImportStatus(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.RepositoryPrefix = nil
+	}
 	if in.Images != nil {
 		in, out := &in.Images, &out.Images
 		*out = make([]api.ImageImportStatus, len(*in))
@@ -569,6 +602,15 @@ func autoConvert_api_ImageStreamImportStatus_To_v1_ImageStreamImportStatus(in *a
 	} else {
 		out.Repository = nil
 	}
+	if in.RepositoryPrefix != nil {
+		in, out := &in.RepositoryPrefix, &out.RepositoryPrefix
+		*out = new(RepositoryPrefixImportStatus)
+		if err := Convert_api_RepositoryPrefixImportStatus_To_v1_RepositoryPrefixImportStatus(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.RepositoryPrefix = nil
+	}
 	if in.Images != nil {
 		in, out := &in.Images, &out.Images
 		*out = make([]ImageImportStatus, len(*in))
@@ -842,6 +884,108 @@ func Convert_api_RepositoryImportStatus_To_v1_RepositoryImportStatus(in *api.Rep
 	return autoConvert_api_RepositoryImportStatus_To_v1_RepositoryImportStatus(in, out, s)
 }
 
+func autoConvert_v1_RepositoryPrefixImportSpec_To_api_RepositoryPrefixImportSpec(in *RepositoryPrefixImportSpec, out *api.RepositoryPrefixImportSpec, s conversion.Scope) error {
+	out.Prefix = in.Prefix
+	out.IncludePatterns = *(*[]string)(unsafe.Pointer(&in.IncludePatterns))
+	out.ExcludePatterns = *(*[]string)(unsafe.Pointer(&in.ExcludePatterns))
+	if err := Convert_v1_TagImportPolicy_To_api_TagImportPolicy(&in.ImportPolicy, &out.ImportPolicy, s); err != nil {
+		return err
+	}
+	if err := Convert_v1_TagReferencePolicy_To_api_TagReferencePolicy(&in.ReferencePolicy, &out.ReferencePolicy, s); err != nil {
+		return err
+	}
+	out.IncludeManifest = in.IncludeManifest
+	return nil
+}
+
+func Convert_v1_RepositoryPrefixImportSpec_To_api_RepositoryPrefixImportSpec(in *RepositoryPrefixImportSpec, out *api.RepositoryPrefixImportSpec, s conversion.Scope) error {
+	return autoConvert_v1_RepositoryPrefixImportSpec_To_api_RepositoryPrefixImportSpec(in, out, s)
+}
+
+func autoConvert_api_RepositoryPrefixImportSpec_To_v1_RepositoryPrefixImportSpec(in *api.RepositoryPrefixImportSpec, out *RepositoryPrefixImportSpec, s conversion.Scope) error {
+	out.Prefix = in.Prefix
+	out.IncludePatterns = *(*[]string)(unsafe.Pointer(&in.IncludePatterns))
+	out.ExcludePatterns = *(*[]string)(unsafe.Pointer(&in.ExcludePatterns))
+	if err := Convert_api_TagImportPolicy_To_v1_TagImportPolicy(&in.ImportPolicy, &out.ImportPolicy, s); err != nil {
+		return err
+	}
+	if err := Convert_api_TagReferencePolicy_To_v1_TagReferencePolicy(&in.ReferencePolicy, &out.ReferencePolicy, s); err != nil {
+		return err
+	}
+	out.IncludeManifest = in.IncludeManifest
+	return nil
+}
+
+func Convert_api_RepositoryPrefixImportSpec_To_v1_RepositoryPrefixImportSpec(in *api.RepositoryPrefixImportSpec, out *RepositoryPrefixImportSpec, s conversion.Scope) error {
+	return autoConvert_api_RepositoryPrefixImportSpec_To_v1_RepositoryPrefixImportSpec(in, out, s)
+}
+
+func autoConvert_v1_RepositoryPrefixImportStatus_To_api_RepositoryPrefixImportStatus(in *RepositoryPrefixImportStatus, out *api.RepositoryPrefixImportStatus, s conversion.Scope) error {
+	out.Status = in.Status
+	if in.Streams != nil {
+		in, out := &in.Streams, &out.Streams
+		*out = make([]api.RepositoryStreamImportStatus, len(*in))
+		for i := range *in {
+			if err := Convert_v1_RepositoryStreamImportStatus_To_api_RepositoryStreamImportStatus(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Streams = nil
+	}
+	out.AdditionalRepositories = *(*[]string)(unsafe.Pointer(&in.AdditionalRepositories))
+	return nil
+}
+
+func Convert_v1_RepositoryPrefixImportStatus_To_api_RepositoryPrefixImportStatus(in *RepositoryPrefixImportStatus, out *api.RepositoryPrefixImportStatus, s conversion.Scope) error {
+	return autoConvert_v1_RepositoryPrefixImportStatus_To_api_RepositoryPrefixImportStatus(in, out, s)
+}
+
+func autoConvert_api_RepositoryPrefixImportStatus_To_v1_RepositoryPrefixImportStatus(in *api.RepositoryPrefixImportStatus, out *RepositoryPrefixImportStatus, s conversion.Scope) error {
+	out.Status = in.Status
+	if in.Streams != nil {
+		in, out := &in.Streams, &out.Streams
+		*out = make([]RepositoryStreamImportStatus, len(*in))
+		for i := range *in {
+			if err := Convert_api_RepositoryStreamImportStatus_To_v1_RepositoryStreamImportStatus(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Streams = nil
+	}
+	out.AdditionalRepositories = *(*[]string)(unsafe.Pointer(&in.AdditionalRepositories))
+	return nil
+}
+
+func Convert_api_RepositoryPrefixImportStatus_To_v1_RepositoryPrefixImportStatus(in *api.RepositoryPrefixImportStatus, out *RepositoryPrefixImportStatus, s conversion.Scope) error {
+	return autoConvert_api_RepositoryPrefixImportStatus_To_v1_RepositoryPrefixImportStatus(in, out, s)
+}
+
+func autoConvert_v1_RepositoryStreamImportStatus_To_api_RepositoryStreamImportStatus(in *RepositoryStreamImportStatus, out *api.RepositoryStreamImportStatus, s conversion.Scope) error {
+	out.Name = in.Name
+	if err := Convert_v1_RepositoryImportStatus_To_api_RepositoryImportStatus(&in.Repository, &out.Repository, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+func Convert_v1_RepositoryStreamImportStatus_To_api_RepositoryStreamImportStatus(in *RepositoryStreamImportStatus, out *api.RepositoryStreamImportStatus, s conversion.Scope) error {
+	return autoConvert_v1_RepositoryStreamImportStatus_To_api_RepositoryStreamImportStatus(in, out, s)
+}
+
+func autoConvert_api_RepositoryStreamImportStatus_To_v1_RepositoryStreamImportStatus(in *api.RepositoryStreamImportStatus, out *RepositoryStreamImportStatus, s conversion.Scope) error {
+	out.Name = in.Name
+	if err := Convert_api_RepositoryImportStatus_To_v1_RepositoryImportStatus(&in.Repository, &out.Repository, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+func Convert_api_RepositoryStreamImportStatus_To_v1_RepositoryStreamImportStatus(in *api.RepositoryStreamImportStatus, out *RepositoryStreamImportStatus, s conversion.Scope) error {
+	return autoConvert_api_RepositoryStreamImportStatus_To_v1_RepositoryStreamImportStatus(in, out, s)
+}
+
 func autoConvert_v1_SignatureCondition_To_api_SignatureCondition(in *SignatureCondition, out *api.SignatureCondition, s conversion.Scope) error {
 	out.Type = api.SignatureConditionType(in.Type)
 	out.Status = pkg_api.ConditionStatus(in.Status)
@@ -991,6 +1135,7 @@ func Convert_api_TagEventCondition_To_v1_TagEventCondition(in *api.TagEventCondi
 func autoConvert_v1_TagImportPolicy_To_api_TagImportPolicy(in *TagImportPolicy, out *api.TagImportPolicy, s conversion.Scope) error {
 	out.Insecure = in.Insecure
 	out.Scheduled = in.Scheduled
+	out.Immutable = in.Immutable
 	return nil
 }
 
@@ -1001,6 +1146,7 @@ func Convert_v1_TagImportPolicy_To_api_TagImportPolicy(in *TagImportPolicy, out
 func autoConvert_api_TagImportPolicy_To_v1_TagImportPolicy(in *api.TagImportPolicy, out *TagImportPolicy, s conversion.Scope) error {
 	out.Insecure = in.Insecure
 	out.Scheduled = in.Scheduled
+	out.Immutable = in.Immutable
 	return nil
 }
 
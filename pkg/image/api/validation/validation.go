@@ -3,6 +3,8 @@ package validation
 import (
 	"bytes"
 	"fmt"
+	"net/url"
+	gopath "path"
 	"regexp"
 	"strings"
 
@@ -13,6 +15,7 @@ import (
 	"k8s.io/kubernetes/pkg/api/validation"
 	"k8s.io/kubernetes/pkg/api/validation/path"
 	"k8s.io/kubernetes/pkg/util/diff"
+	"k8s.io/kubernetes/pkg/util/sets"
 	"k8s.io/kubernetes/pkg/util/validation/field"
 
 	serverapi "github.com/openshift/origin/pkg/cmd/server/api"
@@ -67,6 +70,40 @@ func validateImage(image *api.Image, fldPath *field.Path) field.ErrorList {
 		result = append(result, validateImageSignature(&sig, fldPath.Child("signatures").Index(i))...)
 	}
 
+	if image.VulnerabilityReport != nil {
+		result = append(result, validateImageVulnerabilityReport(image.VulnerabilityReport, fldPath.Child("vulnerabilityReport"))...)
+	}
+
+	return result
+}
+
+var validVulnerabilitySeverities = sets.NewString(
+	string(api.VulnerabilitySeverityUnknown),
+	string(api.VulnerabilitySeverityNegligible),
+	string(api.VulnerabilitySeverityLow),
+	string(api.VulnerabilitySeverityMedium),
+	string(api.VulnerabilitySeverityHigh),
+	string(api.VulnerabilitySeverityCritical),
+)
+
+func validateImageVulnerabilityReport(report *api.ImageVulnerabilityReport, fldPath *field.Path) field.ErrorList {
+	result := field.ErrorList{}
+
+	if len(report.ScannerName) == 0 {
+		result = append(result, field.Required(fldPath.Child("scannerName"), ""))
+	}
+	if !validVulnerabilitySeverities.Has(string(report.HighestSeverity)) {
+		result = append(result, field.NotSupported(fldPath.Child("highestSeverity"), report.HighestSeverity, validVulnerabilitySeverities.List()))
+	}
+	for severity, count := range report.Counts {
+		if !validVulnerabilitySeverities.Has(string(severity)) {
+			result = append(result, field.NotSupported(fldPath.Child("counts"), severity, validVulnerabilitySeverities.List()))
+		}
+		if count < 0 {
+			result = append(result, field.Invalid(fldPath.Child("counts").Key(string(severity)), count, "count cannot be negative"))
+		}
+	}
+
 	return result
 }
 
@@ -222,6 +259,7 @@ func ValidateImageStreamTagReference(tagRef api.TagReference, fldPath *field.Pat
 func ValidateImageStreamUpdate(newStream, oldStream *api.ImageStream) field.ErrorList {
 	result := validation.ValidateObjectMetaUpdate(&newStream.ObjectMeta, &oldStream.ObjectMeta, field.NewPath("metadata"))
 	result = append(result, ValidateImageStream(newStream)...)
+	result = append(result, ValidateImmutableTags(newStream, oldStream)...)
 
 	return result
 }
@@ -229,6 +267,33 @@ func ValidateImageStreamUpdate(newStream, oldStream *api.ImageStream) field.Erro
 // ValidateImageStreamStatusUpdate tests required fields for an ImageStream status update.
 func ValidateImageStreamStatusUpdate(newStream, oldStream *api.ImageStream) field.ErrorList {
 	result := validation.ValidateObjectMetaUpdate(&newStream.ObjectMeta, &oldStream.ObjectMeta, field.NewPath("metadata"))
+	result = append(result, ValidateImmutableTags(newStream, oldStream)...)
+	return result
+}
+
+// ValidateImmutableTags rejects updates that would move or remove the latest image referenced by a
+// tag whose import policy marks it immutable. Clearing the immutable flag on the spec tag in the
+// same update is the explicit way to unlock the tag.
+func ValidateImmutableTags(newStream, oldStream *api.ImageStream) field.ErrorList {
+	result := field.ErrorList{}
+	for tag, tagRef := range newStream.Spec.Tags {
+		if !tagRef.ImportPolicy.Immutable {
+			continue
+		}
+		oldEvent := api.LatestTaggedImage(oldStream, tag)
+		if oldEvent == nil || len(oldEvent.Image) == 0 {
+			// the tag does not reference an image yet, the first push or import is allowed
+			continue
+		}
+		newEvent := api.LatestTaggedImage(newStream, tag)
+		if newEvent == nil {
+			result = append(result, field.Invalid(field.NewPath("status", "tags").Key(tag), tag, fmt.Sprintf("immutable tag already references image %q and may not be removed", oldEvent.Image)))
+			continue
+		}
+		if newEvent.Image != oldEvent.Image {
+			result = append(result, field.Invalid(field.NewPath("status", "tags").Key(tag), newEvent.Image, fmt.Sprintf("immutable tag already references image %q and may not be moved", oldEvent.Image)))
+		}
+	}
 	return result
 }
 
@@ -385,10 +450,67 @@ func ValidateImageStreamImport(isi *api.ImageStreamImport) field.ErrorList {
 			errs = append(errs, field.Invalid(repoPath.Child("from", "kind"), from.Kind, "only DockerImage is supported"))
 		}
 	}
-	if len(isi.Spec.Images) == 0 && isi.Spec.Repository == nil {
-		errs = append(errs, field.Invalid(imagesPath, nil, "you must specify at least one image or a repository import"))
+	if spec := isi.Spec.RepositoryPrefix; spec != nil {
+		prefixPath := specPath.Child("repositoryPrefix")
+		if len(spec.Prefix) == 0 {
+			errs = append(errs, field.Required(prefixPath.Child("prefix"), "a registry repository prefix is required"))
+		} else if _, err := api.ParseDockerImageReference(spec.Prefix + "/name"); err != nil {
+			errs = append(errs, field.Invalid(prefixPath.Child("prefix"), spec.Prefix, "must be a registry host optionally followed by repository segments"))
+		}
+		for i, pattern := range spec.IncludePatterns {
+			if _, err := gopath.Match(pattern, ""); err != nil {
+				errs = append(errs, field.Invalid(prefixPath.Child("includePatterns").Index(i), pattern, err.Error()))
+			}
+		}
+		for i, pattern := range spec.ExcludePatterns {
+			if _, err := gopath.Match(pattern, ""); err != nil {
+				errs = append(errs, field.Invalid(prefixPath.Child("excludePatterns").Index(i), pattern, err.Error()))
+			}
+		}
+	}
+	if len(isi.Spec.Images) == 0 && isi.Spec.Repository == nil && isi.Spec.RepositoryPrefix == nil {
+		errs = append(errs, field.Invalid(imagesPath, nil, "you must specify at least one image, a repository, or a repository prefix import"))
 	}
 
 	errs = append(errs, validation.ValidateObjectMeta(&isi.ObjectMeta, true, ValidateImageStreamName, field.NewPath("metadata"))...)
 	return errs
 }
+
+// ValidateImageStreamPullToken tests required fields for an ImageStreamPullToken.
+func ValidateImageStreamPullToken(pullToken *api.ImageStreamPullToken) field.ErrorList {
+	result := validation.ValidateObjectMeta(&pullToken.ObjectMeta, true, ValidateImageStreamName, field.NewPath("metadata"))
+	if pullToken.ExpiresInSeconds != nil && *pullToken.ExpiresInSeconds <= 0 {
+		result = append(result, field.Invalid(field.NewPath("expiresInSeconds"), *pullToken.ExpiresInSeconds, "must be a positive integer"))
+	}
+	return result
+}
+
+// ValidateRegistryWebhook tests required fields for a RegistryWebhook.
+func ValidateRegistryWebhook(webhook *api.RegistryWebhook) field.ErrorList {
+	result := validation.ValidateObjectMeta(&webhook.ObjectMeta, true, path.ValidatePathSegmentName, field.NewPath("metadata"))
+
+	specPath := field.NewPath("spec")
+	if len(webhook.Spec.URL) == 0 {
+		result = append(result, field.Required(specPath.Child("url"), ""))
+	} else if parsed, err := url.Parse(webhook.Spec.URL); err != nil {
+		result = append(result, field.Invalid(specPath.Child("url"), webhook.Spec.URL, err.Error()))
+	} else if parsed.Scheme != "http" && parsed.Scheme != "https" {
+		result = append(result, field.Invalid(specPath.Child("url"), webhook.Spec.URL, "must be an http or https URL"))
+	}
+
+	validEvents := sets.NewString(api.RegistryWebhookEventPush, api.RegistryWebhookEventDelete)
+	for i, event := range webhook.Spec.Events {
+		if !validEvents.Has(event) {
+			result = append(result, field.NotSupported(specPath.Child("events").Index(i), event, validEvents.List()))
+		}
+	}
+
+	return result
+}
+
+// ValidateRegistryWebhookUpdate tests that an update to a RegistryWebhook is valid.
+func ValidateRegistryWebhookUpdate(newWebhook, oldWebhook *api.RegistryWebhook) field.ErrorList {
+	result := validation.ValidateObjectMetaUpdate(&newWebhook.ObjectMeta, &oldWebhook.ObjectMeta, field.NewPath("metadata"))
+	result = append(result, ValidateRegistryWebhook(newWebhook)...)
+	return result
+}
@@ -601,6 +601,71 @@ func TestValidateImageStream(t *testing.T) {
 	}
 }
 
+func TestValidateImmutableTags(t *testing.T) {
+	stream := func(immutable bool, image string) *api.ImageStream {
+		s := &api.ImageStream{
+			ObjectMeta: kapi.ObjectMeta{Namespace: "ns", Name: "stream"},
+			Spec: api.ImageStreamSpec{
+				Tags: map[string]api.TagReference{
+					"prod": {
+						From:         &kapi.ObjectReference{Kind: "DockerImage", Name: "registry.example.com/repo:prod"},
+						ImportPolicy: api.TagImportPolicy{Immutable: immutable},
+					},
+				},
+			},
+		}
+		if len(image) > 0 {
+			s.Status.Tags = map[string]api.TagEventList{
+				"prod": {Items: []api.TagEvent{{Image: image, DockerImageReference: "registry.example.com/repo@" + image}}},
+			}
+		}
+		return s
+	}
+
+	tests := map[string]struct {
+		old         *api.ImageStream
+		new         *api.ImageStream
+		expectedErr bool
+	}{
+		"first image on immutable tag is allowed": {
+			old: stream(true, ""),
+			new: stream(true, "sha256:0001"),
+		},
+		"immutable tag cannot be moved": {
+			old:         stream(true, "sha256:0001"),
+			new:         stream(true, "sha256:0002"),
+			expectedErr: true,
+		},
+		"immutable tag history cannot be removed": {
+			old:         stream(true, "sha256:0001"),
+			new:         stream(true, ""),
+			expectedErr: true,
+		},
+		"mutable tag can be moved": {
+			old: stream(false, "sha256:0001"),
+			new: stream(false, "sha256:0002"),
+		},
+		"clearing the flag unlocks the tag": {
+			old: stream(true, "sha256:0001"),
+			new: stream(false, "sha256:0002"),
+		},
+		"unchanged immutable tag is allowed": {
+			old: stream(true, "sha256:0001"),
+			new: stream(true, "sha256:0001"),
+		},
+	}
+
+	for name, test := range tests {
+		errs := ValidateImmutableTags(test.new, test.old)
+		if test.expectedErr && len(errs) == 0 {
+			t.Errorf("%s: expected errors, got none", name)
+		}
+		if !test.expectedErr && len(errs) > 0 {
+			t.Errorf("%s: unexpected errors: %v", name, errs)
+		}
+	}
+}
+
 func TestValidateISTUpdate(t *testing.T) {
 	old := &api.ImageStreamTag{
 		ObjectMeta: kapi.ObjectMeta{Namespace: kapi.NamespaceDefault, Name: "foo:bar", ResourceVersion: "1", Annotations: map[string]string{"one": "two"}},
@@ -51,6 +51,9 @@ func RegisterDeepCopies(scheme *runtime.Scheme) error {
 		conversion.GeneratedDeepCopyFunc{Fn: DeepCopy_api_ImageStreamTagList, InType: reflect.TypeOf(&ImageStreamTagList{})},
 		conversion.GeneratedDeepCopyFunc{Fn: DeepCopy_api_RepositoryImportSpec, InType: reflect.TypeOf(&RepositoryImportSpec{})},
 		conversion.GeneratedDeepCopyFunc{Fn: DeepCopy_api_RepositoryImportStatus, InType: reflect.TypeOf(&RepositoryImportStatus{})},
+		conversion.GeneratedDeepCopyFunc{Fn: DeepCopy_api_RepositoryPrefixImportSpec, InType: reflect.TypeOf(&RepositoryPrefixImportSpec{})},
+		conversion.GeneratedDeepCopyFunc{Fn: DeepCopy_api_RepositoryPrefixImportStatus, InType: reflect.TypeOf(&RepositoryPrefixImportStatus{})},
+		conversion.GeneratedDeepCopyFunc{Fn: DeepCopy_api_RepositoryStreamImportStatus, InType: reflect.TypeOf(&RepositoryStreamImportStatus{})},
 		conversion.GeneratedDeepCopyFunc{Fn: DeepCopy_api_SignatureCondition, InType: reflect.TypeOf(&SignatureCondition{})},
 		conversion.GeneratedDeepCopyFunc{Fn: DeepCopy_api_SignatureGenericEntity, InType: reflect.TypeOf(&SignatureGenericEntity{})},
 		conversion.GeneratedDeepCopyFunc{Fn: DeepCopy_api_SignatureIssuer, InType: reflect.TypeOf(&SignatureIssuer{})},
@@ -652,6 +655,15 @@ func DeepCopy_api_ImageStreamImportSpec(in interface{}, out interface{}, c *conv
 		} else {
 			out.Repository = nil
 		}
+		if in.RepositoryPrefix != nil {
+			in, out := &in.RepositoryPrefix, &out.RepositoryPrefix
+			*out = new(RepositoryPrefixImportSpec)
+			if err := DeepCopy_api_RepositoryPrefixImportSpec(*in, *out, c); err != nil {
+				return err
+			}
+		} else {
+			out.RepositoryPrefix = nil
+		}
 		if in.Images != nil {
 			in, out := &in.Images, &out.Images
 			*out = make([]ImageImportSpec, len(*in))
@@ -689,6 +701,15 @@ func DeepCopy_api_ImageStreamImportStatus(in interface{}, out interface{}, c *co
 		} else {
 			out.Repository = nil
 		}
+		if in.RepositoryPrefix != nil {
+			in, out := &in.RepositoryPrefix, &out.RepositoryPrefix
+			*out = new(RepositoryPrefixImportStatus)
+			if err := DeepCopy_api_RepositoryPrefixImportStatus(*in, *out, c); err != nil {
+				return err
+			}
+		} else {
+			out.RepositoryPrefix = nil
+		}
 		if in.Images != nil {
 			in, out := &in.Images, &out.Images
 			*out = make([]ImageImportStatus, len(*in))
@@ -884,6 +905,73 @@ func DeepCopy_api_RepositoryImportStatus(in interface{}, out interface{}, c *con
 	}
 }
 
+func DeepCopy_api_RepositoryPrefixImportSpec(in interface{}, out interface{}, c *conversion.Cloner) error {
+	{
+		in := in.(*RepositoryPrefixImportSpec)
+		out := out.(*RepositoryPrefixImportSpec)
+		out.Prefix = in.Prefix
+		if in.IncludePatterns != nil {
+			in, out := &in.IncludePatterns, &out.IncludePatterns
+			*out = make([]string, len(*in))
+			copy(*out, *in)
+		} else {
+			out.IncludePatterns = nil
+		}
+		if in.ExcludePatterns != nil {
+			in, out := &in.ExcludePatterns, &out.ExcludePatterns
+			*out = make([]string, len(*in))
+			copy(*out, *in)
+		} else {
+			out.ExcludePatterns = nil
+		}
+		out.ImportPolicy = in.ImportPolicy
+		out.ReferencePolicy = in.ReferencePolicy
+		out.IncludeManifest = in.IncludeManifest
+		return nil
+	}
+}
+
+func DeepCopy_api_RepositoryPrefixImportStatus(in interface{}, out interface{}, c *conversion.Cloner) error {
+	{
+		in := in.(*RepositoryPrefixImportStatus)
+		out := out.(*RepositoryPrefixImportStatus)
+		if err := unversioned.DeepCopy_unversioned_Status(&in.Status, &out.Status, c); err != nil {
+			return err
+		}
+		if in.Streams != nil {
+			in, out := &in.Streams, &out.Streams
+			*out = make([]RepositoryStreamImportStatus, len(*in))
+			for i := range *in {
+				if err := DeepCopy_api_RepositoryStreamImportStatus(&(*in)[i], &(*out)[i], c); err != nil {
+					return err
+				}
+			}
+		} else {
+			out.Streams = nil
+		}
+		if in.AdditionalRepositories != nil {
+			in, out := &in.AdditionalRepositories, &out.AdditionalRepositories
+			*out = make([]string, len(*in))
+			copy(*out, *in)
+		} else {
+			out.AdditionalRepositories = nil
+		}
+		return nil
+	}
+}
+
+func DeepCopy_api_RepositoryStreamImportStatus(in interface{}, out interface{}, c *conversion.Cloner) error {
+	{
+		in := in.(*RepositoryStreamImportStatus)
+		out := out.(*RepositoryStreamImportStatus)
+		out.Name = in.Name
+		if err := DeepCopy_api_RepositoryImportStatus(&in.Repository, &out.Repository, c); err != nil {
+			return err
+		}
+		return nil
+	}
+}
+
 func DeepCopy_api_SignatureCondition(in interface{}, out interface{}, c *conversion.Cloner) error {
 	{
 		in := in.(*SignatureCondition)
@@ -989,6 +1077,7 @@ func DeepCopy_api_TagImportPolicy(in interface{}, out interface{}, c *conversion
 		out := out.(*TagImportPolicy)
 		out.Insecure = in.Insecure
 		out.Scheduled = in.Scheduled
+		out.Immutable = in.Immutable
 		return nil
 	}
 }
@@ -0,0 +1,112 @@
+package tagfollow
+
+import (
+	"github.com/golang/glog"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+	kutilerrors "k8s.io/kubernetes/pkg/util/errors"
+
+	"github.com/openshift/origin/pkg/client"
+	oscache "github.com/openshift/origin/pkg/client/cache"
+	"github.com/openshift/origin/pkg/image/api"
+)
+
+// TagFollowController keeps image stream tags that alias a tag in another
+// image stream up to date as the source tag moves. Aliases are spec tags whose
+// from reference is an ImageStreamTag in a different stream - creating them is
+// gated by an imagestreams/layers access check in the image stream strategy,
+// so by the time this controller sees one the tagger was already authorized to
+// pull the source image.
+type TagFollowController struct {
+	// streams is used to update the status of image streams that follow a tag.
+	streams client.ImageStreamsNamespacer
+	// lister provides a local cache of all image streams.
+	lister *oscache.StoreToImageStreamLister
+}
+
+// Handle updates any image stream that follows a tag of the given stream.
+func (c *TagFollowController) Handle(stream *api.ImageStream) error {
+	if len(stream.Status.Tags) == 0 {
+		return nil
+	}
+
+	streams, err := c.lister.List()
+	if err != nil {
+		return err
+	}
+
+	errs := []error{}
+	for _, cached := range streams {
+		if cached.Namespace == stream.Namespace && cached.Name == stream.Name {
+			continue
+		}
+
+		var follower *api.ImageStream
+		for destTag, tagRef := range cached.Spec.Tags {
+			sourceTag, ok := followedTag(cached, tagRef, stream)
+			if !ok {
+				continue
+			}
+			latest := api.LatestTaggedImage(stream, sourceTag)
+			if latest == nil {
+				continue
+			}
+			if !api.DifferentTagEvent(cached, destTag, *latest) {
+				continue
+			}
+			if follower == nil {
+				obj, err := kapi.Scheme.Copy(cached)
+				if err != nil {
+					errs = append(errs, err)
+					break
+				}
+				follower = obj.(*api.ImageStream)
+			}
+			glog.V(4).Infof("Updating tag %s of image stream %s/%s to follow %s:%s in %s", destTag, follower.Namespace, follower.Name, stream.Name, sourceTag, stream.Namespace)
+			if api.AddTagEventToImageStream(follower, destTag, *latest) {
+				api.UpdateTrackingTags(follower, destTag, *latest)
+			}
+		}
+
+		if follower == nil {
+			continue
+		}
+		if _, err := c.streams.ImageStreams(follower.Namespace).UpdateStatus(follower); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return kutilerrors.NewAggregate(errs)
+}
+
+// followedTag returns the tag of stream that tagRef follows, if any. A spec
+// tag follows a stream when its from reference is an ImageStreamTag that
+// resolves to that stream. Reference tags are never followed.
+func followedTag(follower *api.ImageStream, tagRef api.TagReference, stream *api.ImageStream) (string, bool) {
+	if tagRef.Reference {
+		return "", false
+	}
+	if tagRef.From == nil || tagRef.From.Kind != "ImageStreamTag" {
+		return "", false
+	}
+	namespace := tagRef.From.Namespace
+	if len(namespace) == 0 {
+		namespace = follower.Namespace
+	}
+	if namespace != stream.Namespace {
+		return "", false
+	}
+	name := tagRef.From.Name
+	sourceTag := api.DefaultImageTag
+	if strippedName, tag, ok := api.SplitImageStreamTag(name); ok {
+		name = strippedName
+		sourceTag = tag
+	} else {
+		// a from reference inside the same stream is just the tag name
+		name = follower.Name
+		sourceTag = tagRef.From.Name
+	}
+	if name != stream.Name {
+		return "", false
+	}
+	return sourceTag, true
+}
@@ -0,0 +1,167 @@
+package tagfollow
+
+import (
+	"testing"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/api/unversioned"
+	"k8s.io/kubernetes/pkg/client/cache"
+	"k8s.io/kubernetes/pkg/client/testing/core"
+
+	oscache "github.com/openshift/origin/pkg/client/cache"
+	"github.com/openshift/origin/pkg/client/testclient"
+	"github.com/openshift/origin/pkg/image/api"
+	_ "github.com/openshift/origin/pkg/image/api/install"
+)
+
+func sourceStream() *api.ImageStream {
+	return &api.ImageStream{
+		ObjectMeta: kapi.ObjectMeta{Name: "ruby", Namespace: "openshift"},
+		Status: api.ImageStreamStatus{
+			Tags: map[string]api.TagEventList{
+				"2.0": {
+					Items: []api.TagEvent{
+						{
+							Created:              unversioned.Now(),
+							DockerImageReference: "registry:5000/openshift/ruby@sha256:0000000000000000000000000000000000000000000000000000000000000001",
+							Image:                "sha256:0000000000000000000000000000000000000000000000000000000000000001",
+							Generation:           2,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func followerStream(namespace, name string, tagRef api.TagReference) *api.ImageStream {
+	return &api.ImageStream{
+		ObjectMeta: kapi.ObjectMeta{Name: name, Namespace: namespace},
+		Spec: api.ImageStreamSpec{
+			Tags: map[string]api.TagReference{
+				"base": tagRef,
+			},
+		},
+	}
+}
+
+func TestFollowedTag(t *testing.T) {
+	stream := sourceStream()
+
+	tests := []struct {
+		name string
+
+		follower *api.ImageStream
+		tagRef   api.TagReference
+
+		expectedTag string
+		expected    bool
+	}{
+		{
+			name:     "cross namespace alias",
+			follower: followerStream("myproject", "ruby", api.TagReference{}),
+			tagRef: api.TagReference{
+				From: &kapi.ObjectReference{Kind: "ImageStreamTag", Namespace: "openshift", Name: "ruby:2.0"},
+			},
+			expectedTag: "2.0",
+			expected:    true,
+		},
+		{
+			name:     "cross stream alias in source namespace",
+			follower: followerStream("openshift", "rails", api.TagReference{}),
+			tagRef: api.TagReference{
+				From: &kapi.ObjectReference{Kind: "ImageStreamTag", Name: "ruby:2.0"},
+			},
+			expectedTag: "2.0",
+			expected:    true,
+		},
+		{
+			name:     "different stream",
+			follower: followerStream("myproject", "rails", api.TagReference{}),
+			tagRef: api.TagReference{
+				From: &kapi.ObjectReference{Kind: "ImageStreamTag", Namespace: "openshift", Name: "python:2.0"},
+			},
+			expected: false,
+		},
+		{
+			name:     "different namespace",
+			follower: followerStream("myproject", "ruby", api.TagReference{}),
+			tagRef: api.TagReference{
+				From: &kapi.ObjectReference{Kind: "ImageStreamTag", Namespace: "other", Name: "ruby:2.0"},
+			},
+			expected: false,
+		},
+		{
+			name:     "exact image reference",
+			follower: followerStream("myproject", "ruby", api.TagReference{}),
+			tagRef: api.TagReference{
+				From: &kapi.ObjectReference{Kind: "ImageStreamImage", Namespace: "openshift", Name: "ruby@sha256:0000000000000000000000000000000000000000000000000000000000000001"},
+			},
+			expected: false,
+		},
+		{
+			name:     "reference tag",
+			follower: followerStream("myproject", "ruby", api.TagReference{}),
+			tagRef: api.TagReference{
+				Reference: true,
+				From:      &kapi.ObjectReference{Kind: "ImageStreamTag", Namespace: "openshift", Name: "ruby:2.0"},
+			},
+			expected: false,
+		},
+	}
+
+	for _, test := range tests {
+		tag, ok := followedTag(test.follower, test.tagRef, stream)
+		if ok != test.expected {
+			t.Errorf("%s: expected followed: %t, got: %t", test.name, test.expected, ok)
+			continue
+		}
+		if ok && tag != test.expectedTag {
+			t.Errorf("%s: expected tag %q, got %q", test.name, test.expectedTag, tag)
+		}
+	}
+}
+
+func TestHandle(t *testing.T) {
+	stream := sourceStream()
+	follower := followerStream("myproject", "ruby", api.TagReference{
+		From: &kapi.ObjectReference{Kind: "ImageStreamTag", Namespace: "openshift", Name: "ruby:2.0"},
+	})
+
+	fake := &testclient.Fake{}
+	indexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{})
+	indexer.Add(stream)
+	indexer.Add(follower)
+
+	controller := &TagFollowController{
+		streams: fake,
+		lister:  &oscache.StoreToImageStreamLister{Indexer: indexer},
+	}
+
+	if err := controller.Handle(stream); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	actions := fake.Actions()
+	if len(actions) != 1 {
+		t.Fatalf("expected a single status update, got %v", actions)
+	}
+	if !actions[0].Matches("update", "imagestreams") {
+		t.Fatalf("unexpected action: %v", actions[0])
+	}
+	updated := actions[0].(core.UpdateAction).GetObject().(*api.ImageStream)
+	event := api.LatestTaggedImage(updated, "base")
+	if event == nil || event.Image != "sha256:0000000000000000000000000000000000000000000000000000000000000001" {
+		t.Fatalf("tag %q was not updated to follow the source tag: %#v", "base", updated.Status.Tags)
+	}
+
+	// a second sync with the updated stream in the cache should be a no-op
+	fake.ClearActions()
+	indexer.Update(updated)
+	if err := controller.Handle(stream); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(fake.Actions()) != 0 {
+		t.Fatalf("unexpected actions: %v", fake.Actions())
+	}
+}
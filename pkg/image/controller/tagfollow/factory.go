@@ -0,0 +1,62 @@
+package tagfollow
+
+import (
+	"time"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/client/cache"
+	"k8s.io/kubernetes/pkg/runtime"
+	"k8s.io/kubernetes/pkg/util/flowcontrol"
+	utilruntime "k8s.io/kubernetes/pkg/util/runtime"
+	"k8s.io/kubernetes/pkg/watch"
+
+	"github.com/openshift/origin/pkg/client"
+	oscache "github.com/openshift/origin/pkg/client/cache"
+	"github.com/openshift/origin/pkg/controller"
+	"github.com/openshift/origin/pkg/image/api"
+)
+
+// TagFollowControllerFactory can create a TagFollowController.
+type TagFollowControllerFactory struct {
+	Client         client.Interface
+	ResyncInterval time.Duration
+}
+
+// Create creates a TagFollowController.
+func (f *TagFollowControllerFactory) Create() controller.RunnableController {
+	lw := &cache.ListWatch{
+		ListFunc: func(options kapi.ListOptions) (runtime.Object, error) {
+			return f.Client.ImageStreams(kapi.NamespaceAll).List(options)
+		},
+		WatchFunc: func(options kapi.ListOptions) (watch.Interface, error) {
+			return f.Client.ImageStreams(kapi.NamespaceAll).Watch(options)
+		},
+	}
+	q := cache.NewResyncableFIFO(cache.MetaNamespaceKeyFunc)
+	cache.NewReflector(lw, &api.ImageStream{}, q, f.ResyncInterval).Run()
+
+	indexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{})
+	cache.NewReflector(lw, &api.ImageStream{}, indexer, f.ResyncInterval).Run()
+
+	c := &TagFollowController{
+		streams: f.Client,
+		lister:  &oscache.StoreToImageStreamLister{Indexer: indexer},
+	}
+
+	return &controller.RetryController{
+		Queue: q,
+		RetryManager: controller.NewQueueRetryManager(
+			q,
+			cache.MetaNamespaceKeyFunc,
+			func(obj interface{}, err error, retries controller.Retry) bool {
+				utilruntime.HandleError(err)
+				return retries.Count < 5
+			},
+			flowcontrol.NewTokenBucketRateLimiter(1, 10),
+		),
+		Handle: func(obj interface{}) error {
+			stream := obj.(*api.ImageStream)
+			return c.Handle(stream)
+		},
+	}
+}
@@ -51,7 +51,30 @@ func NewContext(transport, insecureTransport http.RoundTripper) Context {
 type Context struct {
 	Transport         http.RoundTripper
 	InsecureTransport http.RoundTripper
-	Challenges        auth.ChallengeManager
+	// RegistryTransports are transports to use for specific registries keyed by the
+	// registry host (including a non-standard port, if any). They take precedence over
+	// Transport and InsecureTransport and allow registries behind different proxies or
+	// with private certificate authorities to be reached simultaneously.
+	RegistryTransports map[string]http.RoundTripper
+	Challenges         auth.ChallengeManager
+}
+
+// WithRegistryTransports sets per-registry transports keyed by registry host.
+func (c Context) WithRegistryTransports(transports map[string]http.RoundTripper) Context {
+	c.RegistryTransports = transports
+	return c
+}
+
+// transportFor returns the transport to use for the given registry, preferring a
+// per-registry transport when one is configured.
+func (c Context) transportFor(registry *url.URL, insecure bool) http.RoundTripper {
+	if t, ok := c.RegistryTransports[registry.Host]; ok {
+		return t
+	}
+	if insecure && c.InsecureTransport != nil {
+		return c.InsecureTransport
+	}
+	return c.Transport
 }
 
 func (c Context) WithCredentials(credentials auth.CredentialStore) RepositoryRetriever {
@@ -78,10 +101,7 @@ func (r *repositoryRetriever) Repository(ctx gocontext.Context, registry *url.UR
 		return nil, err
 	}
 
-	t := r.context.Transport
-	if insecure && r.context.InsecureTransport != nil {
-		t = r.context.InsecureTransport
-	}
+	t := r.context.transportFor(registry, insecure)
 	src := *registry
 	// ping the registry to get challenge headers
 	if err, ok := r.pings[src]; ok {
@@ -0,0 +1,33 @@
+package importer
+
+import (
+	"fmt"
+
+	gocontext "golang.org/x/net/context"
+
+	"github.com/openshift/origin/pkg/image/api"
+)
+
+// RegistryDigestResolver answers what content digest the tag of an external image
+// reference currently points at, without importing the image into an image stream.
+type RegistryDigestResolver struct {
+	// Retriever fetches an authenticated repository for the registry the reference
+	// points at.
+	Retriever RepositoryRetriever
+}
+
+// ResolveImageDigest returns the digest currently associated with the tag of ref in the
+// registry that hosts it.
+func (r *RegistryDigestResolver) ResolveImageDigest(ref api.DockerImageReference) (string, error) {
+	ctx := gocontext.Background()
+	defaultRef := ref.DockerClientDefaults()
+	repo, err := r.Retriever.Repository(ctx, defaultRef.RegistryURL(), defaultRef.RepositoryName(), false)
+	if err != nil {
+		return "", fmt.Errorf("could not connect to repository %q: %v", defaultRef.Exact(), err)
+	}
+	desc, err := repo.Tags(ctx).Get(ctx, defaultRef.Tag)
+	if err != nil {
+		return "", fmt.Errorf("could not get tag %q of repository %q: %v", defaultRef.Tag, defaultRef.Exact(), err)
+	}
+	return desc.Digest.String(), nil
+}
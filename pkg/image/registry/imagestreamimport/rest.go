@@ -37,16 +37,17 @@ type ImporterDockerRegistryFunc func() dockerregistry.Client
 
 // REST implements the RESTStorage interface for ImageStreamImport
 type REST struct {
-	importFn          ImporterFunc
-	streams           imagestream.Registry
-	internalStreams   rest.CreaterUpdater
-	images            rest.Creater
-	secrets           client.ImageStreamSecretsNamespacer
-	transport         http.RoundTripper
-	insecureTransport http.RoundTripper
-	clientFn          ImporterDockerRegistryFunc
-	strategy          *strategy
-	sarClient         client.SubjectAccessReviewInterface
+	importFn           ImporterFunc
+	streams            imagestream.Registry
+	internalStreams    rest.CreaterUpdater
+	images             rest.Creater
+	secrets            client.ImageStreamSecretsNamespacer
+	transport          http.RoundTripper
+	insecureTransport  http.RoundTripper
+	registryTransports map[string]http.RoundTripper
+	clientFn           ImporterDockerRegistryFunc
+	strategy           *strategy
+	sarClient          client.SubjectAccessReviewInterface
 }
 
 // NewREST returns a REST storage implementation that handles importing images. The clientFn argument is optional
@@ -55,22 +56,24 @@ type REST struct {
 func NewREST(importFn ImporterFunc, streams imagestream.Registry, internalStreams rest.CreaterUpdater,
 	images rest.Creater, secrets client.ImageStreamSecretsNamespacer,
 	transport, insecureTransport http.RoundTripper,
+	registryTransports map[string]http.RoundTripper,
 	clientFn ImporterDockerRegistryFunc,
 	allowedImportRegistries *serverapi.AllowedRegistries,
 	registryFn api.DefaultRegistryFunc,
 	sarClient client.SubjectAccessReviewInterface,
 ) *REST {
 	return &REST{
-		importFn:          importFn,
-		streams:           streams,
-		internalStreams:   internalStreams,
-		images:            images,
-		secrets:           secrets,
-		transport:         transport,
-		insecureTransport: insecureTransport,
-		clientFn:          clientFn,
-		strategy:          NewStrategy(allowedImportRegistries, registryFn),
-		sarClient:         sarClient,
+		importFn:           importFn,
+		streams:            streams,
+		internalStreams:    internalStreams,
+		images:             images,
+		secrets:            secrets,
+		transport:          transport,
+		insecureTransport:  insecureTransport,
+		registryTransports: registryTransports,
+		clientFn:           clientFn,
+		strategy:           NewStrategy(allowedImportRegistries, registryFn),
+		sarClient:          sarClient,
 	}
 }
 
@@ -152,7 +155,7 @@ func (r *REST) Create(ctx kapi.Context, obj runtime.Object) (runtime.Object, err
 		}
 		return secrets.Items, nil
 	})
-	importCtx := importer.NewContext(r.transport, r.insecureTransport).WithCredentials(credentials)
+	importCtx := importer.NewContext(r.transport, r.insecureTransport).WithRegistryTransports(r.registryTransports).WithCredentials(credentials)
 	imports := r.importFn(importCtx)
 	if err := imports.Import(ctx.(gocontext.Context), isi); err != nil {
 		return nil, kapierrors.NewInternalError(err)
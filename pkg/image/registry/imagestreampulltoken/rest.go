@@ -0,0 +1,136 @@
+package imagestreampulltoken
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+	"time"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+	kapierrors "k8s.io/kubernetes/pkg/api/errors"
+	"k8s.io/kubernetes/pkg/api/unversioned"
+	"k8s.io/kubernetes/pkg/runtime"
+
+	"github.com/openshift/origin/pkg/authorization/authorizer/scope"
+	"github.com/openshift/origin/pkg/image/api"
+	"github.com/openshift/origin/pkg/image/registry/imagestream"
+	oauthapi "github.com/openshift/origin/pkg/oauth/api"
+	"github.com/openshift/origin/pkg/oauth/registry/oauthaccesstoken"
+	userregistry "github.com/openshift/origin/pkg/user/registry/user"
+)
+
+const (
+	// defaultExpirySeconds is the lifetime applied when the caller does not request one
+	defaultExpirySeconds = int64(3600)
+	// maxExpirySeconds bounds the lifetime a caller may request
+	maxExpirySeconds = int64(86400)
+)
+
+// REST implements the RESTStorage interface for ImageStreamPullToken. It mints scoped
+// OAuth access tokens that grant pull-only access to a single image stream.
+type REST struct {
+	streams      imagestream.Registry
+	accessTokens oauthaccesstoken.Registry
+	users        userregistry.Registry
+	clientName   string
+}
+
+// NewREST returns a new REST. Tokens are recorded against the provided OAuth client name,
+// which must resolve to a registered client.
+func NewREST(streams imagestream.Registry, accessTokens oauthaccesstoken.Registry, users userregistry.Registry, clientName string) *REST {
+	return &REST{
+		streams:      streams,
+		accessTokens: accessTokens,
+		users:        users,
+		clientName:   clientName,
+	}
+}
+
+// New is only implemented to make REST implement RESTStorage
+func (r *REST) New() runtime.Object {
+	return &api.ImageStreamPullToken{}
+}
+
+func (r *REST) Create(ctx kapi.Context, obj runtime.Object) (runtime.Object, error) {
+	pullToken, ok := obj.(*api.ImageStreamPullToken)
+	if !ok {
+		return nil, kapierrors.NewBadRequest(fmt.Sprintf("obj is not an ImageStreamPullToken: %#v", obj))
+	}
+	namespace, ok := kapi.NamespaceFrom(ctx)
+	if !ok {
+		return nil, kapierrors.NewBadRequest("a namespace must be specified to create a pull token")
+	}
+	user, ok := kapi.UserFrom(ctx)
+	if !ok {
+		return nil, kapierrors.NewBadRequest("unable to get user from context")
+	}
+	if len(pullToken.Name) == 0 {
+		return nil, kapierrors.NewBadRequest("metadata.name must identify an image stream")
+	}
+
+	if _, err := r.streams.GetImageStream(ctx, pullToken.Name); err != nil {
+		return nil, err
+	}
+
+	expiresIn := defaultExpirySeconds
+	if pullToken.ExpiresInSeconds != nil {
+		expiresIn = *pullToken.ExpiresInSeconds
+		if expiresIn <= 0 {
+			return nil, kapierrors.NewBadRequest("expiresInSeconds must be a positive integer")
+		}
+		if expiresIn > maxExpirySeconds {
+			expiresIn = maxExpirySeconds
+		}
+	}
+
+	// the token authenticator requires the stored user name and UID to match a User object,
+	// so resolve the requesting user rather than trusting the context UID (which is empty
+	// for certificate based authentication)
+	u, err := r.users.GetUser(ctx, user.GetName())
+	if err != nil {
+		if kapierrors.IsNotFound(err) {
+			return nil, kapierrors.NewBadRequest(fmt.Sprintf("pull tokens can only be created by users backed by a User object: %v", err))
+		}
+		return nil, err
+	}
+
+	value, err := generateTokenValue()
+	if err != nil {
+		return nil, kapierrors.NewInternalError(err)
+	}
+
+	pullScope := scope.RegistryPullScope(namespace, pullToken.Name)
+	now := unversioned.Now()
+	if _, err := r.accessTokens.CreateAccessToken(ctx, &oauthapi.OAuthAccessToken{
+		ObjectMeta: kapi.ObjectMeta{Name: value},
+		ClientName: r.clientName,
+		ExpiresIn:  expiresIn,
+		Scopes:     []string{pullScope},
+		UserName:   u.Name,
+		UserUID:    string(u.UID),
+	}); err != nil {
+		return nil, err
+	}
+
+	return &api.ImageStreamPullToken{
+		ObjectMeta: kapi.ObjectMeta{
+			Name:              pullToken.Name,
+			Namespace:         namespace,
+			CreationTimestamp: now,
+		},
+		ExpiresInSeconds:    &expiresIn,
+		Token:               value,
+		Scope:               pullScope,
+		ExpirationTimestamp: unversioned.NewTime(now.Add(time.Duration(expiresIn) * time.Second)),
+	}, nil
+}
+
+// generateTokenValue returns a URL-safe random token long enough to satisfy access token
+// name validation
+func generateTokenValue() (string, error) {
+	raw := make([]byte, 32)
+	if _, err := rand.Read(raw); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(raw), nil
+}
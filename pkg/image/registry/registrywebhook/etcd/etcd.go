@@ -0,0 +1,35 @@
+package etcd
+
+import (
+	"k8s.io/kubernetes/pkg/registry/generic/registry"
+	"k8s.io/kubernetes/pkg/runtime"
+	"k8s.io/kubernetes/pkg/storage"
+
+	"github.com/openshift/origin/pkg/image/api"
+	"github.com/openshift/origin/pkg/image/registry/registrywebhook"
+	"github.com/openshift/origin/pkg/util/restoptions"
+)
+
+// REST implements a RESTStorage for registry webhooks against etcd
+type REST struct {
+	*registry.Store
+}
+
+// NewREST returns a RESTStorage object that will work against registry webhooks
+func NewREST(optsGetter restoptions.Getter) (*REST, error) {
+	store := &registry.Store{
+		NewFunc:           func() runtime.Object { return &api.RegistryWebhook{} },
+		NewListFunc:       func() runtime.Object { return &api.RegistryWebhookList{} },
+		PredicateFunc:     registrywebhook.Matcher,
+		QualifiedResource: api.Resource("registrywebhooks"),
+
+		CreateStrategy: registrywebhook.Strategy,
+		UpdateStrategy: registrywebhook.Strategy,
+	}
+
+	if err := restoptions.ApplyOptions(optsGetter, store, storage.NoTriggerPublisher); err != nil {
+		return nil, err
+	}
+
+	return &REST{store}, nil
+}
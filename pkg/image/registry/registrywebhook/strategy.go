@@ -0,0 +1,81 @@
+package registrywebhook
+
+import (
+	"fmt"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/fields"
+	"k8s.io/kubernetes/pkg/labels"
+	"k8s.io/kubernetes/pkg/runtime"
+	"k8s.io/kubernetes/pkg/storage"
+	"k8s.io/kubernetes/pkg/util/validation/field"
+
+	"github.com/openshift/origin/pkg/image/api"
+	"github.com/openshift/origin/pkg/image/api/validation"
+)
+
+// webhookStrategy implements behavior for RegistryWebhooks
+type webhookStrategy struct {
+	runtime.ObjectTyper
+	kapi.NameGenerator
+}
+
+// Strategy is the default logic that applies when creating and updating RegistryWebhook
+// objects via the REST API.
+var Strategy = webhookStrategy{kapi.Scheme, kapi.SimpleNameGenerator}
+
+// NamespaceScoped is true for registry webhooks
+func (webhookStrategy) NamespaceScoped() bool {
+	return true
+}
+
+func (webhookStrategy) PrepareForCreate(ctx kapi.Context, obj runtime.Object) {
+}
+
+func (webhookStrategy) PrepareForUpdate(ctx kapi.Context, obj, old runtime.Object) {}
+
+// Canonicalize normalizes the object after validation.
+func (webhookStrategy) Canonicalize(obj runtime.Object) {
+}
+
+// Validate validates a new registry webhook
+func (webhookStrategy) Validate(ctx kapi.Context, obj runtime.Object) field.ErrorList {
+	return validation.ValidateRegistryWebhook(obj.(*api.RegistryWebhook))
+}
+
+// AllowCreateOnUpdate is false for registry webhooks
+func (webhookStrategy) AllowCreateOnUpdate() bool {
+	return false
+}
+
+func (webhookStrategy) AllowUnconditionalUpdate() bool {
+	return false
+}
+
+// ValidateUpdate is the default update validation for a RegistryWebhook
+func (webhookStrategy) ValidateUpdate(ctx kapi.Context, obj, old runtime.Object) field.ErrorList {
+	return validation.ValidateRegistryWebhookUpdate(obj.(*api.RegistryWebhook), old.(*api.RegistryWebhook))
+}
+
+// GetAttrs returns labels and fields of a given object for filtering purposes
+func GetAttrs(o runtime.Object) (labels.Set, fields.Set, error) {
+	obj, ok := o.(*api.RegistryWebhook)
+	if !ok {
+		return nil, nil, fmt.Errorf("not a RegistryWebhook")
+	}
+	return labels.Set(obj.Labels), SelectableFields(obj), nil
+}
+
+// Matcher returns a generic matcher for a given label and field selector.
+func Matcher(label labels.Selector, field fields.Selector) storage.SelectionPredicate {
+	return storage.SelectionPredicate{
+		Label:    label,
+		Field:    field,
+		GetAttrs: GetAttrs,
+	}
+}
+
+// SelectableFields returns a field set that can be used for filter selection
+func SelectableFields(obj *api.RegistryWebhook) fields.Set {
+	return api.RegistryWebhookToSelectableFields(obj)
+}
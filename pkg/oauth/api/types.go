@@ -94,6 +94,14 @@ type OAuthClient struct {
 	// is checked against each restriction.  If any restriction matches, then the scope is allowed.
 	// If no restriction matches, then the scope is denied.
 	ScopeRestrictions []ScopeRestriction
+
+	// AccessTokenMaxAgeSeconds overrides the default access token max age for tokens granted to this client.
+	// 0 means no expiration.
+	AccessTokenMaxAgeSeconds *int32
+
+	// GrantRefreshTokens indicates refresh tokens should be issued alongside access tokens for
+	// this client, allowing expired access tokens to be renewed without re-authentication
+	GrantRefreshTokens bool
 }
 
 type GrantHandlerType string
@@ -463,6 +463,19 @@ func (m *OAuthClient) MarshalTo(data []byte) (int, error) {
 			i += n
 		}
 	}
+	if m.AccessTokenMaxAgeSeconds != nil {
+		data[i] = 0x40
+		i++
+		i = encodeVarintGenerated(data, i, uint64(*m.AccessTokenMaxAgeSeconds))
+	}
+	data[i] = 0x48
+	i++
+	if m.GrantRefreshTokens {
+		data[i] = 1
+	} else {
+		data[i] = 0
+	}
+	i++
 	return i, nil
 }
 
@@ -860,6 +873,10 @@ func (m *OAuthClient) Size() (n int) {
 			n += 1 + l + sovGenerated(uint64(l))
 		}
 	}
+	if m.AccessTokenMaxAgeSeconds != nil {
+		n += 1 + sovGenerated(uint64(*m.AccessTokenMaxAgeSeconds))
+	}
+	n += 2
 	return n
 }
 
@@ -1045,6 +1062,8 @@ func (this *OAuthClient) String() string {
 		`RedirectURIs:` + fmt.Sprintf("%v", this.RedirectURIs) + `,`,
 		`GrantMethod:` + fmt.Sprintf("%v", this.GrantMethod) + `,`,
 		`ScopeRestrictions:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ScopeRestrictions), "ScopeRestriction", "ScopeRestriction", 1), `&`, ``, 1) + `,`,
+		`AccessTokenMaxAgeSeconds:` + valueToStringGenerated(this.AccessTokenMaxAgeSeconds) + `,`,
+		`GrantRefreshTokens:` + fmt.Sprintf("%v", this.GrantRefreshTokens) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -2336,6 +2355,46 @@ func (m *OAuthClient) Unmarshal(data []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccessTokenMaxAgeSeconds", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				v |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AccessTokenMaxAgeSeconds = &v
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GrantRefreshTokens", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.GrantRefreshTokens = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(data[iNdEx:])
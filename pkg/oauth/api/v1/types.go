@@ -103,6 +103,14 @@ type OAuthClient struct {
 	// is checked against each restriction.  If any restriction matches, then the scope is allowed.
 	// If no restriction matches, then the scope is denied.
 	ScopeRestrictions []ScopeRestriction `json:"scopeRestrictions,omitempty" protobuf:"bytes,7,rep,name=scopeRestrictions"`
+
+	// AccessTokenMaxAgeSeconds overrides the default access token max age for tokens granted to this client.
+	// 0 means no expiration.
+	AccessTokenMaxAgeSeconds *int32 `json:"accessTokenMaxAgeSeconds,omitempty" protobuf:"varint,8,opt,name=accessTokenMaxAgeSeconds"`
+
+	// GrantRefreshTokens indicates refresh tokens should be issued alongside access tokens for
+	// this client, allowing expired access tokens to be renewed without re-authentication
+	GrantRefreshTokens bool `json:"grantRefreshTokens,omitempty" protobuf:"varint,9,opt,name=grantRefreshTokens"`
 }
 
 type GrantHandlerType string
@@ -237,6 +237,8 @@ func autoConvert_v1_OAuthClient_To_api_OAuthClient(in *OAuthClient, out *api.OAu
 	out.RedirectURIs = *(*[]string)(unsafe.Pointer(&in.RedirectURIs))
 	out.GrantMethod = api.GrantHandlerType(in.GrantMethod)
 	out.ScopeRestrictions = *(*[]api.ScopeRestriction)(unsafe.Pointer(&in.ScopeRestrictions))
+	out.AccessTokenMaxAgeSeconds = (*int32)(unsafe.Pointer(in.AccessTokenMaxAgeSeconds))
+	out.GrantRefreshTokens = in.GrantRefreshTokens
 	return nil
 }
 
@@ -254,6 +256,8 @@ func autoConvert_api_OAuthClient_To_v1_OAuthClient(in *api.OAuthClient, out *OAu
 	out.RedirectURIs = *(*[]string)(unsafe.Pointer(&in.RedirectURIs))
 	out.GrantMethod = GrantHandlerType(in.GrantMethod)
 	out.ScopeRestrictions = *(*[]ScopeRestriction)(unsafe.Pointer(&in.ScopeRestrictions))
+	out.AccessTokenMaxAgeSeconds = (*int32)(unsafe.Pointer(in.AccessTokenMaxAgeSeconds))
+	out.GrantRefreshTokens = in.GrantRefreshTokens
 	return nil
 }
 
@@ -188,6 +188,14 @@ func DeepCopy_v1_OAuthClient(in interface{}, out interface{}, c *conversion.Clon
 		} else {
 			out.ScopeRestrictions = nil
 		}
+		if in.AccessTokenMaxAgeSeconds != nil {
+			in, out := &in.AccessTokenMaxAgeSeconds, &out.AccessTokenMaxAgeSeconds
+			*out = new(int32)
+			**out = **in
+		} else {
+			out.AccessTokenMaxAgeSeconds = nil
+		}
+		out.GrantRefreshTokens = in.GrantRefreshTokens
 		return nil
 	}
 }
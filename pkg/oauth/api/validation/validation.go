@@ -139,6 +139,10 @@ func ValidateClient(client *api.OAuthClient) field.ErrorList {
 		allErrs = append(allErrs, ValidateScopeRestriction(restriction, field.NewPath("scopeRestrictions").Index(i))...)
 	}
 
+	if client.AccessTokenMaxAgeSeconds != nil && *client.AccessTokenMaxAgeSeconds < 0 {
+		allErrs = append(allErrs, field.Invalid(field.NewPath("accessTokenMaxAgeSeconds"), *client.AccessTokenMaxAgeSeconds, "must be greater than or equal to 0"))
+	}
+
 	return allErrs
 }
 
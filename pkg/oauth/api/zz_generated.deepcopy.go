@@ -188,6 +188,14 @@ func DeepCopy_api_OAuthClient(in interface{}, out interface{}, c *conversion.Clo
 		} else {
 			out.ScopeRestrictions = nil
 		}
+		if in.AccessTokenMaxAgeSeconds != nil {
+			in, out := &in.AccessTokenMaxAgeSeconds, &out.AccessTokenMaxAgeSeconds
+			*out = new(int32)
+			**out = **in
+		} else {
+			out.AccessTokenMaxAgeSeconds = nil
+		}
+		out.GrantRefreshTokens = in.GrantRefreshTokens
 		return nil
 	}
 }
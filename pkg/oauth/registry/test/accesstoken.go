@@ -10,6 +10,7 @@ type AccessTokenRegistry struct {
 	Err                    error
 	AccessTokens           *api.OAuthAccessTokenList
 	AccessToken            *api.OAuthAccessToken
+	CreatedAccessToken     *api.OAuthAccessToken
 	DeletedAccessTokenName string
 }
 
@@ -22,6 +23,7 @@ func (r *AccessTokenRegistry) GetAccessToken(ctx kapi.Context, name string) (*ap
 }
 
 func (r *AccessTokenRegistry) CreateAccessToken(ctx kapi.Context, token *api.OAuthAccessToken) (*api.OAuthAccessToken, error) {
+	r.CreatedAccessToken = token
 	return r.AccessToken, r.Err
 }
 
@@ -1,7 +1,6 @@
 package registrystorage
 
 import (
-	"errors"
 	"strings"
 
 	"github.com/RangelReale/osin"
@@ -142,6 +141,16 @@ func (s *storage) RemoveAuthorize(code string) error {
 // SaveAccess writes AccessData.
 // If RefreshToken is not blank, it must save in a way that can be loaded using LoadRefresh.
 func (s *storage) SaveAccess(data *osin.AccessData) error {
+	// Apply per-client overrides before the access data is persisted and returned to the
+	// client. Mutating the osin data keeps the token response consistent with storage.
+	if wrapper, ok := data.Client.(*clientWrapper); ok && wrapper.client != nil {
+		if wrapper.client.AccessTokenMaxAgeSeconds != nil {
+			data.ExpiresIn = *wrapper.client.AccessTokenMaxAgeSeconds
+		}
+		if !wrapper.client.GrantRefreshTokens {
+			data.RefreshToken = ""
+		}
+	}
 	token, err := s.convertToAccessToken(data)
 	if err != nil {
 		return err
@@ -171,12 +180,44 @@ func (s *storage) RemoveAccess(token string) error {
 // AuthorizeData and AccessData DON'T NEED to be loaded if not easily available.
 // Optionally can return error if expired.
 func (s *storage) LoadRefresh(token string) (*osin.AccessData, error) {
-	return nil, errors.New("not implemented")
+	access, err := s.lookupRefresh(token)
+	if err != nil {
+		return nil, err
+	}
+	if access == nil {
+		glog.V(5).Info("Refresh token not found")
+		return nil, nil
+	}
+	return s.convertFromAccessToken(access)
 }
 
 // RemoveRefresh revokes or deletes refresh AccessData.
 func (s *storage) RemoveRefresh(token string) error {
-	return errors.New("not implemented")
+	access, err := s.lookupRefresh(token)
+	if err != nil {
+		return err
+	}
+	if access == nil {
+		return nil
+	}
+	return s.accesstoken.DeleteAccessToken(kapi.NewContext(), access.Name)
+}
+
+// lookupRefresh finds the access token the given refresh token was issued alongside
+func (s *storage) lookupRefresh(token string) (*api.OAuthAccessToken, error) {
+	if len(token) == 0 {
+		return nil, nil
+	}
+	tokens, err := s.accesstoken.ListAccessTokens(kapi.NewContext(), &kapi.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	for i := range tokens.Items {
+		if tokens.Items[i].RefreshToken == token {
+			return &tokens.Items[i], nil
+		}
+	}
+	return nil, nil
 }
 
 func (s *storage) convertToAuthorizeToken(data *osin.AuthorizeData) (*api.OAuthAuthorizeToken, error) {
@@ -2,8 +2,130 @@ package registrystorage
 
 import (
 	"testing"
+	"time"
+
+	"github.com/RangelReale/osin"
+	kapi "k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/auth/user"
+
+	oauthregistry "github.com/openshift/origin/pkg/auth/oauth/registry"
+	"github.com/openshift/origin/pkg/oauth/api"
+	"github.com/openshift/origin/pkg/oauth/registry/test"
 )
 
 func TestRegistry(t *testing.T) {
 	_ = storage{}
 }
+
+func TestSaveAccessAppliesClientOverrides(t *testing.T) {
+	maxAge := int32(86400)
+	tests := []struct {
+		name                string
+		client              *api.OAuthClient
+		expectedExpiresIn   int64
+		expectedRefreshKept bool
+	}{
+		{
+			name: "no overrides",
+			client: &api.OAuthClient{
+				ObjectMeta: kapi.ObjectMeta{Name: "test"},
+			},
+			expectedExpiresIn:   600,
+			expectedRefreshKept: false,
+		},
+		{
+			name: "custom max age and refresh tokens",
+			client: &api.OAuthClient{
+				ObjectMeta:               kapi.ObjectMeta{Name: "test"},
+				AccessTokenMaxAgeSeconds: &maxAge,
+				GrantRefreshTokens:       true,
+			},
+			expectedExpiresIn:   86400,
+			expectedRefreshKept: true,
+		},
+	}
+
+	for _, tc := range tests {
+		accessTokenRegistry := &test.AccessTokenRegistry{}
+		storage := &storage{
+			accesstoken: accessTokenRegistry,
+			user:        oauthregistry.NewUserConversion(),
+		}
+		data := &osin.AccessData{
+			AccessToken:  "token",
+			RefreshToken: "refresh",
+			ExpiresIn:    600,
+			CreatedAt:    time.Now(),
+			Client:       &clientWrapper{tc.client.Name, tc.client},
+			UserData:     &user.DefaultInfo{Name: "user", UID: "uid"},
+		}
+		if err := storage.SaveAccess(data); err != nil {
+			t.Errorf("%s: unexpected error: %v", tc.name, err)
+			continue
+		}
+		token := accessTokenRegistry.CreatedAccessToken
+		if token == nil {
+			t.Errorf("%s: no token created", tc.name)
+			continue
+		}
+		if token.ExpiresIn != tc.expectedExpiresIn {
+			t.Errorf("%s: expected ExpiresIn %d, got %d", tc.name, tc.expectedExpiresIn, token.ExpiresIn)
+		}
+		if data.ExpiresIn != int32(tc.expectedExpiresIn) {
+			t.Errorf("%s: expected response ExpiresIn %d, got %d", tc.name, tc.expectedExpiresIn, data.ExpiresIn)
+		}
+		if tc.expectedRefreshKept && (token.RefreshToken != "refresh" || data.RefreshToken != "refresh") {
+			t.Errorf("%s: expected the refresh token to be kept", tc.name)
+		}
+		if !tc.expectedRefreshKept && (len(token.RefreshToken) != 0 || len(data.RefreshToken) != 0) {
+			t.Errorf("%s: expected the refresh token to be stripped", tc.name)
+		}
+	}
+}
+
+func TestLoadRefresh(t *testing.T) {
+	accessTokenRegistry := &test.AccessTokenRegistry{
+		AccessTokens: &api.OAuthAccessTokenList{
+			Items: []api.OAuthAccessToken{
+				{
+					ObjectMeta:   kapi.ObjectMeta{Name: "access"},
+					RefreshToken: "refresh",
+					ClientName:   "test",
+					UserName:     "user",
+					UserUID:      "uid",
+				},
+			},
+		},
+	}
+	clientRegistry := &test.ClientRegistry{
+		Client: &api.OAuthClient{ObjectMeta: kapi.ObjectMeta{Name: "test"}},
+	}
+	storage := &storage{
+		accesstoken: accessTokenRegistry,
+		client:      clientRegistry,
+		user:        oauthregistry.NewUserConversion(),
+	}
+
+	data, err := storage.LoadRefresh("refresh")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if data == nil || data.AccessToken != "access" {
+		t.Errorf("expected the access token holding the refresh token, got %#v", data)
+	}
+
+	data, err = storage.LoadRefresh("missing")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if data != nil {
+		t.Errorf("expected no access data for an unknown refresh token, got %#v", data)
+	}
+
+	if err := storage.RemoveRefresh("refresh"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if accessTokenRegistry.DeletedAccessTokenName != "access" {
+		t.Errorf("expected the access token to be deleted, got %q", accessTokenRegistry.DeletedAccessTokenName)
+	}
+}
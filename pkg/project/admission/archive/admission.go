@@ -0,0 +1,123 @@
+package archive
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/golang/glog"
+
+	"k8s.io/kubernetes/pkg/admission"
+	"k8s.io/kubernetes/pkg/api/meta"
+	"k8s.io/kubernetes/pkg/api/unversioned"
+	"k8s.io/kubernetes/pkg/apimachinery/registered"
+	clientset "k8s.io/kubernetes/pkg/client/clientset_generated/internalclientset"
+
+	authorizationapi "github.com/openshift/origin/pkg/authorization/api"
+	oadmission "github.com/openshift/origin/pkg/cmd/server/admission"
+	"github.com/openshift/origin/pkg/cmd/server/bootstrappolicy"
+	projectapi "github.com/openshift/origin/pkg/project/api"
+	"github.com/openshift/origin/pkg/project/cache"
+)
+
+const PluginName = "openshift.io/ProjectArchive"
+
+func init() {
+	admission.RegisterPlugin(PluginName, func(client clientset.Interface, config io.Reader) (admission.Interface, error) {
+		return NewProjectArchive(), nil
+	})
+}
+
+type projectArchive struct {
+	cache *cache.ProjectCache
+}
+
+// allowedResources are resources that may be written even in an archived namespace, so that
+// access checks keep working
+var allowedResources = map[unversioned.GroupResource]bool{
+	authorizationapi.Resource("resourceaccessreviews"):      true,
+	authorizationapi.Resource("localresourceaccessreviews"): true,
+	authorizationapi.Resource("subjectaccessreviews"):       true,
+	authorizationapi.Resource("localsubjectaccessreviews"):  true,
+	authorizationapi.Resource("selfsubjectrulesreviews"):    true,
+	authorizationapi.Resource("subjectrulesreviews"):        true,
+
+	authorizationapi.LegacyResource("resourceaccessreviews"):      true,
+	authorizationapi.LegacyResource("localresourceaccessreviews"): true,
+	authorizationapi.LegacyResource("subjectaccessreviews"):       true,
+	authorizationapi.LegacyResource("localsubjectaccessreviews"):  true,
+	authorizationapi.LegacyResource("selfsubjectrulesreviews"):    true,
+	authorizationapi.LegacyResource("subjectrulesreviews"):        true,
+}
+
+var _ = oadmission.WantsProjectCache(&projectArchive{})
+
+// Admit rejects writes to namespaced resources in archived namespaces.  Members of the
+// system:masters group are exempt so that cluster administrators and the archive controller
+// itself can still manage content in an archived project.
+func (a *projectArchive) Admit(attributes admission.Attributes) (err error) {
+	if len(attributes.GetNamespace()) == 0 {
+		return nil
+	}
+	// status and scale updates are made by node and controller loops; archived content must
+	// still be able to settle
+	if len(attributes.GetSubresource()) > 0 {
+		return nil
+	}
+	if allowedResources[attributes.GetResource().GroupResource()] {
+		return nil
+	}
+	if user := attributes.GetUserInfo(); user != nil {
+		for _, group := range user.GetGroups() {
+			if group == bootstrappolicy.MastersGroup {
+				return nil
+			}
+		}
+	}
+
+	groupMeta, err := registered.Group(attributes.GetKind().Group)
+	if err != nil {
+		return err
+	}
+	mapping, err := groupMeta.RESTMapper.RESTMapping(attributes.GetKind().GroupKind())
+	if err != nil {
+		glog.V(4).Infof("Ignoring archive enforcement for resource %v; no associated default version and kind could be found.", attributes.GetResource())
+		return nil
+	}
+	if mapping.Scope.Name() != meta.RESTScopeNameNamespace {
+		return nil
+	}
+
+	if !a.cache.Running() {
+		return admission.NewForbidden(attributes, err)
+	}
+
+	namespace, err := a.cache.GetNamespace(attributes.GetNamespace())
+	if err != nil {
+		return admission.NewForbidden(attributes, err)
+	}
+
+	if namespace.Annotations[projectapi.ProjectArchived] == "true" {
+		return admission.NewForbidden(attributes, fmt.Errorf("namespace %s is archived and does not accept writes; unarchive the project to modify its content", namespace.Name))
+	}
+
+	return nil
+}
+
+func (a *projectArchive) Handles(operation admission.Operation) bool {
+	return operation == admission.Create || operation == admission.Update
+}
+
+func (a *projectArchive) SetProjectCache(c *cache.ProjectCache) {
+	a.cache = c
+}
+
+func (a *projectArchive) Validate() error {
+	if a.cache == nil {
+		return fmt.Errorf("project archive plugin needs a project cache")
+	}
+	return nil
+}
+
+func NewProjectArchive() admission.Interface {
+	return &projectArchive{}
+}
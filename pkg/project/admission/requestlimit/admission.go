@@ -11,6 +11,7 @@ import (
 	clientset "k8s.io/kubernetes/pkg/client/clientset_generated/internalclientset"
 	"k8s.io/kubernetes/pkg/labels"
 	"k8s.io/kubernetes/pkg/serviceaccount"
+	"k8s.io/kubernetes/pkg/util/sets"
 
 	"github.com/openshift/origin/pkg/client"
 	oadmission "github.com/openshift/origin/pkg/cmd/server/admission"
@@ -86,7 +87,7 @@ func (o *projectRequestLimit) Admit(a admission.Attributes) (err error) {
 	if err != nil {
 		return err
 	}
-	maxProjects, hasLimit, err := o.maxProjectsByRequester(userName)
+	maxProjects, hasLimit, err := o.maxProjectsByRequester(userName, a.GetUserInfo().GetGroups())
 	if err != nil {
 		return err
 	}
@@ -98,7 +99,7 @@ func (o *projectRequestLimit) Admit(a admission.Attributes) (err error) {
 
 // maxProjectsByRequester returns the maximum number of projects allowed for a given user, whether a limit exists, and an error
 // if an error occurred. If a limit doesn't exist, the maximum number should be ignored.
-func (o *projectRequestLimit) maxProjectsByRequester(userName string) (int, bool, error) {
+func (o *projectRequestLimit) maxProjectsByRequester(userName string, groups []string) (int, bool, error) {
 	// service accounts have a different ruleset, check them
 	if _, _, err := serviceaccount.SplitUsername(userName); err == nil {
 		if o.config.MaxProjectsForServiceAccounts == nil {
@@ -128,7 +129,11 @@ func (o *projectRequestLimit) maxProjectsByRequester(userName string) (int, bool
 	}
 	userLabels := labels.Set(user.Labels)
 
+	groupSet := sets.NewString(groups...)
 	for _, limit := range o.config.Limits {
+		if len(limit.Groups) > 0 && !groupSet.HasAny(limit.Groups...) {
+			continue
+		}
 		selector := labels.Set(limit.Selector).AsSelector()
 		if selector.Matches(userLabels) {
 			if limit.MaxProjects == nil {
@@ -124,6 +124,7 @@ kind: ProjectRequestLimitConfig
 func TestMaxProjectByRequester(t *testing.T) {
 	tests := []struct {
 		userLabels      map[string]string
+		userGroups      []string
 		expectUnlimited bool
 		expectedLimit   int
 	}{
@@ -154,7 +155,7 @@ func TestMaxProjectByRequester(t *testing.T) {
 		client := testclient.NewSimpleFake(user)
 		reqLimit.(oadmission.WantsOpenshiftClient).SetOpenshiftClient(client)
 
-		maxProjects, hasLimit, err := reqLimit.(*projectRequestLimit).maxProjectsByRequester("testuser")
+		maxProjects, hasLimit, err := reqLimit.(*projectRequestLimit).maxProjectsByRequester("testuser", tc.userGroups)
 		if err != nil {
 			t.Errorf("Unexpected error: %v", err)
 		}
@@ -176,6 +177,47 @@ func TestMaxProjectByRequester(t *testing.T) {
 	}
 }
 
+func TestMaxProjectByRequesterGroups(t *testing.T) {
+	tests := []struct {
+		userGroups    []string
+		expectedLimit int
+	}{
+		{
+			userGroups:    []string{"ci-teams", "other"},
+			expectedLimit: 5,
+		},
+		{
+			userGroups:    []string{"other"},
+			expectedLimit: 1,
+		},
+		{
+			expectedLimit: 1,
+		},
+	}
+
+	for _, tc := range tests {
+		reqLimit, err := NewProjectRequestLimit(groupConfig())
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		user := fakeUser("testuser", nil)
+		client := testclient.NewSimpleFake(user)
+		reqLimit.(oadmission.WantsOpenshiftClient).SetOpenshiftClient(client)
+
+		maxProjects, hasLimit, err := reqLimit.(*projectRequestLimit).maxProjectsByRequester("testuser", tc.userGroups)
+		if err != nil {
+			t.Errorf("Unexpected error: %v", err)
+		}
+		if !hasLimit {
+			t.Errorf("Expected a limit for groups %v", tc.userGroups)
+			continue
+		}
+		if maxProjects != tc.expectedLimit {
+			t.Errorf("Did not get expected limit for groups %v. Got: %d. Expected: %d", tc.userGroups, maxProjects, tc.expectedLimit)
+		}
+	}
+}
+
 func TestProjectCountByRequester(t *testing.T) {
 	pCache := fakeProjectCache(map[string]projectCount{
 		"user1": {1, 5}, // total 6, expect 4
@@ -410,6 +452,21 @@ func multiLevelConfig() *requestlimitapi.ProjectRequestLimitConfig {
 	}
 }
 
+func groupConfig() *requestlimitapi.ProjectRequestLimitConfig {
+	return &requestlimitapi.ProjectRequestLimitConfig{
+		Limits: []requestlimitapi.ProjectLimitBySelector{
+			{
+				Groups:      []string{"ci-teams"},
+				MaxProjects: intp(5),
+			},
+			{
+				Selector:    map[string]string{},
+				MaxProjects: intp(1),
+			},
+		},
+	}
+}
+
 func emptyConfig() *requestlimitapi.ProjectRequestLimitConfig {
 	return &requestlimitapi.ProjectRequestLimitConfig{}
 }
@@ -27,6 +27,10 @@ type ProjectRequestLimitConfig struct {
 type ProjectLimitBySelector struct {
 	// Selector is a user label selector. An empty selector selects everything.
 	Selector map[string]string `json:"selector",description:"user label selector"`
+	// Groups is a list of group names. If non-empty, the limit only applies to users that
+	// are members of at least one of the listed groups. Group membership is checked in
+	// addition to the label selector.
+	Groups []string `json:"groups,omitempty",description:"group names the limit applies to"`
 	// MaxProjects is the number of projects allowed for this class of users. If MaxProjects is nil,
 	// there is no limit to the number of projects users can request. An unlimited number of projects
 	// is useful in the case a limit is specified as the default for all users and only users with a
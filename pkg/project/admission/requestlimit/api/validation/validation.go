@@ -24,6 +24,11 @@ func ValidateProjectRequestLimitConfig(config *api.ProjectRequestLimitConfig) fi
 func ValidateProjectLimitBySelector(limit api.ProjectLimitBySelector, path *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
 	allErrs = append(allErrs, unversionedvalidation.ValidateLabels(limit.Selector, path.Child("selector"))...)
+	for i, group := range limit.Groups {
+		if len(group) == 0 {
+			allErrs = append(allErrs, field.Invalid(path.Child("groups").Index(i), group, "group name cannot be empty"))
+		}
+	}
 	if limit.MaxProjects != nil && *limit.MaxProjects < 0 {
 		allErrs = append(allErrs, field.Invalid(path.Child("maxProjects"), *limit.MaxProjects, "cannot be a negative number"))
 	}
@@ -0,0 +1,118 @@
+package tolerations
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"k8s.io/kubernetes/pkg/admission"
+	kapi "k8s.io/kubernetes/pkg/api"
+	apierrors "k8s.io/kubernetes/pkg/api/errors"
+	clientset "k8s.io/kubernetes/pkg/client/clientset_generated/internalclientset"
+
+	oadmission "github.com/openshift/origin/pkg/cmd/server/admission"
+	projectapi "github.com/openshift/origin/pkg/project/api"
+	"github.com/openshift/origin/pkg/project/cache"
+)
+
+func init() {
+	admission.RegisterPlugin("openshift.io/ProjectTolerations", func(client clientset.Interface, config io.Reader) (admission.Interface, error) {
+		return NewProjectTolerations(client)
+	})
+}
+
+// projectTolerations is an implementation of admission.Interface. It merges the
+// tolerations held in a project's openshift.io/node-tolerations annotation into
+// every pod created in that project. The annotation is typically seeded from the
+// projectRequestTemplate.
+type projectTolerations struct {
+	*admission.Handler
+	client clientset.Interface
+	cache  *cache.ProjectCache
+}
+
+var _ = oadmission.WantsProjectCache(&projectTolerations{})
+
+// Admit merges the project's default tolerations into the pod.
+func (p *projectTolerations) Admit(a admission.Attributes) (err error) {
+	resource := a.GetResource().GroupResource()
+	if resource != kapi.Resource("pods") {
+		return nil
+	}
+	if a.GetSubresource() != "" {
+		// only run the checks below on pods proper and not subresources
+		return nil
+	}
+
+	obj := a.GetObject()
+	pod, ok := obj.(*kapi.Pod)
+	if !ok {
+		return nil
+	}
+
+	if !p.cache.Running() {
+		return err
+	}
+	namespace, err := p.cache.GetNamespace(a.GetNamespace())
+	if err != nil {
+		return apierrors.NewForbidden(resource, pod.Name, err)
+	}
+
+	annotation, ok := namespace.Annotations[projectapi.ProjectTolerations]
+	if !ok || len(annotation) == 0 {
+		return nil
+	}
+	projectTolerations := []kapi.Toleration{}
+	if err := json.Unmarshal([]byte(annotation), &projectTolerations); err != nil {
+		return apierrors.NewForbidden(resource, pod.Name, fmt.Errorf("invalid project toleration annotation %s: %v", projectapi.ProjectTolerations, err))
+	}
+	if len(projectTolerations) == 0 {
+		return nil
+	}
+
+	tolerations, err := kapi.GetTolerationsFromPodAnnotations(pod.Annotations)
+	if err != nil {
+		return apierrors.NewForbidden(resource, pod.Name, err)
+	}
+	for _, toleration := range projectTolerations {
+		found := false
+		for _, existing := range tolerations {
+			if existing == toleration {
+				found = true
+				break
+			}
+		}
+		if !found {
+			tolerations = append(tolerations, toleration)
+		}
+	}
+
+	data, err := json.Marshal(tolerations)
+	if err != nil {
+		return apierrors.NewForbidden(resource, pod.Name, err)
+	}
+	if pod.Annotations == nil {
+		pod.Annotations = map[string]string{}
+	}
+	pod.Annotations[kapi.TolerationsAnnotationKey] = string(data)
+
+	return nil
+}
+
+func (p *projectTolerations) SetProjectCache(c *cache.ProjectCache) {
+	p.cache = c
+}
+
+func (p *projectTolerations) Validate() error {
+	if p.cache == nil {
+		return fmt.Errorf("project tolerations plugin needs a project cache")
+	}
+	return nil
+}
+
+func NewProjectTolerations(client clientset.Interface) (admission.Interface, error) {
+	return &projectTolerations{
+		Handler: admission.NewHandler(admission.Create),
+		client:  client,
+	}, nil
+}
@@ -0,0 +1,126 @@
+package tolerations
+
+import (
+	"encoding/json"
+	"testing"
+
+	"k8s.io/kubernetes/pkg/admission"
+	kapi "k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/client/cache"
+	"k8s.io/kubernetes/pkg/client/clientset_generated/internalclientset/fake"
+
+	projectapi "github.com/openshift/origin/pkg/project/api"
+	projectcache "github.com/openshift/origin/pkg/project/cache"
+)
+
+// TestPodAdmission verifies that project default tolerations are merged into pods
+func TestPodAdmission(t *testing.T) {
+	dedicated := kapi.Toleration{Key: "dedicated", Operator: kapi.TolerationOpEqual, Value: "myteam", Effect: kapi.TaintEffectNoSchedule}
+	infra := kapi.Toleration{Key: "infra", Operator: kapi.TolerationOpExists}
+
+	tests := []struct {
+		testName string
+
+		projectTolerations []kapi.Toleration
+		rawAnnotation      string
+		podTolerations     []kapi.Toleration
+
+		mergedTolerations []kapi.Toleration
+		admit             bool
+	}{
+		{
+			testName: "no project tolerations",
+			admit:    true,
+		},
+		{
+			testName:           "project toleration is added",
+			projectTolerations: []kapi.Toleration{dedicated},
+			mergedTolerations:  []kapi.Toleration{dedicated},
+			admit:              true,
+		},
+		{
+			testName:           "pod tolerations are kept",
+			projectTolerations: []kapi.Toleration{dedicated},
+			podTolerations:     []kapi.Toleration{infra},
+			mergedTolerations:  []kapi.Toleration{infra, dedicated},
+			admit:              true,
+		},
+		{
+			testName:           "duplicate toleration is not added twice",
+			projectTolerations: []kapi.Toleration{dedicated},
+			podTolerations:     []kapi.Toleration{dedicated},
+			mergedTolerations:  []kapi.Toleration{dedicated},
+			admit:              true,
+		},
+		{
+			testName:      "invalid annotation is rejected",
+			rawAnnotation: "not-json",
+			admit:         false,
+		},
+	}
+
+	for _, test := range tests {
+		annotations := map[string]string{}
+		if len(test.rawAnnotation) != 0 {
+			annotations[projectapi.ProjectTolerations] = test.rawAnnotation
+		} else if test.projectTolerations != nil {
+			data, err := json.Marshal(test.projectTolerations)
+			if err != nil {
+				t.Fatalf("%s: unexpected error: %v", test.testName, err)
+			}
+			annotations[projectapi.ProjectTolerations] = string(data)
+		}
+		project := &kapi.Namespace{
+			ObjectMeta: kapi.ObjectMeta{
+				Name:        "testProject",
+				Annotations: annotations,
+			},
+		}
+		projectStore := projectcache.NewCacheStore(cache.IndexFuncToKeyFuncAdapter(cache.MetaNamespaceIndexFunc))
+		projectStore.Add(project)
+
+		pod := &kapi.Pod{
+			ObjectMeta: kapi.ObjectMeta{Name: "testPod"},
+		}
+		if test.podTolerations != nil {
+			data, err := json.Marshal(test.podTolerations)
+			if err != nil {
+				t.Fatalf("%s: unexpected error: %v", test.testName, err)
+			}
+			pod.Annotations = map[string]string{kapi.TolerationsAnnotationKey: string(data)}
+		}
+
+		mockClientset := fake.NewSimpleClientset()
+		handler := &projectTolerations{client: mockClientset}
+		handler.SetProjectCache(projectcache.NewFake(mockClientset.Core().Namespaces(), projectStore, ""))
+
+		err := handler.Admit(admission.NewAttributesRecord(pod, nil, kapi.Kind("Pod").WithVersion("version"), "testProject", pod.Name, kapi.Resource("pods").WithVersion("version"), "", admission.Create, nil))
+		if test.admit && err != nil {
+			t.Errorf("%s: expected no error but got: %v", test.testName, err)
+			continue
+		}
+		if !test.admit && err == nil {
+			t.Errorf("%s: expected an error", test.testName)
+			continue
+		}
+		if !test.admit {
+			continue
+		}
+
+		tolerations, err := kapi.GetTolerationsFromPodAnnotations(pod.Annotations)
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", test.testName, err)
+			continue
+		}
+		if len(tolerations) != len(test.mergedTolerations) {
+			t.Errorf("%s: expected tolerations %v, got %v", test.testName, test.mergedTolerations, tolerations)
+			continue
+		}
+		for i := range test.mergedTolerations {
+			if tolerations[i] != test.mergedTolerations[i] {
+				t.Errorf("%s: expected tolerations %v, got %v", test.testName, test.mergedTolerations, tolerations)
+				break
+			}
+		}
+	}
+}
@@ -45,14 +45,54 @@ type ProjectRequest struct {
 	kapi.ObjectMeta
 	DisplayName string
 	Description string
+	Status      ProjectRequestStatus
 }
 
+// ProjectRequestStatus describes the outcome of a project request
+type ProjectRequestStatus struct {
+	// Phase is the current phase of the request
+	Phase ProjectRequestPhase
+	// Reason is a human readable explanation of the phase, typically supplied by an
+	// external approval system
+	Reason string
+}
+
+// ProjectRequestPhase describes the phase of an asynchronously approved project request
+type ProjectRequestPhase string
+
+const (
+	// ProjectRequestPending means the request is awaiting a decision from an external approval system
+	// and the project has not been created yet
+	ProjectRequestPending ProjectRequestPhase = "Pending"
+	// ProjectRequestApproved means the request was approved and the project was created
+	ProjectRequestApproved ProjectRequestPhase = "Approved"
+	// ProjectRequestDenied means the request was denied by the external approval system
+	ProjectRequestDenied ProjectRequestPhase = "Denied"
+)
+
 // These constants represent annotations keys affixed to projects
 const (
 	// ProjectNodeSelector is an annotation that holds the node selector;
 	// the node selector annotation determines which nodes will have pods from this project scheduled to them
 	ProjectNodeSelector = "openshift.io/node-selector"
+	// ProjectTolerations is an annotation that holds a JSON-serialized list of tolerations;
+	// the tolerations are merged into every pod created in this project so that taint-based
+	// isolation does not require each pod to carry them explicitly
+	ProjectTolerations = "openshift.io/node-tolerations"
 	// ProjectRequester is the username that requested a given project.  Its not guaranteed to be present,
 	// but it is set by the default project template.
 	ProjectRequester = "openshift.io/requester"
+	// ProjectArchived is an annotation that marks a project as archived when set to "true";
+	// the archive controller scales workloads in an archived project to zero and admission
+	// rejects new writes to it, but all data (PVCs, image streams) is retained.  Removing
+	// the annotation reverses the process.
+	ProjectArchived = "openshift.io/archived"
+	// ProjectArchivedAt is an annotation holding the time at which the archive controller
+	// finished scaling down an archived project.  Its presence indicates that archival has
+	// been carried out; it is removed when the project is unarchived.
+	ProjectArchivedAt = "openshift.io/archived-at"
+	// ProjectAuditLevel is an annotation that selects the audit level for requests made
+	// against the project.  Valid values are None, Metadata and RequestResponse; requests
+	// in projects without the annotation are audited at the Metadata level.
+	ProjectAuditLevel = "openshift.io/audit-level"
 )
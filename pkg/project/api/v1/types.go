@@ -67,4 +67,28 @@ type ProjectRequest struct {
 	DisplayName string `json:"displayName,omitempty" protobuf:"bytes,2,opt,name=displayName"`
 	// Description is the description to apply to a project
 	Description string `json:"description,omitempty" protobuf:"bytes,3,opt,name=description"`
+	// Status describes the outcome of the request when project creation requires external approval
+	Status ProjectRequestStatus `json:"status,omitempty" protobuf:"bytes,4,opt,name=status"`
 }
+
+// ProjectRequestStatus describes the outcome of a project request
+type ProjectRequestStatus struct {
+	// Phase is the current phase of the request
+	Phase ProjectRequestPhase `json:"phase,omitempty" protobuf:"bytes,1,opt,name=phase,casttype=ProjectRequestPhase"`
+	// Reason is a human readable explanation of the phase, typically supplied by an
+	// external approval system
+	Reason string `json:"reason,omitempty" protobuf:"bytes,2,opt,name=reason"`
+}
+
+// ProjectRequestPhase describes the phase of an asynchronously approved project request
+type ProjectRequestPhase string
+
+const (
+	// ProjectRequestPending means the request is awaiting a decision from an external approval system
+	// and the project has not been created yet
+	ProjectRequestPending ProjectRequestPhase = "Pending"
+	// ProjectRequestApproved means the request was approved and the project was created
+	ProjectRequestApproved ProjectRequestPhase = "Approved"
+	// ProjectRequestDenied means the request was denied by the external approval system
+	ProjectRequestDenied ProjectRequestPhase = "Denied"
+)
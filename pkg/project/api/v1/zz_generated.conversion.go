@@ -27,6 +27,8 @@ func RegisterConversions(scheme *runtime.Scheme) error {
 		Convert_api_ProjectList_To_v1_ProjectList,
 		Convert_v1_ProjectRequest_To_api_ProjectRequest,
 		Convert_api_ProjectRequest_To_v1_ProjectRequest,
+		Convert_v1_ProjectRequestStatus_To_api_ProjectRequestStatus,
+		Convert_api_ProjectRequestStatus_To_v1_ProjectRequestStatus,
 		Convert_v1_ProjectSpec_To_api_ProjectSpec,
 		Convert_api_ProjectSpec_To_v1_ProjectSpec,
 		Convert_v1_ProjectStatus_To_api_ProjectStatus,
@@ -114,6 +116,9 @@ func autoConvert_v1_ProjectRequest_To_api_ProjectRequest(in *ProjectRequest, out
 	}
 	out.DisplayName = in.DisplayName
 	out.Description = in.Description
+	if err := Convert_v1_ProjectRequestStatus_To_api_ProjectRequestStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -127,6 +132,9 @@ func autoConvert_api_ProjectRequest_To_v1_ProjectRequest(in *api.ProjectRequest,
 	}
 	out.DisplayName = in.DisplayName
 	out.Description = in.Description
+	if err := Convert_api_ProjectRequestStatus_To_v1_ProjectRequestStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -134,6 +142,26 @@ func Convert_api_ProjectRequest_To_v1_ProjectRequest(in *api.ProjectRequest, out
 	return autoConvert_api_ProjectRequest_To_v1_ProjectRequest(in, out, s)
 }
 
+func autoConvert_v1_ProjectRequestStatus_To_api_ProjectRequestStatus(in *ProjectRequestStatus, out *api.ProjectRequestStatus, s conversion.Scope) error {
+	out.Phase = api.ProjectRequestPhase(in.Phase)
+	out.Reason = in.Reason
+	return nil
+}
+
+func Convert_v1_ProjectRequestStatus_To_api_ProjectRequestStatus(in *ProjectRequestStatus, out *api.ProjectRequestStatus, s conversion.Scope) error {
+	return autoConvert_v1_ProjectRequestStatus_To_api_ProjectRequestStatus(in, out, s)
+}
+
+func autoConvert_api_ProjectRequestStatus_To_v1_ProjectRequestStatus(in *api.ProjectRequestStatus, out *ProjectRequestStatus, s conversion.Scope) error {
+	out.Phase = ProjectRequestPhase(in.Phase)
+	out.Reason = in.Reason
+	return nil
+}
+
+func Convert_api_ProjectRequestStatus_To_v1_ProjectRequestStatus(in *api.ProjectRequestStatus, out *ProjectRequestStatus, s conversion.Scope) error {
+	return autoConvert_api_ProjectRequestStatus_To_v1_ProjectRequestStatus(in, out, s)
+}
+
 func autoConvert_v1_ProjectSpec_To_api_ProjectSpec(in *ProjectSpec, out *api.ProjectSpec, s conversion.Scope) error {
 	out.Finalizers = *(*[]pkg_api.FinalizerName)(unsafe.Pointer(&in.Finalizers))
 	return nil
@@ -22,6 +22,7 @@ func RegisterDeepCopies(scheme *runtime.Scheme) error {
 		conversion.GeneratedDeepCopyFunc{Fn: DeepCopy_v1_Project, InType: reflect.TypeOf(&Project{})},
 		conversion.GeneratedDeepCopyFunc{Fn: DeepCopy_v1_ProjectList, InType: reflect.TypeOf(&ProjectList{})},
 		conversion.GeneratedDeepCopyFunc{Fn: DeepCopy_v1_ProjectRequest, InType: reflect.TypeOf(&ProjectRequest{})},
+		conversion.GeneratedDeepCopyFunc{Fn: DeepCopy_v1_ProjectRequestStatus, InType: reflect.TypeOf(&ProjectRequestStatus{})},
 		conversion.GeneratedDeepCopyFunc{Fn: DeepCopy_v1_ProjectSpec, InType: reflect.TypeOf(&ProjectSpec{})},
 		conversion.GeneratedDeepCopyFunc{Fn: DeepCopy_v1_ProjectStatus, InType: reflect.TypeOf(&ProjectStatus{})},
 	)
@@ -74,6 +75,17 @@ func DeepCopy_v1_ProjectRequest(in interface{}, out interface{}, c *conversion.C
 		}
 		out.DisplayName = in.DisplayName
 		out.Description = in.Description
+		out.Status = in.Status
+		return nil
+	}
+}
+
+func DeepCopy_v1_ProjectRequestStatus(in interface{}, out interface{}, c *conversion.Cloner) error {
+	{
+		in := in.(*ProjectRequestStatus)
+		out := out.(*ProjectRequestStatus)
+		out.Phase = in.Phase
+		out.Reason = in.Reason
 		return nil
 	}
 }
@@ -22,6 +22,7 @@ func RegisterDeepCopies(scheme *runtime.Scheme) error {
 		conversion.GeneratedDeepCopyFunc{Fn: DeepCopy_api_Project, InType: reflect.TypeOf(&Project{})},
 		conversion.GeneratedDeepCopyFunc{Fn: DeepCopy_api_ProjectList, InType: reflect.TypeOf(&ProjectList{})},
 		conversion.GeneratedDeepCopyFunc{Fn: DeepCopy_api_ProjectRequest, InType: reflect.TypeOf(&ProjectRequest{})},
+		conversion.GeneratedDeepCopyFunc{Fn: DeepCopy_api_ProjectRequestStatus, InType: reflect.TypeOf(&ProjectRequestStatus{})},
 		conversion.GeneratedDeepCopyFunc{Fn: DeepCopy_api_ProjectSpec, InType: reflect.TypeOf(&ProjectSpec{})},
 		conversion.GeneratedDeepCopyFunc{Fn: DeepCopy_api_ProjectStatus, InType: reflect.TypeOf(&ProjectStatus{})},
 	)
@@ -74,6 +75,17 @@ func DeepCopy_api_ProjectRequest(in interface{}, out interface{}, c *conversion.
 		}
 		out.DisplayName = in.DisplayName
 		out.Description = in.Description
+		out.Status = in.Status
+		return nil
+	}
+}
+
+func DeepCopy_api_ProjectRequestStatus(in interface{}, out interface{}, c *conversion.Cloner) error {
+	{
+		in := in.(*ProjectRequestStatus)
+		out := out.(*ProjectRequestStatus)
+		out.Phase = in.Phase
+		out.Reason = in.Reason
 		return nil
 	}
 }
@@ -0,0 +1,166 @@
+package controller
+
+import (
+	"strconv"
+	"time"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/client/clientset_generated/internalclientset"
+
+	osclient "github.com/openshift/origin/pkg/client"
+	projectapi "github.com/openshift/origin/pkg/project/api"
+)
+
+// archivedReplicasAnnotation records the replica count a deployment config or replication
+// controller had before its project was archived so the count can be restored on unarchive.
+const archivedReplicasAnnotation = "openshift.io/archived-replicas"
+
+// ArchiveController scales workloads in archived projects down to zero and restores them
+// when the project is unarchived.  Use the ArchiveControllerFactory to create this controller.
+type ArchiveController struct {
+	// Client is an OpenShift client.
+	Client osclient.Interface
+	// KubeClient is a Kubernetes client.
+	KubeClient internalclientset.Interface
+}
+
+// Handle processes a namespace and scales its content up or down to match its archival state
+func (c *ArchiveController) Handle(namespace *kapi.Namespace) (err error) {
+	// archiving a terminating namespace is meaningless
+	if namespace.Status.Phase == kapi.NamespaceTerminating {
+		return nil
+	}
+
+	archived := namespace.Annotations[projectapi.ProjectArchived] == "true"
+	_, scaledDown := namespace.Annotations[projectapi.ProjectArchivedAt]
+
+	switch {
+	case archived && !scaledDown:
+		if err := c.scaleDownContent(namespace.Name); err != nil {
+			return err
+		}
+		return c.setArchivedAt(namespace.Name, time.Now().UTC().Format(time.RFC3339))
+
+	case !archived && scaledDown:
+		if err := c.restoreContent(namespace.Name); err != nil {
+			return err
+		}
+		return c.setArchivedAt(namespace.Name, "")
+	}
+
+	return nil
+}
+
+// scaleDownContent scales all deployment configs and replication controllers in the namespace
+// to zero, recording their previous replica counts so they can be restored later
+func (c *ArchiveController) scaleDownContent(namespace string) error {
+	deploymentConfigs, err := c.Client.DeploymentConfigs(namespace).List(kapi.ListOptions{})
+	if err != nil {
+		return err
+	}
+	for i := range deploymentConfigs.Items {
+		config := &deploymentConfigs.Items[i]
+		if config.Spec.Replicas == 0 {
+			continue
+		}
+		if config.Annotations == nil {
+			config.Annotations = map[string]string{}
+		}
+		config.Annotations[archivedReplicasAnnotation] = strconv.Itoa(int(config.Spec.Replicas))
+		config.Spec.Replicas = 0
+		if _, err := c.Client.DeploymentConfigs(namespace).Update(config); err != nil {
+			return err
+		}
+	}
+
+	controllers, err := c.KubeClient.Core().ReplicationControllers(namespace).List(kapi.ListOptions{})
+	if err != nil {
+		return err
+	}
+	for i := range controllers.Items {
+		controller := &controllers.Items[i]
+		if controller.Spec.Replicas == 0 {
+			continue
+		}
+		if controller.Annotations == nil {
+			controller.Annotations = map[string]string{}
+		}
+		controller.Annotations[archivedReplicasAnnotation] = strconv.Itoa(int(controller.Spec.Replicas))
+		controller.Spec.Replicas = 0
+		if _, err := c.KubeClient.Core().ReplicationControllers(namespace).Update(controller); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// restoreContent scales all deployment configs and replication controllers in the namespace
+// back to the replica counts they had when the project was archived
+func (c *ArchiveController) restoreContent(namespace string) error {
+	deploymentConfigs, err := c.Client.DeploymentConfigs(namespace).List(kapi.ListOptions{})
+	if err != nil {
+		return err
+	}
+	for i := range deploymentConfigs.Items {
+		config := &deploymentConfigs.Items[i]
+		replicas, ok := parseArchivedReplicas(config.Annotations)
+		if !ok {
+			continue
+		}
+		config.Spec.Replicas = replicas
+		delete(config.Annotations, archivedReplicasAnnotation)
+		if _, err := c.Client.DeploymentConfigs(namespace).Update(config); err != nil {
+			return err
+		}
+	}
+
+	controllers, err := c.KubeClient.Core().ReplicationControllers(namespace).List(kapi.ListOptions{})
+	if err != nil {
+		return err
+	}
+	for i := range controllers.Items {
+		controller := &controllers.Items[i]
+		replicas, ok := parseArchivedReplicas(controller.Annotations)
+		if !ok {
+			continue
+		}
+		controller.Spec.Replicas = replicas
+		delete(controller.Annotations, archivedReplicasAnnotation)
+		if _, err := c.KubeClient.Core().ReplicationControllers(namespace).Update(controller); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// setArchivedAt records or clears the archival timestamp on the namespace
+func (c *ArchiveController) setArchivedAt(name, archivedAt string) error {
+	namespace, err := c.KubeClient.Core().Namespaces().Get(name)
+	if err != nil {
+		return err
+	}
+	if len(archivedAt) > 0 {
+		if namespace.Annotations == nil {
+			namespace.Annotations = map[string]string{}
+		}
+		namespace.Annotations[projectapi.ProjectArchivedAt] = archivedAt
+	} else {
+		delete(namespace.Annotations, projectapi.ProjectArchivedAt)
+	}
+	_, err = c.KubeClient.Core().Namespaces().Update(namespace)
+	return err
+}
+
+func parseArchivedReplicas(annotations map[string]string) (int32, bool) {
+	value, exists := annotations[archivedReplicasAnnotation]
+	if !exists {
+		return 0, false
+	}
+	replicas, err := strconv.Atoi(value)
+	if err != nil || replicas < 0 {
+		return 0, false
+	}
+	return int32(replicas), true
+}
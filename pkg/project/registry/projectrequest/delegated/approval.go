@@ -0,0 +1,79 @@
+package delegated
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	projectapi "github.com/openshift/origin/pkg/project/api"
+)
+
+// approvalRequest is the payload sent to the project request approval webhook.
+type approvalRequest struct {
+	// Name is the name of the requested project
+	Name string `json:"name"`
+	// DisplayName is the display name the user asked for
+	DisplayName string `json:"displayName,omitempty"`
+	// Description is the description the user asked for
+	Description string `json:"description,omitempty"`
+	// Requester is the name of the user that issued the project request
+	Requester string `json:"requester,omitempty"`
+}
+
+// approvalDecision is the decision the approval webhook responds with.
+type approvalDecision struct {
+	// Phase must be one of Pending, Approved or Denied
+	Phase projectapi.ProjectRequestPhase `json:"phase"`
+	// Reason optionally explains the decision to the requesting user
+	Reason string `json:"reason,omitempty"`
+}
+
+// approvalWebhook consults an external approval system before a project request is
+// fulfilled. The external system receives the request as JSON and answers with a
+// decision; the project is only materialized once the decision is Approved.
+type approvalWebhook struct {
+	url    string
+	client *http.Client
+}
+
+func newApprovalWebhook(url string) *approvalWebhook {
+	return &approvalWebhook{
+		url:    url,
+		client: &http.Client{Timeout: 10 * time.Second},
+	}
+}
+
+// Approve sends the project request to the external approval system and returns its decision.
+func (w *approvalWebhook) Approve(projectRequest *projectapi.ProjectRequest, requester string) (*approvalDecision, error) {
+	body, err := json.Marshal(&approvalRequest{
+		Name:        projectRequest.Name,
+		DisplayName: projectRequest.DisplayName,
+		Description: projectRequest.Description,
+		Requester:   requester,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := w.client.Post(w.url, "application/json", bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("error calling project request approval webhook: %v", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		return nil, fmt.Errorf("project request approval webhook returned status %q", resp.Status)
+	}
+
+	decision := &approvalDecision{}
+	if err := json.NewDecoder(resp.Body).Decode(decision); err != nil {
+		return nil, fmt.Errorf("error decoding project request approval decision: %v", err)
+	}
+	switch decision.Phase {
+	case projectapi.ProjectRequestPending, projectapi.ProjectRequestApproved, projectapi.ProjectRequestDenied:
+		return decision, nil
+	default:
+		return nil, fmt.Errorf("project request approval webhook returned unrecognized phase %q", decision.Phase)
+	}
+}
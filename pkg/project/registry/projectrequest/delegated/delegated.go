@@ -33,6 +33,14 @@ type REST struct {
 	templateNamespace string
 	templateName      string
 
+	// isolateNetwork if true adds the preset isolation NetworkPolicies to the default project
+	// request template so new projects are isolated by default.
+	isolateNetwork bool
+
+	// approvalWebhook, if set, is consulted before a request is fulfilled so that project
+	// creation can require approval from an external system.
+	approvalWebhook *approvalWebhook
+
 	openshiftClient *client.Client
 	kubeClient      *kclientset.Clientset
 
@@ -41,15 +49,20 @@ type REST struct {
 	policyBindings client.PolicyBindingsListerNamespacer
 }
 
-func NewREST(message, templateNamespace, templateName string, openshiftClient *client.Client, kubeClient *kclientset.Clientset, policyBindingCache client.PolicyBindingsListerNamespacer) *REST {
-	return &REST{
+func NewREST(message, templateNamespace, templateName, approvalWebhookURL string, isolateNetwork bool, openshiftClient *client.Client, kubeClient *kclientset.Clientset, policyBindingCache client.PolicyBindingsListerNamespacer) *REST {
+	storage := &REST{
 		message:           message,
 		templateNamespace: templateNamespace,
 		templateName:      templateName,
+		isolateNetwork:    isolateNetwork,
 		openshiftClient:   openshiftClient,
 		kubeClient:        kubeClient,
 		policyBindings:    policyBindingCache,
 	}
+	if len(approvalWebhookURL) > 0 {
+		storage.approvalWebhook = newApprovalWebhook(approvalWebhookURL)
+	}
+	return storage
 }
 
 func (r *REST) New() runtime.Object {
@@ -82,6 +95,30 @@ func (r *REST) Create(ctx kapi.Context, obj runtime.Object) (runtime.Object, err
 		projectRequester = userInfo.GetName()
 	}
 
+	if r.approvalWebhook != nil {
+		decision, err := r.approvalWebhook.Approve(projectRequest, projectRequester)
+		if err != nil {
+			return nil, kapierror.NewInternalError(err)
+		}
+		switch decision.Phase {
+		case projectapi.ProjectRequestDenied:
+			reason := decision.Reason
+			if len(reason) == 0 {
+				reason = "the project request was denied by the approval system"
+			}
+			return nil, kapierror.NewForbidden(projectapi.Resource("projectrequest"), projectRequest.Name, errors.New(reason))
+		case projectapi.ProjectRequestPending:
+			// the project is not materialized until the external system approves the
+			// request, at which point resubmitting the request succeeds
+			glog.V(4).Infof("Project request %q by %q is pending external approval", projectName, projectRequester)
+			projectRequest.Status = projectapi.ProjectRequestStatus{
+				Phase:  projectapi.ProjectRequestPending,
+				Reason: decision.Reason,
+			}
+			return projectRequest, nil
+		}
+	}
+
 	template, err := r.getTemplate()
 	if err != nil {
 		return nil, err
@@ -204,7 +241,13 @@ func (r *REST) waitForRoleBinding(namespace, name string) {
 
 func (r *REST) getTemplate() (*templateapi.Template, error) {
 	if len(r.templateNamespace) == 0 || len(r.templateName) == 0 {
-		return DefaultTemplate(), nil
+		template := DefaultTemplate()
+		if r.isolateNetwork {
+			if err := AddNetworkPolicyIsolationToTemplate(template); err != nil {
+				return nil, err
+			}
+		}
+		return template, nil
 	}
 
 	return r.openshiftClient.Templates(r.templateNamespace).Get(r.templateName)
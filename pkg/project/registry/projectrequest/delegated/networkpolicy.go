@@ -0,0 +1,65 @@
+package delegated
+
+import (
+	"k8s.io/kubernetes/pkg/api/unversioned"
+	"k8s.io/kubernetes/pkg/apis/extensions"
+	extensionsv1beta1 "k8s.io/kubernetes/pkg/apis/extensions/v1beta1"
+	"k8s.io/kubernetes/pkg/runtime"
+
+	templateapi "github.com/openshift/origin/pkg/template/api"
+)
+
+const (
+	// DefaultDenyPolicyName is the preset policy that isolates the project by denying all ingress.
+	DefaultDenyPolicyName = "default-deny"
+	// AllowFromSameNamespacePolicyName is the preset policy that allows traffic between pods in the project.
+	AllowFromSameNamespacePolicyName = "allow-from-same-namespace"
+	// AllowFromRouterPolicyName is the preset policy that allows traffic from namespaces labeled as
+	// ingress providers, such as the namespace running the router.
+	AllowFromRouterPolicyName = "allow-from-router"
+
+	// IngressPolicyGroupLabel is the label a namespace must carry for its pods to be allowed to send
+	// traffic into projects isolated by the preset policies.
+	IngressPolicyGroupLabel = "network.openshift.io/policy-group"
+	// IngressPolicyGroupValue is the IngressPolicyGroupLabel value identifying ingress provider namespaces.
+	IngressPolicyGroupValue = "ingress"
+)
+
+// NetworkPolicyIsolationObjects returns the preset NetworkPolicies that isolate a new project when the
+// cluster runs the networkpolicy SDN plugin: deny all ingress by default, then allow traffic from pods
+// in the same namespace and from ingress provider namespaces such as the router's.
+func NetworkPolicyIsolationObjects(ns string) []runtime.Object {
+	defaultDeny := &extensions.NetworkPolicy{}
+	defaultDeny.Name = DefaultDenyPolicyName
+	defaultDeny.Namespace = ns
+
+	allowSameNamespace := &extensions.NetworkPolicy{}
+	allowSameNamespace.Name = AllowFromSameNamespacePolicyName
+	allowSameNamespace.Namespace = ns
+	allowSameNamespace.Spec.Ingress = []extensions.NetworkPolicyIngressRule{
+		{
+			From: []extensions.NetworkPolicyPeer{
+				{PodSelector: &unversioned.LabelSelector{}},
+			},
+		},
+	}
+
+	allowFromRouter := &extensions.NetworkPolicy{}
+	allowFromRouter.Name = AllowFromRouterPolicyName
+	allowFromRouter.Namespace = ns
+	allowFromRouter.Spec.Ingress = []extensions.NetworkPolicyIngressRule{
+		{
+			From: []extensions.NetworkPolicyPeer{
+				{NamespaceSelector: &unversioned.LabelSelector{MatchLabels: map[string]string{IngressPolicyGroupLabel: IngressPolicyGroupValue}}},
+			},
+		},
+	}
+
+	return []runtime.Object{defaultDeny, allowSameNamespace, allowFromRouter}
+}
+
+// AddNetworkPolicyIsolationToTemplate appends the preset isolation policies to a project request template.
+func AddNetworkPolicyIsolationToTemplate(template *templateapi.Template) error {
+	ns := "${" + ProjectNameParam + "}"
+	return templateapi.AddObjectsToTemplate(template, NetworkPolicyIsolationObjects(ns), extensionsv1beta1.SchemeGroupVersion)
+}
@@ -0,0 +1,58 @@
+package delegated
+
+import (
+	"testing"
+
+	"k8s.io/kubernetes/pkg/apis/extensions"
+
+	_ "github.com/openshift/origin/pkg/api/install"
+)
+
+func TestNetworkPolicyIsolationObjects(t *testing.T) {
+	objects := NetworkPolicyIsolationObjects("ns")
+	if len(objects) != 3 {
+		t.Fatalf("expected 3 policies, got %d", len(objects))
+	}
+
+	policies := map[string]*extensions.NetworkPolicy{}
+	for _, obj := range objects {
+		policy, ok := obj.(*extensions.NetworkPolicy)
+		if !ok {
+			t.Fatalf("unexpected object %#v", obj)
+		}
+		if policy.Namespace != "ns" {
+			t.Errorf("policy %q has unexpected namespace %q", policy.Name, policy.Namespace)
+		}
+		policies[policy.Name] = policy
+	}
+
+	defaultDeny, ok := policies[DefaultDenyPolicyName]
+	if !ok || len(defaultDeny.Spec.Ingress) != 0 {
+		t.Errorf("expected %q to deny all ingress, got %#v", DefaultDenyPolicyName, defaultDeny)
+	}
+
+	allowSameNamespace, ok := policies[AllowFromSameNamespacePolicyName]
+	if !ok || len(allowSameNamespace.Spec.Ingress) != 1 || allowSameNamespace.Spec.Ingress[0].From[0].PodSelector == nil {
+		t.Errorf("expected %q to allow pods from the same namespace, got %#v", AllowFromSameNamespacePolicyName, allowSameNamespace)
+	}
+
+	allowFromRouter, ok := policies[AllowFromRouterPolicyName]
+	if !ok || len(allowFromRouter.Spec.Ingress) != 1 {
+		t.Fatalf("expected %q to have one ingress rule, got %#v", AllowFromRouterPolicyName, allowFromRouter)
+	}
+	selector := allowFromRouter.Spec.Ingress[0].From[0].NamespaceSelector
+	if selector == nil || selector.MatchLabels[IngressPolicyGroupLabel] != IngressPolicyGroupValue {
+		t.Errorf("expected %q to select ingress provider namespaces, got %#v", AllowFromRouterPolicyName, selector)
+	}
+}
+
+func TestAddNetworkPolicyIsolationToTemplate(t *testing.T) {
+	template := DefaultTemplate()
+	objects := len(template.Objects)
+	if err := AddNetworkPolicyIsolationToTemplate(template); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(template.Objects) != objects+3 {
+		t.Errorf("expected 3 policies to be appended, got %d objects", len(template.Objects))
+	}
+}
@@ -7,10 +7,12 @@ import (
 	"sync"
 	"time"
 
+	"github.com/golang/glog"
 	clientset "k8s.io/kubernetes/pkg/client/clientset_generated/internalclientset"
 
 	"k8s.io/kubernetes/pkg/admission"
 	kapi "k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/api/unversioned"
 	"k8s.io/kubernetes/pkg/client/cache"
 	"k8s.io/kubernetes/pkg/quota"
 	utilwait "k8s.io/kubernetes/pkg/util/wait"
@@ -20,13 +22,14 @@ import (
 	ocache "github.com/openshift/origin/pkg/client/cache"
 	oadmission "github.com/openshift/origin/pkg/cmd/server/admission"
 	"github.com/openshift/origin/pkg/controller/shared"
+	quotaapi "github.com/openshift/origin/pkg/quota/api"
 	"github.com/openshift/origin/pkg/quota/controller/clusterquotamapping"
 )
 
 func init() {
 	admission.RegisterPlugin("openshift.io/ClusterResourceQuota",
 		func(client clientset.Interface, config io.Reader) (admission.Interface, error) {
-			return NewClusterResourceQuota()
+			return NewClusterResourceQuota(client)
 		})
 }
 
@@ -41,6 +44,7 @@ type clusterQuotaAdmission struct {
 	namespaceSynced    func() bool
 	clusterQuotaClient oclient.ClusterResourceQuotasInterface
 	clusterQuotaMapper clusterquotamapping.ClusterQuotaMapper
+	kubeClient         clientset.Interface
 
 	lockFactory LockFactory
 
@@ -58,15 +62,20 @@ var _ oadmission.WantsClusterQuotaMapper = &clusterQuotaAdmission{}
 const (
 	timeToWaitForCacheSync = 10 * time.Second
 	numEvaluatorThreads    = 10
+
+	// NamespaceQuotaViolationAnnotation records the most recent cluster quota violation that was
+	// admitted because every cluster quota selecting the namespace is in Warn mode
+	NamespaceQuotaViolationAnnotation = "quota.openshift.io/last-violation"
 )
 
 // NewClusterResourceQuota configures an admission controller that can enforce clusterQuota constraints
 // using the provided registry.  The registry must have the capability to handle group/kinds that
 // are persisted by the server this admission controller is intercepting
-func NewClusterResourceQuota() (admission.Interface, error) {
+func NewClusterResourceQuota(kubeClient clientset.Interface) (admission.Interface, error) {
 	return &clusterQuotaAdmission{
 		Handler:     admission.NewHandler(admission.Create, admission.Update),
 		lockFactory: NewDefaultLockFactory(),
+		kubeClient:  kubeClient,
 	}, nil
 }
 
@@ -90,7 +99,71 @@ func (q *clusterQuotaAdmission) Admit(a admission.Attributes) (err error) {
 		q.evaluator = resourcequota.NewQuotaEvaluator(clusterQuotaAccessor, q.registry, q.lockAquisition, numEvaluatorThreads, utilwait.NeverStop)
 	})
 
-	return q.evaluator.Evaluate(a)
+	err = q.evaluator.Evaluate(a)
+	if err != nil && q.warnOnly(a.GetNamespace()) {
+		q.recordViolation(a.GetNamespace(), err)
+		return nil
+	}
+	return err
+}
+
+// warnOnly returns true if every cluster quota selecting the namespace is in Warn mode.  If the
+// namespace is also selected by an enforcing quota, violations are rejected as usual since the
+// evaluator does not report which quota was exceeded.
+func (q *clusterQuotaAdmission) warnOnly(namespaceName string) bool {
+	quotaNames, _ := q.clusterQuotaMapper.GetClusterQuotasFor(namespaceName)
+	if len(quotaNames) == 0 {
+		return false
+	}
+	for _, name := range quotaNames {
+		clusterQuota, err := q.clusterQuotaLister.Get(name)
+		if err != nil {
+			return false
+		}
+		if clusterQuota.Spec.Enforcement != quotaapi.ClusterResourceQuotaWarn {
+			return false
+		}
+	}
+	return true
+}
+
+// recordViolation surfaces an admitted quota violation by emitting a warning event against the
+// namespace and annotating the namespace with the violation, both best-effort
+func (q *clusterQuotaAdmission) recordViolation(namespaceName string, violation error) {
+	glog.V(2).Infof("admitting request exceeding cluster quota in Warn mode in namespace %s: %v", namespaceName, violation)
+
+	now := unversioned.Now()
+	event := &kapi.Event{
+		ObjectMeta: kapi.ObjectMeta{Namespace: namespaceName, GenerateName: "clusterquota-warning-"},
+		InvolvedObject: kapi.ObjectReference{
+			Kind:      "Namespace",
+			Name:      namespaceName,
+			Namespace: namespaceName,
+		},
+		Reason:         "ClusterQuotaExceeded",
+		Message:        violation.Error(),
+		Source:         kapi.EventSource{Component: "clusterresourcequota"},
+		Type:           kapi.EventTypeWarning,
+		FirstTimestamp: now,
+		LastTimestamp:  now,
+		Count:          1,
+	}
+	if _, err := q.kubeClient.Core().Events(namespaceName).Create(event); err != nil {
+		glog.V(2).Infof("unable to record cluster quota violation event in namespace %s: %v", namespaceName, err)
+	}
+
+	ns, err := q.kubeClient.Core().Namespaces().Get(namespaceName)
+	if err != nil {
+		glog.V(2).Infof("unable to annotate namespace %s with cluster quota violation: %v", namespaceName, err)
+		return
+	}
+	if ns.Annotations == nil {
+		ns.Annotations = map[string]string{}
+	}
+	ns.Annotations[NamespaceQuotaViolationAnnotation] = violation.Error()
+	if _, err := q.kubeClient.Core().Namespaces().Update(ns); err != nil {
+		glog.V(2).Infof("unable to annotate namespace %s with cluster quota violation: %v", namespaceName, err)
+	}
 }
 
 func (q *clusterQuotaAdmission) lockAquisition(quotas []kapi.ResourceQuota) func() {
@@ -333,6 +333,10 @@ func (m *ClusterResourceQuotaSpec) MarshalTo(data []byte) (int, error) {
 		return 0, err
 	}
 	i += n11
+	data[i] = 0x1a
+	i++
+	i = encodeVarintGenerated(data, i, uint64(len(m.Enforcement)))
+	i += copy(data[i:], m.Enforcement)
 	return i, nil
 }
 
@@ -508,6 +512,8 @@ func (m *ClusterResourceQuotaSpec) Size() (n int) {
 	n += 1 + l + sovGenerated(uint64(l))
 	l = m.Quota.Size()
 	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Enforcement)
+	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
@@ -622,6 +628,7 @@ func (this *ClusterResourceQuotaSpec) String() string {
 	s := strings.Join([]string{`&ClusterResourceQuotaSpec{`,
 		`Selector:` + strings.Replace(strings.Replace(this.Selector.String(), "ClusterResourceQuotaSelector", "ClusterResourceQuotaSelector", 1), `&`, ``, 1) + `,`,
 		`Quota:` + strings.Replace(strings.Replace(this.Quota.String(), "ResourceQuotaSpec", "k8s_io_kubernetes_pkg_api_v1.ResourceQuotaSpec", 1), `&`, ``, 1) + `,`,
+		`Enforcement:` + fmt.Sprintf("%v", this.Enforcement) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -1441,6 +1448,35 @@ func (m *ClusterResourceQuotaSpec) Unmarshal(data []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enforcement", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Enforcement = ClusterResourceQuotaEnforcementType(data[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(data[iNdEx:])
@@ -32,8 +32,24 @@ type ClusterResourceQuotaSpec struct {
 
 	// Quota defines the desired quota
 	Quota kapi.ResourceQuotaSpec `json:"quota" protobuf:"bytes,2,opt,name=quota"`
+
+	// Enforcement controls what happens when usage would exceed the quota. "Enforce" (the
+	// default when empty) rejects the request, "Warn" admits it and only records the violation
+	// on the selected namespace, which lets admins trial quotas before hard enforcement.
+	Enforcement ClusterResourceQuotaEnforcementType `json:"enforcement,omitempty" protobuf:"bytes,3,opt,name=enforcement,casttype=ClusterResourceQuotaEnforcementType"`
 }
 
+// ClusterResourceQuotaEnforcementType describes how a cluster resource quota reacts to usage
+// exceeding its limits
+type ClusterResourceQuotaEnforcementType string
+
+const (
+	// ClusterResourceQuotaEnforce rejects requests that would exceed the quota
+	ClusterResourceQuotaEnforce ClusterResourceQuotaEnforcementType = "Enforce"
+	// ClusterResourceQuotaWarn admits requests that exceed the quota and only records the violation
+	ClusterResourceQuotaWarn ClusterResourceQuotaEnforcementType = "Warn"
+)
+
 // ClusterResourceQuotaSelector is used to select projects.  At least one of LabelSelector or AnnotationSelector
 // must present.  If only one is present, it is the only selection criteria.  If both are specified,
 // the project must match both restrictions.
@@ -213,6 +213,7 @@ func autoConvert_v1_ClusterResourceQuotaSpec_To_api_ClusterResourceQuotaSpec(in
 	if err := api_v1.Convert_v1_ResourceQuotaSpec_To_api_ResourceQuotaSpec(&in.Quota, &out.Quota, s); err != nil {
 		return err
 	}
+	out.Enforcement = api.ClusterResourceQuotaEnforcementType(in.Enforcement)
 	return nil
 }
 
@@ -227,6 +228,7 @@ func autoConvert_api_ClusterResourceQuotaSpec_To_v1_ClusterResourceQuotaSpec(in
 	if err := api_v1.Convert_api_ResourceQuotaSpec_To_v1_ResourceQuotaSpec(&in.Quota, &out.Quota, s); err != nil {
 		return err
 	}
+	out.Enforcement = ClusterResourceQuotaEnforcementType(in.Enforcement)
 	return nil
 }
 
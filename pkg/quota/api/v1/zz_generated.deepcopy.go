@@ -145,6 +145,7 @@ func DeepCopy_v1_ClusterResourceQuotaSpec(in interface{}, out interface{}, c *co
 		if err := api_v1.DeepCopy_v1_ResourceQuotaSpec(&in.Quota, &out.Quota, c); err != nil {
 			return err
 		}
+		out.Enforcement = in.Enforcement
 		return nil
 	}
 }
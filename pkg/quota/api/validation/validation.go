@@ -27,6 +27,12 @@ func ValidateClusterResourceQuota(clusterquota *quotaapi.ClusterResourceQuota) f
 		allErrs = append(allErrs, validation.ValidateAnnotations(clusterquota.Spec.Selector.AnnotationSelector, field.NewPath("spec", "selector", "annotations"))...)
 	}
 
+	switch clusterquota.Spec.Enforcement {
+	case "", quotaapi.ClusterResourceQuotaEnforce, quotaapi.ClusterResourceQuotaWarn:
+	default:
+		allErrs = append(allErrs, field.NotSupported(field.NewPath("spec", "enforcement"), clusterquota.Spec.Enforcement, []string{string(quotaapi.ClusterResourceQuotaEnforce), string(quotaapi.ClusterResourceQuotaWarn)}))
+	}
+
 	allErrs = append(allErrs, validation.ValidateResourceQuotaSpec(&clusterquota.Spec.Quota, field.NewPath("spec", "quota"))...)
 	allErrs = append(allErrs, validation.ValidateResourceQuotaStatus(&clusterquota.Status.Total, field.NewPath("status", "overall"))...)
 
@@ -114,6 +114,18 @@ func TestValidationClusterQuota(t *testing.T) {
 			T: field.ErrorTypeInvalid,
 			F: "spec.quota.scopes",
 		},
+		"bad enforcement": {
+			A: quotaapi.ClusterResourceQuota{
+				ObjectMeta: api.ObjectMeta{Name: "good"},
+				Spec: quotaapi.ClusterResourceQuotaSpec{
+					Selector:    quotaapi.ClusterResourceQuotaSelector{LabelSelector: &unversioned.LabelSelector{MatchLabels: validLabels}},
+					Quota:       spec(),
+					Enforcement: "Audit",
+				},
+			},
+			T: field.ErrorTypeNotSupported,
+			F: "spec.enforcement",
+		},
 		"bad quota spec": {
 			A: quotaapi.ClusterResourceQuota{
 				ObjectMeta: api.ObjectMeta{Name: "good"},
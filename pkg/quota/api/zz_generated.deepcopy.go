@@ -145,6 +145,7 @@ func DeepCopy_api_ClusterResourceQuotaSpec(in interface{}, out interface{}, c *c
 		if err := pkg_api.DeepCopy_api_ResourceQuotaSpec(&in.Quota, &out.Quota, c); err != nil {
 			return err
 		}
+		out.Enforcement = in.Enforcement
 		return nil
 	}
 }
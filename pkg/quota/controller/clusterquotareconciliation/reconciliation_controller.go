@@ -333,7 +333,6 @@ func (c *ClusterQuotaReconcilationController) replenishQuota(groupKind unversion
 	quotaNames, _ := c.clusterQuotaMapper.GetClusterQuotasFor(namespace)
 
 	// only queue those quotas that are tracking a resource associated with this kind.
-	matchedResources := evaluator.MatchesResources()
 	for _, quotaName := range quotaNames {
 		quota, err := c.clusterQuotaLister.Get(quotaName)
 		if err != nil {
@@ -342,7 +341,7 @@ func (c *ClusterQuotaReconcilationController) replenishQuota(groupKind unversion
 		}
 
 		resourceQuotaResources := utilquota.ResourceNames(quota.Status.Total.Hard)
-		if len(utilquota.Intersection(matchedResources, resourceQuotaResources)) > 0 {
+		if len(utilquota.MatchingResources(evaluator, resourceQuotaResources)) > 0 {
 			// TODO: make this support targeted replenishment to a specific kind, right now it does a full recalc on that quota.
 			c.forceCalculation(quotaName, namespace)
 		}
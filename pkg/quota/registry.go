@@ -11,12 +11,14 @@ import (
 	"github.com/openshift/origin/pkg/controller/shared"
 	imageapi "github.com/openshift/origin/pkg/image/api"
 	"github.com/openshift/origin/pkg/quota/image"
+	"github.com/openshift/origin/pkg/quota/route"
+	routeapi "github.com/openshift/origin/pkg/route/api"
 )
 
 // NewOriginQuotaRegistry returns a registry object that knows how to evaluate quota usage of OpenShift
 // resources.
 func NewOriginQuotaRegistry(isInformer shared.ImageStreamInformer, osClient osclient.Interface) kquota.Registry {
-	return image.NewImageQuotaRegistry(isInformer, osClient)
+	return kquota.UnionRegistry{image.NewImageQuotaRegistry(isInformer, osClient), route.NewRouteQuotaRegistry(osClient)}
 }
 
 // NewAllResourceQuotaRegistry returns a registry object that knows how to evaluate all resources
@@ -35,4 +37,7 @@ var AllEvaluatedGroupKinds = []unversioned.GroupKind{
 
 	imageapi.Kind("ImageStream"),
 	imageapi.LegacyKind("ImageStream"),
+
+	routeapi.Kind("Route"),
+	routeapi.LegacyKind("Route"),
 }
@@ -0,0 +1,21 @@
+// Package route implements evaluators of usage for routes. They are supposed to be
+// passed to the resource quota controller and origin resource quota admission plugin.
+package route
+
+import (
+	"k8s.io/kubernetes/pkg/api/unversioned"
+	"k8s.io/kubernetes/pkg/quota"
+	"k8s.io/kubernetes/pkg/quota/generic"
+
+	osclient "github.com/openshift/origin/pkg/client"
+)
+
+// NewRouteQuotaRegistry returns a registry for quota evaluation of OpenShift routes.
+func NewRouteQuotaRegistry(osClient osclient.Interface) quota.Registry {
+	route := NewRouteEvaluator(osClient)
+	return &generic.GenericRegistry{
+		InternalEvaluators: map[unversioned.GroupKind]quota.Evaluator{
+			route.GroupKind(): route,
+		},
+	}
+}
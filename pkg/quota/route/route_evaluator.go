@@ -0,0 +1,95 @@
+package route
+
+import (
+	"fmt"
+
+	"k8s.io/kubernetes/pkg/admission"
+	kapi "k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/api/resource"
+	kquota "k8s.io/kubernetes/pkg/quota"
+	"k8s.io/kubernetes/pkg/quota/generic"
+	"k8s.io/kubernetes/pkg/runtime"
+
+	osclient "github.com/openshift/origin/pkg/client"
+	routeapi "github.com/openshift/origin/pkg/route/api"
+)
+
+const routeEvaluatorName = "Evaluator.Route"
+
+// NewRouteEvaluator computes resource usage of Routes. Routes are counted both in
+// total and per TLS termination type, so administrators can cap the number of
+// externally exposed endpoints in a project.
+func NewRouteEvaluator(routeNamespacer osclient.RoutesNamespacer) kquota.Evaluator {
+	allResources := []kapi.ResourceName{
+		routeapi.ResourceRoutes,
+		routeapi.ResourceEdgeRoutes,
+		routeapi.ResourcePassthroughRoutes,
+		routeapi.ResourceReencryptRoutes,
+	}
+
+	return &generic.GenericEvaluator{
+		Name:              routeEvaluatorName,
+		InternalGroupKind: routeapi.LegacyKind("Route"),
+		InternalOperationResources: map[admission.Operation][]kapi.ResourceName{
+			admission.Create: allResources,
+			admission.Update: allResources,
+		},
+		MatchedResourceNames: allResources,
+		MatchesScopeFunc:     generic.MatchesNoScopeFunc,
+		ConstraintsFunc:      routeConstraintsFunc,
+		UsageFunc:            routeUsageFunc,
+		ListFuncByNamespace: func(namespace string, options kapi.ListOptions) ([]runtime.Object, error) {
+			list, err := routeNamespacer.Routes(namespace).List(options)
+			if err != nil {
+				return nil, err
+			}
+			results := make([]runtime.Object, 0, len(list.Items))
+			for i := range list.Items {
+				results = append(results, &list.Items[i])
+			}
+			return results, nil
+		},
+	}
+}
+
+// routeConstraintsFunc checks that the given object is a route
+func routeConstraintsFunc(required []kapi.ResourceName, object runtime.Object) error {
+	if _, ok := object.(*routeapi.Route); !ok {
+		return fmt.Errorf("unexpected input object %v", object)
+	}
+	return nil
+}
+
+// routeUsageFunc computes the usage of a single route: one generic route, plus one
+// route of its TLS termination type if the route is secured.
+func routeUsageFunc(object runtime.Object) kapi.ResourceList {
+	route, ok := object.(*routeapi.Route)
+	if !ok {
+		return kapi.ResourceList{}
+	}
+
+	res := kapi.ResourceList{
+		routeapi.ResourceRoutes: *resource.NewQuantity(1, resource.DecimalSI),
+	}
+	if name, ok := terminationResourceName(route); ok {
+		res[name] = *resource.NewQuantity(1, resource.DecimalSI)
+	}
+	return res
+}
+
+// terminationResourceName maps a route's TLS termination type onto the resource name
+// counting routes of that type. Insecure routes only count against the generic resource.
+func terminationResourceName(route *routeapi.Route) (kapi.ResourceName, bool) {
+	if route.Spec.TLS == nil {
+		return "", false
+	}
+	switch route.Spec.TLS.Termination {
+	case routeapi.TLSTerminationEdge:
+		return routeapi.ResourceEdgeRoutes, true
+	case routeapi.TLSTerminationPassthrough:
+		return routeapi.ResourcePassthroughRoutes, true
+	case routeapi.TLSTerminationReencrypt:
+		return routeapi.ResourceReencryptRoutes, true
+	}
+	return "", false
+}
@@ -0,0 +1,89 @@
+package route
+
+import (
+	"testing"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+	kresource "k8s.io/kubernetes/pkg/api/resource"
+	kquota "k8s.io/kubernetes/pkg/quota"
+
+	routeapi "github.com/openshift/origin/pkg/route/api"
+)
+
+func TestRouteUsage(t *testing.T) {
+	for _, tc := range []struct {
+		name          string
+		route         *routeapi.Route
+		expectedUsage kapi.ResourceList
+	}{
+		{
+			name: "insecure route",
+			route: &routeapi.Route{
+				ObjectMeta: kapi.ObjectMeta{Namespace: "test", Name: "insecure"},
+			},
+			expectedUsage: kapi.ResourceList{
+				routeapi.ResourceRoutes: kresource.MustParse("1"),
+			},
+		},
+
+		{
+			name: "edge route",
+			route: &routeapi.Route{
+				ObjectMeta: kapi.ObjectMeta{Namespace: "test", Name: "edge"},
+				Spec: routeapi.RouteSpec{
+					TLS: &routeapi.TLSConfig{Termination: routeapi.TLSTerminationEdge},
+				},
+			},
+			expectedUsage: kapi.ResourceList{
+				routeapi.ResourceRoutes:     kresource.MustParse("1"),
+				routeapi.ResourceEdgeRoutes: kresource.MustParse("1"),
+			},
+		},
+
+		{
+			name: "passthrough route",
+			route: &routeapi.Route{
+				ObjectMeta: kapi.ObjectMeta{Namespace: "test", Name: "passthrough"},
+				Spec: routeapi.RouteSpec{
+					TLS: &routeapi.TLSConfig{Termination: routeapi.TLSTerminationPassthrough},
+				},
+			},
+			expectedUsage: kapi.ResourceList{
+				routeapi.ResourceRoutes:            kresource.MustParse("1"),
+				routeapi.ResourcePassthroughRoutes: kresource.MustParse("1"),
+			},
+		},
+
+		{
+			name: "reencrypt route",
+			route: &routeapi.Route{
+				ObjectMeta: kapi.ObjectMeta{Namespace: "test", Name: "reencrypt"},
+				Spec: routeapi.RouteSpec{
+					TLS: &routeapi.TLSConfig{Termination: routeapi.TLSTerminationReencrypt},
+				},
+			},
+			expectedUsage: kapi.ResourceList{
+				routeapi.ResourceRoutes:          kresource.MustParse("1"),
+				routeapi.ResourceReencryptRoutes: kresource.MustParse("1"),
+			},
+		},
+	} {
+		usage := routeUsageFunc(tc.route)
+		expectedResources := kquota.ResourceNames(tc.expectedUsage)
+		if len(usage) != len(expectedResources) {
+			t.Errorf("[%s]: got unexpected number of computed resources: %d != %d", tc.name, len(usage), len(expectedResources))
+		}
+
+		masked := kquota.Mask(usage, expectedResources)
+		for rname, expectedValue := range tc.expectedUsage {
+			v, exists := masked[rname]
+			if !exists {
+				t.Errorf("[%s]: expected resource %q not computed", tc.name, rname)
+				continue
+			}
+			if v.Cmp(expectedValue) != 0 {
+				t.Errorf("[%s]: got unexpected usage for %q: %s != %s", tc.name, rname, v.String(), expectedValue.String())
+			}
+		}
+	}
+}
@@ -0,0 +1,84 @@
+package wildcardpolicy
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"k8s.io/kubernetes/pkg/admission"
+	clientset "k8s.io/kubernetes/pkg/client/clientset_generated/internalclientset"
+
+	oadmission "github.com/openshift/origin/pkg/cmd/server/admission"
+	"github.com/openshift/origin/pkg/project/cache"
+	routeapi "github.com/openshift/origin/pkg/route/api"
+)
+
+const PluginName = "openshift.io/RouteWildcardPolicy"
+
+func init() {
+	admission.RegisterPlugin(PluginName, func(client clientset.Interface, config io.Reader) (admission.Interface, error) {
+		return NewWildcardPolicy(), nil
+	})
+}
+
+// wildcardPolicy rejects routes that request a wildcard policy their namespace has not
+// been granted. Grants are expressed as an annotation on the namespace, which only a
+// cluster administrator can set, so individual namespaces must be blessed before they
+// may claim entire subdomains.
+type wildcardPolicy struct {
+	cache *cache.ProjectCache
+}
+
+var _ = oadmission.WantsProjectCache(&wildcardPolicy{})
+
+func (a *wildcardPolicy) Admit(attributes admission.Attributes) error {
+	if attributes.GetResource().GroupResource() != routeapi.Resource("routes") &&
+		attributes.GetResource().GroupResource() != routeapi.LegacyResource("routes") {
+		return nil
+	}
+	if len(attributes.GetSubresource()) > 0 {
+		return nil
+	}
+	route, ok := attributes.GetObject().(*routeapi.Route)
+	if !ok {
+		return nil
+	}
+	policy := route.Spec.WildcardPolicy
+	if len(policy) == 0 || policy == routeapi.WildcardPolicyNone {
+		return nil
+	}
+
+	if !a.cache.Running() {
+		return admission.NewForbidden(attributes, fmt.Errorf("project cache is not running"))
+	}
+	namespace, err := a.cache.GetNamespace(attributes.GetNamespace())
+	if err != nil {
+		return admission.NewForbidden(attributes, err)
+	}
+
+	for _, allowed := range strings.Split(namespace.Annotations[routeapi.AllowedWildcardPoliciesAnnotation], ",") {
+		if routeapi.WildcardPolicyType(strings.TrimSpace(allowed)) == policy {
+			return nil
+		}
+	}
+	return admission.NewForbidden(attributes, fmt.Errorf("namespace %s is not allowed to create routes with wildcard policy %q; a cluster administrator must set the %s annotation on the namespace to grant it", namespace.Name, policy, routeapi.AllowedWildcardPoliciesAnnotation))
+}
+
+func (a *wildcardPolicy) Handles(operation admission.Operation) bool {
+	return operation == admission.Create || operation == admission.Update
+}
+
+func (a *wildcardPolicy) SetProjectCache(c *cache.ProjectCache) {
+	a.cache = c
+}
+
+func (a *wildcardPolicy) Validate() error {
+	if a.cache == nil {
+		return fmt.Errorf("route wildcard policy plugin needs a project cache")
+	}
+	return nil
+}
+
+func NewWildcardPolicy() admission.Interface {
+	return &wildcardPolicy{}
+}
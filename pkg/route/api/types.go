@@ -202,3 +202,20 @@ const (
 	//          Note that this will not match acme.test only *.acme.test
 	WildcardPolicySubdomain WildcardPolicyType = "Subdomain"
 )
+
+// AllowedWildcardPoliciesAnnotation is set on a namespace by a cluster administrator to
+// grant the namespace the ability to create routes with the listed wildcard policies.
+// The value is a comma delimited list of WildcardPolicyType values. Namespaces without
+// the annotation may only create routes with the None wildcard policy.
+const AllowedWildcardPoliciesAnnotation = "route.openshift.io/allowed-wildcard-policies"
+
+const (
+	// ResourceRoutes represents a number of routes in a project.
+	ResourceRoutes kapi.ResourceName = "openshift.io/routes"
+	// ResourceEdgeRoutes represents a number of routes with edge TLS termination in a project.
+	ResourceEdgeRoutes kapi.ResourceName = "openshift.io/routes.edge"
+	// ResourcePassthroughRoutes represents a number of routes with passthrough TLS termination in a project.
+	ResourcePassthroughRoutes kapi.ResourceName = "openshift.io/routes.passthrough"
+	// ResourceReencryptRoutes represents a number of routes with reencrypt TLS termination in a project.
+	ResourceReencryptRoutes kapi.ResourceName = "openshift.io/routes.reencrypt"
+)
@@ -0,0 +1,309 @@
+package certificate
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/golang/glog"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/client/cache"
+	"k8s.io/kubernetes/pkg/controller"
+	"k8s.io/kubernetes/pkg/runtime"
+	utilruntime "k8s.io/kubernetes/pkg/util/runtime"
+	"k8s.io/kubernetes/pkg/util/sets"
+	"k8s.io/kubernetes/pkg/util/wait"
+	"k8s.io/kubernetes/pkg/util/workqueue"
+	"k8s.io/kubernetes/pkg/watch"
+
+	osclient "github.com/openshift/origin/pkg/client"
+	routeapi "github.com/openshift/origin/pkg/route/api"
+	"github.com/openshift/origin/pkg/service/controller/servingcert"
+)
+
+const (
+	// ProvisionCertificateAnnotation set to "true" on a route requests that a serving
+	// certificate for the route host be issued by the cluster certificate signer and
+	// stored inline in the route's TLS configuration.
+	ProvisionCertificateAnnotation = "route.alpha.openshift.io/provision-certificate"
+	// CertificateSignedByAnnotation stores the common name of the signer that issued the
+	// route's certificate.  A mismatch with the current signer triggers reissuance.
+	CertificateSignedByAnnotation = "route.alpha.openshift.io/certificate-signed-by"
+	// CertificateExpiryAnnotation holds the expiry time of the provisioned certificate in
+	// the RFC3339 format: 2018-11-29T17:44:39Z.  Certificates are renewed before expiry.
+	CertificateExpiryAnnotation = "route.alpha.openshift.io/certificate-expiry"
+	// CertificateErrorAnnotation stores the error that caused cert generation failures.
+	CertificateErrorAnnotation = "route.alpha.openshift.io/certificate-generation-error"
+	// CertificateErrorNumAnnotation stores how many consecutive errors we've hit.  A value
+	// of the maxRetries will prevent the controller from reattempting until it is cleared.
+	CertificateErrorNumAnnotation = "route.alpha.openshift.io/certificate-generation-error-num"
+)
+
+// certificateLifetime is how long provisioned route certificates are valid, in days.
+const certificateLifetime = 365 * 2
+
+// renewBeforeExpiry is how long before expiry a provisioned certificate is reissued.
+var renewBeforeExpiry = 30 * 24 * time.Hour
+
+// RouteCertificateController provisions serving certificates for routes that request one
+// by annotation, storing the certificate and key inline in the route's TLS configuration
+// and renewing them before they expire.
+type RouteCertificateController struct {
+	routeClient osclient.RoutesNamespacer
+
+	// Routes that need to be checked
+	queue      workqueue.RateLimitingInterface
+	maxRetries int
+
+	routeCache      cache.Store
+	routeController *cache.Controller
+	routeHasSynced  informerSynced
+
+	signer servingcert.Signer
+
+	// syncHandler does the work. It's factored out for unit testing
+	syncHandler func(routeKey string) error
+}
+
+// NewRouteCertificateController creates a new RouteCertificateController.
+// TODO this should accept a shared informer
+func NewRouteCertificateController(routeClient osclient.RoutesNamespacer, signer servingcert.Signer, resyncInterval time.Duration) *RouteCertificateController {
+	rc := &RouteCertificateController{
+		routeClient: routeClient,
+
+		queue:      workqueue.NewRateLimitingQueue(workqueue.DefaultControllerRateLimiter()),
+		maxRetries: 10,
+
+		signer: signer,
+	}
+
+	rc.routeCache, rc.routeController = cache.NewInformer(
+		&cache.ListWatch{
+			ListFunc: func(options kapi.ListOptions) (runtime.Object, error) {
+				return rc.routeClient.Routes(kapi.NamespaceAll).List(options)
+			},
+			WatchFunc: func(options kapi.ListOptions) (watch.Interface, error) {
+				return rc.routeClient.Routes(kapi.NamespaceAll).Watch(options)
+			},
+		},
+		&routeapi.Route{},
+		resyncInterval,
+		cache.ResourceEventHandlerFuncs{
+			AddFunc: func(obj interface{}) {
+				route := obj.(*routeapi.Route)
+				glog.V(4).Infof("Adding route %s", route.Name)
+				rc.enqueueRoute(obj)
+			},
+			UpdateFunc: func(old, cur interface{}) {
+				route := cur.(*routeapi.Route)
+				glog.V(4).Infof("Updating route %s", route.Name)
+				// Resync on route object relist.
+				rc.enqueueRoute(cur)
+			},
+		},
+	)
+	rc.routeHasSynced = rc.routeController.HasSynced
+
+	rc.syncHandler = rc.syncRoute
+
+	return rc
+}
+
+// Run begins watching and syncing.
+func (rc *RouteCertificateController) Run(workers int, stopCh <-chan struct{}) {
+	defer utilruntime.HandleCrash()
+	go rc.routeController.Run(stopCh)
+	if !waitForCacheSync(stopCh, rc.routeHasSynced) {
+		return
+	}
+
+	for i := 0; i < workers; i++ {
+		go wait.Until(rc.worker, time.Second, stopCh)
+	}
+
+	<-stopCh
+	glog.Infof("Shutting down route certificate controller")
+	rc.queue.ShutDown()
+}
+
+func (rc *RouteCertificateController) enqueueRoute(obj interface{}) {
+	_, ok := obj.(*routeapi.Route)
+	if !ok {
+		return
+	}
+	key, err := controller.KeyFunc(obj)
+	if err != nil {
+		glog.Errorf("Couldn't get key for object %+v: %v", obj, err)
+		return
+	}
+
+	rc.queue.Add(key)
+}
+
+// worker runs a worker thread that just dequeues items, processes them, and marks them done.
+// It enforces that the syncHandler is never invoked concurrently with the same key.
+func (rc *RouteCertificateController) worker() {
+	for {
+		if !rc.work() {
+			return
+		}
+	}
+}
+
+// work returns true if the worker thread should continue
+func (rc *RouteCertificateController) work() bool {
+	key, quit := rc.queue.Get()
+	if quit {
+		return false
+	}
+	defer rc.queue.Done(key)
+
+	if err := rc.syncHandler(key.(string)); err == nil {
+		// this means the request was successfully handled.  We should "forget" the item so that any retry
+		// later on is reset
+		rc.queue.Forget(key)
+
+	} else {
+		// if we had an error it means that we didn't handle it, which means that we want to requeue the work
+		utilruntime.HandleError(fmt.Errorf("error syncing route, it will be retried: %v", err))
+		rc.queue.AddRateLimited(key)
+	}
+
+	return true
+}
+
+// syncRoute will sync the route with the given key.
+// This function is not meant to be invoked concurrently with the same key.
+func (rc *RouteCertificateController) syncRoute(key string) error {
+	obj, exists, err := rc.routeCache.GetByKey(key)
+	if err != nil {
+		glog.V(4).Infof("Unable to retrieve route %v from store: %v", key, err)
+		return err
+	}
+	if !exists {
+		glog.V(4).Infof("Route has been deleted %v", key)
+		return nil
+	}
+
+	if !rc.requiresCertGeneration(obj.(*routeapi.Route)) {
+		return nil
+	}
+
+	// make a copy to avoid mutating cache state
+	t, err := kapi.Scheme.DeepCopy(obj)
+	if err != nil {
+		return err
+	}
+	route := t.(*routeapi.Route)
+	if route.Annotations == nil {
+		route.Annotations = map[string]string{}
+	}
+
+	servingCert, err := rc.signer.MakeServerCert(sets.NewString(route.Spec.Host), certificateLifetime)
+	if err != nil {
+		// record the failure on the route so operators can see why their route has no
+		// certificate.  If the update fails we'll retry on re-list or on the next event.
+		route.Annotations[CertificateErrorAnnotation] = err.Error()
+		route.Annotations[CertificateErrorNumAnnotation] = strconv.Itoa(getNumFailures(route) + 1)
+		_, updateErr := rc.routeClient.Routes(route.Namespace).Update(route)
+
+		// if we're past the max retries and we successfully updated, then the sync loop successfully handled this route and we want to forget it
+		if updateErr == nil && getNumFailures(route) >= rc.maxRetries {
+			return nil
+		}
+		return err
+	}
+	certBytes, keyBytes, err := servingCert.GetPEMBytes()
+	if err != nil {
+		return err
+	}
+
+	if route.Spec.TLS == nil {
+		route.Spec.TLS = &routeapi.TLSConfig{Termination: routeapi.TLSTerminationEdge}
+	}
+	route.Spec.TLS.Certificate = string(certBytes)
+	route.Spec.TLS.Key = string(keyBytes)
+
+	route.Annotations[CertificateSignedByAnnotation] = rc.signer.CommonName()
+	route.Annotations[CertificateExpiryAnnotation] = servingCert.Certs[0].NotAfter.Format(time.RFC3339)
+	delete(route.Annotations, CertificateErrorAnnotation)
+	delete(route.Annotations, CertificateErrorNumAnnotation)
+	_, err = rc.routeClient.Routes(route.Namespace).Update(route)
+
+	return err
+}
+
+func getNumFailures(route *routeapi.Route) int {
+	numFailuresString := route.Annotations[CertificateErrorNumAnnotation]
+	if len(numFailuresString) == 0 {
+		return 0
+	}
+
+	numFailures, err := strconv.Atoi(numFailuresString)
+	if err != nil {
+		return 0
+	}
+	return numFailures
+}
+
+func (rc *RouteCertificateController) requiresCertGeneration(route *routeapi.Route) bool {
+	if route.Annotations[ProvisionCertificateAnnotation] != "true" {
+		return false
+	}
+	// provisioned certificates only cover the route host
+	if len(route.Spec.Host) == 0 {
+		return false
+	}
+	// passthrough routes terminate TLS at the backend, there is nothing for the router to serve
+	if route.Spec.TLS != nil && route.Spec.TLS.Termination == routeapi.TLSTerminationPassthrough {
+		return false
+	}
+	// a certificate brought by the user is never overwritten
+	if route.Spec.TLS != nil && len(route.Spec.TLS.Certificate) > 0 && len(route.Annotations[CertificateSignedByAnnotation]) == 0 {
+		return false
+	}
+	if getNumFailures(route) >= rc.maxRetries {
+		return false
+	}
+	if route.Spec.TLS == nil || len(route.Spec.TLS.Certificate) == 0 {
+		return true
+	}
+	if route.Annotations[CertificateSignedByAnnotation] != rc.signer.CommonName() {
+		return true
+	}
+	// renew before the recorded expiry.  An unparseable expiry forces reissuance.
+	expiry, err := time.Parse(time.RFC3339, route.Annotations[CertificateExpiryAnnotation])
+	if err != nil {
+		return true
+	}
+	return time.Now().After(expiry.Add(-renewBeforeExpiry))
+}
+
+// TODO this is all in the kube library after the 1.5 rebase
+
+// informerSynced is a function that can be used to determine if an informer has synced.  This is useful for determining if caches have synced.
+type informerSynced func() bool
+
+// syncedPollPeriod controls how often you look at the status of your sync funcs
+const syncedPollPeriod = 100 * time.Millisecond
+
+func waitForCacheSync(stopCh <-chan struct{}, cacheSyncs ...informerSynced) bool {
+	err := wait.PollUntil(syncedPollPeriod,
+		func() (bool, error) {
+			for _, syncFunc := range cacheSyncs {
+				if !syncFunc() {
+					return false, nil
+				}
+			}
+			return true, nil
+		},
+		stopCh)
+	if err != nil {
+		glog.V(2).Infof("stop requested")
+		return false
+	}
+
+	glog.V(4).Infof("caches populated")
+	return true
+}
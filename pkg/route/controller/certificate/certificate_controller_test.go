@@ -0,0 +1,212 @@
+package certificate
+
+import (
+	"crypto/x509"
+	"encoding/pem"
+	"io/ioutil"
+	"testing"
+	"time"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/client/testing/core"
+	"k8s.io/kubernetes/pkg/runtime"
+
+	"github.com/openshift/origin/pkg/client/testclient"
+	"github.com/openshift/origin/pkg/cmd/server/admin"
+	routeapi "github.com/openshift/origin/pkg/route/api"
+	"github.com/openshift/origin/pkg/service/controller/servingcert"
+)
+
+func controllerSetup(startingObjects []*routeapi.Route, t *testing.T) (servingcert.Signer, *testclient.Fake, *RouteCertificateController) {
+	certDir, err := ioutil.TempDir("", "route-cert-unit-")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	caInfo := admin.DefaultServiceSignerCAInfo(certDir)
+
+	caOptions := admin.CreateSignerCertOptions{
+		CertFile: caInfo.CertFile,
+		KeyFile:  caInfo.KeyFile,
+		Name:     admin.DefaultServiceServingCertSignerName(),
+		Output:   ioutil.Discard,
+	}
+	ca, err := caOptions.CreateSignerCert()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	signer := servingcert.NewCASigner(ca)
+
+	osclient := testclient.NewSimpleFake()
+	osclient.PrependReactor("update", "routes", func(action core.Action) (handled bool, ret runtime.Object, err error) {
+		return true, action.(core.UpdateAction).GetObject(), nil
+	})
+
+	controller := NewRouteCertificateController(osclient, signer, 10*time.Minute)
+	controller.routeHasSynced = func() bool { return true }
+	for i := range startingObjects {
+		controller.routeCache.Add(startingObjects[i])
+	}
+
+	return signer, osclient, controller
+}
+
+func TestSyncRouteProvisionsCertificate(t *testing.T) {
+	route := &routeapi.Route{
+		ObjectMeta: kapi.ObjectMeta{
+			Namespace:   "ns",
+			Name:        "frontend",
+			Annotations: map[string]string{ProvisionCertificateAnnotation: "true"},
+		},
+		Spec: routeapi.RouteSpec{Host: "www.example.com"},
+	}
+	signer, osclient, controller := controllerSetup([]*routeapi.Route{route}, t)
+
+	if err := controller.syncHandler("ns/frontend"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var updated *routeapi.Route
+	for _, action := range osclient.Actions() {
+		if action.Matches("update", "routes") {
+			updated = action.(core.UpdateAction).GetObject().(*routeapi.Route)
+		}
+	}
+	if updated == nil {
+		t.Fatalf("expected route update, got actions %#v", osclient.Actions())
+	}
+	if updated.Spec.TLS == nil || updated.Spec.TLS.Termination != routeapi.TLSTerminationEdge {
+		t.Fatalf("expected edge TLS config, got %#v", updated.Spec.TLS)
+	}
+	if len(updated.Spec.TLS.Key) == 0 {
+		t.Errorf("expected a private key on the route")
+	}
+	if updated.Annotations[CertificateSignedByAnnotation] != signer.CommonName() {
+		t.Errorf("expected signed-by annotation %q, got %q", signer.CommonName(), updated.Annotations[CertificateSignedByAnnotation])
+	}
+	expiry, err := time.Parse(time.RFC3339, updated.Annotations[CertificateExpiryAnnotation])
+	if err != nil {
+		t.Fatalf("unexpected expiry annotation: %v", err)
+	}
+	if !expiry.After(time.Now()) {
+		t.Errorf("expected expiry in the future, got %v", expiry)
+	}
+
+	block, _ := pem.Decode([]byte(updated.Spec.TLS.Certificate))
+	if block == nil {
+		t.Fatalf("PEM block not found in route certificate")
+	}
+	cert, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		t.Fatalf("expected valid certificate in first position: %v", err)
+	}
+	if err := cert.VerifyHostname("www.example.com"); err != nil {
+		t.Errorf("certificate does not cover the route host: %v", err)
+	}
+}
+
+func TestRequiresCertGeneration(t *testing.T) {
+	_, _, controller := controllerSetup(nil, t)
+	signedBy := controller.signer.CommonName()
+
+	tests := []struct {
+		name     string
+		route    *routeapi.Route
+		expected bool
+	}{
+		{
+			name: "opted in without TLS",
+			route: &routeapi.Route{
+				ObjectMeta: kapi.ObjectMeta{Annotations: map[string]string{ProvisionCertificateAnnotation: "true"}},
+				Spec:       routeapi.RouteSpec{Host: "www.example.com"},
+			},
+			expected: true,
+		},
+		{
+			name: "not opted in",
+			route: &routeapi.Route{
+				Spec: routeapi.RouteSpec{Host: "www.example.com"},
+			},
+			expected: false,
+		},
+		{
+			name: "no host",
+			route: &routeapi.Route{
+				ObjectMeta: kapi.ObjectMeta{Annotations: map[string]string{ProvisionCertificateAnnotation: "true"}},
+			},
+			expected: false,
+		},
+		{
+			name: "passthrough",
+			route: &routeapi.Route{
+				ObjectMeta: kapi.ObjectMeta{Annotations: map[string]string{ProvisionCertificateAnnotation: "true"}},
+				Spec: routeapi.RouteSpec{
+					Host: "www.example.com",
+					TLS:  &routeapi.TLSConfig{Termination: routeapi.TLSTerminationPassthrough},
+				},
+			},
+			expected: false,
+		},
+		{
+			name: "user provided certificate",
+			route: &routeapi.Route{
+				ObjectMeta: kapi.ObjectMeta{Annotations: map[string]string{ProvisionCertificateAnnotation: "true"}},
+				Spec: routeapi.RouteSpec{
+					Host: "www.example.com",
+					TLS:  &routeapi.TLSConfig{Termination: routeapi.TLSTerminationEdge, Certificate: "cert"},
+				},
+			},
+			expected: false,
+		},
+		{
+			name: "current certificate",
+			route: &routeapi.Route{
+				ObjectMeta: kapi.ObjectMeta{Annotations: map[string]string{
+					ProvisionCertificateAnnotation: "true",
+					CertificateSignedByAnnotation:  signedBy,
+					CertificateExpiryAnnotation:    time.Now().Add(365 * 24 * time.Hour).Format(time.RFC3339),
+				}},
+				Spec: routeapi.RouteSpec{
+					Host: "www.example.com",
+					TLS:  &routeapi.TLSConfig{Termination: routeapi.TLSTerminationEdge, Certificate: "cert"},
+				},
+			},
+			expected: false,
+		},
+		{
+			name: "near expiry",
+			route: &routeapi.Route{
+				ObjectMeta: kapi.ObjectMeta{Annotations: map[string]string{
+					ProvisionCertificateAnnotation: "true",
+					CertificateSignedByAnnotation:  signedBy,
+					CertificateExpiryAnnotation:    time.Now().Add(24 * time.Hour).Format(time.RFC3339),
+				}},
+				Spec: routeapi.RouteSpec{
+					Host: "www.example.com",
+					TLS:  &routeapi.TLSConfig{Termination: routeapi.TLSTerminationEdge, Certificate: "cert"},
+				},
+			},
+			expected: true,
+		},
+		{
+			name: "different signer",
+			route: &routeapi.Route{
+				ObjectMeta: kapi.ObjectMeta{Annotations: map[string]string{
+					ProvisionCertificateAnnotation: "true",
+					CertificateSignedByAnnotation:  "other-signer",
+					CertificateExpiryAnnotation:    time.Now().Add(365 * 24 * time.Hour).Format(time.RFC3339),
+				}},
+				Spec: routeapi.RouteSpec{
+					Host: "www.example.com",
+					TLS:  &routeapi.TLSConfig{Termination: routeapi.TLSTerminationEdge, Certificate: "cert"},
+				},
+			},
+			expected: true,
+		},
+	}
+
+	for _, test := range tests {
+		if actual := controller.requiresCertGeneration(test.route); actual != test.expected {
+			t.Errorf("%s: expected %v, got %v", test.name, test.expected, actual)
+		}
+	}
+}
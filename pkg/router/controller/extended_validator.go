@@ -3,6 +3,7 @@ package controller
 import (
 	"fmt"
 	"reflect"
+	"strings"
 
 	"github.com/golang/glog"
 	kapi "k8s.io/kubernetes/pkg/api"
@@ -61,12 +62,22 @@ func (p *ExtendedValidator) HandleRoute(eventType watch.EventType, route *routea
 
 	if errs := validation.ExtendedValidateRoute(route); len(errs) > 0 {
 		errmsg := ""
+		certificateErrors := 0
 		for i := 0; i < len(errs); i++ {
 			errmsg = errmsg + "\n  - " + errs[i].Error()
+			if strings.HasPrefix(errs[i].Field, "spec.tls") {
+				certificateErrors++
+			}
 		}
 		glog.Errorf("Skipping route %s due to invalid configuration: %s", routeName, errmsg)
 
-		p.recorder.RecordRouteRejection(route, "ExtendedValidationFailed", errmsg)
+		// use a more specific reason when every failure is in the TLS configuration so
+		// certificate problems are distinguishable in route status
+		reason := RouteRejectionExtendedValidationFailed
+		if certificateErrors == len(errs) {
+			reason = RouteRejectionInvalidCertificate
+		}
+		p.recorder.RecordRouteRejection(route, reason, errmsg)
 		return fmt.Errorf("invalid route configuration")
 	}
 
@@ -124,7 +124,7 @@ func (p *HostAdmitter) HandleEndpoints(eventType watch.EventType, endpoints *kap
 func (p *HostAdmitter) HandleRoute(eventType watch.EventType, route *routeapi.Route) error {
 	if err := p.admitter(route); err != nil {
 		glog.Errorf("Route %s not admitted: %s", routeNameKey(route), err.Error())
-		p.recorder.RecordRouteRejection(route, "RouteNotAdmitted", err.Error())
+		p.recorder.RecordRouteRejection(route, RouteRejectionNotAdmitted, err.Error())
 		return err
 	}
 
@@ -168,7 +168,7 @@ func (p *HostAdmitter) addRoute(route *routeapi.Route) error {
 			// route in our namespace.
 			msg = err.Error()
 		}
-		p.recorder.RecordRouteRejection(route, "HostAlreadyClaimed", msg)
+		p.recorder.RecordRouteRejection(route, RouteRejectionHostAlreadyClaimed, msg)
 		return err
 	}
 
@@ -190,7 +190,7 @@ func (p *HostAdmitter) addRoute(route *routeapi.Route) error {
 			msg = fmt.Sprintf("a route in another namespace holds host %s", displacedRoute.Spec.Host)
 		}
 
-		p.recorder.RecordRouteRejection(displacedRoute, "HostAlreadyClaimed", msg)
+		p.recorder.RecordRouteRejection(displacedRoute, RouteRejectionHostAlreadyClaimed, msg)
 		p.plugin.HandleRoute(watch.Deleted, displacedRoute)
 	}
 
@@ -220,7 +220,7 @@ func (p *HostAdmitter) addRoute(route *routeapi.Route) error {
 		p.claimedWildcards.RemoveRoute(wildcardKey, route)
 		p.blockedWildcards.RemoveRoute(wildcardKey, route)
 		err := fmt.Errorf("unsupported wildcard policy %s", route.Spec.WildcardPolicy)
-		p.recorder.RecordRouteRejection(route, "RouteNotAdmitted", err.Error())
+		p.recorder.RecordRouteRejection(route, RouteRejectionNotAdmitted, err.Error())
 		return err
 	}
 
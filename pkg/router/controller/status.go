@@ -10,6 +10,7 @@ import (
 	kapi "k8s.io/kubernetes/pkg/api"
 	"k8s.io/kubernetes/pkg/api/errors"
 	"k8s.io/kubernetes/pkg/api/unversioned"
+	"k8s.io/kubernetes/pkg/client/record"
 	utilruntime "k8s.io/kubernetes/pkg/util/runtime"
 	"k8s.io/kubernetes/pkg/util/sets"
 	"k8s.io/kubernetes/pkg/watch"
@@ -24,6 +25,31 @@ type RejectionRecorder interface {
 	RecordRouteRejection(route *routeapi.Route, reason, message string)
 }
 
+// The machine-readable reasons routers place on the Admitted=False ingress condition.
+// The condition message carries the detail - the conflicting namespace and route for
+// host claims, or the underlying parse error for certificates.
+const (
+	// RouteRejectionNoHostValue means no host was defined for the route.
+	RouteRejectionNoHostValue = "NoHostValue"
+	// RouteRejectionInvalidHost means the host failed validation.
+	RouteRejectionInvalidHost = "InvalidHost"
+	// RouteRejectionHostAlreadyClaimed means the host is owned by another namespace or
+	// an older route.
+	RouteRejectionHostAlreadyClaimed = "HostAlreadyClaimed"
+	// RouteRejectionNotAdmitted means the route was not admitted by the router's
+	// admission policy.
+	RouteRejectionNotAdmitted = "RouteNotAdmitted"
+	// RouteRejectionExtendedValidationFailed means the route failed the extended
+	// validation checks performed by template routers.
+	RouteRejectionExtendedValidationFailed = "ExtendedValidationFailed"
+	// RouteRejectionInvalidCertificate means the TLS configuration of the route could
+	// not be parsed or verified.
+	RouteRejectionInvalidCertificate = "InvalidCertificate"
+	// RouteRejectionThrottled means the router declined to admit the route at this
+	// time and will retry.
+	RouteRejectionThrottled = "Throttled"
+)
+
 // StatusAdmitter ensures routes added to the plugin have status set.
 type StatusAdmitter struct {
 	plugin                  router.Plugin
@@ -33,6 +59,10 @@ type StatusAdmitter struct {
 
 	contentionInterval time.Duration
 	expected           *lru.Cache
+
+	// eventRecorder, when set, posts events on the route as it is admitted or rejected
+	// so users can debug rejections without access to router logs.
+	eventRecorder record.EventRecorder
 }
 
 // NewStatusAdmitter creates a plugin wrapper that ensures every accepted
@@ -52,6 +82,13 @@ func NewStatusAdmitter(plugin router.Plugin, client client.RoutesNamespacer, nam
 	}
 }
 
+// WithEventRecorder sets the recorder used to emit events about route admission and
+// rejection. When no recorder is set, no events are posted.
+func (a *StatusAdmitter) WithEventRecorder(recorder record.EventRecorder) *StatusAdmitter {
+	a.eventRecorder = recorder
+	return a
+}
+
 // Return a time truncated to the second to ensure that in-memory and
 // serialized timestamps can be safely compared.
 func getRfc3339Timestamp() unversioned.Time {
@@ -268,6 +305,9 @@ func (a *StatusAdmitter) admitRoute(oc client.RoutesNamespacer, route *routeapi.
 	})
 	glog.V(4).Infof("admit: admitting route by updating status: %s (%t): %s", route.Name, updated, route.Spec.Host)
 	_, err := oc.Routes(route.Namespace).UpdateStatus(route)
+	if err == nil && a.eventRecorder != nil {
+		a.eventRecorder.Eventf(route, kapi.EventTypeNormal, "RouteAdmitted", "Route admitted by router %s for host %s", name, route.Spec.Host)
+	}
 	return a.recordIngressTouch(route, ingress.Conditions[0].LastTransitionTime, lastTouch, err)
 }
 
@@ -278,6 +318,10 @@ func (a *StatusAdmitter) RecordRouteRejection(route *routeapi.Route, reason, mes
 		return
 	}
 
+	if a.eventRecorder != nil {
+		a.eventRecorder.Eventf(route, kapi.EventTypeWarning, reason, "Route rejected by router %s: %s", a.routerName, message)
+	}
+
 	ingress, changed, lastTouch := recordIngressConditionFailure(route, a.routerName, a.routerCanonicalHostname, routeapi.RouteIngressCondition{
 		Type:    routeapi.RouteAdmitted,
 		Status:  kapi.ConditionFalse,
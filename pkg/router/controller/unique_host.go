@@ -104,7 +104,7 @@ func (p *UniqueHost) HandleRoute(eventType watch.EventType, route *routeapi.Rout
 	host := p.hostForRoute(route)
 	if len(host) == 0 {
 		glog.V(4).Infof("Route %s has no host value", routeName)
-		p.recorder.RecordRouteRejection(route, "NoHostValue", "no host value was defined for the route")
+		p.recorder.RecordRouteRejection(route, RouteRejectionNoHostValue, "no host value was defined for the route")
 		return nil
 	}
 	route.Spec.Host = host
@@ -119,7 +119,7 @@ func (p *UniqueHost) HandleRoute(eventType watch.EventType, route *routeapi.Rout
 		}
 
 		err := fmt.Errorf("host name validation errors: %s", strings.Join(errMessages, ", "))
-		p.recorder.RecordRouteRejection(route, "InvalidHost", err.Error())
+		p.recorder.RecordRouteRejection(route, RouteRejectionInvalidHost, err.Error())
 		return err
 	}
 
@@ -137,7 +137,7 @@ func (p *UniqueHost) HandleRoute(eventType watch.EventType, route *routeapi.Rout
 					if routeapi.RouteLessThan(old[i], route) {
 						glog.V(4).Infof("Route %s cannot take %s from %s", routeName, host, routeNameKey(oldest))
 						err := fmt.Errorf("route %s already exposes %s and is older", oldest.Name, host)
-						p.recorder.RecordRouteRejection(route, "HostAlreadyClaimed", err.Error())
+						p.recorder.RecordRouteRejection(route, RouteRejectionHostAlreadyClaimed, err.Error())
 						return err
 					}
 					added = true
@@ -146,7 +146,7 @@ func (p *UniqueHost) HandleRoute(eventType watch.EventType, route *routeapi.Rout
 						break
 					}
 					glog.V(4).Infof("route %s will replace path %s from %s because it is older", routeName, route.Spec.Path, old[i].Name)
-					p.recorder.RecordRouteRejection(old[i], "HostAlreadyClaimed", fmt.Sprintf("replaced by older route %s", route.Name))
+					p.recorder.RecordRouteRejection(old[i], RouteRejectionHostAlreadyClaimed, fmt.Sprintf("replaced by older route %s", route.Name))
 					p.plugin.HandleRoute(watch.Deleted, old[i])
 					old[i] = route
 				}
@@ -180,13 +180,13 @@ func (p *UniqueHost) HandleRoute(eventType watch.EventType, route *routeapi.Rout
 			if routeapi.RouteLessThan(oldest, route) {
 				glog.V(4).Infof("Route %s cannot take %s from %s", routeName, host, routeNameKey(oldest))
 				err := fmt.Errorf("a route in another namespace holds %s and is older than %s", host, route.Name)
-				p.recorder.RecordRouteRejection(route, "HostAlreadyClaimed", err.Error())
+				p.recorder.RecordRouteRejection(route, RouteRejectionHostAlreadyClaimed, err.Error())
 				return err
 			}
 
 			glog.V(4).Infof("Route %s is reclaiming %s from namespace %s", routeName, host, oldest.Namespace)
 			for i := range old {
-				p.recorder.RecordRouteRejection(old[i], "HostAlreadyClaimed", fmt.Sprintf("namespace %s owns hostname %s", oldest.Namespace, host))
+				p.recorder.RecordRouteRejection(old[i], RouteRejectionHostAlreadyClaimed, fmt.Sprintf("namespace %s owns hostname %s", oldest.Namespace, host))
 				p.plugin.HandleRoute(watch.Deleted, old[i])
 			}
 			p.hostToRoute[host] = []*routeapi.Route{route}
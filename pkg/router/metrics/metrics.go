@@ -12,11 +12,15 @@ import (
 
 // Listen starts a server for health, metrics, and profiling on the provided listen port.
 // It will terminate the process if the server fails. Metrics and profiling are only exposed
-// if username and password are provided and the user's input matches.
-func Listen(listenAddr string, username, password string) {
+// if username and password are provided and the user's input matches. If backendStatus is
+// provided, backend health is reported on /status/backends.
+func Listen(listenAddr string, username, password string, backendStatus http.Handler) {
 	go func() {
 		mux := http.NewServeMux()
 		healthz.InstallHandler(mux)
+		if backendStatus != nil {
+			mux.Handle("/status/backends", backendStatus)
+		}
 
 		// TODO: exclude etcd and other unused metrics
 
@@ -42,6 +42,7 @@ type TemplatePluginConfig struct {
 	ReloadScriptPath       string
 	ReloadInterval         time.Duration
 	ReloadCallbacks        []func()
+	DrainPeriod            time.Duration
 	DefaultCertificate     string
 	DefaultCertificatePath string
 	DefaultCertificateDir  string
@@ -86,6 +87,9 @@ type routerInterface interface {
 	// Commit applies the changes in the background. It kicks off a rate-limited
 	// commit (persist router state + refresh the backend) that coalesces multiple changes.
 	Commit()
+
+	// BackendStatuses reports the health of the backends the router is configured with.
+	BackendStatuses() []BackendStatus
 }
 
 func env(name, defaultValue string) string {
@@ -100,11 +104,14 @@ func env(name, defaultValue string) string {
 func NewTemplatePlugin(cfg TemplatePluginConfig, lookupSvc ServiceLookup) (*TemplatePlugin, error) {
 	templateBaseName := filepath.Base(cfg.TemplatePath)
 	globalFuncs := template.FuncMap{
-		"endpointsForAlias": endpointsForAlias, //returns the list of valid endpoints
-		"env":               env,               //tries to get an environment variable if it can't return a default
-		"matchPattern":      matchPattern,      //anchors provided regular expression and evaluates against given string
-		"isInteger":         isInteger,         //determines if a given variable is an integer
-		"matchValues":       matchValues,       //compares a given string to a list of allowed strings
+		"endpointsForAlias":         endpointsForAlias,         //returns the list of valid endpoints
+		"drainingEndpointsForAlias": drainingEndpointsForAlias, //returns the list of endpoints that are draining before removal
+		"env":                       env,                       //tries to get an environment variable if it can't return a default
+		"matchPattern":              matchPattern,              //anchors provided regular expression and evaluates against given string
+		"isInteger":                 isInteger,                 //determines if a given variable is an integer
+		"matchValues":               matchValues,               //compares a given string to a list of allowed strings
+		"parseHeaderDirectives":     parseHeaderDirectives,     //parses a "name: value | name: value" annotation into header directives
+		"parseHeaderNames":          parseHeaderNames,          //parses a comma separated list of header names
 
 		"genSubdomainWildcardRegexp": genSubdomainWildcardRegexp, //generates a regular expression matching the subdomain for hosts (and paths) with a wildcard policy
 		"generateRouteRegexp":        generateRouteRegexp,        //generates a regular expression matching the route hosts (and paths)
@@ -144,6 +151,7 @@ func NewTemplatePlugin(cfg TemplatePluginConfig, lookupSvc ServiceLookup) (*Temp
 		allowWildcardRoutes:    cfg.AllowWildcardRoutes,
 		peerEndpointsKey:       peerKey,
 		bindPortsAfterSync:     cfg.BindPortsAfterSync,
+		drainPeriod:            cfg.DrainPeriod,
 	}
 	router, err := newTemplateRouter(templateRouterCfg)
 	return newDefaultTemplatePlugin(router, cfg.IncludeUDP, lookupSvc), err
@@ -242,6 +242,10 @@ func (r *TestRouter) Commit() {
 	// No op
 }
 
+func (r *TestRouter) BackendStatuses() []BackendStatus {
+	return []BackendStatus{}
+}
+
 // TestHandleEndpoints test endpoint watch events
 func TestHandleEndpoints(t *testing.T) {
 	testCases := []struct {
@@ -89,6 +89,9 @@ type templateRouter struct {
 	lock sync.Mutex
 	// If true, haproxy should only bind ports when it has route and endpoint state
 	bindPortsAfterSync bool
+	// drainPeriod is how long removed routes and endpoints are retained in drain mode
+	// before being purged from the router configuration. Zero disables draining.
+	drainPeriod time.Duration
 	// whether the router state has been read from the api at least once
 	synced bool
 	// whether a state change has occurred
@@ -97,6 +100,8 @@ type templateRouter struct {
 	metricReload prometheus.Summary
 	// metricWriteConfig tracks writing config
 	metricWriteConfig prometheus.Summary
+	// metricRoutes tracks the number of routes the router is serving, per namespace
+	metricRoutes *prometheus.GaugeVec
 }
 
 // templateRouterCfg holds all configuration items required to initialize the template router
@@ -116,6 +121,7 @@ type templateRouterCfg struct {
 	peerEndpointsKey       string
 	includeUDP             bool
 	bindPortsAfterSync     bool
+	drainPeriod            time.Duration
 }
 
 // templateConfig is a subset of the templateRouter information that should be passed to the template for generating
@@ -179,6 +185,7 @@ func newTemplateRouter(cfg templateRouterCfg) (*templateRouter, error) {
 		peerEndpointsKey:       cfg.peerEndpointsKey,
 		peerEndpoints:          []Endpoint{},
 		bindPortsAfterSync:     cfg.bindPortsAfterSync,
+		drainPeriod:            cfg.drainPeriod,
 
 		metricReload: prometheus.MustRegisterOrGet(prometheus.NewSummary(prometheus.SummaryOpts{
 			Namespace: "template_router",
@@ -190,6 +197,11 @@ func newTemplateRouter(cfg templateRouterCfg) (*templateRouter, error) {
 			Name:      "write_config_seconds",
 			Help:      "Measures the time spent writing out the router configuration to disk in seconds.",
 		})).(prometheus.Summary),
+		metricRoutes: prometheus.MustRegisterOrGet(prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: "template_router",
+			Name:      "routes",
+			Help:      "Reports the number of routes the router is serving, partitioned by namespace.",
+		}, []string{"namespace"})).(*prometheus.GaugeVec),
 
 		rateLimitedCommitFunction:    nil,
 		rateLimitedCommitStopChannel: make(chan struct{}),
@@ -226,6 +238,64 @@ func matchValues(s string, allowedValues ...string) bool {
 	return false
 }
 
+// HeaderDirective is a single header name and value parsed from a route annotation.
+type HeaderDirective struct {
+	Name  string
+	Value string
+}
+
+var (
+	// validHeaderNameRegexp limits header names to the RFC 7230 token characters
+	// commonly used in practice.
+	validHeaderNameRegexp = regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9_-]*$`)
+	// validHeaderValueRegexp rejects values that could terminate or alter the
+	// generated configuration line (newlines, comments, log-format expansion).
+	validHeaderValueRegexp = regexp.MustCompile(`^[^\r\n#%{}]+$`)
+)
+
+// parseHeaderDirectives parses a route annotation of the form
+// "Name1: value1 | Name2: value2" into header directives. Entries with names or
+// values that could break the generated configuration are skipped.
+func parseHeaderDirectives(annotation string) []HeaderDirective {
+	directives := []HeaderDirective{}
+	for _, entry := range strings.Split(annotation, "|") {
+		if len(strings.TrimSpace(entry)) == 0 {
+			continue
+		}
+		parts := strings.SplitN(entry, ":", 2)
+		if len(parts) != 2 {
+			glog.Warningf("Skipping invalid header directive %q, must be of the form name: value", entry)
+			continue
+		}
+		name := strings.TrimSpace(parts[0])
+		value := strings.TrimSpace(parts[1])
+		if !validHeaderNameRegexp.MatchString(name) || !validHeaderValueRegexp.MatchString(value) {
+			glog.Warningf("Skipping header directive with unsafe name or value: %q", entry)
+			continue
+		}
+		directives = append(directives, HeaderDirective{Name: name, Value: value})
+	}
+	return directives
+}
+
+// parseHeaderNames parses a comma separated list of header names from a route
+// annotation, skipping names that are not valid header tokens.
+func parseHeaderNames(annotation string) []string {
+	names := []string{}
+	for _, entry := range strings.Split(annotation, ",") {
+		name := strings.TrimSpace(entry)
+		if len(name) == 0 {
+			continue
+		}
+		if !validHeaderNameRegexp.MatchString(name) {
+			glog.Warningf("Skipping invalid header name %q", name)
+			continue
+		}
+		names = append(names, name)
+	}
+	return names
+}
+
 func matchPattern(pattern, s string) bool {
 	glog.V(5).Infof("matchPattern called with %s and %s", pattern, s)
 	status, err := regexp.MatchString("^("+pattern+")$", s)
@@ -298,6 +368,22 @@ func endpointsForAlias(alias ServiceAliasConfig, svc ServiceUnit) []Endpoint {
 	return endpoints
 }
 
+// drainingEndpointsForAlias is the endpointsForAlias equivalent for the endpoints
+// of a service unit that are draining rather than active.
+func drainingEndpointsForAlias(alias ServiceAliasConfig, svc ServiceUnit) []Endpoint {
+	if len(alias.PreferPort) == 0 {
+		return svc.DrainingEndpointTable
+	}
+	endpoints := make([]Endpoint, 0, len(svc.DrainingEndpointTable))
+	for i := range svc.DrainingEndpointTable {
+		endpoint := svc.DrainingEndpointTable[i]
+		if endpoint.PortName == alias.PreferPort || endpoint.Port == alias.PreferPort {
+			endpoints = append(endpoints, endpoint)
+		}
+	}
+	return endpoints
+}
+
 func (r *templateRouter) EnableRateLimiter(interval int, handlerFunc ratelimiter.HandlerFunc) {
 	keyFunc := func(_ interface{}) (string, error) {
 		return "templaterouter", nil
@@ -379,7 +465,17 @@ func (r *templateRouter) readState() error {
 		return nil
 	}
 
-	return json.Unmarshal(data, &r.state)
+	if err := json.Unmarshal(data, &r.state); err != nil {
+		return err
+	}
+	// routes that were draining when the state was persisted have been deleted
+	// from the api and will not be purged by a timer, drop them now
+	for key, cfg := range r.state {
+		if cfg.Draining {
+			delete(r.state, key)
+		}
+	}
+	return nil
 }
 
 // Commit applies the changes made to the router configuration - persists
@@ -422,6 +518,8 @@ func (r *templateRouter) commitAndReload() error {
 		return err
 	}
 
+	r.updateRouteMetrics()
+
 	for i, fn := range r.reloadCallbacks {
 		glog.V(4).Infof("Calling reload function %d", i)
 		fn()
@@ -438,6 +536,21 @@ func (r *templateRouter) commitAndReload() error {
 	return nil
 }
 
+// updateRouteMetrics refreshes the per-namespace route count gauge from the current state.
+func (r *templateRouter) updateRouteMetrics() {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	counts := map[string]int{}
+	for _, cfg := range r.state {
+		counts[cfg.Namespace]++
+	}
+	r.metricRoutes.Reset()
+	for namespace, count := range counts {
+		r.metricRoutes.WithLabelValues(namespace).Set(float64(count))
+	}
+}
+
 // writeState writes the state of this router to disk.
 func (r *templateRouter) writeState() error {
 	data, err := json.MarshalIndent(r.state, "", "  ")
@@ -592,6 +705,12 @@ func (r *templateRouter) DeleteEndpoints(id string) {
 		return
 	}
 
+	if r.drainPeriod > 0 && len(service.EndpointTable) > 0 {
+		// keep the removed endpoints around in drain mode so in-flight requests
+		// can complete, and purge them once the drain period has passed
+		service.DrainingEndpointTable = append(service.DrainingEndpointTable, service.EndpointTable...)
+		time.AfterFunc(r.drainPeriod, func() { r.purgeDrainedEndpoints(id) })
+	}
 	service.EndpointTable = []Endpoint{}
 
 	r.serviceUnits[id] = service
@@ -712,6 +831,16 @@ func (r *templateRouter) AddRoute(route *routeapi.Route) {
 
 	if existingConfig, exists := r.state[backendKey]; exists {
 		if configsAreEqual(newConfig, &existingConfig) {
+			// a route that is re-added while it was draining comes straight back
+			// into active service; the scheduled purge ignores it once the drain
+			// flag is cleared
+			if existingConfig.Draining {
+				r.lock.Lock()
+				existingConfig.Draining = false
+				r.state[backendKey] = existingConfig
+				r.stateChanged = true
+				r.lock.Unlock()
+			}
 			return
 		}
 
@@ -755,11 +884,62 @@ func (r *templateRouter) RemoveRoute(route *routeapi.Route) {
 		return
 	}
 
+	if r.drainPeriod > 0 {
+		// retain the backend in drain mode so in-flight requests can complete,
+		// and purge it once the drain period has passed
+		serviceAliasConfig.Draining = true
+		r.state[routeKey] = serviceAliasConfig
+		r.stateChanged = true
+		time.AfterFunc(r.drainPeriod, func() { r.purgeDrainedRoute(routeKey) })
+		return
+	}
+
 	r.cleanUpServiceAliasConfig(&serviceAliasConfig)
 	delete(r.state, routeKey)
 	r.stateChanged = true
 }
 
+// purgeDrainedEndpoints removes the draining endpoints of the service with the given id
+// once the drain period has expired and commits the change.
+func (r *templateRouter) purgeDrainedEndpoints(id string) {
+	if func() bool {
+		r.lock.Lock()
+		defer r.lock.Unlock()
+
+		service, ok := r.findMatchingServiceUnit(id)
+		if !ok || len(service.DrainingEndpointTable) == 0 {
+			return false
+		}
+		service.DrainingEndpointTable = nil
+		r.serviceUnits[id] = service
+		r.stateChanged = true
+		return true
+	}() {
+		r.Commit()
+	}
+}
+
+// purgeDrainedRoute removes a route that was retained in drain mode once the drain
+// period has expired and commits the change. If the route was re-added while it was
+// draining this is a no-op.
+func (r *templateRouter) purgeDrainedRoute(routeKey string) {
+	if func() bool {
+		r.lock.Lock()
+		defer r.lock.Unlock()
+
+		serviceAliasConfig, ok := r.state[routeKey]
+		if !ok || !serviceAliasConfig.Draining {
+			return false
+		}
+		r.cleanUpServiceAliasConfig(&serviceAliasConfig)
+		delete(r.state, routeKey)
+		r.stateChanged = true
+		return true
+	}() {
+		r.Commit()
+	}
+}
+
 // AddEndpoints adds new Endpoints for the given id.
 func (r *templateRouter) AddEndpoints(id string, endpoints []Endpoint) {
 	r.lock.Lock()
@@ -773,6 +953,20 @@ func (r *templateRouter) AddEndpoints(id string, endpoints []Endpoint) {
 	}
 
 	frontend.EndpointTable = endpoints
+	// endpoints that came back before their drain period expired are active again
+	if len(frontend.DrainingEndpointTable) > 0 {
+		active := sets.NewString()
+		for i := range endpoints {
+			active.Insert(endpoints[i].ID)
+		}
+		draining := []Endpoint{}
+		for i := range frontend.DrainingEndpointTable {
+			if !active.Has(frontend.DrainingEndpointTable[i].ID) {
+				draining = append(draining, frontend.DrainingEndpointTable[i])
+			}
+		}
+		frontend.DrainingEndpointTable = draining
+	}
 	r.serviceUnits[id] = frontend
 
 	if id == r.peerEndpointsKey {
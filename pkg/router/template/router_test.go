@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"reflect"
 	"testing"
+	"time"
 
 	kapi "k8s.io/kubernetes/pkg/api"
 	"k8s.io/kubernetes/pkg/util/intstr"
@@ -195,6 +196,59 @@ func TestDeleteEndpoints(t *testing.T) {
 	}
 }
 
+// TestDeleteEndpointsDrain tests that deleted endpoints are kept in drain mode
+// until the drain period expires when draining is enabled
+func TestDeleteEndpointsDrain(t *testing.T) {
+	router := NewFakeTemplateRouter()
+	router.drainPeriod = time.Hour
+	router.EnableRateLimiter(1, func() error { return nil })
+	suKey := "test"
+	router.CreateServiceUnit(suKey)
+
+	router.AddEndpoints(suKey, []Endpoint{
+		{
+			ID:   "ep1",
+			IP:   "ip",
+			Port: "port",
+		},
+	})
+
+	router.DeleteEndpoints(suKey)
+
+	su, ok := router.FindServiceUnit(suKey)
+	if !ok {
+		t.Fatalf("Unable to find created service unit %s", suKey)
+	}
+	if len(su.EndpointTable) > 0 {
+		t.Errorf("Expected endpoint table to be empty")
+	}
+	if len(su.DrainingEndpointTable) != 1 {
+		t.Fatalf("Expected draining endpoint table to contain 1 entry, got %d", len(su.DrainingEndpointTable))
+	}
+
+	// a re-added endpoint must no longer be draining
+	router.AddEndpoints(suKey, []Endpoint{
+		{
+			ID:   "ep1",
+			IP:   "ip",
+			Port: "port",
+		},
+	})
+
+	su, _ = router.FindServiceUnit(suKey)
+	if len(su.DrainingEndpointTable) > 0 {
+		t.Errorf("Expected draining endpoint table to be empty after the endpoint was re-added")
+	}
+
+	router.DeleteEndpoints(suKey)
+	router.purgeDrainedEndpoints(suKey)
+
+	su, _ = router.FindServiceUnit(suKey)
+	if len(su.DrainingEndpointTable) > 0 {
+		t.Errorf("Expected draining endpoint table to be empty after the purge")
+	}
+}
+
 // TestRouteKey tests that route keys are created as expected
 func TestRouteKey(t *testing.T) {
 	router := NewFakeTemplateRouter()
@@ -504,6 +558,54 @@ func TestRemoveRoute(t *testing.T) {
 	}
 }
 
+// TestRemoveRouteDrain tests that removed routes are retained in drain mode
+// until the drain period expires when draining is enabled
+func TestRemoveRouteDrain(t *testing.T) {
+	router := NewFakeTemplateRouter()
+	router.drainPeriod = time.Hour
+	router.EnableRateLimiter(1, func() error { return nil })
+	route := &routeapi.Route{
+		ObjectMeta: kapi.ObjectMeta{
+			Namespace: "foo",
+			Name:      "bar",
+		},
+		Spec: routeapi.RouteSpec{
+			Host: "host",
+		},
+	}
+	suKey := "test"
+
+	router.CreateServiceUnit(suKey)
+	router.AddRoute(route)
+
+	routeKey := router.routeKey(route)
+	router.RemoveRoute(route)
+
+	saCfg, ok := router.state[routeKey]
+	if !ok {
+		t.Fatalf("Route %v was expected to be retained in drain mode but was not found", route)
+	}
+	if !saCfg.Draining {
+		t.Errorf("Expected service alias config for route %v to be draining", route)
+	}
+
+	// a re-added route must no longer be draining
+	router.AddRoute(route)
+	if saCfg := router.state[routeKey]; saCfg.Draining {
+		t.Errorf("Expected service alias config for route %v to no longer be draining after the route was re-added", route)
+	}
+	router.purgeDrainedRoute(routeKey)
+	if _, ok := router.state[routeKey]; !ok {
+		t.Errorf("Route %v was expected to survive a purge while not draining", route)
+	}
+
+	router.RemoveRoute(route)
+	router.purgeDrainedRoute(routeKey)
+	if _, ok := router.state[routeKey]; ok {
+		t.Errorf("Route %v was expected to be deleted after the purge but was still found", route)
+	}
+}
+
 func TestShouldWriteCertificates(t *testing.T) {
 	testCases := []struct {
 		name             string
@@ -670,3 +772,101 @@ func TestAddRouteEdgeTerminationInsecurePolicy(t *testing.T) {
 		}
 	}
 }
+
+// TestParseHeaderDirectives tests parsing header set annotations into validated
+// name/value pairs
+func TestParseHeaderDirectives(t *testing.T) {
+	testCases := []struct {
+		Name       string
+		Annotation string
+		Expected   []HeaderDirective
+	}{
+		{
+			Name:       "single header",
+			Annotation: "X-Frame-Options: SAMEORIGIN",
+			Expected:   []HeaderDirective{{Name: "X-Frame-Options", Value: "SAMEORIGIN"}},
+		},
+		{
+			Name:       "multiple headers",
+			Annotation: "X-Frame-Options: DENY | X-XSS-Protection: 1; mode=block",
+			Expected: []HeaderDirective{
+				{Name: "X-Frame-Options", Value: "DENY"},
+				{Name: "X-XSS-Protection", Value: "1; mode=block"},
+			},
+		},
+		{
+			Name:       "missing separator skipped",
+			Annotation: "NotAHeader | X-Test: value",
+			Expected:   []HeaderDirective{{Name: "X-Test", Value: "value"}},
+		},
+		{
+			Name:       "invalid name skipped",
+			Annotation: "Bad Header: value",
+			Expected:   []HeaderDirective{},
+		},
+		{
+			Name:       "newline in value skipped",
+			Annotation: "X-Test: value\nserver evil",
+			Expected:   []HeaderDirective{},
+		},
+		{
+			Name:       "comment character in value skipped",
+			Annotation: "X-Test: value # comment",
+			Expected:   []HeaderDirective{},
+		},
+		{
+			Name:       "log format expansion in value skipped",
+			Annotation: "X-Test: %[src]",
+			Expected:   []HeaderDirective{},
+		},
+		{
+			Name:       "empty annotation",
+			Annotation: "",
+			Expected:   []HeaderDirective{},
+		},
+	}
+
+	for _, tc := range testCases {
+		directives := parseHeaderDirectives(tc.Annotation)
+		if !reflect.DeepEqual(directives, tc.Expected) {
+			t.Errorf("%s: expected %v, got %v", tc.Name, tc.Expected, directives)
+		}
+	}
+}
+
+// TestParseHeaderNames tests parsing comma separated header name annotations
+func TestParseHeaderNames(t *testing.T) {
+	testCases := []struct {
+		Name       string
+		Annotation string
+		Expected   []string
+	}{
+		{
+			Name:       "single name",
+			Annotation: "Server",
+			Expected:   []string{"Server"},
+		},
+		{
+			Name:       "multiple names with whitespace",
+			Annotation: "Server, X-Powered-By",
+			Expected:   []string{"Server", "X-Powered-By"},
+		},
+		{
+			Name:       "invalid name skipped",
+			Annotation: "Server, not a header",
+			Expected:   []string{"Server"},
+		},
+		{
+			Name:       "empty entries skipped",
+			Annotation: ",Server,",
+			Expected:   []string{"Server"},
+		},
+	}
+
+	for _, tc := range testCases {
+		names := parseHeaderNames(tc.Annotation)
+		if !reflect.DeepEqual(names, tc.Expected) {
+			t.Errorf("%s: expected %v, got %v", tc.Name, tc.Expected, names)
+		}
+	}
+}
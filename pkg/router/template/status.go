@@ -0,0 +1,80 @@
+package templaterouter
+
+import (
+	"encoding/json"
+	"net/http"
+	"sort"
+)
+
+// BackendStatus describes the health of a single route backend as seen by the router.
+type BackendStatus struct {
+	// Namespace is the namespace of the route.
+	Namespace string `json:"namespace"`
+	// Name is the name of the route.
+	Name string `json:"name"`
+	// Host is the host the route is exposed on.
+	Host string `json:"host"`
+	// Active indicates whether the backend has at least one endpoint to route to.
+	Active bool `json:"active"`
+	// Endpoints is the number of endpoints currently backing the route.
+	Endpoints int `json:"endpoints"`
+}
+
+// BackendStatusReporter reports the health of the backends the router is configured with.
+type BackendStatusReporter interface {
+	BackendStatuses() []BackendStatus
+}
+
+// BackendStatuses returns the health of every route backend the router is configured with.
+func (r *templateRouter) BackendStatuses() []BackendStatus {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	statuses := []BackendStatus{}
+	for _, cfg := range r.state {
+		endpoints := 0
+		for name := range cfg.ServiceUnitNames {
+			if unit, ok := r.serviceUnits[name]; ok {
+				endpoints += len(unit.EndpointTable)
+			}
+		}
+		statuses = append(statuses, BackendStatus{
+			Namespace: cfg.Namespace,
+			Name:      cfg.Name,
+			Host:      cfg.Host,
+			Active:    endpoints > 0,
+			Endpoints: endpoints,
+		})
+	}
+	sort.Sort(backendStatusesByRoute(statuses))
+	return statuses
+}
+
+// BackendStatuses returns the health of every route backend the underlying router is
+// configured with.
+func (p *TemplatePlugin) BackendStatuses() []BackendStatus {
+	return p.Router.BackendStatuses()
+}
+
+// StatusHandler returns an http.Handler that reports the router's backend health as JSON.
+func StatusHandler(reporter BackendStatusReporter) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(w).Encode(reporter.BackendStatuses()); err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		}
+	})
+}
+
+// backendStatusesByRoute sorts backend statuses by namespace and name so the output of
+// the status endpoint is stable
+type backendStatusesByRoute []BackendStatus
+
+func (s backendStatusesByRoute) Len() int      { return len(s) }
+func (s backendStatusesByRoute) Swap(i, j int) { s[i], s[j] = s[j], s[i] }
+func (s backendStatusesByRoute) Less(i, j int) bool {
+	if s[i].Namespace == s[j].Namespace {
+		return s[i].Name < s[j].Name
+	}
+	return s[i].Namespace < s[j].Namespace
+}
@@ -0,0 +1,60 @@
+package templaterouter
+
+import (
+	"testing"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+
+	routeapi "github.com/openshift/origin/pkg/route/api"
+)
+
+// TestBackendStatuses tests that backend health reflects the endpoints backing each route
+func TestBackendStatuses(t *testing.T) {
+	router := NewFakeTemplateRouter()
+
+	backedRoute := &routeapi.Route{
+		ObjectMeta: kapi.ObjectMeta{
+			Namespace: "foo",
+			Name:      "backed",
+		},
+		Spec: routeapi.RouteSpec{
+			Host: "backed.example.com",
+			To: routeapi.RouteTargetReference{
+				Name: "backedService",
+			},
+		},
+	}
+	emptyRoute := &routeapi.Route{
+		ObjectMeta: kapi.ObjectMeta{
+			Namespace: "foo",
+			Name:      "empty",
+		},
+		Spec: routeapi.RouteSpec{
+			Host: "empty.example.com",
+			To: routeapi.RouteTargetReference{
+				Name: "emptyService",
+			},
+		},
+	}
+	router.AddRoute(backedRoute)
+	router.AddRoute(emptyRoute)
+	router.AddEndpoints("foo/backedService", []Endpoint{
+		{
+			ID:   "ep1",
+			IP:   "ip",
+			Port: "port",
+		},
+	})
+
+	statuses := router.BackendStatuses()
+	if len(statuses) != 2 {
+		t.Fatalf("Expected 2 backend statuses, got %d", len(statuses))
+	}
+	// statuses are sorted by namespace and name
+	if !statuses[0].Active || statuses[0].Endpoints != 1 {
+		t.Errorf("Expected route %s to be active with 1 endpoint, got %#v", backedRoute.Name, statuses[0])
+	}
+	if statuses[1].Active || statuses[1].Endpoints != 0 {
+		t.Errorf("Expected route %s to be inactive with no endpoints, got %#v", emptyRoute.Name, statuses[1])
+	}
+}
@@ -13,6 +13,10 @@ type ServiceUnit struct {
 	// EndpointTable are endpoints that back the service, this translates into a final backend
 	// implementation for routers.
 	EndpointTable []Endpoint
+	// DrainingEndpointTable are endpoints that have been removed from the service but are kept
+	// in the router configuration in drain mode (accepting no new connections) until the drain
+	// period expires, so that in-flight requests can complete.
+	DrainingEndpointTable []Endpoint
 }
 
 // ServiceAliasConfig is a route for a service.  Uniquely identified by host + path.
@@ -55,6 +59,10 @@ type ServiceAliasConfig struct {
 
 	// ActiveServiceUnits is a count of the service units with a non-zero weight
 	ActiveServiceUnits int
+
+	// Draining indicates the route has been removed but its backend is retained until the
+	// drain period expires so that in-flight requests can complete.
+	Draining bool
 }
 
 type ServiceAliasConfigStatus string
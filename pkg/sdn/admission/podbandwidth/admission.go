@@ -0,0 +1,132 @@
+package podbandwidth
+
+import (
+	"fmt"
+	"io"
+
+	"k8s.io/kubernetes/pkg/admission"
+	kapi "k8s.io/kubernetes/pkg/api"
+	apierrors "k8s.io/kubernetes/pkg/api/errors"
+	"k8s.io/kubernetes/pkg/api/resource"
+	clientset "k8s.io/kubernetes/pkg/client/clientset_generated/internalclientset"
+
+	oadmission "github.com/openshift/origin/pkg/cmd/server/admission"
+	"github.com/openshift/origin/pkg/project/cache"
+	sdnapi "github.com/openshift/origin/pkg/sdn/api"
+)
+
+func init() {
+	admission.RegisterPlugin("openshift.io/PodBandwidth", func(client clientset.Interface, config io.Reader) (admission.Interface, error) {
+		return NewPodBandwidth(client)
+	})
+}
+
+// bandwidthPolicies pairs each pod bandwidth annotation with the namespace
+// annotations holding its project-level default and limit.
+var bandwidthPolicies = []struct {
+	pod       string
+	nsDefault string
+	nsLimit   string
+}{
+	{sdnapi.IngressBandwidthAnnotation, sdnapi.IngressBandwidthDefaultAnnotation, sdnapi.IngressBandwidthLimitAnnotation},
+	{sdnapi.EgressBandwidthAnnotation, sdnapi.EgressBandwidthDefaultAnnotation, sdnapi.EgressBandwidthLimitAnnotation},
+}
+
+// podBandwidth is an implementation of admission.Interface. It applies the
+// bandwidth policy held in a project's annotations to every pod created in
+// that project: pods without a bandwidth annotation inherit the project
+// default, and pods requesting more than the project limit are rejected. The
+// annotations are typically seeded from the projectRequestTemplate.
+type podBandwidth struct {
+	*admission.Handler
+	client clientset.Interface
+	cache  *cache.ProjectCache
+}
+
+var _ = oadmission.WantsProjectCache(&podBandwidth{})
+
+// Admit defaults and enforces the project's pod bandwidth annotations.
+func (p *podBandwidth) Admit(a admission.Attributes) (err error) {
+	resource := a.GetResource().GroupResource()
+	if resource != kapi.Resource("pods") {
+		return nil
+	}
+	if a.GetSubresource() != "" {
+		// only run the checks below on pods proper and not subresources
+		return nil
+	}
+
+	obj := a.GetObject()
+	pod, ok := obj.(*kapi.Pod)
+	if !ok {
+		return nil
+	}
+
+	if !p.cache.Running() {
+		return err
+	}
+	namespace, err := p.cache.GetNamespace(a.GetNamespace())
+	if err != nil {
+		return apierrors.NewForbidden(resource, pod.Name, err)
+	}
+
+	for _, policy := range bandwidthPolicies {
+		if _, ok := pod.Annotations[policy.pod]; !ok {
+			if value, ok := namespace.Annotations[policy.nsDefault]; ok {
+				if pod.Annotations == nil {
+					pod.Annotations = map[string]string{}
+				}
+				pod.Annotations[policy.pod] = value
+			}
+		}
+		if err := enforceBandwidthLimit(pod, namespace, policy.pod, policy.nsLimit); err != nil {
+			return apierrors.NewForbidden(resource, pod.Name, err)
+		}
+	}
+
+	return nil
+}
+
+// enforceBandwidthLimit rejects pods whose bandwidth annotation exceeds the
+// limit recorded on their namespace. Unparseable pod annotations are left for
+// pod validation to reject.
+func enforceBandwidthLimit(pod *kapi.Pod, namespace *kapi.Namespace, podAnnotation, limitAnnotation string) error {
+	limitValue, ok := namespace.Annotations[limitAnnotation]
+	if !ok {
+		return nil
+	}
+	limit, err := resource.ParseQuantity(limitValue)
+	if err != nil {
+		return fmt.Errorf("invalid project bandwidth annotation %s: %v", limitAnnotation, err)
+	}
+	podValue, ok := pod.Annotations[podAnnotation]
+	if !ok {
+		return nil
+	}
+	quantity, err := resource.ParseQuantity(podValue)
+	if err != nil {
+		return nil
+	}
+	if quantity.Cmp(limit) > 0 {
+		return fmt.Errorf("pod annotation %s exceeds the project limit of %s", podAnnotation, limitValue)
+	}
+	return nil
+}
+
+func (p *podBandwidth) SetProjectCache(c *cache.ProjectCache) {
+	p.cache = c
+}
+
+func (p *podBandwidth) Validate() error {
+	if p.cache == nil {
+		return fmt.Errorf("pod bandwidth plugin needs a project cache")
+	}
+	return nil
+}
+
+func NewPodBandwidth(client clientset.Interface) (admission.Interface, error) {
+	return &podBandwidth{
+		Handler: admission.NewHandler(admission.Create),
+		client:  client,
+	}, nil
+}
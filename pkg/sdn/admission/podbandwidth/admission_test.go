@@ -0,0 +1,146 @@
+package podbandwidth
+
+import (
+	"testing"
+
+	"k8s.io/kubernetes/pkg/admission"
+	kapi "k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/client/cache"
+	"k8s.io/kubernetes/pkg/client/clientset_generated/internalclientset/fake"
+
+	projectcache "github.com/openshift/origin/pkg/project/cache"
+	sdnapi "github.com/openshift/origin/pkg/sdn/api"
+)
+
+// TestPodAdmission verifies that project bandwidth defaults and limits are
+// applied to pods
+func TestPodAdmission(t *testing.T) {
+	tests := []struct {
+		testName string
+
+		projectAnnotations map[string]string
+		podAnnotations     map[string]string
+
+		expectedAnnotations map[string]string
+		admit               bool
+	}{
+		{
+			testName: "no project policy",
+			podAnnotations: map[string]string{
+				sdnapi.IngressBandwidthAnnotation: "10M",
+			},
+			expectedAnnotations: map[string]string{
+				sdnapi.IngressBandwidthAnnotation: "10M",
+			},
+			admit: true,
+		},
+		{
+			testName: "project default is applied",
+			projectAnnotations: map[string]string{
+				sdnapi.IngressBandwidthDefaultAnnotation: "10M",
+				sdnapi.EgressBandwidthDefaultAnnotation:  "5M",
+			},
+			expectedAnnotations: map[string]string{
+				sdnapi.IngressBandwidthAnnotation: "10M",
+				sdnapi.EgressBandwidthAnnotation:  "5M",
+			},
+			admit: true,
+		},
+		{
+			testName: "pod annotation overrides the default",
+			projectAnnotations: map[string]string{
+				sdnapi.IngressBandwidthDefaultAnnotation: "10M",
+			},
+			podAnnotations: map[string]string{
+				sdnapi.IngressBandwidthAnnotation: "5M",
+			},
+			expectedAnnotations: map[string]string{
+				sdnapi.IngressBandwidthAnnotation: "5M",
+			},
+			admit: true,
+		},
+		{
+			testName: "pod under the limit is admitted",
+			projectAnnotations: map[string]string{
+				sdnapi.IngressBandwidthLimitAnnotation: "100M",
+			},
+			podAnnotations: map[string]string{
+				sdnapi.IngressBandwidthAnnotation: "10M",
+			},
+			expectedAnnotations: map[string]string{
+				sdnapi.IngressBandwidthAnnotation: "10M",
+			},
+			admit: true,
+		},
+		{
+			testName: "pod over the limit is rejected",
+			projectAnnotations: map[string]string{
+				sdnapi.EgressBandwidthLimitAnnotation: "10M",
+			},
+			podAnnotations: map[string]string{
+				sdnapi.EgressBandwidthAnnotation: "100M",
+			},
+			admit: false,
+		},
+		{
+			testName: "default over the limit is rejected",
+			projectAnnotations: map[string]string{
+				sdnapi.IngressBandwidthDefaultAnnotation: "100M",
+				sdnapi.IngressBandwidthLimitAnnotation:   "10M",
+			},
+			admit: false,
+		},
+		{
+			testName: "invalid project limit is rejected",
+			projectAnnotations: map[string]string{
+				sdnapi.IngressBandwidthLimitAnnotation: "not-a-quantity",
+			},
+			admit: false,
+		},
+	}
+
+	for _, test := range tests {
+		project := &kapi.Namespace{
+			ObjectMeta: kapi.ObjectMeta{
+				Name:        "testProject",
+				Annotations: test.projectAnnotations,
+			},
+		}
+		projectStore := projectcache.NewCacheStore(cache.IndexFuncToKeyFuncAdapter(cache.MetaNamespaceIndexFunc))
+		projectStore.Add(project)
+
+		pod := &kapi.Pod{
+			ObjectMeta: kapi.ObjectMeta{
+				Name:        "testPod",
+				Annotations: test.podAnnotations,
+			},
+		}
+
+		mockClientset := fake.NewSimpleClientset()
+		handler := &podBandwidth{client: mockClientset}
+		handler.SetProjectCache(projectcache.NewFake(mockClientset.Core().Namespaces(), projectStore, ""))
+
+		err := handler.Admit(admission.NewAttributesRecord(pod, nil, kapi.Kind("Pod").WithVersion("version"), "testProject", pod.Name, kapi.Resource("pods").WithVersion("version"), "", admission.Create, nil))
+		if test.admit && err != nil {
+			t.Errorf("%s: expected no error but got: %v", test.testName, err)
+			continue
+		}
+		if !test.admit && err == nil {
+			t.Errorf("%s: expected an error", test.testName)
+			continue
+		}
+		if !test.admit {
+			continue
+		}
+
+		if len(pod.Annotations) != len(test.expectedAnnotations) {
+			t.Errorf("%s: expected annotations %v, got %v", test.testName, test.expectedAnnotations, pod.Annotations)
+			continue
+		}
+		for key, value := range test.expectedAnnotations {
+			if pod.Annotations[key] != value {
+				t.Errorf("%s: expected annotation %s=%s, got %s", test.testName, key, value, pod.Annotations[key])
+			}
+		}
+	}
+}
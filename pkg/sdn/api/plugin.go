@@ -13,6 +13,17 @@ const (
 	IngressBandwidthAnnotation = "kubernetes.io/ingress-bandwidth"
 	EgressBandwidthAnnotation  = "kubernetes.io/egress-bandwidth"
 	AssignMacvlanAnnotation    = "pod.network.openshift.io/assign-macvlan"
+	AssignMacvlanMACAnnotation = "pod.network.openshift.io/assign-macvlan-mac"
+
+	// Egress router HA annotations. Pods carrying the same HA group name form an
+	// active/standby pair; the master fails the active role over to a healthy standby
+	// when the active pod dies.
+	EgressRouterHAGroupAnnotation = "pod.network.openshift.io/egress-router-ha-group"
+	EgressRouterRoleAnnotation    = "pod.network.openshift.io/egress-router-role"
+
+	// Egress router HA roles
+	EgressRouterRoleActive  = "active"
+	EgressRouterRoleStandby = "standby"
 
 	// HostSubnet annotations. (Note: should be "hostsubnet.network.openshift.io/", but the incorrect name is now part of the API.)
 	AssignHostSubnetAnnotation = "pod.network.openshift.io/assign-subnet"
@@ -20,6 +31,15 @@ const (
 
 	// NetNamespace annotations
 	MulticastEnabledAnnotation = "netnamespace.network.openshift.io/multicast-enabled"
+
+	// Namespace annotations carrying the admin's bandwidth policy for the project,
+	// typically seeded from the projectRequestTemplate. The defaults apply to pods
+	// that do not request a bandwidth themselves; the limits cap what any pod in
+	// the namespace may use.
+	IngressBandwidthDefaultAnnotation = "network.openshift.io/ingress-bandwidth-default"
+	EgressBandwidthDefaultAnnotation  = "network.openshift.io/egress-bandwidth-default"
+	IngressBandwidthLimitAnnotation   = "network.openshift.io/ingress-bandwidth-limit"
+	EgressBandwidthLimitAnnotation    = "network.openshift.io/egress-bandwidth-limit"
 )
 
 func IsOpenShiftNetworkPlugin(pluginName string) bool {
@@ -36,3 +56,10 @@ func IsOpenShiftMultitenantNetworkPlugin(pluginName string) bool {
 	}
 	return false
 }
+
+func IsOpenShiftNetworkPolicyPlugin(pluginName string) bool {
+	if strings.ToLower(pluginName) == NetworkPolicyPluginName {
+		return true
+	}
+	return false
+}
@@ -37,6 +37,9 @@ type HostSubnet struct {
 	Host   string
 	HostIP string
 	Subnet string
+
+	// EgressIPs is the list of automatic egress IP addresses currently hosted by this node
+	EgressIPs []string
 }
 
 // HostSubnetList is a collection of HostSubnets
@@ -53,6 +56,10 @@ type NetNamespace struct {
 
 	NetName string
 	NetID   uint32
+
+	// EgressIPs is the list of egress IP addresses to use for traffic leaving the cluster
+	// from pods in this namespace. If set, traffic is NATed to the first available IP.
+	EgressIPs []string
 }
 
 // NetNamespaceList is a collection of NetNamespaces
@@ -70,9 +77,13 @@ const (
 	EgressNetworkPolicyRuleDeny  EgressNetworkPolicyRuleType = "Deny"
 )
 
-// EgressNetworkPolicyPeer specifies a target to apply egress policy to
+// EgressNetworkPolicyPeer specifies a target to apply egress policy to;
+// exactly one of CIDRSelector and DNSName must be set
 type EgressNetworkPolicyPeer struct {
 	CIDRSelector string
+	// DNSName is a domain name whose resolved addresses the rule applies to; the
+	// node re-resolves the name on TTL expiry and updates its rules accordingly
+	DNSName string
 }
 
 // EgressNetworkPolicyRule contains a single egress network policy rule
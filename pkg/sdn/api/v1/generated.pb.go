@@ -268,6 +268,10 @@ func (m *EgressNetworkPolicyPeer) MarshalTo(data []byte) (int, error) {
 	i++
 	i = encodeVarintGenerated(data, i, uint64(len(m.CIDRSelector)))
 	i += copy(data[i:], m.CIDRSelector)
+	data[i] = 0x12
+	i++
+	i = encodeVarintGenerated(data, i, uint64(len(m.DNSName)))
+	i += copy(data[i:], m.DNSName)
 	return i, nil
 }
 
@@ -366,6 +370,21 @@ func (m *HostSubnet) MarshalTo(data []byte) (int, error) {
 	i++
 	i = encodeVarintGenerated(data, i, uint64(len(m.Subnet)))
 	i += copy(data[i:], m.Subnet)
+	if len(m.EgressIPs) > 0 {
+		for _, s := range m.EgressIPs {
+			data[i] = 0x2a
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				data[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			data[i] = uint8(l)
+			i++
+			i += copy(data[i:], s)
+		}
+	}
 	return i, nil
 }
 
@@ -437,6 +456,21 @@ func (m *NetNamespace) MarshalTo(data []byte) (int, error) {
 	data[i] = 0x18
 	i++
 	i = encodeVarintGenerated(data, i, uint64(m.NetID))
+	if len(m.EgressIPs) > 0 {
+		for _, s := range m.EgressIPs {
+			data[i] = 0x22
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				data[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			data[i] = uint8(l)
+			i++
+			i += copy(data[i:], s)
+		}
+	}
 	return i, nil
 }
 
@@ -563,6 +597,8 @@ func (m *EgressNetworkPolicyPeer) Size() (n int) {
 	_ = l
 	l = len(m.CIDRSelector)
 	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.DNSName)
+	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
@@ -599,6 +635,12 @@ func (m *HostSubnet) Size() (n int) {
 	n += 1 + l + sovGenerated(uint64(l))
 	l = len(m.Subnet)
 	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.EgressIPs) > 0 {
+		for _, s := range m.EgressIPs {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -624,6 +666,12 @@ func (m *NetNamespace) Size() (n int) {
 	l = len(m.NetName)
 	n += 1 + l + sovGenerated(uint64(l))
 	n += 1 + sovGenerated(uint64(m.NetID))
+	if len(m.EgressIPs) > 0 {
+		for _, s := range m.EgressIPs {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -707,6 +755,7 @@ func (this *EgressNetworkPolicyPeer) String() string {
 	}
 	s := strings.Join([]string{`&EgressNetworkPolicyPeer{`,
 		`CIDRSelector:` + fmt.Sprintf("%v", this.CIDRSelector) + `,`,
+		`DNSName:` + fmt.Sprintf("%v", this.DNSName) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -741,6 +790,7 @@ func (this *HostSubnet) String() string {
 		`Host:` + fmt.Sprintf("%v", this.Host) + `,`,
 		`HostIP:` + fmt.Sprintf("%v", this.HostIP) + `,`,
 		`Subnet:` + fmt.Sprintf("%v", this.Subnet) + `,`,
+		`EgressIPs:` + fmt.Sprintf("%v", this.EgressIPs) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -764,6 +814,7 @@ func (this *NetNamespace) String() string {
 		`ObjectMeta:` + strings.Replace(strings.Replace(this.ObjectMeta.String(), "ObjectMeta", "k8s_io_kubernetes_pkg_api_v1.ObjectMeta", 1), `&`, ``, 1) + `,`,
 		`NetName:` + fmt.Sprintf("%v", this.NetName) + `,`,
 		`NetID:` + fmt.Sprintf("%v", this.NetID) + `,`,
+		`EgressIPs:` + fmt.Sprintf("%v", this.EgressIPs) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -1363,6 +1414,35 @@ func (m *EgressNetworkPolicyPeer) Unmarshal(data []byte) error {
 			}
 			m.CIDRSelector = string(data[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DNSName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DNSName = string(data[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(data[iNdEx:])
@@ -1720,6 +1800,35 @@ func (m *HostSubnet) Unmarshal(data []byte) error {
 			}
 			m.Subnet = string(data[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EgressIPs", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EgressIPs = append(m.EgressIPs, string(data[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(data[iNdEx:])
@@ -1959,6 +2068,35 @@ func (m *NetNamespace) Unmarshal(data []byte) error {
 					break
 				}
 			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EgressIPs", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EgressIPs = append(m.EgressIPs, string(data[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(data[iNdEx:])
@@ -51,6 +51,11 @@ type HostSubnet struct {
 	HostIP string `json:"hostIP" protobuf:"bytes,3,opt,name=hostIP"`
 	// Subnet is the CIDR range of the overlay network assigned to the node for its pods
 	Subnet string `json:"subnet" protobuf:"bytes,4,opt,name=subnet"`
+
+	// EgressIPs is the list of automatic egress IP addresses currently hosted by this node.
+	// If EgressCIDRs is empty, this can be set by hand; if EgressCIDRs is set, the master
+	// will overwrite the value here with its own allocation of egress IPs.
+	EgressIPs []string `json:"egressIPs,omitempty" protobuf:"bytes,5,rep,name=egressIPs"`
 }
 
 // HostSubnetList is a collection of HostSubnets
@@ -74,6 +79,11 @@ type NetNamespace struct {
 	NetName string `json:"netname" protobuf:"bytes,2,opt,name=netname"`
 	// NetID is the network identifier of the network namespace assigned to each overlay network packet. This can be manipulated with the "oadm pod-network" commands.
 	NetID uint32 `json:"netid" protobuf:"varint,3,opt,name=netid"`
+
+	// EgressIPs is a list of reserved IPs that will be used as the source for external
+	// traffic coming from pods in this namespace. (If empty, external traffic will be
+	// masqueraded to the node IP.)
+	EgressIPs []string `json:"egressIPs,omitempty" protobuf:"bytes,4,rep,name=egressIPs"`
 }
 
 // NetNamespaceList is a collection of NetNamespaces
@@ -93,10 +103,14 @@ const (
 	EgressNetworkPolicyRuleDeny  EgressNetworkPolicyRuleType = "Deny"
 )
 
-// EgressNetworkPolicyPeer specifies a target to apply egress network policy to
+// EgressNetworkPolicyPeer specifies a target to apply egress network policy to;
+// exactly one of cidrSelector and dnsName must be set
 type EgressNetworkPolicyPeer struct {
 	// cidrSelector is the CIDR range to allow/deny traffic to
-	CIDRSelector string `json:"cidrSelector" protobuf:"bytes,1,rep,name=cidrSelector"`
+	CIDRSelector string `json:"cidrSelector,omitempty" protobuf:"bytes,1,rep,name=cidrSelector"`
+	// dnsName is a domain name whose resolved addresses are allowed/denied; the node
+	// re-resolves the name when its TTL expires and updates the rules accordingly
+	DNSName string `json:"dnsName,omitempty" protobuf:"bytes,2,opt,name=dnsName"`
 }
 
 // EgressNetworkPolicyRule contains a single egress network policy rule
@@ -185,6 +185,7 @@ func Convert_api_EgressNetworkPolicyList_To_v1_EgressNetworkPolicyList(in *api.E
 
 func autoConvert_v1_EgressNetworkPolicyPeer_To_api_EgressNetworkPolicyPeer(in *EgressNetworkPolicyPeer, out *api.EgressNetworkPolicyPeer, s conversion.Scope) error {
 	out.CIDRSelector = in.CIDRSelector
+	out.DNSName = in.DNSName
 	return nil
 }
 
@@ -194,6 +195,7 @@ func Convert_v1_EgressNetworkPolicyPeer_To_api_EgressNetworkPolicyPeer(in *Egres
 
 func autoConvert_api_EgressNetworkPolicyPeer_To_v1_EgressNetworkPolicyPeer(in *api.EgressNetworkPolicyPeer, out *EgressNetworkPolicyPeer, s conversion.Scope) error {
 	out.CIDRSelector = in.CIDRSelector
+	out.DNSName = in.DNSName
 	return nil
 }
 
@@ -250,6 +252,7 @@ func autoConvert_v1_HostSubnet_To_api_HostSubnet(in *HostSubnet, out *api.HostSu
 	out.Host = in.Host
 	out.HostIP = in.HostIP
 	out.Subnet = in.Subnet
+	out.EgressIPs = *(*[]string)(unsafe.Pointer(&in.EgressIPs))
 	return nil
 }
 
@@ -264,6 +267,7 @@ func autoConvert_api_HostSubnet_To_v1_HostSubnet(in *api.HostSubnet, out *HostSu
 	out.Host = in.Host
 	out.HostIP = in.HostIP
 	out.Subnet = in.Subnet
+	out.EgressIPs = *(*[]string)(unsafe.Pointer(&in.EgressIPs))
 	return nil
 }
 
@@ -317,6 +321,7 @@ func autoConvert_v1_NetNamespace_To_api_NetNamespace(in *NetNamespace, out *api.
 	}
 	out.NetName = in.NetName
 	out.NetID = in.NetID
+	out.EgressIPs = *(*[]string)(unsafe.Pointer(&in.EgressIPs))
 	return nil
 }
 
@@ -330,6 +335,7 @@ func autoConvert_api_NetNamespace_To_v1_NetNamespace(in *api.NetNamespace, out *
 	}
 	out.NetName = in.NetName
 	out.NetID = in.NetID
+	out.EgressIPs = *(*[]string)(unsafe.Pointer(&in.EgressIPs))
 	return nil
 }
 
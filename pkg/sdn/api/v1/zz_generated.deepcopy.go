@@ -111,6 +111,7 @@ func DeepCopy_v1_EgressNetworkPolicyPeer(in interface{}, out interface{}, c *con
 		in := in.(*EgressNetworkPolicyPeer)
 		out := out.(*EgressNetworkPolicyPeer)
 		out.CIDRSelector = in.CIDRSelector
+		out.DNSName = in.DNSName
 		return nil
 	}
 }
@@ -153,6 +154,13 @@ func DeepCopy_v1_HostSubnet(in interface{}, out interface{}, c *conversion.Clone
 		out.Host = in.Host
 		out.HostIP = in.HostIP
 		out.Subnet = in.Subnet
+		if in.EgressIPs != nil {
+			in, out := &in.EgressIPs, &out.EgressIPs
+			*out = make([]string, len(*in))
+			copy(*out, *in)
+		} else {
+			out.EgressIPs = nil
+		}
 		return nil
 	}
 }
@@ -188,6 +196,13 @@ func DeepCopy_v1_NetNamespace(in interface{}, out interface{}, c *conversion.Clo
 		}
 		out.NetName = in.NetName
 		out.NetID = in.NetID
+		if in.EgressIPs != nil {
+			in, out := &in.EgressIPs, &out.EgressIPs
+			*out = make([]string, len(*in))
+			copy(*out, *in)
+		} else {
+			out.EgressIPs = nil
+		}
 		return nil
 	}
 }
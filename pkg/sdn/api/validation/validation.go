@@ -6,6 +6,7 @@ import (
 
 	"k8s.io/kubernetes/pkg/api/validation"
 	"k8s.io/kubernetes/pkg/api/validation/path"
+	utilvalidation "k8s.io/kubernetes/pkg/util/validation"
 	"k8s.io/kubernetes/pkg/util/validation/field"
 
 	sdnapi "github.com/openshift/origin/pkg/sdn/api"
@@ -104,6 +105,11 @@ func ValidateHostSubnet(hs *sdnapi.HostSubnet) field.ErrorList {
 	if net.ParseIP(hs.HostIP) == nil {
 		allErrs = append(allErrs, field.Invalid(field.NewPath("hostIP"), hs.HostIP, "invalid IP address"))
 	}
+	for i, ip := range hs.EgressIPs {
+		if net.ParseIP(ip) == nil {
+			allErrs = append(allErrs, field.Invalid(field.NewPath("egressIPs").Index(i), ip, "invalid IP address"))
+		}
+	}
 	return allErrs
 }
 
@@ -129,6 +135,11 @@ func ValidateNetNamespace(netnamespace *sdnapi.NetNamespace) field.ErrorList {
 	if err := sdnapi.ValidVNID(netnamespace.NetID); err != nil {
 		allErrs = append(allErrs, field.Invalid(field.NewPath("netid"), netnamespace.NetID, err.Error()))
 	}
+	for i, ip := range netnamespace.EgressIPs {
+		if net.ParseIP(ip) == nil {
+			allErrs = append(allErrs, field.Invalid(field.NewPath("egressIPs").Index(i), ip, "invalid IP address"))
+		}
+	}
 	return allErrs
 }
 
@@ -147,9 +158,17 @@ func ValidateEgressNetworkPolicy(policy *sdnapi.EgressNetworkPolicy) field.Error
 			allErrs = append(allErrs, field.Invalid(field.NewPath("spec").Child("egress").Index(i).Child("type"), rule.Type, "invalid policy type"))
 		}
 
-		_, _, err := net.ParseCIDR(rule.To.CIDRSelector)
-		if err != nil {
-			allErrs = append(allErrs, field.Invalid(field.NewPath("spec").Child("egress").Index(i).Child("to"), rule.To.CIDRSelector, err.Error()))
+		if (len(rule.To.CIDRSelector) == 0) == (len(rule.To.DNSName) == 0) {
+			allErrs = append(allErrs, field.Invalid(field.NewPath("spec").Child("egress").Index(i).Child("to"), rule.To, "must specify exactly one of cidrSelector and dnsName"))
+		} else if len(rule.To.CIDRSelector) > 0 {
+			_, _, err := net.ParseCIDR(rule.To.CIDRSelector)
+			if err != nil {
+				allErrs = append(allErrs, field.Invalid(field.NewPath("spec").Child("egress").Index(i).Child("to").Child("cidrSelector"), rule.To.CIDRSelector, err.Error()))
+			}
+		} else {
+			for _, msg := range utilvalidation.IsDNS1123Subdomain(rule.To.DNSName) {
+				allErrs = append(allErrs, field.Invalid(field.NewPath("spec").Child("egress").Index(i).Child("to").Child("dnsName"), rule.To.DNSName, msg))
+			}
 		}
 	}
 
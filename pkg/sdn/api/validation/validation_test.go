@@ -237,6 +237,59 @@ func TestValidateEgressNetworkPolicy(t *testing.T) {
 			},
 			expectedErrors: 2,
 		},
+		{
+			name: "Good DNS name",
+			fw: &api.EgressNetworkPolicy{
+				ObjectMeta: kapi.ObjectMeta{
+					Name:      "default",
+					Namespace: "testing",
+				},
+				Spec: api.EgressNetworkPolicySpec{
+					Egress: []api.EgressNetworkPolicyRule{
+						{
+							Type: api.EgressNetworkPolicyRuleAllow,
+							To: api.EgressNetworkPolicyPeer{
+								DNSName: "github.com",
+							},
+						},
+						{
+							Type: api.EgressNetworkPolicyRuleDeny,
+							To: api.EgressNetworkPolicyPeer{
+								CIDRSelector: "0.0.0.0/0",
+							},
+						},
+					},
+				},
+			},
+			expectedErrors: 0,
+		},
+		{
+			name: "Bad DNS destination",
+			fw: &api.EgressNetworkPolicy{
+				ObjectMeta: kapi.ObjectMeta{
+					Name:      "default",
+					Namespace: "testing",
+				},
+				Spec: api.EgressNetworkPolicySpec{
+					Egress: []api.EgressNetworkPolicyRule{
+						{
+							Type: api.EgressNetworkPolicyRuleAllow,
+							To: api.EgressNetworkPolicyPeer{
+								DNSName: "bad^host",
+							},
+						},
+						{
+							Type: api.EgressNetworkPolicyRuleAllow,
+							To: api.EgressNetworkPolicyPeer{
+								CIDRSelector: "1.2.3.0/24",
+								DNSName:      "github.com",
+							},
+						},
+					},
+				},
+			},
+			expectedErrors: 2,
+		},
 	}
 
 	for _, tc := range tests {
@@ -415,14 +415,25 @@ func (plugin *OsdnNode) updateEgressNetworkPolicyRules(vnid uint32) {
 				action = "drop"
 			}
 
-			var dst string
-			if rule.To.CIDRSelector == "0.0.0.0/0" {
-				dst = ""
+			var dsts []string
+			if len(rule.To.DNSName) > 0 {
+				ips := plugin.egressDNS.GetIPs(rule.To.DNSName)
+				if len(ips) == 0 {
+					glog.Warningf("Could not resolve %q for EgressNetworkPolicy rule; skipping", rule.To.DNSName)
+					continue
+				}
+				for _, ip := range ips {
+					dsts = append(dsts, fmt.Sprintf(", nw_dst=%s", ip))
+				}
+			} else if rule.To.CIDRSelector == "0.0.0.0/0" {
+				dsts = []string{""}
 			} else {
-				dst = fmt.Sprintf(", nw_dst=%s", rule.To.CIDRSelector)
+				dsts = []string{fmt.Sprintf(", nw_dst=%s", rule.To.CIDRSelector)}
 			}
 
-			otx.AddFlow("table=100, reg0=%d, priority=%d, ip%s, actions=%s", vnid, priority, dst, action)
+			for _, dst := range dsts {
+				otx.AddFlow("table=100, reg0=%d, priority=%d, ip%s, actions=%s", vnid, priority, dst, action)
+			}
 		}
 		otx.DeleteFlows("table=100, reg0=%d, cookie=1/1", vnid)
 	}
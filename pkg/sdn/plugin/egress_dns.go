@@ -0,0 +1,239 @@
+package plugin
+
+import (
+	"fmt"
+	"net"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/golang/glog"
+	"github.com/miekg/dns"
+
+	osapi "github.com/openshift/origin/pkg/sdn/api"
+
+	ktypes "k8s.io/kubernetes/pkg/types"
+	"k8s.io/kubernetes/pkg/util/sets"
+	utilwait "k8s.io/kubernetes/pkg/util/wait"
+)
+
+const (
+	// egressDNSDefaultTTL is used when the resolver does not return a usable TTL
+	egressDNSDefaultTTL = 30 * time.Minute
+	// egressDNSMinTTL bounds how often a single name is re-resolved, and is also
+	// the granularity at which expired names are noticed
+	egressDNSMinTTL = 30 * time.Second
+)
+
+// dnsValue holds the most recent resolution of a single DNS name
+type dnsValue struct {
+	ips           []string
+	nextQueryTime time.Time
+}
+
+// egressDNSPolicy records which DNS names an EgressNetworkPolicy references
+type egressDNSPolicy struct {
+	namespace string
+	dnsNames  []string
+}
+
+// egressDNS tracks the DNS names referenced by egress network policies,
+// re-resolves each name when its TTL expires, and reports the namespaces whose
+// resolved addresses changed so the node can regenerate its OVS rules.
+type egressDNS struct {
+	lock sync.Mutex
+
+	// dns maps each referenced DNS name to its most recent resolution
+	dns map[string]*dnsValue
+	// policies maps policy UID to the namespace and DNS names it references
+	policies map[ktypes.UID]egressDNSPolicy
+}
+
+func newEgressDNS() *egressDNS {
+	return &egressDNS{
+		dns:      make(map[string]*dnsValue),
+		policies: make(map[ktypes.UID]egressDNSPolicy),
+	}
+}
+
+// UpdatePolicy records the DNS names referenced by policy, resolving any names
+// that have not been seen before. Pass a policy with no DNS rules (or call
+// DeletePolicy) to drop a previously recorded policy.
+func (e *egressDNS) UpdatePolicy(policy *osapi.EgressNetworkPolicy) {
+	dnsNames := []string{}
+	for _, rule := range policy.Spec.Egress {
+		if len(rule.To.DNSName) > 0 {
+			dnsNames = append(dnsNames, rule.To.DNSName)
+		}
+	}
+
+	e.lock.Lock()
+	defer e.lock.Unlock()
+
+	if len(dnsNames) == 0 {
+		delete(e.policies, policy.UID)
+	} else {
+		e.policies[policy.UID] = egressDNSPolicy{namespace: policy.Namespace, dnsNames: dnsNames}
+		for _, dnsName := range dnsNames {
+			if _, exists := e.dns[dnsName]; !exists {
+				e.dns[dnsName] = &dnsValue{}
+				e.resolve(dnsName)
+			}
+		}
+	}
+	e.garbageCollect()
+}
+
+// DeletePolicy drops the record for the policy with the given UID.
+func (e *egressDNS) DeletePolicy(uid ktypes.UID) {
+	e.lock.Lock()
+	defer e.lock.Unlock()
+
+	delete(e.policies, uid)
+	e.garbageCollect()
+}
+
+// garbageCollect drops resolutions for names that no policy references any
+// longer. Callers must hold e.lock.
+func (e *egressDNS) garbageCollect() {
+	referenced := sets.NewString()
+	for _, policy := range e.policies {
+		referenced.Insert(policy.dnsNames...)
+	}
+	for dnsName := range e.dns {
+		if !referenced.Has(dnsName) {
+			delete(e.dns, dnsName)
+		}
+	}
+}
+
+// GetIPs returns the most recently resolved addresses of dnsName, sorted so
+// that flow generation is stable across refreshes.
+func (e *egressDNS) GetIPs(dnsName string) []string {
+	e.lock.Lock()
+	defer e.lock.Unlock()
+
+	value, exists := e.dns[dnsName]
+	if !exists {
+		return nil
+	}
+	return value.ips
+}
+
+// Run periodically re-resolves names whose TTLs have expired and invokes
+// update with the namespaces whose resolved addresses changed.
+func (e *egressDNS) Run(update func(namespaces []string)) {
+	utilwait.Forever(func() {
+		namespaces := e.refresh()
+		if len(namespaces) > 0 {
+			update(namespaces)
+		}
+	}, egressDNSMinTTL)
+}
+
+// refresh re-resolves every name whose TTL has expired and returns the
+// namespaces of the policies referencing names whose addresses changed.
+func (e *egressDNS) refresh() []string {
+	e.lock.Lock()
+	defer e.lock.Unlock()
+
+	changed := sets.NewString()
+	now := time.Now()
+	for dnsName, value := range e.dns {
+		if value.nextQueryTime.After(now) {
+			continue
+		}
+		oldIPs := value.ips
+		e.resolve(dnsName)
+		if !equalStringSlices(oldIPs, value.ips) {
+			changed.Insert(dnsName)
+		}
+	}
+	if changed.Len() == 0 {
+		return nil
+	}
+
+	namespaces := sets.NewString()
+	for _, policy := range e.policies {
+		for _, dnsName := range policy.dnsNames {
+			if changed.Has(dnsName) {
+				namespaces.Insert(policy.namespace)
+				break
+			}
+		}
+	}
+	return namespaces.List()
+}
+
+// resolve queries the node's nameservers for dnsName and stores the addresses
+// and next query time on its dnsValue. Failed queries keep the previous
+// addresses and are retried after the minimum TTL. Callers must hold e.lock.
+func (e *egressDNS) resolve(dnsName string) {
+	value := e.dns[dnsName]
+	ips, ttl, err := queryDNSName(dnsName)
+	if err != nil {
+		glog.Errorf("Could not resolve %q for EgressNetworkPolicy: %v", dnsName, err)
+		value.nextQueryTime = time.Now().Add(egressDNSMinTTL)
+		return
+	}
+	sort.Strings(ips)
+	value.ips = ips
+	if ttl < egressDNSMinTTL {
+		ttl = egressDNSMinTTL
+	}
+	value.nextQueryTime = time.Now().Add(ttl)
+}
+
+// queryDNSName resolves dnsName to its A records using the node's resolver
+// configuration, returning the addresses and the smallest TTL in the answer.
+func queryDNSName(dnsName string) ([]string, time.Duration, error) {
+	config, err := dns.ClientConfigFromFile("/etc/resolv.conf")
+	if err != nil {
+		return nil, 0, err
+	}
+
+	msg := new(dns.Msg)
+	msg.SetQuestion(dns.Fqdn(dnsName), dns.TypeA)
+	client := &dns.Client{Timeout: 5 * time.Second}
+
+	var lastErr error
+	for _, server := range config.Servers {
+		in, _, err := client.Exchange(msg, net.JoinHostPort(server, config.Port))
+		if err != nil {
+			lastErr = err
+			continue
+		}
+		if in.Rcode != dns.RcodeSuccess {
+			lastErr = fmt.Errorf("DNS query for %q returned rcode %d", dnsName, in.Rcode)
+			continue
+		}
+
+		ips := []string{}
+		ttl := egressDNSDefaultTTL
+		for _, answer := range in.Answer {
+			if record, ok := answer.(*dns.A); ok {
+				ips = append(ips, record.A.String())
+				if recordTTL := time.Duration(record.Hdr.Ttl) * time.Second; recordTTL > 0 && recordTTL < ttl {
+					ttl = recordTTL
+				}
+			}
+		}
+		return ips, ttl, nil
+	}
+	if lastErr == nil {
+		lastErr = fmt.Errorf("no nameservers configured in /etc/resolv.conf")
+	}
+	return nil, 0, lastErr
+}
+
+func equalStringSlices(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,90 @@
+package plugin
+
+import (
+	"fmt"
+
+	log "github.com/golang/glog"
+
+	osapi "github.com/openshift/origin/pkg/sdn/api"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/client/cache"
+	utilwait "k8s.io/kubernetes/pkg/util/wait"
+)
+
+// EgressHAStartMaster watches egress router pods that belong to an HA group and fails
+// the active role over to a healthy standby pod when the active pod becomes unhealthy
+// or is deleted. The standby reacts to the promotion through the downward API and
+// starts announcing the shared MAC/IP.
+func (master *OsdnMaster) EgressHAStartMaster() error {
+	go utilwait.Forever(master.watchEgressRouterPods, 0)
+	return nil
+}
+
+func (master *OsdnMaster) watchEgressRouterPods() {
+	RunEventQueue(master.kClient.CoreClient.RESTClient(), Pods, func(delta cache.Delta) error {
+		pod := delta.Object.(*kapi.Pod)
+		group, ok := pod.Annotations[osapi.EgressRouterHAGroupAnnotation]
+		if !ok {
+			return nil
+		}
+		if pod.Annotations[osapi.EgressRouterRoleAnnotation] != osapi.EgressRouterRoleActive {
+			return nil
+		}
+
+		deleted := delta.Type == cache.Deleted
+		if !deleted && egressRouterHealthy(pod) {
+			return nil
+		}
+		log.V(2).Infof("Active egress router %s/%s of HA group %q failed, looking for a standby", pod.Namespace, pod.Name, group)
+		return master.failOverEgressRouter(pod, group, deleted)
+	})
+}
+
+// egressRouterHealthy returns true while the pod is running and ready.
+func egressRouterHealthy(pod *kapi.Pod) bool {
+	if pod.DeletionTimestamp != nil {
+		return false
+	}
+	if pod.Status.Phase != kapi.PodRunning {
+		return false
+	}
+	return kapi.IsPodReady(pod)
+}
+
+// failOverEgressRouter demotes the failed active pod of the HA group and promotes a
+// healthy standby in its place. If no healthy standby exists the group is left as it
+// is; a later event for a recovering standby retries the failover.
+func (master *OsdnMaster) failOverEgressRouter(failed *kapi.Pod, group string, deleted bool) error {
+	if !deleted {
+		// Demote the failed pod first so that there is never more than one
+		// active pod announcing the shared MAC/IP, and so that repeated events
+		// for the failed pod do not promote a second standby.
+		failed.Annotations[osapi.EgressRouterRoleAnnotation] = osapi.EgressRouterRoleStandby
+		if _, err := master.kClient.Core().Pods(failed.Namespace).Update(failed); err != nil {
+			return fmt.Errorf("could not demote failed egress router %s/%s: %v", failed.Namespace, failed.Name, err)
+		}
+	}
+
+	pods, err := master.kClient.Core().Pods(failed.Namespace).List(kapi.ListOptions{})
+	if err != nil {
+		return fmt.Errorf("could not list pods of egress router HA group %q: %v", group, err)
+	}
+	for i := range pods.Items {
+		pod := &pods.Items[i]
+		if pod.Name == failed.Name || pod.Annotations[osapi.EgressRouterHAGroupAnnotation] != group {
+			continue
+		}
+		if pod.Annotations[osapi.EgressRouterRoleAnnotation] != osapi.EgressRouterRoleStandby || !egressRouterHealthy(pod) {
+			continue
+		}
+		pod.Annotations[osapi.EgressRouterRoleAnnotation] = osapi.EgressRouterRoleActive
+		if _, err := master.kClient.Core().Pods(pod.Namespace).Update(pod); err != nil {
+			return fmt.Errorf("could not promote standby egress router %s/%s: %v", pod.Namespace, pod.Name, err)
+		}
+		log.Infof("Promoted standby egress router %s/%s to active for HA group %q", pod.Namespace, pod.Name, group)
+		return nil
+	}
+	log.Warningf("No healthy standby egress router found for HA group %q in namespace %s", group, failed.Namespace)
+	return nil
+}
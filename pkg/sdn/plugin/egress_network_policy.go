@@ -25,6 +25,7 @@ func (plugin *OsdnNode) SetupEgressNetworkPolicy() error {
 			continue
 		}
 		plugin.egressPolicies[vnid] = append(plugin.egressPolicies[vnid], policy)
+		plugin.egressDNS.UpdatePolicy(&policy)
 	}
 
 	for vnid := range plugin.egressPolicies {
@@ -32,9 +33,23 @@ func (plugin *OsdnNode) SetupEgressNetworkPolicy() error {
 	}
 
 	go utilwait.Forever(plugin.watchEgressNetworkPolicies, 0)
+	go plugin.egressDNS.Run(plugin.updateEgressNetworkPolicyDNSRules)
 	return nil
 }
 
+// updateEgressNetworkPolicyDNSRules regenerates the egress rules of every
+// namespace whose DNS-based rules resolved to a different set of addresses.
+func (plugin *OsdnNode) updateEgressNetworkPolicyDNSRules(namespaces []string) {
+	for _, namespace := range namespaces {
+		vnid, err := plugin.policy.GetVNID(namespace)
+		if err != nil {
+			glog.Warningf("Could not find netid for namespace %q: %v", namespace, err)
+			continue
+		}
+		plugin.updateEgressNetworkPolicyRules(vnid)
+	}
+}
+
 func (plugin *OsdnNode) watchEgressNetworkPolicies() {
 	RunEventQueue(plugin.osClient, EgressNetworkPolicies, func(delta cache.Delta) error {
 		policy := delta.Object.(*osapi.EgressNetworkPolicy)
@@ -53,6 +68,9 @@ func (plugin *OsdnNode) watchEgressNetworkPolicies() {
 		}
 		if delta.Type != cache.Deleted && len(policy.Spec.Egress) > 0 {
 			policies = append(policies, *policy)
+			plugin.egressDNS.UpdatePolicy(policy)
+		} else {
+			plugin.egressDNS.DeletePolicy(policy.UID)
 		}
 		plugin.egressPolicies[vnid] = policies
 
@@ -92,6 +92,10 @@ func StartMaster(networkConfig osconfigapi.MasterNetworkConfig, osClient *osclie
 		return err
 	}
 
+	if err = master.EgressHAStartMaster(); err != nil {
+		return err
+	}
+
 	switch networkConfig.NetworkPluginName {
 	case osapi.MultiTenantPluginName:
 		master.vnids = newMasterVNIDMap(true)
@@ -61,6 +61,7 @@ type OsdnNode struct {
 	iptablesSyncPeriod time.Duration
 	mtu                uint32
 	egressPolicies     map[uint32][]osapi.EgressNetworkPolicy
+	egressDNS          *egressDNS
 
 	host             knetwork.Host
 	kubeletCniPlugin knetwork.NetworkPlugin
@@ -126,6 +127,7 @@ func NewNodePlugin(pluginName string, osClient *osclient.Client, kClient *kclien
 		iptablesSyncPeriod: iptablesSyncPeriod,
 		mtu:                mtu,
 		egressPolicies:     make(map[uint32][]osapi.EgressNetworkPolicy),
+		egressDNS:          newEgressDNS(),
 	}
 
 	if err := plugin.dockerPreCNICleanup(); err != nil {
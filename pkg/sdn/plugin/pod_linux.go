@@ -19,6 +19,7 @@ import (
 	"github.com/golang/glog"
 
 	kapi "k8s.io/kubernetes/pkg/api"
+	kresource "k8s.io/kubernetes/pkg/api/resource"
 	kcontainer "k8s.io/kubernetes/pkg/kubelet/container"
 	"k8s.io/kubernetes/pkg/kubelet/dockertools"
 	knetwork "k8s.io/kubernetes/pkg/kubelet/network"
@@ -123,6 +124,16 @@ func maybeAddMacvlan(pod *kapi.Pod, netns string) error {
 		return fmt.Errorf("failed to find default route interface")
 	}
 
+	// An explicit MAC address lets an active/standby pair of egress routers share
+	// a single MAC/IP, so failing over does not depend on updating peer ARP caches.
+	var macAddr net.HardwareAddr
+	if val, ok := pod.Annotations[sdnapi.AssignMacvlanMACAnnotation]; ok {
+		macAddr, err = net.ParseMAC(val)
+		if err != nil {
+			return fmt.Errorf("pod has invalid %q annotation: %v", sdnapi.AssignMacvlanMACAnnotation, err)
+		}
+	}
+
 	podNs, err := ns.GetNS(netns)
 	if err != nil {
 		return fmt.Errorf("could not open netns %q", netns)
@@ -131,10 +142,11 @@ func maybeAddMacvlan(pod *kapi.Pod, netns string) error {
 
 	err = netlink.LinkAdd(&netlink.Macvlan{
 		LinkAttrs: netlink.LinkAttrs{
-			MTU:         defIface.Attrs().MTU,
-			Name:        "macvlan0",
-			ParentIndex: defIface.Attrs().Index,
-			Namespace:   netlink.NsFd(podNs.Fd()),
+			MTU:          defIface.Attrs().MTU,
+			Name:         "macvlan0",
+			ParentIndex:  defIface.Attrs().Index,
+			Namespace:    netlink.NsFd(podNs.Fd()),
+			HardwareAddr: macAddr,
 		},
 		Mode: netlink.MACVLAN_MODE_PRIVATE,
 	})
@@ -213,10 +225,52 @@ func setupPodFlows(ovsif *ovs.Interface, ofport int, podIP, podMac string, vnid
 	return otx.EndTransaction()
 }
 
-func setupPodBandwidth(ovsif *ovs.Interface, pod *kapi.Pod, hostVeth string) error {
-	podIngress, podEgress, err := kbandwidth.ExtractPodBandwidthResources(pod.Annotations)
+// effectivePodBandwidth returns the pod's ingress and egress bandwidth after
+// applying the policy carried by its namespace annotations: pods that do not
+// request a bandwidth inherit the namespace default, and no pod may exceed the
+// namespace limit.
+func effectivePodBandwidth(podAnnotations, nsAnnotations map[string]string) (*kresource.Quantity, *kresource.Quantity, error) {
+	podIngress, podEgress, err := kbandwidth.ExtractPodBandwidthResources(podAnnotations)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to parse pod bandwidth: %v", err)
+	}
+	podIngress, err = applyNamespaceBandwidth(podIngress, nsAnnotations, sdnapi.IngressBandwidthDefaultAnnotation, sdnapi.IngressBandwidthLimitAnnotation)
+	if err != nil {
+		return nil, nil, err
+	}
+	podEgress, err = applyNamespaceBandwidth(podEgress, nsAnnotations, sdnapi.EgressBandwidthDefaultAnnotation, sdnapi.EgressBandwidthLimitAnnotation)
 	if err != nil {
-		return fmt.Errorf("failed to parse pod bandwidth: %v", err)
+		return nil, nil, err
+	}
+	return podIngress, podEgress, nil
+}
+
+func applyNamespaceBandwidth(podValue *kresource.Quantity, nsAnnotations map[string]string, defaultAnnotation, limitAnnotation string) (*kresource.Quantity, error) {
+	if podValue == nil {
+		if value, ok := nsAnnotations[defaultAnnotation]; ok {
+			quantity, err := kresource.ParseQuantity(value)
+			if err != nil {
+				return nil, fmt.Errorf("failed to parse namespace annotation %s: %v", defaultAnnotation, err)
+			}
+			podValue = &quantity
+		}
+	}
+	if value, ok := nsAnnotations[limitAnnotation]; ok {
+		limit, err := kresource.ParseQuantity(value)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse namespace annotation %s: %v", limitAnnotation, err)
+		}
+		if podValue != nil && podValue.Cmp(limit) > 0 {
+			podValue = &limit
+		}
+	}
+	return podValue, nil
+}
+
+func setupPodBandwidth(ovsif *ovs.Interface, pod *kapi.Pod, nsAnnotations map[string]string, hostVeth string) error {
+	podIngress, podEgress, err := effectivePodBandwidth(pod.Annotations, nsAnnotations)
+	if err != nil {
+		return err
 	}
 	if podIngress == nil && podEgress == nil {
 		return nil
@@ -268,6 +322,16 @@ func cleanupPodFlows(ovsif *ovs.Interface, podIP string) error {
 	return otx.EndTransaction()
 }
 
+// namespaceAnnotations fetches the annotations of the pod's namespace, which
+// carry the admin's default and limit bandwidth settings.
+func (m *podManager) namespaceAnnotations(namespace string) (map[string]string, error) {
+	ns, err := m.kClient.Namespaces().Get(namespace)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch namespace %q: %v", namespace, err)
+	}
+	return ns.Annotations, nil
+}
+
 func cleanupPodBandwidth(ovsif *ovs.Interface, hostVeth string) error {
 	qos, err := ovsif.Get("port", hostVeth, "qos")
 	if err != nil || qos == "[]" {
@@ -461,7 +525,11 @@ func (m *podManager) setup(req *cniserver.PodRequest) (*cnitypes.Result, *runnin
 	if err := setupPodFlows(m.ovs, ofport, podIP.String(), contVethMac, vnid); err != nil {
 		return nil, nil, err
 	}
-	if err := setupPodBandwidth(m.ovs, pod, hostVethName); err != nil {
+	nsAnnotations, err := m.namespaceAnnotations(req.PodNamespace)
+	if err != nil {
+		return nil, nil, err
+	}
+	if err := setupPodBandwidth(m.ovs, pod, nsAnnotations, hostVethName); err != nil {
 		return nil, nil, err
 	}
 
@@ -518,7 +586,11 @@ func (m *podManager) update(req *cniserver.PodRequest) (uint32, error) {
 	if err := cleanupPodBandwidth(m.ovs, hostVethName); err != nil {
 		return 0, err
 	}
-	if err := setupPodBandwidth(m.ovs, pod, hostVethName); err != nil {
+	nsAnnotations, err := m.namespaceAnnotations(req.PodNamespace)
+	if err != nil {
+		return 0, err
+	}
+	if err := setupPodBandwidth(m.ovs, pod, nsAnnotations, hostVethName); err != nil {
 		return 0, err
 	}
 
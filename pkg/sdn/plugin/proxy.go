@@ -135,15 +135,20 @@ func (proxy *OsdnProxy) updateEgressNetworkPolicy(policy osapi.EgressNetworkPoli
 		return
 	}
 
-	firewall := make([]firewallItem, len(policy.Spec.Egress))
-	for i, rule := range policy.Spec.Egress {
+	firewall := []firewallItem{}
+	for _, rule := range policy.Spec.Egress {
+		if len(rule.To.DNSName) > 0 {
+			// DNS-based rules are enforced by the OVS flows on each node; the
+			// proxy-level endpoint filter only understands CIDRs
+			continue
+		}
 		_, cidr, err := net.ParseCIDR(rule.To.CIDRSelector)
 		if err != nil {
 			// should have been caught by validation
 			glog.Errorf("Illegal CIDR value %q in EgressNetworkPolicy rule for policy: %v", rule.To.CIDRSelector, policy.UID)
 			return
 		}
-		firewall[i] = firewallItem{rule.Type, cidr}
+		firewall = append(firewall, firewallItem{rule.Type, cidr})
 	}
 
 	// Add/Update/Delete firewall rules for the namespace
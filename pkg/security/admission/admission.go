@@ -12,6 +12,8 @@ import (
 	oscc "github.com/openshift/origin/pkg/security/scc"
 	kadmission "k8s.io/kubernetes/pkg/admission"
 	kapi "k8s.io/kubernetes/pkg/api"
+	kauthorizer "k8s.io/kubernetes/pkg/auth/authorizer"
+	kuser "k8s.io/kubernetes/pkg/auth/user"
 	clientset "k8s.io/kubernetes/pkg/client/clientset_generated/internalclientset"
 	scc "k8s.io/kubernetes/pkg/securitycontextconstraints"
 	"k8s.io/kubernetes/pkg/serviceaccount"
@@ -31,12 +33,14 @@ func init() {
 
 type constraint struct {
 	*kadmission.Handler
-	client    clientset.Interface
-	sccLister *oscache.IndexerToSecurityContextConstraintsLister
+	client     clientset.Interface
+	sccLister  *oscache.IndexerToSecurityContextConstraintsLister
+	authorizer kauthorizer.Authorizer
 }
 
 var _ kadmission.Interface = &constraint{}
 var _ = oadmission.WantsInformers(&constraint{})
+var _ = oadmission.WantsAuthorizer(&constraint{})
 
 // NewConstraint creates a new SCC constraint admission plugin.
 func NewConstraint(kclient clientset.Interface) *constraint {
@@ -76,8 +80,7 @@ func (c *constraint) Admit(a kadmission.Attributes) error {
 	// get all constraints that are usable by the user
 	glog.V(4).Infof("getting security context constraints for pod %s (generate: %s) in namespace %s with user info %v", pod.Name, pod.GenerateName, a.GetNamespace(), a.GetUserInfo())
 
-	sccMatcher := oscc.NewDefaultSCCMatcher(c.sccLister)
-	matchedConstraints, err := sccMatcher.FindApplicableSCCs(a.GetUserInfo())
+	matchedConstraints, err := c.matchConstraints(a.GetUserInfo(), a.GetNamespace())
 	if err != nil {
 		return kadmission.NewForbidden(a, err)
 	}
@@ -86,7 +89,7 @@ func (c *constraint) Admit(a kadmission.Attributes) error {
 	if len(pod.Spec.ServiceAccountName) > 0 {
 		userInfo := serviceaccount.UserInfo(a.GetNamespace(), pod.Spec.ServiceAccountName, "")
 		glog.V(4).Infof("getting security context constraints for pod %s (generate: %s) with service account info %v", pod.Name, pod.GenerateName, userInfo)
-		saConstraints, err := sccMatcher.FindApplicableSCCs(userInfo)
+		saConstraints, err := c.matchConstraints(userInfo, a.GetNamespace())
 		if err != nil {
 			return kadmission.NewForbidden(a, err)
 		}
@@ -126,16 +129,64 @@ func (c *constraint) Admit(a kadmission.Attributes) error {
 	return kadmission.NewForbidden(a, fmt.Errorf("unable to validate against any security context constraint: %v", validationErrs))
 }
 
+// matchConstraints returns the constraints usable by the given user, either through the
+// constraint's legacy users/groups lists or through a role granting the "use" verb on the
+// constraint in the pod's namespace.
+func (c *constraint) matchConstraints(userInfo kuser.Info, namespace string) ([]*kapi.SecurityContextConstraints, error) {
+	constraints, err := c.sccLister.List()
+	if err != nil {
+		return nil, err
+	}
+	var matchedConstraints []*kapi.SecurityContextConstraints
+	for _, constraint := range constraints {
+		if oscc.ConstraintAppliesTo(constraint, userInfo) || c.authorizedToUse(userInfo, namespace, constraint.Name) {
+			matchedConstraints = append(matchedConstraints, constraint)
+		}
+	}
+	return matchedConstraints, nil
+}
+
+// authorizedToUse checks whether the user has been granted the "use" verb on the named
+// constraint in the given namespace, allowing project scoped SCC grants via role bindings
+// without editing the users/groups lists on the SCC object itself.
+func (c *constraint) authorizedToUse(userInfo kuser.Info, namespace, name string) bool {
+	if c.authorizer == nil || userInfo == nil {
+		return false
+	}
+	allowed, _, err := c.authorizer.Authorize(kauthorizer.AttributesRecord{
+		User:            userInfo,
+		Verb:            "use",
+		Namespace:       namespace,
+		Resource:        "securitycontextconstraints",
+		Name:            name,
+		APIGroup:        kapi.GroupName,
+		ResourceRequest: true,
+	})
+	if err != nil {
+		glog.V(4).Infof("error authorizing use of security context constraint %s: %v", name, err)
+		return false
+	}
+	return allowed
+}
+
 // SetInformers implements WantsInformers interface for constraint.
 func (c *constraint) SetInformers(informers shared.InformerFactory) {
 	c.sccLister = informers.SecurityContextConstraints().Lister()
 }
 
+// SetAuthorizer implements WantsAuthorizer interface for constraint.
+func (c *constraint) SetAuthorizer(authorizer kauthorizer.Authorizer) {
+	c.authorizer = authorizer
+}
+
 // Validate defines actions to vallidate security admission
 func (c *constraint) Validate() error {
 	if c.sccLister == nil {
 		return fmt.Errorf("sccLister not initialized")
 	}
+	if c.authorizer == nil {
+		return fmt.Errorf("authorizer not initialized")
+	}
 	return nil
 }
 
@@ -8,6 +8,7 @@ import (
 
 	kadmission "k8s.io/kubernetes/pkg/admission"
 	kapi "k8s.io/kubernetes/pkg/api"
+	kauthorizer "k8s.io/kubernetes/pkg/auth/authorizer"
 	"k8s.io/kubernetes/pkg/auth/user"
 	"k8s.io/kubernetes/pkg/client/cache"
 	clientset "k8s.io/kubernetes/pkg/client/clientset_generated/internalclientset"
@@ -164,6 +165,73 @@ func testSCCAdmit(testCaseName string, sccs []*kapi.SecurityContextConstraints,
 	}
 }
 
+// fakeSCCAuthorizer grants the "use" verb on a single SCC to a single user
+type fakeSCCAuthorizer struct {
+	allowedUser string
+	allowedSCC  string
+}
+
+func (a *fakeSCCAuthorizer) Authorize(attributes kauthorizer.Attributes) (bool, string, error) {
+	ui := attributes.GetUser()
+	allowed := ui != nil && ui.GetName() == a.allowedUser &&
+		attributes.GetVerb() == "use" &&
+		attributes.GetResource() == "securitycontextconstraints" &&
+		attributes.GetName() == a.allowedSCC
+	return allowed, "", nil
+}
+
+func TestAdmitAuthorizedConstraint(t *testing.T) {
+	namespace := admissiontesting.CreateNamespaceForTest()
+	serviceAccount := admissiontesting.CreateSAForTest()
+	tc := clientsetfake.NewSimpleClientset(namespace, serviceAccount)
+
+	// an SCC with empty users/groups lists is only usable through an RBAC grant of the
+	// "use" verb
+	boundSCC := laxSCC()
+	boundSCC.Name = "scc-rbac-bound"
+	boundSCC.Groups = []string{}
+
+	cache := &oscache.IndexerToSecurityContextConstraintsLister{
+		Indexer: cache.NewIndexer(cache.MetaNamespaceKeyFunc,
+			cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc}),
+	}
+	cache.Add(boundSCC)
+
+	testCases := map[string]struct {
+		userName   string
+		shouldPass bool
+	}{
+		"user with a use grant is admitted": {
+			userName:   "granted-user",
+			shouldPass: true,
+		},
+		"user without a use grant is rejected": {
+			userName:   "other-user",
+			shouldPass: false,
+		},
+	}
+
+	for k, v := range testCases {
+		plugin := &constraint{
+			Handler:    kadmission.NewHandler(kadmission.Create),
+			client:     tc,
+			sccLister:  cache,
+			authorizer: &fakeSCCAuthorizer{allowedUser: "granted-user", allowedSCC: boundSCC.Name},
+		}
+
+		pod := goodPod()
+		attrs := kadmission.NewAttributesRecord(pod, nil, kapi.Kind("Pod").WithVersion("version"), pod.Namespace, pod.Name, kapi.Resource("pods").WithVersion("version"), "", kadmission.Create, &user.DefaultInfo{Name: v.userName})
+		err := plugin.Admit(attrs)
+
+		if v.shouldPass && err != nil {
+			t.Errorf("%s expected no errors but received %v", k, err)
+		}
+		if !v.shouldPass && err == nil {
+			t.Errorf("%s expected errors but received none", k)
+		}
+	}
+}
+
 func TestAdmit(t *testing.T) {
 	// create the annotated namespace and add it to the fake client
 	namespace := admissiontesting.CreateNamespaceForTest()
@@ -8,6 +8,7 @@ import (
 	"github.com/openshift/origin/pkg/controller/shared"
 	kadmission "k8s.io/kubernetes/pkg/admission"
 	kapi "k8s.io/kubernetes/pkg/api"
+	kauthorizer "k8s.io/kubernetes/pkg/auth/authorizer"
 	clientset "k8s.io/kubernetes/pkg/client/clientset_generated/internalclientset"
 )
 
@@ -20,6 +21,7 @@ func init() {
 
 var _ kadmission.Interface = &sccExecRestrictions{}
 var _ = oadmission.WantsInformers(&sccExecRestrictions{})
+var _ = oadmission.WantsAuthorizer(&sccExecRestrictions{})
 
 // sccExecRestrictions is an implementation of admission.Interface which says no to a pod/exec on
 // a pod that the user would not be allowed to create
@@ -69,6 +71,11 @@ func (d *sccExecRestrictions) SetInformers(informers shared.InformerFactory) {
 	d.constraintAdmission.sccLister = informers.SecurityContextConstraints().Lister()
 }
 
+// SetAuthorizer implements WantsAuthorizer interface for sccExecRestrictions.
+func (d *sccExecRestrictions) SetAuthorizer(authorizer kauthorizer.Authorizer) {
+	d.constraintAdmission.authorizer = authorizer
+}
+
 // Validate defines actions to validate sccExecRestrictions
 func (d *sccExecRestrictions) Validate() error {
 	return d.constraintAdmission.Validate()
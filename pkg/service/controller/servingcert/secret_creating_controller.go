@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/golang/glog"
@@ -20,7 +21,6 @@ import (
 	"k8s.io/kubernetes/pkg/util/workqueue"
 	"k8s.io/kubernetes/pkg/watch"
 
-	"github.com/openshift/origin/pkg/cmd/server/crypto"
 	"github.com/openshift/origin/pkg/cmd/server/crypto/extensions"
 )
 
@@ -44,13 +44,36 @@ const (
 	// ServingCertExpiryAnnotation is an annotation that holds the expiry time of the certificate.  It accepts time in the
 	// RFC3339 format: 2018-11-29T17:44:39Z
 	ServingCertExpiryAnnotation = "service.alpha.openshift.io/expiry"
+	// ServingCertAdditionalSANsAnnotation holds a comma delimited list of additional DNS names or IP
+	// addresses to include as subject alternative names on the generated certificate.
+	ServingCertAdditionalSANsAnnotation = "service.alpha.openshift.io/serving-cert-additional-sans"
+	// ServingCertCAConfigMapName is the name of the configmap, maintained by the controller in
+	// every namespace that uses serving certs, holding the CA bundle of the signer so clients
+	// (for example reencrypt routes) can verify the generated certificates.
+	ServingCertCAConfigMapName = "service-ca"
+	// ServingCertCAKey is the key under which the CA bundle is stored in the configmap.
+	ServingCertCAKey = "service-ca.crt"
 )
 
+// servingCertHostnames returns the hostnames the serving cert for the service must cover: the
+// cluster-internal DNS names of the service plus any additional SANs requested by annotation.
+func servingCertHostnames(service *kapi.Service, dnsSuffix string) sets.String {
+	dnsName := service.Name + "." + service.Namespace + ".svc"
+	hostnames := sets.NewString(dnsName, dnsName+"."+dnsSuffix)
+	for _, san := range strings.Split(service.Annotations[ServingCertAdditionalSANsAnnotation], ",") {
+		if san = strings.TrimSpace(san); len(san) > 0 {
+			hostnames.Insert(san)
+		}
+	}
+	return hostnames
+}
+
 // ServiceServingCertController is responsible for synchronizing Service objects stored
 // in the system with actual running replica sets and pods.
 type ServiceServingCertController struct {
-	serviceClient kcoreclient.ServicesGetter
-	secretClient  kcoreclient.SecretsGetter
+	serviceClient   kcoreclient.ServicesGetter
+	secretClient    kcoreclient.SecretsGetter
+	configMapClient kcoreclient.ConfigMapsGetter
 
 	// Services that need to be checked
 	queue      workqueue.RateLimitingInterface
@@ -64,7 +87,7 @@ type ServiceServingCertController struct {
 	secretController *cache.Controller
 	secretHasSynced  informerSynced
 
-	ca         *crypto.CA
+	signer     Signer
 	publicCert string
 	dnsSuffix  string
 
@@ -74,15 +97,16 @@ type ServiceServingCertController struct {
 
 // NewServiceServingCertController creates a new ServiceServingCertController.
 // TODO this should accept a shared informer
-func NewServiceServingCertController(serviceClient kcoreclient.ServicesGetter, secretClient kcoreclient.SecretsGetter, ca *crypto.CA, dnsSuffix string, resyncInterval time.Duration) *ServiceServingCertController {
+func NewServiceServingCertController(serviceClient kcoreclient.ServicesGetter, secretClient kcoreclient.SecretsGetter, configMapClient kcoreclient.ConfigMapsGetter, signer Signer, dnsSuffix string, resyncInterval time.Duration) *ServiceServingCertController {
 	sc := &ServiceServingCertController{
-		serviceClient: serviceClient,
-		secretClient:  secretClient,
+		serviceClient:   serviceClient,
+		secretClient:    secretClient,
+		configMapClient: configMapClient,
 
 		queue:      workqueue.NewRateLimitingQueue(workqueue.DefaultControllerRateLimiter()),
 		maxRetries: 10,
 
-		ca:        ca,
+		signer:    signer,
 		dnsSuffix: dnsSuffix,
 	}
 
@@ -249,11 +273,9 @@ func (sc *ServiceServingCertController) syncService(key string) error {
 		service.Annotations = map[string]string{}
 	}
 
-	dnsName := service.Name + "." + service.Namespace + ".svc"
-	fqDNSName := dnsName + "." + sc.dnsSuffix
 	certificateLifetime := 365 * 2 // 2 years
-	servingCert, err := sc.ca.MakeServerCert(
-		sets.NewString(dnsName, fqDNSName),
+	servingCert, err := sc.signer.MakeServerCert(
+		servingCertHostnames(service, sc.dnsSuffix),
 		certificateLifetime,
 		extensions.ServiceServerCertificateExtension(service),
 	)
@@ -325,7 +347,11 @@ func (sc *ServiceServingCertController) syncService(key string) error {
 		}
 	}
 
-	service.Annotations[ServingCertCreatedByAnnotation] = sc.ca.Config.Certs[0].Subject.CommonName
+	if err := sc.ensureCAConfigMap(service.Namespace); err != nil {
+		return err
+	}
+
+	service.Annotations[ServingCertCreatedByAnnotation] = sc.signer.CommonName()
 	delete(service.Annotations, ServingCertErrorAnnotation)
 	delete(service.Annotations, ServingCertErrorNumAnnotation)
 	_, err = sc.serviceClient.Services(service.Namespace).Update(service)
@@ -333,6 +359,38 @@ func (sc *ServiceServingCertController) syncService(key string) error {
 	return err
 }
 
+// ensureCAConfigMap publishes the signing CA bundle into the namespace so that
+// clients connecting to the generated serving certs can verify them.
+func (sc *ServiceServingCertController) ensureCAConfigMap(namespace string) error {
+	caBundle := string(sc.signer.CABundle())
+	configMap, err := sc.configMapClient.ConfigMaps(namespace).Get(ServingCertCAConfigMapName)
+	if kapierrors.IsNotFound(err) {
+		_, err := sc.configMapClient.ConfigMaps(namespace).Create(&kapi.ConfigMap{
+			ObjectMeta: kapi.ObjectMeta{
+				Namespace: namespace,
+				Name:      ServingCertCAConfigMapName,
+			},
+			Data: map[string]string{ServingCertCAKey: caBundle},
+		})
+		if kapierrors.IsAlreadyExists(err) {
+			return nil
+		}
+		return err
+	}
+	if err != nil {
+		return err
+	}
+	if configMap.Data[ServingCertCAKey] == caBundle {
+		return nil
+	}
+	if configMap.Data == nil {
+		configMap.Data = map[string]string{}
+	}
+	configMap.Data[ServingCertCAKey] = caBundle
+	_, err = sc.configMapClient.ConfigMaps(namespace).Update(configMap)
+	return err
+}
+
 func getNumFailures(service *kapi.Service) int {
 	numFailuresString := service.Annotations[ServingCertErrorNumAnnotation]
 	if len(numFailuresString) == 0 {
@@ -354,7 +412,7 @@ func (sc *ServiceServingCertController) requiresCertGeneration(service *kapi.Ser
 	if getNumFailures(service) >= sc.maxRetries {
 		return false
 	}
-	if service.Annotations[ServingCertCreatedByAnnotation] == sc.ca.Config.Certs[0].Subject.CommonName {
+	if service.Annotations[ServingCertCreatedByAnnotation] == sc.signer.CommonName() {
 		return false
 	}
 	// TODO: use the lister here
@@ -52,7 +52,7 @@ func controllerSetup(startingObjects []runtime.Object, stopChannel chan struct{}
 	kubeclient.PrependWatchReactor("services", core.DefaultWatchReactor(fakeWatch, nil))
 	kubeclient.PrependWatchReactor("secrets", core.DefaultWatchReactor(fakeSecretWatch, nil))
 
-	controller := NewServiceServingCertController(kubeclient.Core(), kubeclient.Core(), ca, "cluster.local", 10*time.Minute)
+	controller := NewServiceServingCertController(kubeclient.Core(), kubeclient.Core(), kubeclient.Core(), NewCASigner(ca), "cluster.local", 10*time.Minute)
 	controller.serviceHasSynced = func() bool { return true }
 	controller.secretHasSynced = func() bool { return true }
 
@@ -145,8 +145,22 @@ func TestBasicControllerFlow(t *testing.T) {
 
 	foundSecret := false
 	foundServiceUpdate := false
+	foundCAConfigMap := false
 	for _, action := range kubeclient.Actions() {
 		switch {
+		case action.Matches("create", "configmaps"):
+			createConfigMap := action.(core.CreateAction)
+			newConfigMap := createConfigMap.GetObject().(*kapi.ConfigMap)
+			if newConfigMap.Name != ServingCertCAConfigMapName {
+				t.Errorf("expected %v, got %v", ServingCertCAConfigMapName, newConfigMap.Name)
+				continue
+			}
+			if len(newConfigMap.Data[ServingCertCAKey]) == 0 {
+				t.Errorf("expected the CA bundle to be published, got %v", newConfigMap.Data)
+				continue
+			}
+			foundCAConfigMap = true
+
 		case action.Matches("create", "secrets"):
 			createSecret := action.(core.CreateAction)
 			newSecret := createSecret.GetObject().(*kapi.Secret)
@@ -185,6 +199,9 @@ func TestBasicControllerFlow(t *testing.T) {
 	if !foundServiceUpdate {
 		t.Errorf("service wasn't updated.  Got %v\n", kubeclient.Actions())
 	}
+	if !foundCAConfigMap {
+		t.Errorf("CA configmap wasn't created.  Got %v\n", kubeclient.Actions())
+	}
 }
 
 func TestAlreadyExistingSecretControllerFlow(t *testing.T) {
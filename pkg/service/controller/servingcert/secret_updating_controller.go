@@ -12,12 +12,10 @@ import (
 	"k8s.io/kubernetes/pkg/controller"
 	"k8s.io/kubernetes/pkg/runtime"
 	utilruntime "k8s.io/kubernetes/pkg/util/runtime"
-	"k8s.io/kubernetes/pkg/util/sets"
 	"k8s.io/kubernetes/pkg/util/wait"
 	"k8s.io/kubernetes/pkg/util/workqueue"
 	"k8s.io/kubernetes/pkg/watch"
 
-	"github.com/openshift/origin/pkg/cmd/server/crypto"
 	"github.com/openshift/origin/pkg/cmd/server/crypto/extensions"
 )
 
@@ -37,7 +35,7 @@ type ServiceServingCertUpdateController struct {
 	secretController *cache.Controller
 	secretHasSynced  informerSynced
 
-	ca         *crypto.CA
+	signer     Signer
 	publicCert string
 	dnsSuffix  string
 	// minTimeLeftForCert is how much time is remaining for the serving cert before regenerating it.
@@ -49,13 +47,13 @@ type ServiceServingCertUpdateController struct {
 
 // NewServiceServingCertUpdateController creates a new ServiceServingCertUpdateController.
 // TODO this should accept a shared informer
-func NewServiceServingCertUpdateController(serviceClient kcoreclient.ServicesGetter, secretClient kcoreclient.SecretsGetter, ca *crypto.CA, dnsSuffix string, resyncInterval time.Duration) *ServiceServingCertUpdateController {
+func NewServiceServingCertUpdateController(serviceClient kcoreclient.ServicesGetter, secretClient kcoreclient.SecretsGetter, signer Signer, dnsSuffix string, resyncInterval time.Duration) *ServiceServingCertUpdateController {
 	sc := &ServiceServingCertUpdateController{
 		secretClient: secretClient,
 
 		queue: workqueue.NewRateLimitingQueue(workqueue.DefaultControllerRateLimiter()),
 
-		ca:        ca,
+		signer:    signer,
 		dnsSuffix: dnsSuffix,
 		// TODO base the expiry time on a percentage of the time for the lifespan of the cert
 		minTimeLeftForCert: 1 * time.Hour,
@@ -232,11 +230,9 @@ func (sc *ServiceServingCertUpdateController) syncSecret(key string) error {
 	}
 	secret := t.(*kapi.Secret)
 
-	dnsName := service.Name + "." + secret.Namespace + ".svc"
-	fqDNSName := dnsName + "." + sc.dnsSuffix
 	certificateLifetime := 365 * 2 // 2 years
-	servingCert, err := sc.ca.MakeServerCert(
-		sets.NewString(dnsName, fqDNSName),
+	servingCert, err := sc.signer.MakeServerCert(
+		servingCertHostnames(service, sc.dnsSuffix),
 		certificateLifetime,
 		extensions.ServiceServerCertificateExtension(service),
 	)
@@ -0,0 +1,187 @@
+package servingcert
+
+import (
+	"bytes"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"net"
+	"net/http"
+	"time"
+
+	"k8s.io/kubernetes/pkg/util/sets"
+
+	"github.com/openshift/origin/pkg/cmd/server/crypto"
+)
+
+// Signer produces signed serving certificates for a set of hostnames.
+type Signer interface {
+	// MakeServerCert returns a signed serving certificate valid for the given hostnames.
+	MakeServerCert(hostnames sets.String, expireDays int, fns ...crypto.CertificateExtensionFunc) (*crypto.TLSCertificateConfig, error)
+	// CommonName identifies the signing CA.  It is recorded on services to detect whether
+	// their serving certs were produced by the current signer.
+	CommonName() string
+	// CABundle returns the PEM encoded certificate chain of the signing CA, suitable for
+	// verifying serving certs produced by this signer.
+	CABundle() []byte
+}
+
+// encodeCABundle PEM encodes the given certificates into a single bundle.
+func encodeCABundle(certs []*x509.Certificate) []byte {
+	bundle := []byte{}
+	for _, cert := range certs {
+		bundle = append(bundle, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: cert.Raw})...)
+	}
+	return bundle
+}
+
+// caSigner signs serving certificates with the built-in self-signed CA.
+type caSigner struct {
+	ca *crypto.CA
+}
+
+var _ Signer = &caSigner{}
+
+// NewCASigner returns a Signer backed by the given self-signed CA.
+func NewCASigner(ca *crypto.CA) Signer {
+	return &caSigner{ca: ca}
+}
+
+func (s *caSigner) MakeServerCert(hostnames sets.String, expireDays int, fns ...crypto.CertificateExtensionFunc) (*crypto.TLSCertificateConfig, error) {
+	return s.ca.MakeServerCert(hostnames, expireDays, fns...)
+}
+
+func (s *caSigner) CommonName() string {
+	return s.ca.Config.Certs[0].Subject.CommonName
+}
+
+func (s *caSigner) CABundle() []byte {
+	return encodeCABundle(s.ca.Config.Certs)
+}
+
+// remoteSigner delegates serving cert signing to an external CA that exposes a
+// CFSSL-compatible signing endpoint (cfssl serve, or Vault fronted by such an API).
+// The signer generates the private key and CSR locally, so the key never leaves
+// the cluster.  The certificate lifetime and any requested extensions are
+// determined by the external CA's signing profile; expireDays and extension
+// funcs are advisory only.
+type remoteSigner struct {
+	signURL string
+	caCerts []*x509.Certificate
+	client  *http.Client
+}
+
+var _ Signer = &remoteSigner{}
+
+// NewRemoteSigner returns a Signer that submits CSRs to the CFSSL-compatible
+// signing endpoint at signURL.  caBundleFile must contain the PEM encoded
+// certificate chain of the external CA so generated secrets carry the full chain.
+func NewRemoteSigner(signURL, caBundleFile string) (Signer, error) {
+	roots, err := crypto.GetTLSCARoots(caBundleFile)
+	if err != nil {
+		return nil, err
+	}
+	if len(roots.Roots) == 0 {
+		return nil, fmt.Errorf("no certificates found in %s", caBundleFile)
+	}
+	return &remoteSigner{
+		signURL: signURL,
+		caCerts: roots.Roots,
+		client:  &http.Client{Timeout: 30 * time.Second},
+	}, nil
+}
+
+func (s *remoteSigner) CommonName() string {
+	return s.caCerts[0].Subject.CommonName
+}
+
+func (s *remoteSigner) CABundle() []byte {
+	return encodeCABundle(s.caCerts)
+}
+
+// signRequest is the payload accepted by the CFSSL sign endpoint.
+type signRequest struct {
+	CertificateRequest string `json:"certificate_request"`
+	Profile            string `json:"profile,omitempty"`
+}
+
+// signResponse is the envelope returned by the CFSSL sign endpoint.
+type signResponse struct {
+	Success bool `json:"success"`
+	Result  struct {
+		Certificate string `json:"certificate"`
+	} `json:"result"`
+	Errors []struct {
+		Code    int    `json:"code"`
+		Message string `json:"message"`
+	} `json:"errors"`
+}
+
+func (s *remoteSigner) MakeServerCert(hostnames sets.String, expireDays int, fns ...crypto.CertificateExtensionFunc) (*crypto.TLSCertificateConfig, error) {
+	if hostnames.Len() == 0 {
+		return nil, fmt.Errorf("at least one hostname is required")
+	}
+
+	privateKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		return nil, err
+	}
+
+	template := &x509.CertificateRequest{
+		Subject: pkix.Name{CommonName: hostnames.List()[0]},
+	}
+	for _, host := range hostnames.List() {
+		if ip := net.ParseIP(host); ip != nil {
+			template.IPAddresses = append(template.IPAddresses, ip)
+		} else {
+			template.DNSNames = append(template.DNSNames, host)
+		}
+	}
+	csrDER, err := x509.CreateCertificateRequest(rand.Reader, template, privateKey)
+	if err != nil {
+		return nil, err
+	}
+	csrPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE REQUEST", Bytes: csrDER})
+
+	body, err := json.Marshal(&signRequest{CertificateRequest: string(csrPEM), Profile: "server"})
+	if err != nil {
+		return nil, err
+	}
+	resp, err := s.client.Post(s.signURL, "application/json", bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("external signer %s returned %d", s.signURL, resp.StatusCode)
+	}
+
+	response := &signResponse{}
+	if err := json.NewDecoder(resp.Body).Decode(response); err != nil {
+		return nil, err
+	}
+	if !response.Success {
+		if len(response.Errors) > 0 {
+			return nil, fmt.Errorf("external signer %s refused to sign: %s", s.signURL, response.Errors[0].Message)
+		}
+		return nil, fmt.Errorf("external signer %s refused to sign", s.signURL)
+	}
+
+	block, _ := pem.Decode([]byte(response.Result.Certificate))
+	if block == nil {
+		return nil, fmt.Errorf("external signer %s returned no PEM certificate", s.signURL)
+	}
+	cert, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		return nil, err
+	}
+
+	return &crypto.TLSCertificateConfig{
+		Certs: append([]*x509.Certificate{cert}, s.caCerts...),
+		Key:   privateKey,
+	}, nil
+}
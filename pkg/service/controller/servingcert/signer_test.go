@@ -0,0 +1,127 @@
+package servingcert
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/json"
+	"encoding/pem"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/util/sets"
+)
+
+func TestServingCertHostnames(t *testing.T) {
+	service := &kapi.Service{
+		ObjectMeta: kapi.ObjectMeta{
+			Name:      "svc",
+			Namespace: "ns",
+			Annotations: map[string]string{
+				ServingCertAdditionalSANsAnnotation: "svc.example.com, 10.0.0.1,,",
+			},
+		},
+	}
+	expected := sets.NewString("svc.ns.svc", "svc.ns.svc.cluster.local", "svc.example.com", "10.0.0.1")
+	if actual := servingCertHostnames(service, "cluster.local"); !actual.Equal(expected) {
+		t.Errorf("expected %v, actual %v", expected.List(), actual.List())
+	}
+
+	service.Annotations = nil
+	expected = sets.NewString("svc.ns.svc", "svc.ns.svc.cluster.local")
+	if actual := servingCertHostnames(service, "cluster.local"); !actual.Equal(expected) {
+		t.Errorf("expected %v, actual %v", expected.List(), actual.List())
+	}
+}
+
+func TestRemoteSignerMakeServerCert(t *testing.T) {
+	caKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	caTemplate := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "external-signer"},
+		NotBefore:             time.Now().Add(-1 * time.Hour),
+		NotAfter:              time.Now().Add(1 * time.Hour),
+		IsCA:                  true,
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+	}
+	caDER, err := x509.CreateCertificate(rand.Reader, caTemplate, caTemplate, &caKey.PublicKey, caKey)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	caCert, err := x509.ParseCertificate(caDER)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		request := &signRequest{}
+		if err := json.NewDecoder(req.Body).Decode(request); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		block, _ := pem.Decode([]byte(request.CertificateRequest))
+		if block == nil {
+			http.Error(w, "no CSR", http.StatusBadRequest)
+			return
+		}
+		csr, err := x509.ParseCertificateRequest(block.Bytes)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		template := &x509.Certificate{
+			SerialNumber: big.NewInt(2),
+			Subject:      csr.Subject,
+			DNSNames:     csr.DNSNames,
+			IPAddresses:  csr.IPAddresses,
+			NotBefore:    time.Now().Add(-1 * time.Hour),
+			NotAfter:     time.Now().Add(1 * time.Hour),
+		}
+		certDER, err := x509.CreateCertificate(rand.Reader, template, caCert, csr.PublicKey, caKey)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		response := &signResponse{Success: true}
+		response.Result.Certificate = string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: certDER}))
+		json.NewEncoder(w).Encode(response)
+	}))
+	defer server.Close()
+
+	signer := &remoteSigner{
+		signURL: server.URL,
+		caCerts: []*x509.Certificate{caCert},
+		client:  &http.Client{},
+	}
+
+	if expected, actual := "external-signer", signer.CommonName(); expected != actual {
+		t.Errorf("expected %v, actual %v", expected, actual)
+	}
+
+	servingCert, err := signer.MakeServerCert(sets.NewString("svc.ns.svc", "10.0.0.1"), 365)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(servingCert.Certs) != 2 {
+		t.Fatalf("expected leaf and CA in the chain, got %d certs", len(servingCert.Certs))
+	}
+	leaf := servingCert.Certs[0]
+	if len(leaf.DNSNames) != 1 || leaf.DNSNames[0] != "svc.ns.svc" {
+		t.Errorf("unexpected DNSNames: %v", leaf.DNSNames)
+	}
+	if len(leaf.IPAddresses) != 1 || leaf.IPAddresses[0].String() != "10.0.0.1" {
+		t.Errorf("unexpected IPAddresses: %v", leaf.IPAddresses)
+	}
+	if err := leaf.CheckSignatureFrom(caCert); err != nil {
+		t.Errorf("expected the leaf to be signed by the external CA: %v", err)
+	}
+}
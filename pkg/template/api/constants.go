@@ -43,4 +43,20 @@ const (
 	// ServiceMetadataIconClass is the key for the template iconClass as returned
 	// in the services.metadata map from a service broker catalog response
 	ServiceMetadataIconClass = "console.openshift.io/iconClass"
+
+	// ConditionAnnotation may be set on a template object and is evaluated
+	// against the template parameters during processing. The object is only
+	// instantiated when the annotation evaluates to true.
+	ConditionAnnotation = "template.openshift.io/condition"
+
+	// CopiesAnnotation may be set on a template object and is evaluated
+	// against the template parameters during processing. The object is
+	// instantiated once per requested copy, with the zero-based index of each
+	// copy exposed through the COPY_INDEX parameter.
+	CopiesAnnotation = "template.openshift.io/copies"
+
+	// CopyIndexParameterName is the name of the implicit parameter holding the
+	// zero-based index of the object copy being processed. It is only defined
+	// for objects carrying the CopiesAnnotation.
+	CopyIndexParameterName = "COPY_INDEX"
 )
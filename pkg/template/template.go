@@ -1,10 +1,14 @@
 package template
 
 import (
+	"crypto/sha256"
+	"encoding/base64"
 	"fmt"
 	"regexp"
+	"strconv"
 	"strings"
 
+	kapi "k8s.io/kubernetes/pkg/api"
 	"k8s.io/kubernetes/pkg/api/meta"
 	"k8s.io/kubernetes/pkg/runtime"
 	"k8s.io/kubernetes/pkg/util/validation/field"
@@ -21,6 +25,9 @@ var stringParameterExp = regexp.MustCompile(`\$\{([a-zA-Z0-9\_]+?)\}`)
 // match ${{KEY}} exact match only, KEY will be grouped
 var nonStringParameterExp = regexp.MustCompile(`^\$\{\{([a-zA-Z0-9\_]+)\}\}$`)
 
+// match ${FUNC(KEY)} in generator expressions, FUNC and KEY will be grouped
+var functionParameterExp = regexp.MustCompile(`\$\{(base64|sha256)\(([a-zA-Z0-9\_]+)\)\}`)
+
 // Processor process the Template into the List with substituted parameters
 type Processor struct {
 	Generators map[string]Generator
@@ -53,6 +60,7 @@ func (p *Processor) Process(template *api.Template) field.ErrorList {
 	template.Message, _ = p.EvaluateParameterSubstitution(paramMap, template.Message)
 
 	itemPath := field.NewPath("item")
+	objects := []runtime.Object{}
 	for i, item := range template.Objects {
 		idxPath := itemPath.Index(i)
 		if obj, ok := item.(*runtime.Unknown); ok {
@@ -65,26 +73,91 @@ func (p *Processor) Process(template *api.Template) field.ErrorList {
 			item = decodedObj
 		}
 
-		// If an object definition's metadata includes a hardcoded namespace field, the field will be stripped out of
-		// the definition during template instantiation.  Namespace fields that contain a ${PARAMETER_REFERENCE}
-		// will be left in place, resolved during parameter substition, and the object will be created in the
-		// referenced namespace.
-		stripNamespace(item)
-
-		newItem, err := p.SubstituteParameters(paramMap, item)
+		// Evaluate the object's condition and copies annotations against the
+		// parameters, which may drop the object or repeat it.
+		copies, err := p.evaluateObjectControls(paramMap, item)
 		if err != nil {
-			templateErrors = append(templateErrors, field.Invalid(idxPath.Child("parameters"), template.Parameters, err.Error()))
+			templateErrors = append(templateErrors, field.Invalid(idxPath.Child("annotations"), item, err.Error()))
+			continue
 		}
-		if err := util.AddObjectLabels(newItem, template.ObjectLabels); err != nil {
-			templateErrors = append(templateErrors, field.Invalid(idxPath.Child("labels"),
-				template.ObjectLabels, fmt.Sprintf("label could not be applied: %v", err)))
+
+		for _, item := range copies {
+			// If an object definition's metadata includes a hardcoded namespace field, the field will be stripped out of
+			// the definition during template instantiation.  Namespace fields that contain a ${PARAMETER_REFERENCE}
+			// will be left in place, resolved during parameter substition, and the object will be created in the
+			// referenced namespace.
+			stripNamespace(item)
+
+			newItem, err := p.SubstituteParameters(paramMap, item)
+			if err != nil {
+				templateErrors = append(templateErrors, field.Invalid(idxPath.Child("parameters"), template.Parameters, err.Error()))
+			}
+			if err := util.AddObjectLabels(newItem, template.ObjectLabels); err != nil {
+				templateErrors = append(templateErrors, field.Invalid(idxPath.Child("labels"),
+					template.ObjectLabels, fmt.Sprintf("label could not be applied: %v", err)))
+			}
+			objects = append(objects, newItem)
 		}
-		template.Objects[i] = newItem
 	}
+	template.Objects = objects
 
 	return templateErrors
 }
 
+// evaluateObjectControls applies the object's condition and copies annotations,
+// if present, against the template parameters. It returns no objects when the
+// condition evaluates to false, and one copy of the object per requested
+// repetition otherwise, with each copy's zero-based index exposed through the
+// COPY_INDEX parameter. The annotations are stripped from the returned objects.
+func (p *Processor) evaluateObjectControls(params map[string]api.Parameter, obj runtime.Object) ([]runtime.Object, error) {
+	accessor, err := meta.Accessor(obj)
+	if err != nil {
+		// objects without metadata cannot carry the annotations
+		return []runtime.Object{obj}, nil
+	}
+	annotations := accessor.GetAnnotations()
+
+	if condition, ok := annotations[api.ConditionAnnotation]; ok {
+		value, _ := p.EvaluateParameterSubstitution(params, condition)
+		include, err := strconv.ParseBool(strings.TrimSpace(value))
+		if err != nil {
+			return nil, fmt.Errorf("annotation %s must evaluate to a boolean, got %q", api.ConditionAnnotation, value)
+		}
+		delete(annotations, api.ConditionAnnotation)
+		accessor.SetAnnotations(annotations)
+		if !include {
+			return nil, nil
+		}
+	}
+
+	copies, ok := annotations[api.CopiesAnnotation]
+	if !ok {
+		return []runtime.Object{obj}, nil
+	}
+	value, _ := p.EvaluateParameterSubstitution(params, copies)
+	count, err := strconv.Atoi(strings.TrimSpace(value))
+	if err != nil || count < 0 {
+		return nil, fmt.Errorf("annotation %s must evaluate to a non-negative integer, got %q", api.CopiesAnnotation, value)
+	}
+	delete(annotations, api.CopiesAnnotation)
+	accessor.SetAnnotations(annotations)
+
+	indexParam := map[string]api.Parameter{}
+	objects := []runtime.Object{}
+	for index := 0; index < count; index++ {
+		copied, err := kapi.Scheme.Copy(obj)
+		if err != nil {
+			return nil, fmt.Errorf("unable to copy object: %v", err)
+		}
+		indexParam[api.CopyIndexParameterName] = api.Parameter{Name: api.CopyIndexParameterName, Value: strconv.Itoa(index)}
+		stringreplace.VisitObjectStrings(copied, func(in string) (string, bool) {
+			return p.EvaluateParameterSubstitution(indexParam, in)
+		})
+		objects = append(objects, copied)
+	}
+	return objects, nil
+}
+
 func stripNamespace(obj runtime.Object) {
 	// Remove namespace from the item unless it contains a ${PARAMETER_REFERENCE}
 	if itemMeta, err := meta.Accessor(obj); err == nil && len(itemMeta.GetNamespace()) > 0 && !stringParameterExp.MatchString(itemMeta.GetNamespace()) {
@@ -188,37 +261,116 @@ func (p *Processor) SubstituteParameters(params map[string]api.Parameter, item r
 // "0x[A-F0-9]{4}"  | "0xB3AF"
 // "[a-zA-Z0-9]{8}" | "hW4yQU5i"
 // If an error occurs, the parameter that caused the error is returned along with the error message.
+//
+// A From expression may reference other parameters by name ("${APP_NAME}-[a-z0-9]{5}") or
+// through the base64 and sha256 functions ("${sha256(MASTER_PASSWORD)}"). Parameters are
+// resolved in dependency order, so the referenced parameter may itself be generated.
 func (p *Processor) GenerateParameterValues(t *api.Template) *field.Error {
-	for i := range t.Parameters {
-		param := &t.Parameters[i]
-		if len(param.Value) > 0 {
-			continue
-		}
-		templatePath := field.NewPath("template").Child("parameters").Index(i)
-		if param.Generate != "" {
-			generator, ok := p.Generators[param.Generate]
-			if !ok {
-				err := fmt.Errorf("Unknown generator name '%v' for parameter %s", param.Generate, param.Name)
-				return field.Invalid(templatePath, param.Generate, err.Error())
+	resolved := make([]bool, len(t.Parameters))
+	for remaining := len(t.Parameters); remaining > 0; {
+		progress := false
+		for i := range t.Parameters {
+			if resolved[i] {
+				continue
 			}
-			if generator == nil {
-				err := fmt.Errorf("template.parameters[%v]: Invalid '%v' generator for parameter %s", i, param.Generate, param.Name)
-				return field.Invalid(templatePath, param, err.Error())
+			param := &t.Parameters[i]
+			templatePath := field.NewPath("template").Child("parameters").Index(i)
+			if len(param.Value) > 0 {
+				resolved[i], progress, remaining = true, true, remaining-1
+				continue
 			}
-			value, err := generator.GenerateValue(param.From)
-			if err != nil {
-				return field.Invalid(templatePath, param, err.Error())
+			if param.Generate != "" {
+				// wait until every parameter referenced by the expression has a value
+				if !referencesResolved(t, resolved, param.From) {
+					continue
+				}
+				generator, ok := p.Generators[param.Generate]
+				if !ok {
+					err := fmt.Errorf("Unknown generator name '%v' for parameter %s", param.Generate, param.Name)
+					return field.Invalid(templatePath, param.Generate, err.Error())
+				}
+				if generator == nil {
+					err := fmt.Errorf("template.parameters[%v]: Invalid '%v' generator for parameter %s", i, param.Generate, param.Name)
+					return field.Invalid(templatePath, param, err.Error())
+				}
+				value, err := generator.GenerateValue(expandParameterReferences(t, param.From))
+				if err != nil {
+					return field.Invalid(templatePath, param, err.Error())
+				}
+				param.Value, ok = value.(string)
+				if !ok {
+					err := fmt.Errorf("template.parameters[%v]: Unable to convert the generated value '%#v' to string for parameter %s", i, value, param.Name)
+					return field.Invalid(templatePath, param, err.Error())
+				}
 			}
-			param.Value, ok = value.(string)
-			if !ok {
-				err := fmt.Errorf("template.parameters[%v]: Unable to convert the generated value '%#v' to string for parameter %s", i, value, param.Name)
-				return field.Invalid(templatePath, param, err.Error())
+			if len(param.Value) == 0 && param.Required {
+				err := fmt.Errorf("template.parameters[%v]: parameter %s is required and must be specified", i, param.Name)
+				return field.Required(templatePath, err.Error())
 			}
+			resolved[i], progress, remaining = true, true, remaining-1
 		}
-		if len(param.Value) == 0 && param.Required {
-			err := fmt.Errorf("template.parameters[%v]: parameter %s is required and must be specified", i, param.Name)
-			return field.Required(templatePath, err.Error())
+		if !progress {
+			for i := range t.Parameters {
+				if !resolved[i] {
+					param := &t.Parameters[i]
+					err := fmt.Errorf("template.parameters[%v]: circular reference in generator expression for parameter %s", i, param.Name)
+					return field.Invalid(field.NewPath("template").Child("parameters").Index(i), param.From, err.Error())
+				}
+			}
 		}
 	}
 	return nil
 }
+
+// parameterReferences returns the names of the parameters referenced by the given
+// generator expression
+func parameterReferences(expression string) []string {
+	refs := []string{}
+	for _, match := range stringParameterExp.FindAllStringSubmatch(expression, -1) {
+		refs = append(refs, match[1])
+	}
+	for _, match := range functionParameterExp.FindAllStringSubmatch(expression, -1) {
+		refs = append(refs, match[2])
+	}
+	return refs
+}
+
+// referencesResolved returns true if every parameter referenced by the given generator
+// expression has been resolved. References to parameters that are not defined in the
+// template are ignored and left in place.
+func referencesResolved(t *api.Template, resolved []bool, expression string) bool {
+	for _, name := range parameterReferences(expression) {
+		for i := range t.Parameters {
+			if t.Parameters[i].Name == name && !resolved[i] {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// expandParameterReferences substitutes ${NAME}, ${base64(NAME)} and ${sha256(NAME)}
+// occurrences in the given generator expression with the referenced parameter values
+func expandParameterReferences(t *api.Template, expression string) string {
+	out := expression
+	for _, match := range functionParameterExp.FindAllStringSubmatch(expression, -1) {
+		param := GetParameterByName(t, match[2])
+		if param == nil {
+			continue
+		}
+		value := ""
+		switch match[1] {
+		case "base64":
+			value = base64.StdEncoding.EncodeToString([]byte(param.Value))
+		case "sha256":
+			value = fmt.Sprintf("%x", sha256.Sum256([]byte(param.Value)))
+		}
+		out = strings.Replace(out, match[0], value, 1)
+	}
+	for _, match := range stringParameterExp.FindAllStringSubmatch(expression, -1) {
+		if param := GetParameterByName(t, match[1]); param != nil {
+			out = strings.Replace(out, match[0], param.Value, 1)
+		}
+	}
+	return out
+}
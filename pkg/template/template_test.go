@@ -10,6 +10,7 @@ import (
 	"testing"
 
 	kapi "k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/api/meta"
 	"k8s.io/kubernetes/pkg/runtime"
 	"k8s.io/kubernetes/pkg/util/diff"
 	"k8s.io/kubernetes/pkg/util/validation/field"
@@ -177,6 +178,79 @@ func TestParameterGenerators(t *testing.T) {
 	}
 }
 
+func TestParameterReferenceGenerators(t *testing.T) {
+	tests := []struct {
+		name       string
+		parameters []api.Parameter
+		expected   map[string]string
+		errType    field.ErrorType
+	}{
+		{
+			name: "reference resolved in dependency order",
+			parameters: []api.Parameter{
+				{Name: "DERIVED", Generate: "expression", From: "${BASE}-db"},
+				{Name: "BASE", Generate: "expression", From: "myapp"},
+			},
+			expected: map[string]string{"BASE": "myapp", "DERIVED": "myapp-db"},
+		},
+		{
+			name: "base64 function",
+			parameters: []api.Parameter{
+				{Name: "ENCODED", Generate: "expression", From: "${base64(SECRET)}"},
+				{Name: "SECRET", Value: "password"},
+			},
+			expected: map[string]string{"ENCODED": "cGFzc3dvcmQ="},
+		},
+		{
+			name: "sha256 function",
+			parameters: []api.Parameter{
+				{Name: "HASHED", Generate: "expression", From: "${sha256(SECRET)}"},
+				{Name: "SECRET", Value: "password"},
+			},
+			expected: map[string]string{"HASHED": "5e884898da28047151d0e56f8dc6292773603d0d6aabbdd62a11ef721d1542d8"},
+		},
+		{
+			name: "undefined reference is left in place",
+			parameters: []api.Parameter{
+				{Name: "DERIVED", Generate: "expression", From: "${MISSING}-db"},
+			},
+			expected: map[string]string{"DERIVED": "${MISSING}-db"},
+		},
+		{
+			name: "circular reference fails",
+			parameters: []api.Parameter{
+				{Name: "A", Generate: "expression", From: "${B}"},
+				{Name: "B", Generate: "expression", From: "${A}"},
+			},
+			errType: field.ErrorTypeInvalid,
+		},
+	}
+
+	for _, test := range tests {
+		processor := NewProcessor(map[string]generator.Generator{
+			"expression": generator.NewExpressionValueGenerator(rand.New(rand.NewSource(1337))),
+		})
+		template := api.Template{Parameters: test.parameters}
+		err := processor.GenerateParameterValues(&template)
+		if test.errType != "" {
+			if err == nil || err.Type != test.errType {
+				t.Errorf("%s: expected error of type %s, got %v", test.name, test.errType, err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s: unexpected error %v", test.name, err)
+			continue
+		}
+		for name, value := range test.expected {
+			param := GetParameterByName(&template, name)
+			if param == nil || param.Value != value {
+				t.Errorf("%s: expected parameter %s to have value %q, got %#v", test.name, name, value, param)
+			}
+		}
+	}
+}
+
 func TestProcessValue(t *testing.T) {
 	var template api.Template
 	if err := runtime.DecodeInto(kapi.Codecs.UniversalDecoder(), []byte(`{
@@ -411,3 +485,100 @@ func TestProcessTemplateParameters(t *testing.T) {
 		t.Errorf("unexpected output: %s", diff.StringDiff(string(exp), string(result)))
 	}
 }
+
+func TestProcessObjectConditionsAndCopies(t *testing.T) {
+	var template api.Template
+	if err := runtime.DecodeInto(kapi.Codecs.UniversalDecoder(), []byte(`{
+		"kind":"Template", "apiVersion":"v1",
+		"objects": [
+			{
+				"kind": "Route", "apiVersion": "v1",
+				"metadata": {"name": "frontend", "annotations": {"template.openshift.io/condition": "${CREATE_ROUTE}"}}
+			},
+			{
+				"kind": "Service", "apiVersion": "v1",
+				"metadata": {"name": "frontend", "annotations": {"template.openshift.io/condition": "${CREATE_SERVICE}", "keep": "me"}}
+			},
+			{
+				"kind": "Pod", "apiVersion": "v1",
+				"metadata": {"name": "worker-${COPY_INDEX}", "annotations": {"template.openshift.io/copies": "${WORKER_COUNT}"}}
+			}
+		]
+	}`), &template); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	AddParameter(&template, makeParameter("CREATE_ROUTE", "false", "", false))
+	AddParameter(&template, makeParameter("CREATE_SERVICE", "true", "", false))
+	AddParameter(&template, makeParameter("WORKER_COUNT", "2", "", false))
+
+	generators := map[string]generator.Generator{
+		"expression": generator.NewExpressionValueGenerator(rand.New(rand.NewSource(1337))),
+	}
+	processor := NewProcessor(generators)
+	if errs := processor.Process(&template); len(errs) > 0 {
+		t.Fatalf("unexpected error: %v", errs)
+	}
+
+	expectedNames := []string{"frontend", "worker-0", "worker-1"}
+	if len(template.Objects) != len(expectedNames) {
+		t.Fatalf("expected %d objects, got %#v", len(expectedNames), template.Objects)
+	}
+	for i, obj := range template.Objects {
+		accessor, err := meta.Accessor(obj)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if accessor.GetName() != expectedNames[i] {
+			t.Errorf("expected object %d to be named %s, got %s", i, expectedNames[i], accessor.GetName())
+		}
+		annotations := accessor.GetAnnotations()
+		if _, ok := annotations[api.ConditionAnnotation]; ok {
+			t.Errorf("expected the condition annotation to be stripped from object %d", i)
+		}
+		if _, ok := annotations[api.CopiesAnnotation]; ok {
+			t.Errorf("expected the copies annotation to be stripped from object %d", i)
+		}
+	}
+	service, err := meta.Accessor(template.Objects[0])
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if service.GetAnnotations()["keep"] != "me" {
+		t.Errorf("expected unrelated annotations to be kept, got %v", service.GetAnnotations())
+	}
+}
+
+func TestProcessObjectConditionsAndCopiesErrors(t *testing.T) {
+	tests := map[string]string{
+		"condition must be a boolean": `{
+			"kind":"Template", "apiVersion":"v1",
+			"objects": [
+				{
+					"kind": "Service", "apiVersion": "v1",
+					"metadata": {"name": "frontend", "annotations": {"template.openshift.io/condition": "not-a-bool"}}
+				}
+			]
+		}`,
+		"copies must be a non-negative integer": `{
+			"kind":"Template", "apiVersion":"v1",
+			"objects": [
+				{
+					"kind": "Pod", "apiVersion": "v1",
+					"metadata": {"name": "worker", "annotations": {"template.openshift.io/copies": "-1"}}
+				}
+			]
+		}`,
+	}
+
+	for name, input := range tests {
+		var template api.Template
+		if err := runtime.DecodeInto(kapi.Codecs.UniversalDecoder(), []byte(input), &template); err != nil {
+			t.Fatalf("%s: unexpected error: %v", name, err)
+		}
+		processor := NewProcessor(map[string]generator.Generator{})
+		if errs := processor.Process(&template); len(errs) == 0 {
+			t.Errorf("%s: expected an error", name)
+		}
+	}
+}
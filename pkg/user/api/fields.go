@@ -10,6 +10,15 @@ func GroupToSelectableFields(group *Group) fields.Set {
 	}
 }
 
+// GroupMappingToSelectableFields returns a label set that represents the object
+// changes to the returned keys require registering conversions for existing versions using Scheme.AddFieldLabelConversionFunc
+func GroupMappingToSelectableFields(mapping *GroupMapping) fields.Set {
+	return fields.Set{
+		"metadata.name": mapping.Name,
+		"providerName":  mapping.ProviderName,
+	}
+}
+
 // IdentityToSelectableFields returns a label set that represents the object
 // changes to the returned keys require registering conversions for existing versions using Scheme.AddFieldLabelConversionFunc
 func IdentityToSelectableFields(identity *Identity) fields.Set {
@@ -22,6 +31,14 @@ func IdentityToSelectableFields(identity *Identity) fields.Set {
 	}
 }
 
+// UserPreferenceToSelectableFields returns a label set that represents the object
+// changes to the returned keys require registering conversions for existing versions using Scheme.AddFieldLabelConversionFunc
+func UserPreferenceToSelectableFields(preference *UserPreference) fields.Set {
+	return fields.Set{
+		"metadata.name": preference.Name,
+	}
+}
+
 // UserToSelectableFields returns a label set that represents the object
 // changes to the returned keys require registering conversions for existing versions using Scheme.AddFieldLabelConversionFunc
 func UserToSelectableFields(user *User) fields.Set {
@@ -15,7 +15,7 @@ func init() {
 			VersionPreferenceOrder:     []string{v1.SchemeGroupVersion.Version},
 			ImportPrefix:               importPrefix,
 			AddInternalObjectsToScheme: api.AddToScheme,
-			RootScopedKinds:            sets.NewString("User", "Identity", "UserIdentityMapping", "Group"),
+			RootScopedKinds:            sets.NewString("User", "Identity", "UserIdentityMapping", "Group", "GroupMapping", "UserPreference"),
 		},
 		announced.VersionToSchemeFunc{
 			v1.SchemeGroupVersion.Version: v1.AddToScheme,
@@ -88,7 +88,7 @@ func addVersionsToScheme(externalVersions ...unversioned.GroupVersion) {
 }
 
 func newRESTMapper(externalVersions []unversioned.GroupVersion) meta.RESTMapper {
-	rootScoped := sets.NewString("User", "Identity", "UserIdentityMapping", "Group")
+	rootScoped := sets.NewString("User", "Identity", "UserIdentityMapping", "Group", "GroupMapping", "UserPreference")
 	ignoredKinds := sets.NewString()
 	return kapi.NewDefaultRESTMapper(externalVersions, interfacesFor, importPrefix, ignoredKinds, rootScoped)
 }
@@ -65,6 +65,10 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 		&UserIdentityMapping{},
 		&Group{},
 		&GroupList{},
+		&GroupMapping{},
+		&GroupMappingList{},
+		&UserPreference{},
+		&UserPreferenceList{},
 	}
 	scheme.AddKnownTypes(SchemeGroupVersion,
 		append(types,
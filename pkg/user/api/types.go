@@ -71,3 +71,60 @@ type GroupList struct {
 	unversioned.ListMeta
 	Items []Group
 }
+
+// GroupMapping declaratively maps identity provider attributes to Groups. Mappings
+// are evaluated every time an identity logs in, so group membership stays current
+// without a periodic sync job.
+type GroupMapping struct {
+	unversioned.TypeMeta
+	kapi.ObjectMeta
+
+	// ProviderName restricts the mapping to identities from the named identity
+	// provider. If empty, identities from all providers are evaluated.
+	ProviderName string
+
+	// Attribute is the name of the identity attribute whose values are evaluated,
+	// e.g. an LDAP attribute or an OIDC claim recorded on the identity.
+	Attribute string
+
+	// Pattern is an optional anchored regular expression an attribute value must
+	// match for the mapping to apply. If empty, every value of the attribute applies.
+	Pattern string
+
+	// GroupNameFormat determines the name of the group a matching value maps to.
+	// It is expanded with the submatches of Pattern, e.g. "ldap-$1". If empty, the
+	// attribute value itself is used as the group name.
+	GroupNameFormat string
+}
+
+type GroupMappingList struct {
+	unversioned.TypeMeta
+	unversioned.ListMeta
+	Items []GroupMapping
+}
+
+// UserPreference holds per-user client settings so the web console and oc can
+// share them across sessions and machines. Each object is named after the user
+// it belongs to; users read and write their own preferences via the "~" name.
+type UserPreference struct {
+	unversioned.TypeMeta
+	kapi.ObjectMeta
+
+	// DefaultProject is the project clients should select when none is specified
+	DefaultProject string
+
+	// OutputFormat is the preferred output format for commands that support -o
+	OutputFormat string
+
+	// FavoriteCommands is an ordered list of commands the user has marked as favorites
+	FavoriteCommands []string
+
+	// Extra holds additional free-form settings, e.g. console display options
+	Extra map[string]string
+}
+
+type UserPreferenceList struct {
+	unversioned.TypeMeta
+	unversioned.ListMeta
+	Items []UserPreference
+}
@@ -14,6 +14,12 @@ func addConversionFuncs(scheme *runtime.Scheme) error {
 		return err
 	}
 
+	if err := scheme.AddFieldLabelConversionFunc("v1", "GroupMapping",
+		oapi.GetFieldLabelConversionFunc(api.GroupMappingToSelectableFields(&api.GroupMapping{}), nil),
+	); err != nil {
+		return err
+	}
+
 	if err := scheme.AddFieldLabelConversionFunc("v1", "Identity",
 		oapi.GetFieldLabelConversionFunc(api.IdentityToSelectableFields(&api.Identity{}), nil),
 	); err != nil {
@@ -25,5 +31,11 @@ func addConversionFuncs(scheme *runtime.Scheme) error {
 	); err != nil {
 		return err
 	}
+
+	if err := scheme.AddFieldLabelConversionFunc("v1", "UserPreference",
+		oapi.GetFieldLabelConversionFunc(api.UserPreferenceToSelectableFields(&api.UserPreference{}), nil),
+	); err != nil {
+		return err
+	}
 	return nil
 }
@@ -112,3 +112,68 @@ type GroupList struct {
 	// Items is the list of groups
 	Items []Group `json:"items" protobuf:"bytes,2,rep,name=items"`
 }
+
+// GroupMapping declaratively maps identity provider attributes to Groups. Mappings
+// are evaluated every time an identity logs in, so group membership stays current
+// without a periodic sync job.
+type GroupMapping struct {
+	unversioned.TypeMeta `json:",inline"`
+	// Standard object's metadata.
+	kapi.ObjectMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+
+	// ProviderName restricts the mapping to identities from the named identity
+	// provider. If empty, identities from all providers are evaluated.
+	ProviderName string `json:"providerName,omitempty" protobuf:"bytes,2,opt,name=providerName"`
+
+	// Attribute is the name of the identity attribute whose values are evaluated,
+	// e.g. an LDAP attribute or an OIDC claim recorded on the identity.
+	Attribute string `json:"attribute" protobuf:"bytes,3,opt,name=attribute"`
+
+	// Pattern is an optional anchored regular expression an attribute value must
+	// match for the mapping to apply. If empty, every value of the attribute applies.
+	Pattern string `json:"pattern,omitempty" protobuf:"bytes,4,opt,name=pattern"`
+
+	// GroupNameFormat determines the name of the group a matching value maps to.
+	// It is expanded with the submatches of Pattern, e.g. "ldap-$1". If empty, the
+	// attribute value itself is used as the group name.
+	GroupNameFormat string `json:"groupNameFormat,omitempty" protobuf:"bytes,5,opt,name=groupNameFormat"`
+}
+
+// GroupMappingList is a collection of GroupMappings
+type GroupMappingList struct {
+	unversioned.TypeMeta `json:",inline"`
+	// Standard object's metadata.
+	unversioned.ListMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+	// Items is the list of group mappings
+	Items []GroupMapping `json:"items" protobuf:"bytes,2,rep,name=items"`
+}
+
+// UserPreference holds per-user client settings so the web console and oc can
+// share them across sessions and machines. Each object is named after the user
+// it belongs to; users read and write their own preferences via the "~" name.
+type UserPreference struct {
+	unversioned.TypeMeta `json:",inline"`
+	// Standard object's metadata.
+	kapi.ObjectMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+
+	// DefaultProject is the project clients should select when none is specified
+	DefaultProject string `json:"defaultProject,omitempty" protobuf:"bytes,2,opt,name=defaultProject"`
+
+	// OutputFormat is the preferred output format for commands that support -o
+	OutputFormat string `json:"outputFormat,omitempty" protobuf:"bytes,3,opt,name=outputFormat"`
+
+	// FavoriteCommands is an ordered list of commands the user has marked as favorites
+	FavoriteCommands []string `json:"favoriteCommands,omitempty" protobuf:"bytes,4,rep,name=favoriteCommands"`
+
+	// Extra holds additional free-form settings, e.g. console display options
+	Extra map[string]string `json:"extra,omitempty" protobuf:"bytes,5,rep,name=extra"`
+}
+
+// UserPreferenceList is a collection of UserPreferences
+type UserPreferenceList struct {
+	unversioned.TypeMeta `json:",inline"`
+	// Standard object's metadata.
+	unversioned.ListMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+	// Items is the list of user preferences
+	Items []UserPreference `json:"items" protobuf:"bytes,2,rep,name=items"`
+}
@@ -2,6 +2,7 @@ package validation
 
 import (
 	"fmt"
+	"regexp"
 	"strings"
 
 	kvalidation "k8s.io/kubernetes/pkg/api/validation"
@@ -97,6 +98,43 @@ func ValidateGroupUpdate(group *api.Group, old *api.Group) field.ErrorList {
 	return allErrs
 }
 
+func ValidateGroupMapping(mapping *api.GroupMapping) field.ErrorList {
+	allErrs := kvalidation.ValidateObjectMeta(&mapping.ObjectMeta, false, path.ValidatePathSegmentName, field.NewPath("metadata"))
+
+	if len(mapping.ProviderName) > 0 {
+		if reasons := ValidateIdentityProviderName(mapping.ProviderName); len(reasons) != 0 {
+			allErrs = append(allErrs, field.Invalid(field.NewPath("providerName"), mapping.ProviderName, strings.Join(reasons, ", ")))
+		}
+	}
+
+	if len(mapping.Attribute) == 0 {
+		allErrs = append(allErrs, field.Required(field.NewPath("attribute"), ""))
+	}
+
+	if len(mapping.Pattern) > 0 {
+		if _, err := regexp.Compile(mapping.Pattern); err != nil {
+			allErrs = append(allErrs, field.Invalid(field.NewPath("pattern"), mapping.Pattern, err.Error()))
+		}
+	} else if strings.Contains(mapping.GroupNameFormat, "$") {
+		allErrs = append(allErrs, field.Invalid(field.NewPath("groupNameFormat"), mapping.GroupNameFormat, "may not reference submatches when pattern is not set"))
+	}
+
+	// A format without submatch references must itself be a valid group name
+	if len(mapping.GroupNameFormat) > 0 && !strings.Contains(mapping.GroupNameFormat, "$") {
+		if reasons := ValidateGroupName(mapping.GroupNameFormat, false); len(reasons) != 0 {
+			allErrs = append(allErrs, field.Invalid(field.NewPath("groupNameFormat"), mapping.GroupNameFormat, strings.Join(reasons, ", ")))
+		}
+	}
+
+	return allErrs
+}
+
+func ValidateGroupMappingUpdate(mapping *api.GroupMapping, old *api.GroupMapping) field.ErrorList {
+	allErrs := kvalidation.ValidateObjectMetaUpdate(&mapping.ObjectMeta, &old.ObjectMeta, field.NewPath("metadata"))
+	allErrs = append(allErrs, ValidateGroupMapping(mapping)...)
+	return allErrs
+}
+
 func ValidateUser(user *api.User) field.ErrorList {
 	allErrs := kvalidation.ValidateObjectMeta(&user.ObjectMeta, false, ValidateUserName, field.NewPath("metadata"))
 	identitiesPath := field.NewPath("identities")
@@ -128,6 +166,32 @@ func ValidateUserUpdate(user *api.User, old *api.User) field.ErrorList {
 	return allErrs
 }
 
+func ValidateUserPreference(preference *api.UserPreference) field.ErrorList {
+	// The name of a preference object is the name of the user it belongs to
+	allErrs := kvalidation.ValidateObjectMeta(&preference.ObjectMeta, false, ValidateUserName, field.NewPath("metadata"))
+
+	if len(preference.DefaultProject) > 0 {
+		if reasons := kvalidation.ValidateNamespaceName(preference.DefaultProject, false); len(reasons) != 0 {
+			allErrs = append(allErrs, field.Invalid(field.NewPath("defaultProject"), preference.DefaultProject, strings.Join(reasons, ", ")))
+		}
+	}
+
+	favoritesPath := field.NewPath("favoriteCommands")
+	for index, command := range preference.FavoriteCommands {
+		if len(command) == 0 {
+			allErrs = append(allErrs, field.Invalid(favoritesPath.Index(index), command, "may not be empty"))
+		}
+	}
+
+	return allErrs
+}
+
+func ValidateUserPreferenceUpdate(preference *api.UserPreference, old *api.UserPreference) field.ErrorList {
+	allErrs := kvalidation.ValidateObjectMetaUpdate(&preference.ObjectMeta, &old.ObjectMeta, field.NewPath("metadata"))
+	allErrs = append(allErrs, ValidateUserPreference(preference)...)
+	return allErrs
+}
+
 func ValidateIdentity(identity *api.Identity) field.ErrorList {
 	allErrs := kvalidation.ValidateObjectMeta(&identity.ObjectMeta, false, ValidateIdentityName, field.NewPath("metadata"))
 
@@ -0,0 +1,35 @@
+package etcd
+
+import (
+	"k8s.io/kubernetes/pkg/registry/generic/registry"
+	"k8s.io/kubernetes/pkg/runtime"
+	"k8s.io/kubernetes/pkg/storage"
+
+	"github.com/openshift/origin/pkg/user/api"
+	"github.com/openshift/origin/pkg/user/registry/groupmapping"
+	"github.com/openshift/origin/pkg/util/restoptions"
+)
+
+// REST implements a RESTStorage for group mappings against etcd
+type REST struct {
+	*registry.Store
+}
+
+// NewREST returns a RESTStorage object that will work against group mappings
+func NewREST(optsGetter restoptions.Getter) (*REST, error) {
+	store := &registry.Store{
+		NewFunc:           func() runtime.Object { return &api.GroupMapping{} },
+		NewListFunc:       func() runtime.Object { return &api.GroupMappingList{} },
+		PredicateFunc:     groupmapping.Matcher,
+		QualifiedResource: api.Resource("groupmappings"),
+
+		CreateStrategy: groupmapping.Strategy,
+		UpdateStrategy: groupmapping.Strategy,
+	}
+
+	if err := restoptions.ApplyOptions(optsGetter, store, storage.NoTriggerPublisher); err != nil {
+		return nil, err
+	}
+
+	return &REST{store}, nil
+}
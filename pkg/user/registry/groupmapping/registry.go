@@ -0,0 +1,82 @@
+package groupmapping
+
+import (
+	kapi "k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/api/rest"
+	"k8s.io/kubernetes/pkg/watch"
+
+	"github.com/openshift/origin/pkg/user/api"
+)
+
+// Registry is an interface implemented by things that know how to store GroupMapping objects.
+type Registry interface {
+	// ListGroupMappings obtains a list of group mappings having labels which match selector.
+	ListGroupMappings(ctx kapi.Context, options *kapi.ListOptions) (*api.GroupMappingList, error)
+	// GetGroupMapping returns a specific group mapping
+	GetGroupMapping(ctx kapi.Context, name string) (*api.GroupMapping, error)
+	// CreateGroupMapping creates a group mapping
+	CreateGroupMapping(ctx kapi.Context, mapping *api.GroupMapping) (*api.GroupMapping, error)
+	// UpdateGroupMapping updates an existing group mapping
+	UpdateGroupMapping(ctx kapi.Context, mapping *api.GroupMapping) (*api.GroupMapping, error)
+	// DeleteGroupMapping deletes a name.
+	DeleteGroupMapping(ctx kapi.Context, name string) error
+	// WatchGroupMappings watches group mappings.
+	WatchGroupMappings(ctx kapi.Context, options *kapi.ListOptions) (watch.Interface, error)
+}
+
+// Storage is an interface for a standard REST Storage backend
+type Storage interface {
+	rest.StandardStorage
+}
+
+// storage puts strong typing around storage calls
+type storage struct {
+	Storage
+}
+
+// NewRegistry returns a new Registry interface for the given Storage. Any mismatched
+// types will panic.
+func NewRegistry(s Storage) Registry {
+	return &storage{s}
+}
+
+func (s *storage) ListGroupMappings(ctx kapi.Context, options *kapi.ListOptions) (*api.GroupMappingList, error) {
+	obj, err := s.List(ctx, options)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*api.GroupMappingList), nil
+}
+
+func (s *storage) GetGroupMapping(ctx kapi.Context, name string) (*api.GroupMapping, error) {
+	obj, err := s.Get(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*api.GroupMapping), nil
+}
+
+func (s *storage) CreateGroupMapping(ctx kapi.Context, mapping *api.GroupMapping) (*api.GroupMapping, error) {
+	obj, err := s.Create(ctx, mapping)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*api.GroupMapping), nil
+}
+
+func (s *storage) UpdateGroupMapping(ctx kapi.Context, mapping *api.GroupMapping) (*api.GroupMapping, error) {
+	obj, _, err := s.Update(ctx, mapping.Name, rest.DefaultUpdatedObjectInfo(mapping, kapi.Scheme))
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*api.GroupMapping), nil
+}
+
+func (s *storage) DeleteGroupMapping(ctx kapi.Context, name string) error {
+	_, err := s.Delete(ctx, name, nil)
+	return err
+}
+
+func (s *storage) WatchGroupMappings(ctx kapi.Context, options *kapi.ListOptions) (watch.Interface, error) {
+	return s.Watch(ctx, options)
+}
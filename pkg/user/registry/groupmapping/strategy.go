@@ -0,0 +1,84 @@
+package groupmapping
+
+import (
+	"fmt"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/fields"
+	"k8s.io/kubernetes/pkg/labels"
+	"k8s.io/kubernetes/pkg/runtime"
+	kstorage "k8s.io/kubernetes/pkg/storage"
+	"k8s.io/kubernetes/pkg/util/validation/field"
+
+	"github.com/openshift/origin/pkg/user/api"
+	"github.com/openshift/origin/pkg/user/api/validation"
+)
+
+// groupMappingStrategy implements behavior for GroupMappings
+type groupMappingStrategy struct {
+	runtime.ObjectTyper
+}
+
+// Strategy is the default logic that applies when creating and updating GroupMapping
+// objects via the REST API.
+var Strategy = groupMappingStrategy{kapi.Scheme}
+
+func (groupMappingStrategy) PrepareForUpdate(ctx kapi.Context, obj, old runtime.Object) {}
+
+// NamespaceScoped is false for group mappings
+func (groupMappingStrategy) NamespaceScoped() bool {
+	return false
+}
+
+func (groupMappingStrategy) GenerateName(base string) string {
+	return base
+}
+
+func (groupMappingStrategy) PrepareForCreate(ctx kapi.Context, obj runtime.Object) {
+}
+
+// Validate validates a new group mapping
+func (groupMappingStrategy) Validate(ctx kapi.Context, obj runtime.Object) field.ErrorList {
+	return validation.ValidateGroupMapping(obj.(*api.GroupMapping))
+}
+
+// AllowCreateOnUpdate is false for group mappings
+func (groupMappingStrategy) AllowCreateOnUpdate() bool {
+	return false
+}
+
+func (groupMappingStrategy) AllowUnconditionalUpdate() bool {
+	return false
+}
+
+// Canonicalize normalizes the object after validation.
+func (groupMappingStrategy) Canonicalize(obj runtime.Object) {
+}
+
+// ValidateUpdate is the default update validation for an end group mapping.
+func (groupMappingStrategy) ValidateUpdate(ctx kapi.Context, obj, old runtime.Object) field.ErrorList {
+	return validation.ValidateGroupMappingUpdate(obj.(*api.GroupMapping), old.(*api.GroupMapping))
+}
+
+// GetAttrs returns labels and fields of a given object for filtering purposes
+func GetAttrs(o runtime.Object) (labels.Set, fields.Set, error) {
+	obj, ok := o.(*api.GroupMapping)
+	if !ok {
+		return nil, nil, fmt.Errorf("not a GroupMapping")
+	}
+	return labels.Set(obj.Labels), SelectableFields(obj), nil
+}
+
+// Matcher returns a generic matcher for a given label and field selector.
+func Matcher(label labels.Selector, field fields.Selector) kstorage.SelectionPredicate {
+	return kstorage.SelectionPredicate{
+		Label:    label,
+		Field:    field,
+		GetAttrs: GetAttrs,
+	}
+}
+
+// SelectableFields returns a field set that can be used for filter selection
+func SelectableFields(obj *api.GroupMapping) fields.Set {
+	return api.GroupMappingToSelectableFields(obj)
+}
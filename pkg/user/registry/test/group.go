@@ -0,0 +1,80 @@
+package test
+
+import (
+	kapi "k8s.io/kubernetes/pkg/api"
+	kerrs "k8s.io/kubernetes/pkg/api/errors"
+	"k8s.io/kubernetes/pkg/watch"
+
+	"github.com/openshift/origin/pkg/user/api"
+)
+
+type GroupRegistry struct {
+	GetErr map[string]error
+	Get    map[string]*api.Group
+
+	CreateErr error
+	Create    *api.Group
+
+	UpdateErr map[string]error
+	Update    *api.Group
+
+	ListErr error
+	List    *api.GroupList
+
+	Actions *[]Action
+}
+
+func NewGroupRegistry() *GroupRegistry {
+	return &GroupRegistry{
+		GetErr:    map[string]error{},
+		Get:       map[string]*api.Group{},
+		UpdateErr: map[string]error{},
+		Actions:   &[]Action{},
+	}
+}
+
+func (r *GroupRegistry) GetGroup(ctx kapi.Context, name string) (*api.Group, error) {
+	*r.Actions = append(*r.Actions, Action{"GetGroup", name})
+	if group, ok := r.Get[name]; ok {
+		return group, nil
+	}
+	if err, ok := r.GetErr[name]; ok {
+		return nil, err
+	}
+	return nil, kerrs.NewNotFound(api.Resource("group"), name)
+}
+
+func (r *GroupRegistry) CreateGroup(ctx kapi.Context, g *api.Group) (*api.Group, error) {
+	*r.Actions = append(*r.Actions, Action{"CreateGroup", g})
+	if r.Create == nil && r.CreateErr == nil {
+		return g, nil
+	}
+	return r.Create, r.CreateErr
+}
+
+func (r *GroupRegistry) UpdateGroup(ctx kapi.Context, g *api.Group) (*api.Group, error) {
+	*r.Actions = append(*r.Actions, Action{"UpdateGroup", g})
+	err, _ := r.UpdateErr[g.Name]
+	if r.Update == nil && err == nil {
+		return g, nil
+	}
+	return r.Update, err
+}
+
+func (r *GroupRegistry) DeleteGroup(ctx kapi.Context, name string) error {
+	*r.Actions = append(*r.Actions, Action{"DeleteGroup", name})
+	return nil
+}
+
+func (r *GroupRegistry) ListGroups(ctx kapi.Context, options *kapi.ListOptions) (*api.GroupList, error) {
+	*r.Actions = append(*r.Actions, Action{"ListGroups", options})
+	if r.List == nil && r.ListErr == nil {
+		return &api.GroupList{}, nil
+	}
+	return r.List, r.ListErr
+}
+
+func (r *GroupRegistry) WatchGroups(ctx kapi.Context, options *kapi.ListOptions) (watch.Interface, error) {
+	*r.Actions = append(*r.Actions, Action{"WatchGroups", options})
+	return nil, nil
+}
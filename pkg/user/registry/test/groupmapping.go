@@ -0,0 +1,80 @@
+package test
+
+import (
+	kapi "k8s.io/kubernetes/pkg/api"
+	kerrs "k8s.io/kubernetes/pkg/api/errors"
+	"k8s.io/kubernetes/pkg/watch"
+
+	"github.com/openshift/origin/pkg/user/api"
+)
+
+type GroupMappingRegistry struct {
+	GetErr map[string]error
+	Get    map[string]*api.GroupMapping
+
+	CreateErr error
+	Create    *api.GroupMapping
+
+	UpdateErr map[string]error
+	Update    *api.GroupMapping
+
+	ListErr error
+	List    *api.GroupMappingList
+
+	Actions *[]Action
+}
+
+func NewGroupMappingRegistry() *GroupMappingRegistry {
+	return &GroupMappingRegistry{
+		GetErr:    map[string]error{},
+		Get:       map[string]*api.GroupMapping{},
+		UpdateErr: map[string]error{},
+		Actions:   &[]Action{},
+	}
+}
+
+func (r *GroupMappingRegistry) GetGroupMapping(ctx kapi.Context, name string) (*api.GroupMapping, error) {
+	*r.Actions = append(*r.Actions, Action{"GetGroupMapping", name})
+	if mapping, ok := r.Get[name]; ok {
+		return mapping, nil
+	}
+	if err, ok := r.GetErr[name]; ok {
+		return nil, err
+	}
+	return nil, kerrs.NewNotFound(api.Resource("groupmapping"), name)
+}
+
+func (r *GroupMappingRegistry) CreateGroupMapping(ctx kapi.Context, m *api.GroupMapping) (*api.GroupMapping, error) {
+	*r.Actions = append(*r.Actions, Action{"CreateGroupMapping", m})
+	if r.Create == nil && r.CreateErr == nil {
+		return m, nil
+	}
+	return r.Create, r.CreateErr
+}
+
+func (r *GroupMappingRegistry) UpdateGroupMapping(ctx kapi.Context, m *api.GroupMapping) (*api.GroupMapping, error) {
+	*r.Actions = append(*r.Actions, Action{"UpdateGroupMapping", m})
+	err, _ := r.UpdateErr[m.Name]
+	if r.Update == nil && err == nil {
+		return m, nil
+	}
+	return r.Update, err
+}
+
+func (r *GroupMappingRegistry) DeleteGroupMapping(ctx kapi.Context, name string) error {
+	*r.Actions = append(*r.Actions, Action{"DeleteGroupMapping", name})
+	return nil
+}
+
+func (r *GroupMappingRegistry) ListGroupMappings(ctx kapi.Context, options *kapi.ListOptions) (*api.GroupMappingList, error) {
+	*r.Actions = append(*r.Actions, Action{"ListGroupMappings", options})
+	if r.List == nil && r.ListErr == nil {
+		return &api.GroupMappingList{}, nil
+	}
+	return r.List, r.ListErr
+}
+
+func (r *GroupMappingRegistry) WatchGroupMappings(ctx kapi.Context, options *kapi.ListOptions) (watch.Interface, error) {
+	*r.Actions = append(*r.Actions, Action{"WatchGroupMappings", options})
+	return nil, nil
+}
@@ -0,0 +1,102 @@
+package etcd
+
+import (
+	"errors"
+	"strings"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+	kerrs "k8s.io/kubernetes/pkg/api/errors"
+	"k8s.io/kubernetes/pkg/api/rest"
+	"k8s.io/kubernetes/pkg/registry/generic/registry"
+	"k8s.io/kubernetes/pkg/runtime"
+	"k8s.io/kubernetes/pkg/storage"
+	"k8s.io/kubernetes/pkg/util/validation/field"
+
+	"github.com/openshift/origin/pkg/user/api"
+	"github.com/openshift/origin/pkg/user/api/validation"
+	"github.com/openshift/origin/pkg/user/registry/userpreference"
+	"github.com/openshift/origin/pkg/util/restoptions"
+)
+
+// rest implements a RESTStorage for user preferences against etcd
+type REST struct {
+	*registry.Store
+}
+
+// NewREST returns a RESTStorage object that will work against user preferences
+func NewREST(optsGetter restoptions.Getter) (*REST, error) {
+	store := &registry.Store{
+		NewFunc:           func() runtime.Object { return &api.UserPreference{} },
+		NewListFunc:       func() runtime.Object { return &api.UserPreferenceList{} },
+		PredicateFunc:     userpreference.Matcher,
+		QualifiedResource: api.Resource("userpreferences"),
+
+		CreateStrategy: userpreference.Strategy,
+		UpdateStrategy: userpreference.Strategy,
+	}
+
+	if err := restoptions.ApplyOptions(optsGetter, store, storage.NoTriggerPublisher); err != nil {
+		return nil, err
+	}
+
+	return &REST{store}, nil
+}
+
+// resolveName maps "~" to the name of the currently authenticated user and
+// rejects names that cannot belong to a persisted user.
+func resolveName(ctx kapi.Context, name string) (string, error) {
+	if name == "~" {
+		user, ok := kapi.UserFrom(ctx)
+		if !ok || user.GetName() == "" {
+			return "", kerrs.NewForbidden(api.Resource("userpreference"), "~", errors.New("requests to ~ must be authenticated"))
+		}
+		name = user.GetName()
+	}
+
+	if reasons := validation.ValidateUserName(name, false); len(reasons) != 0 {
+		return "", field.Invalid(field.NewPath("metadata", "name"), name, strings.Join(reasons, ", "))
+	}
+
+	return name, nil
+}
+
+// Get retrieves the item from etcd. An empty preference object is returned for
+// users that have not stored any preferences yet.
+func (r *REST) Get(ctx kapi.Context, name string) (runtime.Object, error) {
+	name, err := resolveName(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+
+	obj, err := r.Store.Get(ctx, name)
+	if err == nil {
+		return obj, nil
+	}
+
+	if !kerrs.IsNotFound(err) {
+		return nil, err
+	}
+
+	return &api.UserPreference{ObjectMeta: kapi.ObjectMeta{Name: name}}, nil
+}
+
+// Update stores the preferences, creating them on first write. The "~" name is
+// resolved to the authenticated user so users can persist their own settings
+// without knowing their exact user name.
+func (r *REST) Update(ctx kapi.Context, name string, objInfo rest.UpdatedObjectInfo) (runtime.Object, bool, error) {
+	name, err := resolveName(ctx, name)
+	if err != nil {
+		return nil, false, err
+	}
+
+	objInfo = rest.WrapUpdatedObjectInfo(objInfo, func(ctx kapi.Context, newObj runtime.Object, oldObj runtime.Object) (runtime.Object, error) {
+		preference, ok := newObj.(*api.UserPreference)
+		if !ok {
+			return nil, kerrs.NewBadRequest("not a UserPreference")
+		}
+		preference.Name = name
+		return preference, nil
+	})
+
+	return r.Store.Update(ctx, name, objInfo)
+}
@@ -0,0 +1,82 @@
+package userpreference
+
+import (
+	kapi "k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/api/rest"
+	"k8s.io/kubernetes/pkg/watch"
+
+	"github.com/openshift/origin/pkg/user/api"
+)
+
+// Registry is an interface implemented by things that know how to store UserPreference objects.
+type Registry interface {
+	// ListUserPreferences obtains a list of user preferences having labels which match selector.
+	ListUserPreferences(ctx kapi.Context, options *kapi.ListOptions) (*api.UserPreferenceList, error)
+	// GetUserPreference returns a specific user preference
+	GetUserPreference(ctx kapi.Context, name string) (*api.UserPreference, error)
+	// CreateUserPreference creates a user preference
+	CreateUserPreference(ctx kapi.Context, preference *api.UserPreference) (*api.UserPreference, error)
+	// UpdateUserPreference updates an existing user preference
+	UpdateUserPreference(ctx kapi.Context, preference *api.UserPreference) (*api.UserPreference, error)
+	// DeleteUserPreference deletes a name.
+	DeleteUserPreference(ctx kapi.Context, name string) error
+	// WatchUserPreferences watches user preferences.
+	WatchUserPreferences(ctx kapi.Context, options *kapi.ListOptions) (watch.Interface, error)
+}
+
+// Storage is an interface for a standard REST Storage backend
+type Storage interface {
+	rest.StandardStorage
+}
+
+// storage puts strong typing around storage calls
+type storage struct {
+	Storage
+}
+
+// NewRegistry returns a new Registry interface for the given Storage. Any mismatched
+// types will panic.
+func NewRegistry(s Storage) Registry {
+	return &storage{s}
+}
+
+func (s *storage) ListUserPreferences(ctx kapi.Context, options *kapi.ListOptions) (*api.UserPreferenceList, error) {
+	obj, err := s.List(ctx, options)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*api.UserPreferenceList), nil
+}
+
+func (s *storage) GetUserPreference(ctx kapi.Context, name string) (*api.UserPreference, error) {
+	obj, err := s.Get(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*api.UserPreference), nil
+}
+
+func (s *storage) CreateUserPreference(ctx kapi.Context, preference *api.UserPreference) (*api.UserPreference, error) {
+	obj, err := s.Create(ctx, preference)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*api.UserPreference), nil
+}
+
+func (s *storage) UpdateUserPreference(ctx kapi.Context, preference *api.UserPreference) (*api.UserPreference, error) {
+	obj, _, err := s.Update(ctx, preference.Name, rest.DefaultUpdatedObjectInfo(preference, kapi.Scheme))
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*api.UserPreference), nil
+}
+
+func (s *storage) DeleteUserPreference(ctx kapi.Context, name string) error {
+	_, err := s.Delete(ctx, name, nil)
+	return err
+}
+
+func (s *storage) WatchUserPreferences(ctx kapi.Context, options *kapi.ListOptions) (watch.Interface, error) {
+	return s.Watch(ctx, options)
+}
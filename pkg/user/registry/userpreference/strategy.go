@@ -0,0 +1,85 @@
+package userpreference
+
+import (
+	"fmt"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/fields"
+	"k8s.io/kubernetes/pkg/labels"
+	"k8s.io/kubernetes/pkg/runtime"
+	kstorage "k8s.io/kubernetes/pkg/storage"
+	"k8s.io/kubernetes/pkg/util/validation/field"
+
+	"github.com/openshift/origin/pkg/user/api"
+	"github.com/openshift/origin/pkg/user/api/validation"
+)
+
+// userPreferenceStrategy implements behavior for UserPreferences
+type userPreferenceStrategy struct {
+	runtime.ObjectTyper
+}
+
+// Strategy is the default logic that applies when creating and updating UserPreference
+// objects via the REST API.
+var Strategy = userPreferenceStrategy{kapi.Scheme}
+
+func (userPreferenceStrategy) PrepareForUpdate(ctx kapi.Context, obj, old runtime.Object) {}
+
+// NamespaceScoped is false for user preferences
+func (userPreferenceStrategy) NamespaceScoped() bool {
+	return false
+}
+
+func (userPreferenceStrategy) GenerateName(base string) string {
+	return base
+}
+
+func (userPreferenceStrategy) PrepareForCreate(ctx kapi.Context, obj runtime.Object) {
+}
+
+// Validate validates a new user preference
+func (userPreferenceStrategy) Validate(ctx kapi.Context, obj runtime.Object) field.ErrorList {
+	return validation.ValidateUserPreference(obj.(*api.UserPreference))
+}
+
+// AllowCreateOnUpdate is true so that users can persist their preferences with a
+// single update to their own object, whether or not one exists yet
+func (userPreferenceStrategy) AllowCreateOnUpdate() bool {
+	return true
+}
+
+func (userPreferenceStrategy) AllowUnconditionalUpdate() bool {
+	return true
+}
+
+// Canonicalize normalizes the object after validation.
+func (userPreferenceStrategy) Canonicalize(obj runtime.Object) {
+}
+
+// ValidateUpdate is the default update validation for an end user preference.
+func (userPreferenceStrategy) ValidateUpdate(ctx kapi.Context, obj, old runtime.Object) field.ErrorList {
+	return validation.ValidateUserPreferenceUpdate(obj.(*api.UserPreference), old.(*api.UserPreference))
+}
+
+// GetAttrs returns labels and fields of a given object for filtering purposes
+func GetAttrs(o runtime.Object) (labels.Set, fields.Set, error) {
+	obj, ok := o.(*api.UserPreference)
+	if !ok {
+		return nil, nil, fmt.Errorf("not a UserPreference")
+	}
+	return labels.Set(obj.Labels), SelectableFields(obj), nil
+}
+
+// Matcher returns a generic matcher for a given label and field selector.
+func Matcher(label labels.Selector, field fields.Selector) kstorage.SelectionPredicate {
+	return kstorage.SelectionPredicate{
+		Label:    label,
+		Field:    field,
+		GetAttrs: GetAttrs,
+	}
+}
+
+// SelectableFields returns a field set that can be used for filter selection
+func SelectableFields(obj *api.UserPreference) fields.Set {
+	return api.UserPreferenceToSelectableFields(obj)
+}
@@ -0,0 +1,137 @@
+package util
+
+import (
+	"fmt"
+	"time"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+
+	buildapi "github.com/openshift/origin/pkg/build/api"
+	deployapi "github.com/openshift/origin/pkg/deploy/api"
+	imageapi "github.com/openshift/origin/pkg/image/api"
+	routeapi "github.com/openshift/origin/pkg/route/api"
+)
+
+// CreateResourceQuota creates a resource quota with the given hard limits in the test
+// namespace of the provided CLI and returns it. The quota is created with admin
+// privileges so tests can constrain resources the test user could not otherwise quota.
+func CreateResourceQuota(oc *CLI, name string, hard kapi.ResourceList) (*kapi.ResourceQuota, error) {
+	rq := &kapi.ResourceQuota{
+		ObjectMeta: kapi.ObjectMeta{Name: name},
+		Spec:       kapi.ResourceQuotaSpec{Hard: hard},
+	}
+	return oc.AdminKubeClient().Core().ResourceQuotas(oc.Namespace()).Create(rq)
+}
+
+// WaitForQuotaUsage waits until the usage of the named quota in the test namespace
+// matches the expected resource list. It returns the masked usage that was observed.
+func WaitForQuotaUsage(oc *CLI, name string, expected kapi.ResourceList, timeout time.Duration) (kapi.ResourceList, error) {
+	return WaitForResourceQuotaSync(
+		oc.KubeClient().Core().ResourceQuotas(oc.Namespace()),
+		name,
+		expected,
+		false,
+		timeout,
+	)
+}
+
+// CreateQuotaImageStreams creates the given number of empty image streams in the test
+// namespace and returns their names. Image streams count against the
+// openshift.io/imagestreams quota resource.
+func CreateQuotaImageStreams(oc *CLI, count int) ([]string, error) {
+	names := []string{}
+	for i := 0; i < count; i++ {
+		is := &imageapi.ImageStream{
+			ObjectMeta: kapi.ObjectMeta{Name: fmt.Sprintf("quota-is-%d", i)},
+		}
+		if _, err := oc.Client().ImageStreams(oc.Namespace()).Create(is); err != nil {
+			return names, err
+		}
+		names = append(names, is.Name)
+	}
+	return names, nil
+}
+
+// CreateQuotaBuildConfigs creates the given number of minimal docker build configs in
+// the test namespace and returns their names.
+func CreateQuotaBuildConfigs(oc *CLI, count int) ([]string, error) {
+	names := []string{}
+	for i := 0; i < count; i++ {
+		bc := &buildapi.BuildConfig{
+			ObjectMeta: kapi.ObjectMeta{Name: fmt.Sprintf("quota-bc-%d", i)},
+		}
+		bc.Spec.Source.Git = &buildapi.GitBuildSource{URI: "https://example.org/repo.git"}
+		bc.Spec.Strategy.DockerStrategy = &buildapi.DockerBuildStrategy{}
+		if _, err := oc.Client().BuildConfigs(oc.Namespace()).Create(bc); err != nil {
+			return names, err
+		}
+		names = append(names, bc.Name)
+	}
+	return names, nil
+}
+
+// CreateQuotaDeploymentConfigs creates the given number of deployment configs with zero
+// replicas in the test namespace and returns their names. The configs deploy nothing
+// but still consume object count quota.
+func CreateQuotaDeploymentConfigs(oc *CLI, count int) ([]string, error) {
+	names := []string{}
+	for i := 0; i < count; i++ {
+		name := fmt.Sprintf("quota-dc-%d", i)
+		dc := &deployapi.DeploymentConfig{
+			ObjectMeta: kapi.ObjectMeta{Name: name},
+			Spec: deployapi.DeploymentConfigSpec{
+				Replicas: 0,
+				Selector: map[string]string{"name": name},
+				Template: &kapi.PodTemplateSpec{
+					ObjectMeta: kapi.ObjectMeta{Labels: map[string]string{"name": name}},
+					Spec: kapi.PodSpec{
+						Containers: []kapi.Container{
+							{
+								Name:  "idle",
+								Image: "openshift/hello-openshift",
+							},
+						},
+					},
+				},
+			},
+		}
+		if _, err := oc.Client().DeploymentConfigs(oc.Namespace()).Create(dc); err != nil {
+			return names, err
+		}
+		names = append(names, dc.Name)
+	}
+	return names, nil
+}
+
+// CreateQuotaRoutes creates the given number of routes pointing at the named service in
+// the test namespace and returns their names. The service does not need to exist for
+// the routes to be admitted into quota.
+func CreateQuotaRoutes(oc *CLI, serviceName string, count int) ([]string, error) {
+	names := []string{}
+	for i := 0; i < count; i++ {
+		route := &routeapi.Route{
+			ObjectMeta: kapi.ObjectMeta{Name: fmt.Sprintf("quota-route-%d", i)},
+			Spec: routeapi.RouteSpec{
+				To: routeapi.RouteTargetReference{
+					Kind: "Service",
+					Name: serviceName,
+				},
+			},
+		}
+		if _, err := oc.Client().Routes(oc.Namespace()).Create(route); err != nil {
+			return names, err
+		}
+		names = append(names, route.Name)
+	}
+	return names, nil
+}
+
+// AssertQuotaUsage creates objects with the provided create function and then waits for
+// the named quota to report the expected usage. It is a convenience for regression
+// tests of origin-specific quota evaluators.
+func AssertQuotaUsage(oc *CLI, name string, create func() error, expected kapi.ResourceList, timeout time.Duration) (kapi.ResourceList, error) {
+	if err := create(); err != nil {
+		return nil, err
+	}
+	return WaitForQuotaUsage(oc, name, expected, timeout)
+}
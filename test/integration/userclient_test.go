@@ -93,19 +93,19 @@ func TestUserInitialization(t *testing.T) {
 	}
 	identityRegistry := identityregistry.NewRegistry(identityStorage)
 
-	lookup, err := identitymapper.NewIdentityUserMapper(identityRegistry, userRegistry, identitymapper.MappingMethodLookup)
+	lookup, err := identitymapper.NewIdentityUserMapper(identityRegistry, userRegistry, identitymapper.MappingMethodLookup, nil)
 	if err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
-	generate, err := identitymapper.NewIdentityUserMapper(identityRegistry, userRegistry, identitymapper.MappingMethodGenerate)
+	generate, err := identitymapper.NewIdentityUserMapper(identityRegistry, userRegistry, identitymapper.MappingMethodGenerate, nil)
 	if err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
-	add, err := identitymapper.NewIdentityUserMapper(identityRegistry, userRegistry, identitymapper.MappingMethodAdd)
+	add, err := identitymapper.NewIdentityUserMapper(identityRegistry, userRegistry, identitymapper.MappingMethodAdd, nil)
 	if err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
-	claim, err := identitymapper.NewIdentityUserMapper(identityRegistry, userRegistry, identitymapper.MappingMethodClaim)
+	claim, err := identitymapper.NewIdentityUserMapper(identityRegistry, userRegistry, identitymapper.MappingMethodClaim, nil)
 	if err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}